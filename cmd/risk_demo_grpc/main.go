@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/threagile/threagile/pkg/model"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type customRiskRule string
+
+func main() {
+	serveError := model.ServeGrpcPlugin(new(customRiskRule))
+	if serveError != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to serve grpc plugin: %v\n", serveError)
+		os.Exit(-2)
+	}
+}
+
+func (r customRiskRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:                         "demo-grpc",
+		Title:                      "Just a gRPC Demo",
+		Description:                "Demo Description",
+		Impact:                     "Demo Impact",
+		ASVS:                       "Demo ASVS",
+		CheatSheet:                 "https://example.com",
+		Action:                     "Demo Action",
+		Mitigation:                 "Demo Mitigation",
+		Check:                      "Demo Check",
+		Function:                   types.Development,
+		STRIDE:                     types.Tampering,
+		DetectionLogic:             "Demo Detection",
+		RiskAssessment:             "Demo Risk Assessment",
+		FalsePositives:             "Demo False Positive.",
+		ModelFailurePossibleReason: false,
+		CWE:                        0,
+	}
+}
+
+func (r customRiskRule) SupportedTags() []string {
+	return []string{"demo tag"}
+}
+
+func (r customRiskRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	generatedRisks := make([]*types.Risk, 0)
+	for _, techAsset := range parsedModel.TechnicalAssets {
+		generatedRisks = append(generatedRisks, createRisk(techAsset))
+	}
+	return generatedRisks, nil
+}
+
+func createRisk(technicalAsset *types.TechnicalAsset) *types.Risk {
+	category := new(customRiskRule).Category()
+	risk := &types.Risk{
+		CategoryId:                   category.ID,
+		Severity:                     types.CalculateSeverity(types.VeryLikely, types.MediumImpact),
+		ExploitationLikelihood:       types.VeryLikely,
+		ExploitationImpact:           types.MediumImpact,
+		Title:                        "<b>gRPC Demo</b> risk at <b>" + technicalAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
+	return risk
+}
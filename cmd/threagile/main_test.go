@@ -80,3 +80,44 @@ func TestParseModelJson(t *testing.T) {
 		return
 	}
 }
+
+func TestLoadModelJsonFile(t *testing.T) {
+	yamlModelFile := filepath.Join("..", "..", "test", "all.yaml")
+	yamlModel := *new(input.Model).Defaults()
+	yamlLoadError := yamlModel.Load(yamlModelFile)
+	if yamlLoadError != nil {
+		t.Errorf("unable to parse model yaml %q: %v", yamlModelFile, yamlLoadError)
+		return
+	}
+
+	yamlData, yamlMarshalError := json.MarshalIndent(yamlModel, "", "  ")
+	if yamlMarshalError != nil {
+		t.Errorf("unable to print model yaml %q: %v", yamlModelFile, yamlMarshalError)
+		return
+	}
+
+	jsonModelFile := filepath.Join(t.TempDir(), "all.json")
+	writeError := os.WriteFile(jsonModelFile, yamlData, 0600)
+	if writeError != nil {
+		t.Errorf("unable to write model json %q: %v", jsonModelFile, writeError)
+		return
+	}
+
+	jsonModel := *new(input.Model).Defaults()
+	jsonLoadError := jsonModel.Load(jsonModelFile)
+	if jsonLoadError != nil {
+		t.Errorf("unable to parse model json %q: %v", jsonModelFile, jsonLoadError)
+		return
+	}
+
+	jsonData, jsonMarshalError := json.MarshalIndent(jsonModel, "", "  ")
+	if jsonMarshalError != nil {
+		t.Errorf("unable to print model json %q: %v", jsonModelFile, jsonMarshalError)
+		return
+	}
+
+	if string(yamlData) != string(jsonData) {
+		t.Errorf("loading model json is broken; diff: %v", textdiff.Unified(yamlModelFile, jsonModelFile, string(yamlData), string(jsonData)))
+		return
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -32,26 +33,38 @@ type Config struct {
 	TempFolderValue   string `json:"TempFolder,omitempty" yaml:"TempFolder"`
 	KeyFolderValue    string `json:"KeyFolder,omitempty" yaml:"KeyFolder"`
 
-	InputFileValue                   string `json:"InputFile,omitempty" yaml:"InputFile"`
-	ImportedInputFileValue           string `json:"ImportedInputFile,omitempty" yaml:"ImportedInputFile"`
-	DataFlowDiagramFilenamePNGValue  string `json:"DataFlowDiagramFilenamePNG,omitempty" yaml:"DataFlowDiagramFilenamePNG"`
-	DataAssetDiagramFilenamePNGValue string `json:"DataAssetDiagramFilenamePNG,omitempty" yaml:"DataAssetDiagramFilenamePNG"`
-	DataFlowDiagramFilenameDOTValue  string `json:"DataFlowDiagramFilenameDOT,omitempty" yaml:"DataFlowDiagramFilenameDOT"`
-	DataAssetDiagramFilenameDOTValue string `json:"DataAssetDiagramFilenameDOT,omitempty" yaml:"DataAssetDiagramFilenameDOT"`
-	ReportFilenameValue              string `json:"ReportFilename,omitempty" yaml:"ReportFilename"`
-	ExcelRisksFilenameValue          string `json:"ExcelRisksFilename,omitempty" yaml:"ExcelRisksFilename"`
-	ExcelTagsFilenameValue           string `json:"ExcelTagsFilename,omitempty" yaml:"ExcelTagsFilename"`
-	JsonRisksFilenameValue           string `json:"JsonRisksFilename,omitempty" yaml:"JsonRisksFilename"`
-	JsonTechnicalAssetsFilenameValue string `json:"JsonTechnicalAssetsFilename,omitempty" yaml:"JsonTechnicalAssetsFilename"`
-	JsonStatsFilenameValue           string `json:"JsonStatsFilename,omitempty" yaml:"JsonStatsFilename"`
-	TemplateFilenameValue            string `json:"TemplateFilename,omitempty" yaml:"TemplateFilename"`
-	ReportLogoImagePathValue         string `json:"ReportLogoImagePath,omitempty" yaml:"ReportLogoImagePath"`
-	TechnologyFilenameValue          string `json:"TechnologyFilename,omitempty" yaml:"TechnologyFilename"`
-
-	RiskRulePluginsValue   []string        `json:"RiskRulePlugins,omitempty" yaml:"RiskRulePlugins"`
-	SkipRiskRulesValue     []string        `json:"SkipRiskRules,omitempty" yaml:"SkipRiskRules"`
-	ExecuteModelMacroValue string          `json:"ExecuteModelMacro,omitempty" yaml:"ExecuteModelMacro"`
-	RiskExcelValue         RiskExcelConfig `json:"RiskExcel" yaml:"RiskExcel"`
+	InputFileValue                   string            `json:"InputFile,omitempty" yaml:"InputFile"`
+	ImportedInputFileValue           string            `json:"ImportedInputFile,omitempty" yaml:"ImportedInputFile"`
+	ModelOverlaysValue               []string          `json:"ModelOverlays,omitempty" yaml:"ModelOverlays"`
+	ModelVariablesValue              map[string]string `json:"ModelVariables,omitempty" yaml:"ModelVariables"`
+	DataFlowDiagramFilenamePNGValue  string            `json:"DataFlowDiagramFilenamePNG,omitempty" yaml:"DataFlowDiagramFilenamePNG"`
+	DataAssetDiagramFilenamePNGValue string            `json:"DataAssetDiagramFilenamePNG,omitempty" yaml:"DataAssetDiagramFilenamePNG"`
+	DataFlowDiagramFilenameDOTValue  string            `json:"DataFlowDiagramFilenameDOT,omitempty" yaml:"DataFlowDiagramFilenameDOT"`
+	DataAssetDiagramFilenameDOTValue string            `json:"DataAssetDiagramFilenameDOT,omitempty" yaml:"DataAssetDiagramFilenameDOT"`
+	ReportFilenameValue              string            `json:"ReportFilename,omitempty" yaml:"ReportFilename"`
+	ExcelRisksFilenameValue          string            `json:"ExcelRisksFilename,omitempty" yaml:"ExcelRisksFilename"`
+	ExcelTagsFilenameValue           string            `json:"ExcelTagsFilename,omitempty" yaml:"ExcelTagsFilename"`
+	JsonRisksFilenameValue           string            `json:"JsonRisksFilename,omitempty" yaml:"JsonRisksFilename"`
+	JsonTechnicalAssetsFilenameValue string            `json:"JsonTechnicalAssetsFilename,omitempty" yaml:"JsonTechnicalAssetsFilename"`
+	JsonStatsFilenameValue           string            `json:"JsonStatsFilename,omitempty" yaml:"JsonStatsFilename"`
+	TemplateFilenameValue            string            `json:"TemplateFilename,omitempty" yaml:"TemplateFilename"`
+	ReportLogoImagePathValue         string            `json:"ReportLogoImagePath,omitempty" yaml:"ReportLogoImagePath"`
+	TechnologyFilenameValue          string            `json:"TechnologyFilename,omitempty" yaml:"TechnologyFilename"`
+
+	RiskRulePluginsValue         []string        `json:"RiskRulePlugins,omitempty" yaml:"RiskRulePlugins"`
+	RiskRulesFolderValue         string          `json:"RiskRulesFolder,omitempty" yaml:"RiskRulesFolder"`
+	GrpcPluginFolderValue        string          `json:"GrpcPluginFolder,omitempty" yaml:"GrpcPluginFolder"`
+	GrpcPluginTimeoutValue       int             `json:"GrpcPluginTimeout,omitempty" yaml:"GrpcPluginTimeout"`
+	RiskRulesConfigFilenameValue string          `json:"RiskRulesConfigFilename,omitempty" yaml:"RiskRulesConfigFilename"`
+	RiskRulesProfileValue        string          `json:"RiskRulesProfile,omitempty" yaml:"RiskRulesProfile"`
+	SkipRiskRulesValue           []string        `json:"SkipRiskRules,omitempty" yaml:"SkipRiskRules"`
+	RiskRuleWorkersValue         int             `json:"RiskRuleWorkers,omitempty" yaml:"RiskRuleWorkers"`
+	RiskCacheFilenameValue       string          `json:"RiskCacheFilename,omitempty" yaml:"RiskCacheFilename"`
+	ConsolidateRisksValue        bool            `json:"ConsolidateRisks,omitempty" yaml:"ConsolidateRisks"`
+	RiskLabelsValue              []string        `json:"RiskLabels,omitempty" yaml:"RiskLabels"`
+	RuleTracesFilenameValue      string          `json:"RuleTracesFilename,omitempty" yaml:"RuleTracesFilename"`
+	ExecuteModelMacroValue       string          `json:"ExecuteModelMacro,omitempty" yaml:"ExecuteModelMacro"`
+	RiskExcelValue               RiskExcelConfig `json:"RiskExcel" yaml:"RiskExcel"`
 
 	ServerModeValue               bool `json:"ServerMode,omitempty" yaml:"ServerMode"`
 	ServerPortValue               int  `json:"ServerPort,omitempty" yaml:"ServerPort"`
@@ -93,6 +106,8 @@ type ConfigGetter interface {
 	GetKeyFolder() string
 	GetTechnologyFilename() string
 	GetInputFile() string
+	GetModelOverlays() []string
+	GetModelVariables() map[string]string
 	GetDataFlowDiagramFilenamePNG() string
 	GetDataAssetDiagramFilenamePNG() string
 	GetDataFlowDiagramFilenameDOT() string
@@ -106,7 +121,17 @@ type ConfigGetter interface {
 	GetReportLogoImagePath() string
 	GetTemplateFilename() string
 	GetRiskRulePlugins() []string
+	GetRiskRulesFolder() string
+	GetGrpcPluginFolder() string
+	GetGrpcPluginTimeout() time.Duration
+	GetRiskRulesConfigFilename() string
+	GetRiskRulesProfile() string
 	GetSkipRiskRules() []string
+	GetRiskRuleWorkers() int
+	GetRiskCacheFilename() string
+	GetConsolidateRisks() bool
+	GetRiskLabels() []string
+	GetRuleTracesFilename() string
 	GetExecuteModelMacro() string
 	GetRiskExcelConfigHideColumns() []string
 	GetRiskExcelConfigSortByColumns() []string
@@ -149,9 +174,21 @@ type ConfigSetter interface {
 	SetServerFolder(serverFolder string)
 	SetTempFolder(tempFolder string)
 	SetInputFile(inputFile string)
+	SetModelOverlays(modelOverlays []string)
+	SetModelVariables(modelVariables map[string]string)
 	SetTemplateFilename(templateFilename string)
 	SetRiskRulePlugins(riskRulePlugins []string)
+	SetRiskRulesFolder(riskRulesFolder string)
+	SetGrpcPluginFolder(grpcPluginFolder string)
+	SetGrpcPluginTimeout(grpcPluginTimeout int)
+	SetRiskRulesConfigFilename(riskRulesConfigFilename string)
+	SetRiskRulesProfile(riskRulesProfile string)
 	SetSkipRiskRules(skipRiskRules []string)
+	SetRiskRuleWorkers(riskRuleWorkers int)
+	SetRiskCacheFilename(riskCacheFilename string)
+	SetConsolidateRisks(consolidateRisks bool)
+	SetRiskLabels(riskLabels []string)
+	SetRuleTracesFilename(ruleTracesFilename string)
 	SetServerMode(serverMode bool)
 	SetServerPort(serverPort int)
 	SetDiagramDPI(diagramDPI int)
@@ -173,6 +210,8 @@ func (c *Config) Defaults(buildTimestamp string) *Config {
 		KeyFolderValue:    KeyDir,
 
 		InputFileValue:                   InputFile,
+		ModelOverlaysValue:               make([]string, 0),
+		ModelVariablesValue:              make(map[string]string),
 		DataFlowDiagramFilenamePNGValue:  DataFlowDiagramFilenamePNG,
 		DataAssetDiagramFilenamePNGValue: DataAssetDiagramFilenamePNG,
 		DataFlowDiagramFilenameDOTValue:  DataFlowDiagramFilenameDOT,
@@ -187,9 +226,19 @@ func (c *Config) Defaults(buildTimestamp string) *Config {
 		ReportLogoImagePathValue:         ReportLogoImagePath,
 		TechnologyFilenameValue:          "",
 
-		RiskRulePluginsValue:   make([]string, 0),
-		SkipRiskRulesValue:     make([]string, 0),
-		ExecuteModelMacroValue: "",
+		RiskRulePluginsValue:         make([]string, 0),
+		RiskRulesFolderValue:         "",
+		GrpcPluginFolderValue:        "",
+		GrpcPluginTimeoutValue:       DefaultGrpcPluginTimeout,
+		RiskRulesConfigFilenameValue: "",
+		RiskRulesProfileValue:        "",
+		SkipRiskRulesValue:           make([]string, 0),
+		RiskRuleWorkersValue:         0,
+		RiskCacheFilenameValue:       "",
+		ConsolidateRisksValue:        false,
+		RiskLabelsValue:              make([]string, 0),
+		RuleTracesFilenameValue:      "",
+		ExecuteModelMacroValue:       "",
 		RiskExcelValue: RiskExcelConfig{
 			HideColumns:        make([]string, 0),
 			SortByColumns:      make([]string, 0),
@@ -310,6 +359,30 @@ func (c *Config) Load(configFilename string) error {
 		c.TechnologyFilenameValue = c.CleanPath(c.TechnologyFilenameValue)
 	}
 
+	if c.RiskRulesFolderValue != "" {
+		c.RiskRulesFolderValue = c.CleanPath(c.RiskRulesFolderValue)
+	}
+
+	if c.GrpcPluginFolderValue != "" {
+		c.GrpcPluginFolderValue = c.CleanPath(c.GrpcPluginFolderValue)
+	}
+
+	if c.RiskRulesConfigFilenameValue != "" {
+		c.RiskRulesConfigFilenameValue = c.CleanPath(c.RiskRulesConfigFilenameValue)
+	}
+
+	if c.RiskCacheFilenameValue != "" {
+		c.RiskCacheFilenameValue = c.CleanPath(c.RiskCacheFilenameValue)
+	}
+
+	for index, overlay := range c.ModelOverlaysValue {
+		c.ModelOverlaysValue[index] = c.CleanPath(overlay)
+	}
+
+	if c.RuleTracesFilenameValue != "" {
+		c.RuleTracesFilenameValue = c.CleanPath(c.RuleTracesFilenameValue)
+	}
+
 	serverFolderError := c.CheckServerFolder()
 	if serverFolderError != nil {
 		errorList = append(errorList, serverFolderError)
@@ -378,6 +451,12 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("ImportedInputFile"):
 			c.ImportedInputFileValue = config.ImportedInputFileValue
 
+		case strings.ToLower("ModelOverlays"):
+			c.ModelOverlaysValue = config.ModelOverlaysValue
+
+		case strings.ToLower("ModelVariables"):
+			c.ModelVariablesValue = config.ModelVariablesValue
+
 		case strings.ToLower("DataFlowDiagramFilenamePNG"):
 			c.DataFlowDiagramFilenamePNGValue = config.DataFlowDiagramFilenamePNGValue
 
@@ -420,9 +499,39 @@ func (c *Config) Merge(config Config, values map[string]any) {
 		case strings.ToLower("RiskRulePlugins"):
 			c.RiskRulePluginsValue = config.RiskRulePluginsValue
 
+		case strings.ToLower("RiskRulesFolder"):
+			c.RiskRulesFolderValue = config.RiskRulesFolderValue
+
+		case strings.ToLower("GrpcPluginFolder"):
+			c.GrpcPluginFolderValue = config.GrpcPluginFolderValue
+
+		case strings.ToLower("GrpcPluginTimeout"):
+			c.GrpcPluginTimeoutValue = config.GrpcPluginTimeoutValue
+
+		case strings.ToLower("RiskRulesConfigFilename"):
+			c.RiskRulesConfigFilenameValue = config.RiskRulesConfigFilenameValue
+
+		case strings.ToLower("RiskRulesProfile"):
+			c.RiskRulesProfileValue = config.RiskRulesProfileValue
+
 		case strings.ToLower("SkipRiskRules"):
 			c.SkipRiskRulesValue = config.SkipRiskRulesValue
 
+		case strings.ToLower("RiskRuleWorkers"):
+			c.RiskRuleWorkersValue = config.RiskRuleWorkersValue
+
+		case strings.ToLower("RiskCacheFilename"):
+			c.RiskCacheFilenameValue = config.RiskCacheFilenameValue
+
+		case strings.ToLower("ConsolidateRisks"):
+			c.ConsolidateRisksValue = config.ConsolidateRisksValue
+
+		case strings.ToLower("RiskLabels"):
+			c.RiskLabelsValue = config.RiskLabelsValue
+
+		case strings.ToLower("RuleTracesFilename"):
+			c.RuleTracesFilenameValue = config.RuleTracesFilenameValue
+
 		case strings.ToLower("ExecuteModelMacro"):
 			c.ExecuteModelMacroValue = config.ExecuteModelMacroValue
 
@@ -650,6 +759,22 @@ func (c *Config) SetImportedInputFile(inputFile string) {
 	c.ImportedInputFileValue = inputFile
 }
 
+func (c *Config) GetModelOverlays() []string {
+	return c.ModelOverlaysValue
+}
+
+func (c *Config) SetModelOverlays(modelOverlays []string) {
+	c.ModelOverlaysValue = modelOverlays
+}
+
+func (c *Config) GetModelVariables() map[string]string {
+	return c.ModelVariablesValue
+}
+
+func (c *Config) SetModelVariables(modelVariables map[string]string) {
+	c.ModelVariablesValue = modelVariables
+}
+
 func (c *Config) GetDataFlowDiagramFilenamePNG() string {
 	return c.DataFlowDiagramFilenamePNGValue
 }
@@ -710,6 +835,46 @@ func (c *Config) SetRiskRulePlugins(riskRulePlugins []string) {
 	c.RiskRulePluginsValue = riskRulePlugins
 }
 
+func (c *Config) GetRiskRulesFolder() string {
+	return c.RiskRulesFolderValue
+}
+
+func (c *Config) SetRiskRulesFolder(riskRulesFolder string) {
+	c.RiskRulesFolderValue = riskRulesFolder
+}
+
+func (c *Config) GetGrpcPluginFolder() string {
+	return c.GrpcPluginFolderValue
+}
+
+func (c *Config) SetGrpcPluginFolder(grpcPluginFolder string) {
+	c.GrpcPluginFolderValue = grpcPluginFolder
+}
+
+func (c *Config) GetGrpcPluginTimeout() time.Duration {
+	return time.Duration(c.GrpcPluginTimeoutValue) * time.Second
+}
+
+func (c *Config) SetGrpcPluginTimeout(grpcPluginTimeout int) {
+	c.GrpcPluginTimeoutValue = grpcPluginTimeout
+}
+
+func (c *Config) GetRiskRulesConfigFilename() string {
+	return c.RiskRulesConfigFilenameValue
+}
+
+func (c *Config) SetRiskRulesConfigFilename(riskRulesConfigFilename string) {
+	c.RiskRulesConfigFilenameValue = riskRulesConfigFilename
+}
+
+func (c *Config) GetRiskRulesProfile() string {
+	return c.RiskRulesProfileValue
+}
+
+func (c *Config) SetRiskRulesProfile(riskRulesProfile string) {
+	c.RiskRulesProfileValue = riskRulesProfile
+}
+
 func (c *Config) GetSkipRiskRules() []string {
 	return c.SkipRiskRulesValue
 }
@@ -718,6 +883,46 @@ func (c *Config) SetSkipRiskRules(skipRiskRules []string) {
 	c.SkipRiskRulesValue = skipRiskRules
 }
 
+func (c *Config) GetRiskRuleWorkers() int {
+	return c.RiskRuleWorkersValue
+}
+
+func (c *Config) SetRiskRuleWorkers(riskRuleWorkers int) {
+	c.RiskRuleWorkersValue = riskRuleWorkers
+}
+
+func (c *Config) GetRiskCacheFilename() string {
+	return c.RiskCacheFilenameValue
+}
+
+func (c *Config) SetRiskCacheFilename(riskCacheFilename string) {
+	c.RiskCacheFilenameValue = riskCacheFilename
+}
+
+func (c *Config) GetConsolidateRisks() bool {
+	return c.ConsolidateRisksValue
+}
+
+func (c *Config) SetConsolidateRisks(consolidateRisks bool) {
+	c.ConsolidateRisksValue = consolidateRisks
+}
+
+func (c *Config) GetRiskLabels() []string {
+	return c.RiskLabelsValue
+}
+
+func (c *Config) SetRiskLabels(riskLabels []string) {
+	c.RiskLabelsValue = riskLabels
+}
+
+func (c *Config) GetRuleTracesFilename() string {
+	return c.RuleTracesFilenameValue
+}
+
+func (c *Config) SetRuleTracesFilename(ruleTracesFilename string) {
+	c.RuleTracesFilenameValue = ruleTracesFilename
+}
+
 func (c *Config) GetExecuteModelMacro() string {
 	return c.ExecuteModelMacroValue
 }
@@ -30,6 +30,7 @@ const (
 	MinGraphvizDPI                  = 20
 	MaxGraphvizDPI                  = 300
 	DefaultBackupHistoryFilesToKeep = 50
+	DefaultGrpcPluginTimeout        = 300
 )
 
 const (
@@ -37,12 +38,14 @@ const (
 	CreateExampleModelCommand   = "create-example-model"
 	CreateStubModelCommand      = "create-stub-model"
 	CreateEditingSupportCommand = "create-editing-support"
+	CreateCustomRuleCommand     = "create-custom-rule"
 	ImportModelCommand         	= "import-model"
 	ListTypesCommand            = "list-types"
 	ListRiskRulesCommand        = "list-risk-rules"
 	ListModelMacrosCommand      = "list-model-macros"
 	Print3rdPartyCommand        = "print-3rd-party-licenses"
 	PrintLicenseCommand         = "print-license"
+	QueryRisksCommand           = "query-risks"
 
 	CreateCommand       = "create"
 	ExplainCommand      = "explain"
@@ -6,6 +6,7 @@ package threagile
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/spf13/cobra"
 	"github.com/threagile/threagile/pkg/examples"
@@ -111,5 +112,43 @@ func (what *Threagile) initCreate() *Threagile {
 		},
 	})
 
+	what.rootCmd.AddCommand(&cobra.Command{
+		Use:   CreateCustomRuleCommand + " <rule-id> [" + examples.CustomRuleGo + "|" + examples.CustomRuleCEL + "|" + examples.CustomRuleStarlark + "]",
+		Short: "Create custom risk rule",
+		Long: "\n" + Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp) + "\n\njust create the skeleton of a custom risk rule with category metadata and a sample detection in the output directory: " +
+			"a Go module serving the rule as gRPC plugin along with ready-to-run tests (default), a CEL risk rule or a Starlark risk rule",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			what.processArgs(cmd, args)
+
+			kind := examples.CustomRuleGo
+			if len(args) > 1 {
+				kind = args[1]
+			}
+
+			files, err := examples.CreateCustomRule(what.config.GetOutputFolder(), args[0], kind)
+			if err != nil {
+				cmd.Printf("Unable to create custom risk rule: %v", err)
+				return err
+			}
+
+			cmd.Println(Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp))
+			cmd.Println("The following files were created:")
+			sort.Strings(files)
+			for _, file := range files {
+				cmd.Println(" - " + file)
+			}
+			cmd.Println()
+			switch kind {
+			case examples.CustomRuleGo:
+				cmd.Println("Run \"go mod tidy\" and \"go test ./...\" in the module folder, then build it and copy the executable into the folder given by -custom-risk-rules-grpc-dir.")
+			default:
+				cmd.Println("Copy the risk rule into the folder given by -custom-risk-rules-dir.")
+			}
+			cmd.Println()
+			return nil
+		},
+	})
+
 	return what
 }
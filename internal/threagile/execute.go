@@ -24,11 +24,21 @@ func (what *Threagile) initExecute() *Threagile {
 
 			progressReporter := DefaultProgressReporter{Verbose: what.config.GetVerbose()}
 
+			// macros write the model back to the input file, which would then contain the changes of the overlays
+			if len(what.config.GetModelOverlays()) > 0 {
+				return fmt.Errorf("model macros cannot be executed with model overlays")
+			}
+
 			r, err := model.ReadAndAnalyzeModel(what.config, risks.GetBuiltInRiskRules(), progressReporter)
 			if err != nil {
 				return fmt.Errorf("unable to read and analyze model: %w", err)
 			}
 
+			// as well as the values of the model variables instead of the variables
+			if len(r.ModelInput.Variables) > 0 {
+				return fmt.Errorf("model macros cannot be executed with model variables")
+			}
+
 			macrosId := args[0]
 			err = macros.ExecuteModelMacro(r.ModelInput, what.config.GetInputFile(), r.ParsedModel, macrosId)
 			if err != nil {
@@ -61,10 +61,17 @@ func (what *Threagile) explainRisk(cmd *cobra.Command, args []string) error {
 		return runError
 	}
 
-	// todo: implement this
+	for _, risk := range args {
+		cmd.Printf("Explanation for risk %q:\n", risk)
+		explainError := result.ExplainRisk(what.config, risk, cmd)
+		if explainError != nil {
+			cmd.Printf("Failed to explain risk %q: %v\n", risk, explainError)
+			return explainError
+		}
+		cmd.Println()
+	}
 
-	_ = result
-	return fmt.Errorf("not implemented yet")
+	return nil
 }
 
 func (what *Threagile) explainRules(cmd *cobra.Command, args []string) error {
@@ -76,7 +83,7 @@ func (what *Threagile) explainRules(cmd *cobra.Command, args []string) error {
 	cmd.Println("----------------------")
 	cmd.Println("Custom risk rules:")
 	cmd.Println("----------------------")
-	customRiskRules := model.LoadCustomRiskRules(what.config.GetPluginFolder(), what.config.GetRiskRulePlugins(), DefaultProgressReporter{Verbose: what.config.GetVerbose()})
+	customRiskRules := model.LoadCustomRiskRules(what.config.GetPluginFolder(), what.config.GetRiskRulePlugins(), what.config.GetRiskRulesFolder(), what.config.GetGrpcPluginFolder(), what.config.GetGrpcPluginTimeout(), DefaultProgressReporter{Verbose: what.config.GetVerbose()})
 	for _, rule := range customRiskRules {
 		cmd.Printf("%v: %v\n", rule.Category().ID, rule.Category().Description)
 	}
@@ -23,6 +23,8 @@ const (
 
 	inputFileFlagName               = "model"
 	importedFileFlagName            = "imported-model"
+	modelOverlaysFlagName           = "model-overlays"
+	setModelVariableFlagName        = "set"
 	dataFlowDiagramPNGFileFlagName  = "data-flow-diagram-png"
 	dataAssetDiagramPNGFileFlagName = "data-asset-diagram-png"
 	dataFlowDiagramDOTFileFlagName  = "data-flow-diagram-dot"
@@ -38,7 +40,17 @@ const (
 	technologyFileFlagName          = "technology"
 
 	customRiskRulesPluginFlagName = "custom-risk-rules-plugin"
+	customRiskRulesDirFlagName    = "custom-risk-rules-dir"
+	grpcPluginDirFlagName         = "custom-risk-rules-grpc-dir"
+	grpcPluginTimeoutFlagName     = "custom-risk-rules-grpc-timeout"
+	riskRulesConfigFlagName       = "risk-rules-config"
+	riskRulesProfileFlagName      = "risk-rules-profile"
 	skipRiskRulesFlagName         = "skip-risk-rules"
+	riskRuleWorkersFlagName       = "risk-rule-workers"
+	riskCacheFlagName             = "risk-cache"
+	consolidateRisksFlagName      = "consolidate-risks"
+	riskLabelsFlagName            = "risk-labels"
+	ruleTracesFlagName            = "rule-traces"
 	executeModelMacroFlagName     = "execute-model-macro"
 
 	serverModeFlagName               = "server-mode"
@@ -78,6 +90,9 @@ type Flags struct {
 	configFlag           string
 	riskRulePluginsValue string
 	skipRiskRulesValue   string
+	riskLabelsValue      string
+	modelOverlaysValue   string
+	modelVariablesValue  []string
 
 	generateDataFlowDiagramFlag     bool // deprecated
 	generateDataAssetDiagramFlag    bool // deprecated
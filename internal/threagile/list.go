@@ -16,23 +16,36 @@ func (what *Threagile) initList() *Threagile {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			what.processArgs(cmd, args)
 
+			registry := risks.NewBuiltInRegistry()
+			registry.RegisterAll(model.LoadCustomRiskRules(what.config.GetPluginFolder(), what.config.GetRiskRulePlugins(), what.config.GetRiskRulesFolder(), what.config.GetGrpcPluginFolder(), what.config.GetGrpcPluginTimeout(), DefaultProgressReporter{Verbose: what.config.GetVerbose()}), risks.PluginRule)
+
+			disableError := what.disableSkippedRiskRules(registry)
+			if disableError != nil {
+				cmd.Printf("Unable to apply risk rule profile: %v", disableError)
+				return disableError
+			}
+
 			cmd.Println(Logo + "\n\n" + fmt.Sprintf(VersionText, what.buildTimestamp))
 			cmd.Println("The following risk rules are available (can be extended via custom risk rules):")
 			cmd.Println()
-			cmd.Println("----------------------")
-			cmd.Println("Custom risk rules:")
-			cmd.Println("----------------------")
-			customRiskRules := model.LoadCustomRiskRules(what.config.GetPluginFolder(), what.config.GetRiskRulePlugins(), DefaultProgressReporter{Verbose: what.config.GetVerbose()})
-			for id, customRule := range customRiskRules {
-				cmd.Println(id, "-->", customRule.Category().Title, "--> with tags:", customRule.SupportedTags())
+			if len(what.config.GetRiskRulesProfile()) > 0 {
+				cmd.Printf("Enabled by risk rule profile %q", what.config.GetRiskRulesProfile())
+			} else {
+				cmd.Print("Enabled without risk rule profile")
+			}
+			if len(what.config.GetSkipRiskRules()) > 0 {
+				cmd.Printf(", skipping %v", what.config.GetSkipRiskRules())
 			}
 			cmd.Println()
-			cmd.Println("--------------------")
-			cmd.Println("Built-in risk rules:")
-			cmd.Println("--------------------")
 			cmd.Println()
-			for _, rule := range risks.GetBuiltInRiskRules() {
-				cmd.Println(rule.Category().ID, "-->", rule.Category().Title, "--> with tags:", rule.SupportedTags())
+			for _, rule := range registry.List() {
+				status := "disabled"
+				if rule.Enabled {
+					status = "enabled"
+				}
+
+				cmd.Printf("%v --> %v --> %v, %v --> STRIDE: %v, CWE: %v --> with tags: %v --> labels: %v\n",
+					rule.Category().ID, rule.Category().Title, rule.Source, status, rule.Category().STRIDE, rule.Category().CWE, rule.SupportedTags(), rule.Category().Labels)
 			}
 
 			return nil
@@ -86,3 +99,24 @@ func (what *Threagile) initList() *Threagile {
 
 	return what
 }
+
+// disableSkippedRiskRules disables the risk rules not enabled by the active risk rule profile and the risk rules to skip
+func (what *Threagile) disableSkippedRiskRules(registry *risks.Registry) error {
+	rulesConfig, rulesConfigError := model.LoadRulesConfig(what.config.GetRiskRulesConfigFilename())
+	if rulesConfigError != nil {
+		return rulesConfigError
+	}
+
+	skipped, profileError := rulesConfig.SkippedRules(what.config.GetRiskRulesProfile(), risks.GetBuiltInRiskRuleProfiles(), registry.RiskRules())
+	if profileError != nil {
+		return profileError
+	}
+
+	for _, id := range what.config.GetSkipRiskRules() {
+		if _, ok := registry.Get(id); ok {
+			skipped = append(skipped, id)
+		}
+	}
+
+	return registry.Disable(skipped...)
+}
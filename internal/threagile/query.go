@@ -0,0 +1,56 @@
+package threagile
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/threagile/threagile/pkg/model"
+	"github.com/threagile/threagile/pkg/risks"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func (what *Threagile) initQuery() *Threagile {
+	what.rootCmd.AddCommand(&cobra.Command{
+		Use:   QueryRisksCommand + " <query>...",
+		Short: "Print the synthetic ids of the risks matching any of the queries",
+		Long: "Print the synthetic ids of the risks matching any of the queries, one per line.\n" +
+			"A query consists of whitespace separated terms, all of which have to match:\n" +
+			"  <category>@<id>   risk id pattern with \"*\" as wildcard, e.g. \"sql-nosql-injection@*\" or \"*@sql-database\"\n" +
+			"  tag=<tag>         the most relevant model elements of the risk are tagged with the tag\n" +
+			"  severity=<value>  the risk severity, also severity>=<value> and severity<=<value>",
+		Args: cobra.MinimumNArgs(1),
+		RunE: what.queryRisks,
+	})
+
+	return what
+}
+
+func (what *Threagile) queryRisks(cmd *cobra.Command, args []string) error {
+	what.processArgs(cmd, args)
+
+	queries := make([]*types.RiskQuery, 0)
+	for _, arg := range args {
+		query, queryError := types.ParseRiskQuery(arg)
+		if queryError != nil {
+			return queryError
+		}
+
+		queries = append(queries, query)
+	}
+
+	result, runError := model.ReadAndAnalyzeModel(what.config, risks.GetBuiltInRiskRules(), DefaultProgressReporter{Verbose: what.config.GetVerbose()})
+	if runError != nil {
+		cmd.Printf("Failed to read and analyze model: %v", runError)
+		return runError
+	}
+
+	printed := make(map[string]bool)
+	for _, query := range queries {
+		for _, risk := range result.ParsedModel.QueryRisks(query) {
+			if !printed[risk.SyntheticId] {
+				printed[risk.SyntheticId] = true
+				cmd.Println(risk.SyntheticId)
+			}
+		}
+	}
+
+	return nil
+}
@@ -70,8 +70,10 @@ func (what *Threagile) initFlags() *Threagile {
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.TempFolderValue, tempDirFlagName, what.config.GetTempFolder(), "temporary folder location")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.KeyFolderValue, keyDirFlagName, what.config.GetKeyFolder(), "key folder location")
 
-	what.rootCmd.PersistentFlags().StringVar(&what.flags.InputFileValue, inputFileFlagName, what.config.GetInputFile(), "input model yaml file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.InputFileValue, inputFileFlagName, what.config.GetInputFile(), "input model yaml or json file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ImportedInputFileValue, importedFileFlagName, what.config.GetImportedInputFile(), "imported input model yaml file")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.modelOverlaysValue, modelOverlaysFlagName, strings.Join(what.config.GetModelOverlays(), ","), "comma-separated list of model overlay files applied to the input model in order")
+	what.rootCmd.PersistentFlags().StringArrayVar(&what.flags.modelVariablesValue, setModelVariableFlagName, nil, "set a model variable as key=value, overriding the variables of the model (may be repeated)")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.DataFlowDiagramFilenamePNGValue, dataFlowDiagramPNGFileFlagName, what.config.GetDataFlowDiagramFilenamePNG(), "data flow diagram PNG file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.DataAssetDiagramFilenamePNGValue, dataAssetDiagramPNGFileFlagName, what.config.GetDataAssetDiagramFilenamePNG(), "data asset diagram PNG file")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.DataFlowDiagramFilenameDOTValue, dataFlowDiagramDOTFileFlagName, what.config.GetDataFlowDiagramFilenameDOT(), "data flow diagram DOT file")
@@ -87,7 +89,17 @@ func (what *Threagile) initFlags() *Threagile {
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.TechnologyFilenameValue, technologyFileFlagName, what.config.GetTechnologyFilename(), "file name of additional technologies")
 
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.riskRulePluginsValue, customRiskRulesPluginFlagName, strings.Join(what.config.GetRiskRulePlugins(), ","), "comma-separated list of plugins file names with custom risk rules to load")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.RiskRulesFolderValue, customRiskRulesDirFlagName, what.config.GetRiskRulesFolder(), "directory with custom risk rules written as CEL expressions or Starlark scripts")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.GrpcPluginFolderValue, grpcPluginDirFlagName, what.config.GetGrpcPluginFolder(), "directory with grpc risk rule plugins to load")
+	what.rootCmd.PersistentFlags().IntVar(&what.flags.GrpcPluginTimeoutValue, grpcPluginTimeoutFlagName, what.config.GrpcPluginTimeoutValue, "timeout in seconds for each call of a grpc risk rule plugin")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.RiskRulesConfigFilenameValue, riskRulesConfigFlagName, what.config.GetRiskRulesConfigFilename(), "rules config file with parameters of risk rules")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.RiskRulesProfileValue, riskRulesProfileFlagName, what.config.GetRiskRulesProfile(), "name of the risk rule profile selecting the risk rules to run (like owasp-top10, api-security, cloud or minimal)")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.skipRiskRulesValue, skipRiskRulesFlagName, strings.Join(what.config.GetSkipRiskRules(), ","), "comma-separated list of risk rules (by their ID) to skip")
+	what.rootCmd.PersistentFlags().IntVar(&what.flags.RiskRuleWorkersValue, riskRuleWorkersFlagName, what.config.GetRiskRuleWorkers(), "maximum number of risk rules to run concurrently (0 for the number of CPUs)")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.RiskCacheFilenameValue, riskCacheFlagName, what.config.GetRiskCacheFilename(), "risk cache file enabling incremental risk generation for changed technical assets")
+	what.rootCmd.PersistentFlags().BoolVar(&what.flags.ConsolidateRisksValue, consolidateRisksFlagName, what.config.GetConsolidateRisks(), "consolidate risks of the same communication link or technical asset under a primary risk")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.riskLabelsValue, riskLabelsFlagName, strings.Join(what.config.GetRiskLabels(), ","), "comma-separated list of risk labels, only risks with any of these labels are reported")
+	what.rootCmd.PersistentFlags().StringVar(&what.flags.RuleTracesFilenameValue, ruleTracesFlagName, what.config.GetRuleTracesFilename(), "file to write the timing of each risk rule to as JSON")
 	what.rootCmd.PersistentFlags().StringVar(&what.flags.ExecuteModelMacroValue, executeModelMacroFlagName, what.config.GetExecuteModelMacro(), "macro to execute")
 
 	// RiskExcelValue not available as flags
@@ -325,6 +337,29 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.InputFileValue = what.config.CleanPath(what.flags.InputFileValue)
 	}
 
+	if what.isFlagOverridden(cmd, modelOverlaysFlagName) {
+		what.config.ModelOverlaysValue = make([]string, 0)
+		for _, overlay := range strings.Split(what.flags.modelOverlaysValue, ",") {
+			what.config.ModelOverlaysValue = append(what.config.ModelOverlaysValue, what.config.CleanPath(overlay))
+		}
+	}
+
+	if what.isFlagOverridden(cmd, setModelVariableFlagName) {
+		if what.config.ModelVariablesValue == nil {
+			what.config.ModelVariablesValue = make(map[string]string)
+		}
+
+		for _, assignment := range what.flags.modelVariablesValue {
+			name, value, ok := strings.Cut(assignment, "=")
+			if !ok {
+				what.rootCmd.Printf("WARNING: ignoring model variable %q, expected key=value\n", assignment)
+				continue
+			}
+
+			what.config.ModelVariablesValue[name] = value
+		}
+	}
+
 	if what.isFlagOverridden(cmd, dataFlowDiagramPNGFileFlagName) {
 		what.config.DataFlowDiagramFilenamePNGValue = what.config.CleanPath(what.flags.DataFlowDiagramFilenamePNGValue)
 	}
@@ -381,10 +416,50 @@ func (what *Threagile) processArgs(cmd *cobra.Command, args []string) bool {
 		what.config.RiskRulePluginsValue = strings.Split(what.flags.riskRulePluginsValue, ",")
 	}
 
+	if what.isFlagOverridden(cmd, customRiskRulesDirFlagName) {
+		what.config.RiskRulesFolderValue = what.config.CleanPath(what.flags.RiskRulesFolderValue)
+	}
+
+	if what.isFlagOverridden(cmd, grpcPluginDirFlagName) {
+		what.config.GrpcPluginFolderValue = what.config.CleanPath(what.flags.GrpcPluginFolderValue)
+	}
+
+	if what.isFlagOverridden(cmd, grpcPluginTimeoutFlagName) {
+		what.config.GrpcPluginTimeoutValue = what.flags.GrpcPluginTimeoutValue
+	}
+
+	if what.isFlagOverridden(cmd, riskRulesConfigFlagName) {
+		what.config.RiskRulesConfigFilenameValue = what.config.CleanPath(what.flags.RiskRulesConfigFilenameValue)
+	}
+
+	if what.isFlagOverridden(cmd, riskRulesProfileFlagName) {
+		what.config.RiskRulesProfileValue = what.flags.RiskRulesProfileValue
+	}
+
 	if what.isFlagOverridden(cmd, skipRiskRulesFlagName) {
 		what.config.SkipRiskRulesValue = strings.Split(what.flags.skipRiskRulesValue, ",")
 	}
 
+	if what.isFlagOverridden(cmd, riskRuleWorkersFlagName) {
+		what.config.RiskRuleWorkersValue = what.flags.RiskRuleWorkersValue
+	}
+
+	if what.isFlagOverridden(cmd, riskCacheFlagName) {
+		what.config.RiskCacheFilenameValue = what.config.CleanPath(what.flags.RiskCacheFilenameValue)
+	}
+
+	if what.isFlagOverridden(cmd, consolidateRisksFlagName) {
+		what.config.ConsolidateRisksValue = what.flags.ConsolidateRisksValue
+	}
+
+	if what.isFlagOverridden(cmd, riskLabelsFlagName) {
+		what.config.RiskLabelsValue = strings.Split(what.flags.riskLabelsValue, ",")
+	}
+
+	if what.isFlagOverridden(cmd, ruleTracesFlagName) {
+		what.config.RuleTracesFilenameValue = what.config.CleanPath(what.flags.RuleTracesFilenameValue)
+	}
+
 	if what.isFlagOverridden(cmd, executeModelMacroFlagName) {
 		what.config.ExecuteModelMacroValue = what.flags.ExecuteModelMacroValue
 	}
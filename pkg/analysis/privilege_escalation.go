@@ -0,0 +1,100 @@
+package analysis
+
+import (
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type PrivilegeEscalationStep struct {
+	TechnicalAsset    *types.TechnicalAsset
+	CommunicationLink *types.CommunicationLink // outgoing link to the next step, nil for the last step
+}
+
+type PrivilegeEscalationPath struct {
+	Steps []*PrivilegeEscalationStep
+}
+
+func (what *PrivilegeEscalationPath) Source() *types.TechnicalAsset {
+	return what.Steps[0].TechnicalAsset
+}
+
+func (what *PrivilegeEscalationPath) Target() *types.TechnicalAsset {
+	return what.Steps[len(what.Steps)-1].TechnicalAsset
+}
+
+func (what *PrivilegeEscalationPath) TechnicalAssetIDs() []string {
+	result := make([]string, 0)
+	for _, step := range what.Steps {
+		result = append(result, step.TechnicalAsset.Id)
+	}
+	return result
+}
+
+// FindPrivilegeEscalationPaths finds chains of authenticated communication links starting at an internet-facing
+// technical asset and ending at a high-privilege technical asset, where the trust level increases with each hop.
+// maxDepth limits the number of communication links per path.
+func FindPrivilegeEscalationPaths(model *types.Model, maxDepth int) []*PrivilegeEscalationPath {
+	paths := make([]*PrivilegeEscalationPath, 0)
+	for _, id := range model.SortedTechnicalAssetIDs() {
+		techAsset := model.TechnicalAssets[id]
+		if !techAsset.Internet || IsHighPrivilege(model, techAsset) {
+			continue
+		}
+
+		visited := map[string]bool{techAsset.Id: true}
+		steps := []*PrivilegeEscalationStep{{TechnicalAsset: techAsset}}
+		findPrivilegeEscalationPaths(model, maxDepth, steps, visited, &paths)
+	}
+	return paths
+}
+
+func findPrivilegeEscalationPaths(model *types.Model, maxDepth int, steps []*PrivilegeEscalationStep, visited map[string]bool, paths *[]*PrivilegeEscalationPath) {
+	if len(steps) > maxDepth {
+		return
+	}
+
+	current := steps[len(steps)-1].TechnicalAsset
+	for _, commLink := range current.CommunicationLinks {
+		if commLink.Authentication == types.NoneAuthentication {
+			continue
+		}
+
+		next, ok := model.TechnicalAssets[commLink.TargetId]
+		if !ok || visited[next.Id] {
+			continue
+		}
+
+		if TrustLevel(model, next) <= TrustLevel(model, current) {
+			continue
+		}
+
+		nextSteps := make([]*PrivilegeEscalationStep, 0, len(steps)+1)
+		nextSteps = append(nextSteps, steps[:len(steps)-1]...)
+		nextSteps = append(nextSteps,
+			&PrivilegeEscalationStep{TechnicalAsset: current, CommunicationLink: commLink},
+			&PrivilegeEscalationStep{TechnicalAsset: next},
+		)
+
+		if IsHighPrivilege(model, next) {
+			if !next.OutOfScope {
+				*paths = append(*paths, &PrivilegeEscalationPath{Steps: nextSteps})
+			}
+			continue
+		}
+
+		visited[next.Id] = true
+		findPrivilegeEscalationPaths(model, maxDepth, nextSteps, visited, paths)
+		delete(visited, next.Id)
+	}
+}
+
+// IsHighPrivilege reports whether a technical asset processes strictly-confidential data or is tagged as 'admin'
+func IsHighPrivilege(model *types.Model, techAsset *types.TechnicalAsset) bool {
+	return model.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential || techAsset.IsTaggedWithAny("admin")
+}
+
+// TrustLevel sums up the highest processed confidentiality, integrity and availability ratings of a technical asset
+func TrustLevel(model *types.Model, techAsset *types.TechnicalAsset) int {
+	return int(model.HighestProcessedConfidentiality(techAsset)) +
+		int(model.HighestProcessedIntegrity(techAsset)) +
+		int(model.HighestProcessedAvailability(techAsset))
+}
@@ -0,0 +1,131 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func link(source string, target string, authentication types.Authentication) *types.CommunicationLink {
+	return &types.CommunicationLink{
+		Id:             source + ">" + target,
+		SourceId:       source,
+		TargetId:       target,
+		Authentication: authentication,
+	}
+}
+
+func fourHopModel() *types.Model {
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"web": {
+				Id:                 "web",
+				Internet:           true,
+				Confidentiality:    types.Public,
+				CommunicationLinks: []*types.CommunicationLink{link("web", "app", types.Token)},
+			},
+			"app": {
+				Id:              "app",
+				Confidentiality: types.Internal,
+				CommunicationLinks: []*types.CommunicationLink{
+					link("app", "service", types.Credentials),
+					link("app", "web", types.Token), // back link, must not be followed
+				},
+			},
+			"service": {
+				Id:                 "service",
+				Confidentiality:    types.Restricted,
+				CommunicationLinks: []*types.CommunicationLink{link("service", "backend", types.ClientCertificate)},
+			},
+			"backend": {
+				Id:              "backend",
+				Confidentiality: types.Confidential,
+				CommunicationLinks: []*types.CommunicationLink{
+					link("backend", "vault", types.Token),
+					link("backend", "app", types.Token), // cycle back to a lower trust level
+				},
+			},
+			"vault": {
+				Id:              "vault",
+				Confidentiality: types.StrictlyConfidential,
+			},
+		},
+	}
+}
+
+func TestFindPrivilegeEscalationPathsEmptyModel(t *testing.T) {
+	paths := FindPrivilegeEscalationPaths(&types.Model{}, 5)
+
+	assert.Empty(t, paths)
+}
+
+func TestFindPrivilegeEscalationPathsFourHops(t *testing.T) {
+	paths := FindPrivilegeEscalationPaths(fourHopModel(), 5)
+
+	assert.Len(t, paths, 1)
+	assert.Equal(t, []string{"web", "app", "service", "backend", "vault"}, paths[0].TechnicalAssetIDs())
+	assert.Equal(t, "web", paths[0].Source().Id)
+	assert.Equal(t, "vault", paths[0].Target().Id)
+	assert.Equal(t, "web>app", paths[0].Steps[0].CommunicationLink.Id)
+	assert.Equal(t, "backend>vault", paths[0].Steps[3].CommunicationLink.Id)
+	assert.Nil(t, paths[0].Steps[4].CommunicationLink)
+}
+
+func TestFindPrivilegeEscalationPathsMaxDepthExceeded(t *testing.T) {
+	paths := FindPrivilegeEscalationPaths(fourHopModel(), 3)
+
+	assert.Empty(t, paths)
+}
+
+func TestFindPrivilegeEscalationPathsUnauthenticatedHopNotFollowed(t *testing.T) {
+	model := fourHopModel()
+	model.TechnicalAssets["service"].CommunicationLinks[0].Authentication = types.NoneAuthentication
+
+	paths := FindPrivilegeEscalationPaths(model, 5)
+
+	assert.Empty(t, paths)
+}
+
+func TestFindPrivilegeEscalationPathsCycleTerminates(t *testing.T) {
+	model := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"a": {
+				Id:                 "a",
+				Internet:           true,
+				CommunicationLinks: []*types.CommunicationLink{link("a", "b", types.Token)},
+			},
+			"b": {
+				Id:                 "b",
+				Confidentiality:    types.Internal,
+				CommunicationLinks: []*types.CommunicationLink{link("b", "a", types.Token)},
+			},
+		},
+	}
+
+	paths := FindPrivilegeEscalationPaths(model, 10)
+
+	assert.Empty(t, paths)
+}
+
+func TestFindPrivilegeEscalationPathsAdminTaggedTarget(t *testing.T) {
+	model := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"a": {
+				Id:                 "a",
+				Internet:           true,
+				CommunicationLinks: []*types.CommunicationLink{link("a", "admin", types.Token)},
+			},
+			"admin": {
+				Id:              "admin",
+				Confidentiality: types.Internal,
+				Tags:            []string{"admin"},
+			},
+		},
+	}
+
+	paths := FindPrivilegeEscalationPaths(model, 10)
+
+	assert.Len(t, paths, 1)
+	assert.Equal(t, []string{"a", "admin"}, paths[0].TechnicalAssetIDs())
+}
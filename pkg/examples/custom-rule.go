@@ -0,0 +1,117 @@
+package examples
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+const (
+	CustomRuleGo       = "go"
+	CustomRuleCEL      = "cel"
+	CustomRuleStarlark = "starlark"
+)
+
+//go:embed templates/custom-rule
+var customRuleTemplates embed.FS
+
+var customRuleIdExpression = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+type customRuleData struct {
+	Id       string
+	Title    string
+	TypeName string
+}
+
+// CreateCustomRule creates the skeleton of a custom risk rule with category metadata and a sample detection in the output directory:
+// a Go module serving the rule as gRPC plugin along with its tests, a CEL risk rule or a Starlark risk rule; it returns the files created
+func CreateCustomRule(outputDir string, ruleId string, kind string) ([]string, error) {
+	if !customRuleIdExpression.MatchString(ruleId) {
+		return nil, fmt.Errorf("invalid risk rule id %q, expected lower case words separated by dashes like %q", ruleId, "unencrypted-customer-database")
+	}
+
+	var files map[string]string
+	switch kind {
+	case CustomRuleGo:
+		files = map[string]string{
+			"go/go.mod.tmpl":       filepath.Join(outputDir, ruleId, "go.mod"),
+			"go/main.go.tmpl":      filepath.Join(outputDir, ruleId, "main.go"),
+			"go/rule.go.tmpl":      filepath.Join(outputDir, ruleId, "rule.go"),
+			"go/rule_test.go.tmpl": filepath.Join(outputDir, ruleId, "rule_test.go"),
+		}
+
+	case CustomRuleCEL:
+		files = map[string]string{"cel.yaml.tmpl": filepath.Join(outputDir, ruleId+".yaml")}
+
+	case CustomRuleStarlark:
+		files = map[string]string{"starlark.star.tmpl": filepath.Join(outputDir, ruleId+".star")}
+
+	default:
+		return nil, fmt.Errorf("unknown kind of custom risk rule %q, expected one of %q, %q or %q", kind, CustomRuleGo, CustomRuleCEL, CustomRuleStarlark)
+	}
+
+	data := newCustomRuleData(ruleId)
+	contents := make(map[string][]byte)
+	for templateName, filename := range files {
+		if _, statError := os.Stat(filename); statError == nil {
+			return nil, fmt.Errorf("file %q already exists", filename)
+		}
+
+		content, renderError := renderCustomRuleTemplate(templateName, data)
+		if renderError != nil {
+			return nil, renderError
+		}
+
+		contents[filename] = content
+	}
+
+	created := make([]string, 0)
+	for filename, content := range contents {
+		mkdirError := os.MkdirAll(filepath.Dir(filename), 0750)
+		if mkdirError != nil {
+			return created, mkdirError
+		}
+
+		writeError := os.WriteFile(filename, content, 0600)
+		if writeError != nil {
+			return created, writeError
+		}
+
+		created = append(created, filename)
+	}
+
+	return created, nil
+}
+
+func newCustomRuleData(ruleId string) customRuleData {
+	words := strings.Split(ruleId, "-")
+	for n, word := range words {
+		words[n] = strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return customRuleData{
+		Id:       ruleId,
+		Title:    strings.Join(words, " "),
+		TypeName: strings.Join(words, "") + "Rule",
+	}
+}
+
+func renderCustomRuleTemplate(name string, data customRuleData) ([]byte, error) {
+	parsedTemplate, parseError := template.ParseFS(customRuleTemplates, "templates/custom-rule/"+name)
+	if parseError != nil {
+		return nil, fmt.Errorf("failed to parse template %q: %w", name, parseError)
+	}
+
+	var content bytes.Buffer
+	executeError := parsedTemplate.Execute(&content, data)
+	if executeError != nil {
+		return nil, fmt.Errorf("failed to render template %q: %w", name, executeError)
+	}
+
+	return content.Bytes(), nil
+}
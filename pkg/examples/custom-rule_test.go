@@ -0,0 +1,103 @@
+package examples
+
+import (
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/risks/cel"
+	"github.com/threagile/threagile/pkg/risks/starlark"
+	risktesting "github.com/threagile/threagile/pkg/risks/testing"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func testCustomRuleModel() *types.Model {
+	return risktesting.NewModel().
+		DataAsset("customer-data", func(dataAsset *types.DataAsset) {
+			dataAsset.Confidentiality = types.StrictlyConfidential
+		}).
+		TechnicalAsset("tagged", func(techAsset *types.TechnicalAsset) {
+			techAsset.Tags = []string{"customer-db-exposure"}
+			techAsset.DataAssetsProcessed = []string{"customer-data"}
+		}).
+		TechnicalAsset("untagged").
+		Build()
+}
+
+func TestCreateCustomRuleGo(t *testing.T) {
+	outputDir := t.TempDir()
+
+	files, err := CreateCustomRule(outputDir, "customer-db-exposure", CustomRuleGo)
+
+	assert.NoError(t, err)
+	moduleDir := filepath.Join(outputDir, "customer-db-exposure")
+	assert.ElementsMatch(t, []string{
+		filepath.Join(moduleDir, "go.mod"),
+		filepath.Join(moduleDir, "main.go"),
+		filepath.Join(moduleDir, "rule.go"),
+		filepath.Join(moduleDir, "rule_test.go"),
+	}, files)
+
+	for _, name := range []string{"main.go", "rule.go", "rule_test.go"} {
+		content, readError := os.ReadFile(filepath.Join(moduleDir, name))
+		assert.NoError(t, readError)
+
+		formatted, formatError := format.Source(content)
+		assert.NoError(t, formatError, name)
+		assert.Equal(t, string(formatted), string(content), name)
+	}
+
+	rule, readError := os.ReadFile(filepath.Join(moduleDir, "rule.go"))
+	assert.NoError(t, readError)
+	assert.Contains(t, string(rule), "type CustomerDbExposureRule struct{}")
+	assert.Contains(t, string(rule), `Title:                      "Customer Db Exposure",`)
+}
+
+func TestCreateCustomRuleScripts(t *testing.T) {
+	testCases := map[string]func(folder string) (types.RiskRules, error){
+		CustomRuleCEL:      cel.LoadRiskRules,
+		CustomRuleStarlark: starlark.LoadRiskRules,
+	}
+
+	for kind, loadRiskRules := range testCases {
+		t.Run(kind, func(t *testing.T) {
+			outputDir := t.TempDir()
+
+			files, err := CreateCustomRule(outputDir, "customer-db-exposure", kind)
+			assert.NoError(t, err)
+			assert.Len(t, files, 1)
+
+			rules, loadError := loadRiskRules(outputDir)
+			assert.NoError(t, loadError)
+			assert.Contains(t, rules, "customer-db-exposure")
+
+			rule := rules["customer-db-exposure"]
+			assert.Equal(t, "Customer Db Exposure", rule.Category().Title)
+			assert.Equal(t, []string{"customer-db-exposure"}, rule.SupportedTags())
+
+			risks, riskError := rule.GenerateRisks(testCustomRuleModel())
+			assert.NoError(t, riskError)
+			assert.Len(t, risks, 1)
+			assert.Equal(t, "tagged", risks[0].MostRelevantTechnicalAssetId)
+			assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+		})
+	}
+}
+
+func TestCreateCustomRuleErrors(t *testing.T) {
+	outputDir := t.TempDir()
+
+	_, err := CreateCustomRule(outputDir, "Invalid_Id", CustomRuleGo)
+	assert.Error(t, err)
+
+	_, err = CreateCustomRule(outputDir, "valid-id", "unknown")
+	assert.Error(t, err)
+
+	_, err = CreateCustomRule(outputDir, "valid-id", CustomRuleCEL)
+	assert.NoError(t, err)
+
+	_, err = CreateCustomRule(outputDir, "valid-id", CustomRuleCEL)
+	assert.ErrorContains(t, err, "already exists")
+}
@@ -3,20 +3,21 @@ package input
 import "fmt"
 
 type CommunicationLink struct {
-	Target                 string   `yaml:"target,omitempty" json:"target,omitempty"`
-	Description            string   `yaml:"description,omitempty" json:"description,omitempty"`
-	Protocol               string   `yaml:"protocol,omitempty" json:"protocol,omitempty"`
-	Authentication         string   `yaml:"authentication,omitempty" json:"authentication,omitempty"`
-	Authorization          string   `yaml:"authorization,omitempty" json:"authorization,omitempty"`
-	Tags                   []string `yaml:"tags,omitempty" json:"tags,omitempty"`
-	VPN                    bool     `yaml:"vpn,omitempty" json:"vpn,omitempty"`
-	IpFiltered             bool     `yaml:"ip_filtered,omitempty" json:"ip_filtered,omitempty"`
-	Readonly               bool     `yaml:"readonly,omitempty" json:"readonly,omitempty"`
-	Usage                  string   `yaml:"usage,omitempty" json:"usage,omitempty"`
-	DataAssetsSent         []string `yaml:"data_assets_sent,omitempty" json:"data_assets_sent,omitempty"`
-	DataAssetsReceived     []string `yaml:"data_assets_received,omitempty" json:"data_assets_received,omitempty"`
-	DiagramTweakWeight     int      `yaml:"diagram_tweak_weight,omitempty" json:"diagram_tweak_weight,omitempty"`
-	DiagramTweakConstraint bool     `yaml:"diagram_tweak_constraint,omitempty" json:"diagram_tweak_constraint,omitempty"`
+	Target                 string           `yaml:"target,omitempty" json:"target,omitempty"`
+	Description            string           `yaml:"description,omitempty" json:"description,omitempty"`
+	Protocol               string           `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+	Authentication         string           `yaml:"authentication,omitempty" json:"authentication,omitempty"`
+	Authorization          string           `yaml:"authorization,omitempty" json:"authorization,omitempty"`
+	Tags                   []string         `yaml:"tags,omitempty" json:"tags,omitempty"`
+	VPN                    bool             `yaml:"vpn,omitempty" json:"vpn,omitempty"`
+	IpFiltered             bool             `yaml:"ip_filtered,omitempty" json:"ip_filtered,omitempty"`
+	Readonly               bool             `yaml:"readonly,omitempty" json:"readonly,omitempty"`
+	Usage                  string           `yaml:"usage,omitempty" json:"usage,omitempty"`
+	DataAssetsSent         []string         `yaml:"data_assets_sent,omitempty" json:"data_assets_sent,omitempty"`
+	DataAssetsReceived     []string         `yaml:"data_assets_received,omitempty" json:"data_assets_received,omitempty"`
+	DiagramTweakWeight     int              `yaml:"diagram_tweak_weight,omitempty" json:"diagram_tweak_weight,omitempty"`
+	DiagramTweakConstraint bool             `yaml:"diagram_tweak_constraint,omitempty" json:"diagram_tweak_constraint,omitempty"`
+	SuppressedRisks        []SuppressedRisk `yaml:"suppressed_risks,omitempty" json:"suppressed_risks,omitempty"`
 }
 
 func (what *CommunicationLink) Merge(other CommunicationLink) error {
@@ -77,6 +78,8 @@ func (what *CommunicationLink) Merge(other CommunicationLink) error {
 		what.DiagramTweakConstraint = other.DiagramTweakConstraint
 	}
 
+	what.SuppressedRisks = new(SuppressedRisk).MergeList(what.SuppressedRisks, other.SuppressedRisks)
+
 	return nil
 }
 
@@ -0,0 +1,23 @@
+package input
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// isJSONFile checks if the model file is given as JSON (by its ".json" extension) instead of YAML; both share the same schema
+func isJSONFile(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".json")
+}
+
+// unmarshalFile parses the content of a model file (or an included file or overlay) according to its file format
+func unmarshalFile(filename string, data []byte, value any) error {
+	if isJSONFile(filename) {
+		return json.Unmarshal(data, value)
+	}
+
+	return yaml.Unmarshal(data, value)
+}
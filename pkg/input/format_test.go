@@ -0,0 +1,49 @@
+package input
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadJsonModel(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.json": `{
+  "variables": {"product": "Shop"},
+  "title": "${product}",
+  "includes": ["data-assets.yaml", "teams/*.json"],
+  "technical_assets": {
+    "Web": {"id": "web", "internet": true, "tags": ["dev"]}
+  }
+}`,
+		"data-assets.yaml":  "data_assets:\n  Customer Data:\n    id: customer-data\n",
+		"teams/search.json": `{"technical_assets": {"Search": {"id": "search", "communication_links": {"Web Traffic": {"target": "web", "protocol": "https"}}}}}`,
+		"prod.json":         `{"technical_assets": {"Web": {"internet": false, "tags": null}}}`,
+	})
+
+	model, err := loadModel(t, filepath.Join(dir, "threagile.json"))
+	assert.NoError(t, err)
+	assert.NoError(t, model.ApplyOverlay(filepath.Join(dir, "prod.json")))
+
+	assert.Equal(t, "Shop", model.Title)
+	assert.Contains(t, model.DataAssets, "Customer Data")
+	assert.False(t, model.TechnicalAssets["Web"].Internet)
+	assert.Empty(t, model.TechnicalAssets["Web"].Tags)
+	assert.Equal(t, "https", model.TechnicalAssets["Search"].CommunicationLinks["Web Traffic"].Protocol)
+}
+
+func TestLoadInvalidJsonModel(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{"threagile.json": `{"title": "Shop",}`})
+
+	_, err := loadModel(t, filepath.Join(dir, "threagile.json"))
+
+	assert.ErrorContains(t, err, "unable to parse model file: invalid character")
+}
+
+func TestIsJSONFile(t *testing.T) {
+	assert.True(t, isJSONFile("threagile.json"))
+	assert.True(t, isJSONFile(filepath.Join("models", "threagile.JSON")))
+	assert.False(t, isJSONFile("threagile.yaml"))
+	assert.False(t, isJSONFile("json"))
+}
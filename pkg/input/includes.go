@@ -0,0 +1,85 @@
+package input
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// includeTracker keeps track of the model files being merged: a file including itself (directly or via other files)
+// is an include cycle, a file included more than once (like shared data assets included by several team files) is merged once only
+type includeTracker struct {
+	chain  []string
+	merged map[string]bool
+}
+
+func newIncludeTracker(filenames ...string) *includeTracker {
+	what := &includeTracker{merged: make(map[string]bool)}
+	for _, filename := range filenames {
+		what.chain = append(what.chain, absolutePath(filename))
+		what.merged[absolutePath(filename)] = true
+	}
+
+	return what
+}
+
+// enter starts merging the file, it returns false if the file has already been merged
+func (what *includeTracker) enter(filename string) (bool, error) {
+	path := absolutePath(filename)
+	for index, included := range what.chain {
+		if included == path {
+			return false, fmt.Errorf("include cycle: %v", strings.Join(slices.Concat(what.chain[index:], []string{path}), " -> "))
+		}
+	}
+
+	if what.merged[path] {
+		return false, nil
+	}
+
+	what.chain = append(what.chain, path)
+	what.merged[path] = true
+
+	return true, nil
+}
+
+func (what *includeTracker) leave() {
+	what.chain = what.chain[:len(what.chain)-1]
+}
+
+// includeFilenames returns the model files of an include, which is a file name or a glob pattern like "teams/*.yaml";
+// relative names are relative to dir, the directory of the including file
+func includeFilenames(dir string, include string) ([]string, error) {
+	pattern := include
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(dir, include)
+	}
+
+	if !isIncludePattern(include) {
+		return []string{filepath.Clean(pattern)}, nil
+	}
+
+	filenames, globError := filepath.Glob(pattern)
+	if globError != nil {
+		return nil, fmt.Errorf("invalid include pattern %q: %w", include, globError)
+	}
+
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("include pattern %q does not match any file", include)
+	}
+
+	return filenames, nil
+}
+
+func isIncludePattern(include string) bool {
+	return strings.ContainsAny(include, "*?[")
+}
+
+func absolutePath(filename string) string {
+	path, pathError := filepath.Abs(filename)
+	if pathError != nil {
+		return filepath.Clean(filename)
+	}
+
+	return path
+}
@@ -0,0 +1,139 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeModelFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		filename := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filename), 0750))
+		assert.NoError(t, os.WriteFile(filename, []byte(content), 0600))
+	}
+
+	return dir
+}
+
+func loadModel(t *testing.T, filename string) (*Model, error) {
+	t.Helper()
+
+	model := new(Model).Defaults()
+	return model, model.Load(filename)
+}
+
+func TestLoadMergesIncludes(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.yaml": `
+title: Shop
+includes:
+  - shared/data-assets.yaml
+  - teams/*.yaml
+contributors:
+  - name: Alice
+`,
+		"shared/data-assets.yaml": `
+data_assets:
+  Customer Data:
+    id: customer-data
+    confidentiality: confidential
+`,
+		// both team files include the shared data assets, which are merged once only
+		"teams/checkout.yaml": `
+includes:
+  - ../shared/data-assets.yaml
+contributors:
+  - name: Bob
+technical_assets:
+  Checkout:
+    id: checkout
+    data_assets_processed:
+      - customer-data
+`,
+		"teams/search.yaml": `
+includes:
+  - ../shared/data-assets.yaml
+technical_assets:
+  Search:
+    id: search
+  Checkout:
+    id: checkout
+    tags:
+      - search
+`,
+	})
+
+	model, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Shop", model.Title)
+	assert.Len(t, model.DataAssets, 1)
+	assert.Len(t, model.TechnicalAssets, 2)
+	assert.Equal(t, []string{"customer-data"}, model.TechnicalAssets["Checkout"].DataAssetsProcessed)
+	assert.Equal(t, []string{"search"}, model.TechnicalAssets["Checkout"].Tags)
+	assert.ElementsMatch(t, []string{"Alice", "Bob"}, []string{model.Contributors[0].Name, model.Contributors[1].Name})
+}
+
+func TestLoadIncludesAbsolutePath(t *testing.T) {
+	shared := writeModelFiles(t, map[string]string{
+		"trust-boundaries.yaml": `
+trust_boundaries:
+  Network:
+    id: network
+`,
+	})
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.yaml": "includes:\n  - " + filepath.Join(shared, "trust-boundaries.yaml") + "\n",
+	})
+
+	model, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+
+	assert.NoError(t, err)
+	assert.Contains(t, model.TrustBoundaries, "Network")
+}
+
+func TestLoadIncludeErrors(t *testing.T) {
+	testCases := map[string]struct {
+		files map[string]string
+		err   string
+	}{
+		"conflicting values": {
+			files: map[string]string{
+				"threagile.yaml": "includes:\n  - teams/*.yaml\n",
+				"teams/a.yaml":   "technical_assets:\n  Web:\n    id: web\n    encryption: none\n",
+				"teams/b.yaml":   "technical_assets:\n  Web:\n    id: web\n    encryption: transparent\n",
+			},
+			err: `failed to merge technical assets: failed to merge technical asset "Web": failed to merge encryption: conflicting string values: "none" versus "transparent"`,
+		},
+		"include cycle": {
+			files: map[string]string{
+				"threagile.yaml": "includes:\n  - a.yaml\n",
+				"a.yaml":         "includes:\n  - b.yaml\n",
+				"b.yaml":         "includes:\n  - threagile.yaml\n",
+			},
+			err: "include cycle: ",
+		},
+		"missing file": {
+			files: map[string]string{"threagile.yaml": "includes:\n  - missing.yaml\n"},
+			err:   "unable to read model file",
+		},
+		"pattern without match": {
+			files: map[string]string{"threagile.yaml": "includes:\n  - teams/*.yaml\n"},
+			err:   `include pattern "teams/*.yaml" does not match any file`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := writeModelFiles(t, testCase.files)
+
+			_, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+
+			assert.ErrorContains(t, err, testCase.err)
+		})
+	}
+}
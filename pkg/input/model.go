@@ -6,7 +6,6 @@ package input
 
 import (
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"slices"
@@ -14,8 +13,6 @@ import (
 	"strings"
 
 	"github.com/mpvl/unique"
-
-	"gopkg.in/yaml.v3"
 )
 
 // === Model Type Stuff ======================================
@@ -23,6 +20,7 @@ import (
 type Model struct { // TODO: Eventually remove this and directly use ParsedModelRoot? But then the error messages for model errors are not quite as good anymore...
 	ThreagileVersion                              string                    `yaml:"threagile_version,omitempty" json:"threagile_version,omitempty"`
 	Includes                                      []string                  `yaml:"includes,omitempty" json:"includes,omitempty"`
+	Variables                                     map[string]string         `yaml:"variables,omitempty" json:"variables,omitempty"`
 	Title                                         string                    `yaml:"title,omitempty" json:"title,omitempty"`
 	Author                                        Author                    `yaml:"author,omitempty" json:"author,omitempty"`
 	Contributors                                  []Author                  `yaml:"contributors,omitempty" json:"contributors,omitempty"`
@@ -42,6 +40,7 @@ type Model struct { // TODO: Eventually remove this and directly use ParsedModel
 	SharedRuntimes                                map[string]SharedRuntime  `yaml:"shared_runtimes,omitempty" json:"shared_runtimes,omitempty"`
 	CustomRiskCategories                          RiskCategories            `yaml:"custom_risk_categories,omitempty" json:"custom_risk_categories,omitempty"`
 	RiskTracking                                  map[string]RiskTracking   `yaml:"risk_tracking,omitempty" json:"risk_tracking,omitempty"`
+	SecurityInvariants                            []SecurityInvariant       `yaml:"security_invariants,omitempty" json:"security_invariants,omitempty"`
 	DiagramTweakNodesep                           int                       `yaml:"diagram_tweak_nodesep,omitempty" json:"diagram_tweak_nodesep,omitempty"`
 	DiagramTweakRanksep                           int                       `yaml:"diagram_tweak_ranksep,omitempty" json:"diagram_tweak_ranksep,omitempty"`
 	DiagramTweakEdgeLayout                        string                    `yaml:"diagram_tweak_edge_layout,omitempty" json:"diagram_tweak_edge_layout,omitempty"`
@@ -67,43 +66,100 @@ func (model *Model) Defaults() *Model {
 	return model
 }
 
+// Load reads the model file along with the model files it includes; conflicting values of the same item in different files are an error
 func (model *Model) Load(inputFilename string) error {
+	return model.LoadWithVariables(inputFilename, nil)
+}
+
+// LoadWithVariables loads the model, replacing "${name}" in the model files by the value of the variable before parsing them.
+// The variables given (like the ones set via command line) override the variables of the model file, which override the ones of included files.
+func (model *Model) LoadWithVariables(inputFilename string, variables map[string]string) error {
 	modelYaml, readError := os.ReadFile(filepath.Clean(inputFilename))
 	if readError != nil {
-		log.Fatal("Unable to read model file: ", readError)
+		return fmt.Errorf("unable to read model file: %w", readError)
 	}
 
-	unmarshalError := yaml.Unmarshal(modelYaml, &model)
+	variables = fileVariables(modelYaml, variables)
+	modelYaml, substituteError := substituteVariables(modelYaml, variables)
+	if substituteError != nil {
+		return fmt.Errorf("unable to substitute model variables: %w", substituteError)
+	}
+
+	unmarshalError := unmarshalFile(inputFilename, modelYaml, &model)
 	if unmarshalError != nil {
-		log.Fatal("Unable to parse model yaml: ", unmarshalError)
+		return fmt.Errorf("unable to parse model file: %w", unmarshalError)
 	}
 
+	model.Variables = variables
+	includes := newIncludeTracker(inputFilename)
 	for _, includeFile := range model.Includes {
-		mergeError := model.Merge(filepath.Dir(inputFilename), includeFile)
+		mergeError := model.mergeInclude(filepath.Dir(inputFilename), includeFile, includes, variables)
 		if mergeError != nil {
-			log.Fatalf("Unable to merge model include %q: %v", includeFile, mergeError)
+			return fmt.Errorf("unable to merge model include %q: %w", includeFile, mergeError)
 		}
 	}
 
 	return nil
 }
 
+// Merge merges the model file, or the model files matching the glob pattern, into the model; relative names are relative to dir
 func (model *Model) Merge(dir string, includeFilename string) error {
-	modelYaml, readError := os.ReadFile(filepath.Clean(filepath.Join(dir, includeFilename)))
+	return model.mergeInclude(dir, includeFilename, newIncludeTracker(), model.Variables)
+}
+
+func (model *Model) mergeInclude(dir string, include string, includes *includeTracker, variables map[string]string) error {
+	filenames, includeError := includeFilenames(dir, include)
+	if includeError != nil {
+		return includeError
+	}
+
+	for _, filename := range filenames {
+		mergeError := model.mergeFile(filename, includes, variables)
+		if mergeError != nil {
+			if isIncludePattern(include) {
+				return fmt.Errorf("failed to merge %q: %w", filename, mergeError)
+			}
+
+			return mergeError
+		}
+	}
+
+	return nil
+}
+
+func (model *Model) mergeFile(filename string, includes *includeTracker, variables map[string]string) error {
+	first, enterError := includes.enter(filename)
+	if enterError != nil {
+		return enterError
+	}
+
+	if !first {
+		return nil
+	}
+
+	defer includes.leave()
+
+	modelYaml, readError := os.ReadFile(filepath.Clean(filename))
 	if readError != nil {
 		return fmt.Errorf("unable to read model file: %w", readError)
 	}
 
+	variables = fileVariables(modelYaml, variables)
+	modelYaml, substituteError := substituteVariables(modelYaml, variables)
+	if substituteError != nil {
+		return fmt.Errorf("unable to substitute model variables: %w", substituteError)
+	}
+
 	var fileStructure map[string]any
-	unmarshalStructureError := yaml.Unmarshal(modelYaml, &fileStructure)
+	unmarshalStructureError := unmarshalFile(filename, modelYaml, &fileStructure)
 	if unmarshalStructureError != nil {
 		return fmt.Errorf("unable to parse model structure: %w", unmarshalStructureError)
 	}
 
 	var includedModel Model
-	unmarshalError := yaml.Unmarshal(modelYaml, &includedModel)
+	unmarshalError := unmarshalFile(filename, modelYaml, &includedModel)
 	if unmarshalError != nil {
-		return fmt.Errorf("unable to parse model yaml: %w", unmarshalError)
+		return fmt.Errorf("unable to parse model file: %w", unmarshalError)
 	}
 
 	var mergeError error
@@ -111,7 +167,7 @@ func (model *Model) Merge(dir string, includeFilename string) error {
 		switch strings.ToLower(item) {
 		case strings.ToLower("includes"):
 			for _, includeFile := range includedModel.Includes {
-				mergeError = model.Merge(filepath.Join(dir, filepath.Dir(includeFilename)), includeFile)
+				mergeError = model.mergeInclude(filepath.Dir(filename), includeFile, includes, variables)
 				if mergeError != nil {
 					return fmt.Errorf("failed to merge model include %q: %w", includeFile, mergeError)
 				}
@@ -136,7 +192,7 @@ func (model *Model) Merge(dir string, includeFilename string) error {
 			}
 
 		case strings.ToLower("contributors"):
-			model.Contributors, mergeError = new(Author).MergeList(append(model.Contributors, includedModel.Author))
+			model.Contributors, mergeError = new(Author).MergeList(append(model.Contributors, includedModel.Contributors...))
 			if mergeError != nil {
 				return fmt.Errorf("failed to merge contributors: %w", mergeError)
 			}
@@ -231,6 +287,9 @@ func (model *Model) Merge(dir string, includeFilename string) error {
 				return fmt.Errorf("failed to merge risk tracking: %w", mergeError)
 			}
 
+		case strings.ToLower("security_invariants"):
+			model.SecurityInvariants = new(SecurityInvariant).MergeList(model.SecurityInvariants, includedModel.SecurityInvariants)
+
 		case "diagram_tweak_nodesep":
 			model.DiagramTweakNodesep = includedModel.DiagramTweakNodesep
 
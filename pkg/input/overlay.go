@@ -0,0 +1,88 @@
+package input
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ApplyOverlay patches the model with an overlay file, a partial model with the changes of an environment like prod.
+// The overlay is applied like a JSON merge patch: maps (like technical assets or their communication links) are patched
+// key by key, other values (including lists) replace the values of the model and null removes a value.
+// Variables of the model are substituted in the overlay, the ones defined by the overlay apply to the overlay only.
+func (model *Model) ApplyOverlay(overlayFilename string) error {
+	overlayYaml, readError := os.ReadFile(filepath.Clean(overlayFilename))
+	if readError != nil {
+		return fmt.Errorf("unable to read model overlay: %w", readError)
+	}
+
+	overlayYaml, substituteError := substituteVariables(overlayYaml, fileVariables(overlayYaml, model.Variables))
+	if substituteError != nil {
+		return fmt.Errorf("unable to substitute model variables: %w", substituteError)
+	}
+
+	var overlay map[string]any
+	unmarshalOverlayError := unmarshalFile(overlayFilename, overlayYaml, &overlay)
+	if unmarshalOverlayError != nil {
+		return fmt.Errorf("unable to parse model overlay: %w", unmarshalOverlayError)
+	}
+
+	if _, ok := overlay["includes"]; ok {
+		return fmt.Errorf("model overlays must not include other files")
+	}
+
+	modelYaml, marshalError := yaml.Marshal(model)
+	if marshalError != nil {
+		return fmt.Errorf("unable to export model: %w", marshalError)
+	}
+
+	var base map[string]any
+	unmarshalModelError := yaml.Unmarshal(modelYaml, &base)
+	if unmarshalModelError != nil {
+		return fmt.Errorf("unable to export model: %w", unmarshalModelError)
+	}
+
+	patchedYaml, patchError := yaml.Marshal(mergePatch(base, overlay))
+	if patchError != nil {
+		return fmt.Errorf("unable to apply model overlay: %w", patchError)
+	}
+
+	// unknown fields are most likely typos in the overlay, which would silently not change anything otherwise
+	patchedModel := new(Model).Defaults()
+	decoder := yaml.NewDecoder(bytes.NewReader(patchedYaml))
+	decoder.KnownFields(true)
+	decodeError := decoder.Decode(patchedModel)
+	if decodeError != nil {
+		return fmt.Errorf("unable to apply model overlay: %w", decodeError)
+	}
+
+	patchedModel.Variables = model.Variables
+	*model = *patchedModel
+	return nil
+}
+
+func mergePatch(target any, patch any) any {
+	patchMap, patchIsMap := patch.(map[string]any)
+	if !patchIsMap {
+		return patch
+	}
+
+	targetMap, targetIsMap := target.(map[string]any)
+	if !targetIsMap {
+		targetMap = make(map[string]any)
+	}
+
+	for key, value := range patchMap {
+		if value == nil {
+			delete(targetMap, key)
+			continue
+		}
+
+		targetMap[key] = mergePatch(targetMap[key], value)
+	}
+
+	return targetMap
+}
@@ -0,0 +1,130 @@
+package input
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const overlayBaseModel = `
+title: Shop
+tags_available:
+  - dev
+technical_assets:
+  Web:
+    id: web
+    internet: false
+    encryption: none
+    tags:
+      - dev
+    communication_links:
+      Database Traffic:
+        target: db
+        protocol: jdbc
+  Database:
+    id: db
+    out_of_scope: true
+    justification_out_of_scope: shared database of the dev environment
+`
+
+func TestApplyOverlay(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.yaml": overlayBaseModel,
+		"prod.yaml": `
+title: Shop (prod)
+technical_assets:
+  Web:
+    internet: true
+    tags: []
+    communication_links:
+      Database Traffic:
+        protocol: jdbc-encrypted
+  Database:
+    out_of_scope: false
+    justification_out_of_scope: null
+    encryption: transparent
+`,
+	})
+
+	model, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+	assert.NoError(t, err)
+	assert.NoError(t, model.ApplyOverlay(filepath.Join(dir, "prod.yaml")))
+
+	assert.Equal(t, "Shop (prod)", model.Title)
+	assert.Equal(t, []string{"dev"}, model.TagsAvailable)
+
+	web := model.TechnicalAssets["Web"]
+	assert.Equal(t, "web", web.ID)
+	assert.True(t, web.Internet)
+	assert.Equal(t, "none", web.Encryption)
+	assert.Empty(t, web.Tags)
+	assert.Equal(t, "db", web.CommunicationLinks["Database Traffic"].Target)
+	assert.Equal(t, "jdbc-encrypted", web.CommunicationLinks["Database Traffic"].Protocol)
+
+	database := model.TechnicalAssets["Database"]
+	assert.False(t, database.OutOfScope)
+	assert.Empty(t, database.JustificationOutOfScope)
+	assert.Equal(t, "transparent", database.Encryption)
+}
+
+func TestApplyOverlaysInOrder(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.yaml": overlayBaseModel,
+		"stage.yaml":     "technical_assets:\n  Web:\n    encryption: transparent\n    internet: true\n",
+		"hotfix.yaml":    "technical_assets:\n  Web:\n    encryption: data-with-symmetric-shared-key\n",
+	})
+
+	model, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+	assert.NoError(t, err)
+	assert.NoError(t, model.ApplyOverlay(filepath.Join(dir, "stage.yaml")))
+	assert.NoError(t, model.ApplyOverlay(filepath.Join(dir, "hotfix.yaml")))
+
+	assert.Equal(t, "data-with-symmetric-shared-key", model.TechnicalAssets["Web"].Encryption)
+	assert.True(t, model.TechnicalAssets["Web"].Internet)
+}
+
+func TestApplyEmptyOverlayKeepsModel(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{"empty.yaml": ""})
+
+	model, err := loadModel(t, filepath.Join("..", "..", "demo", "example", "threagile.yaml"))
+	assert.NoError(t, err)
+	original, err := loadModel(t, filepath.Join("..", "..", "demo", "example", "threagile.yaml"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, model.ApplyOverlay(filepath.Join(dir, "empty.yaml")))
+
+	assert.Equal(t, original, model)
+}
+
+func TestApplyOverlayErrors(t *testing.T) {
+	testCases := map[string]struct {
+		overlay string
+		err     string
+	}{
+		"unknown field": {
+			overlay: "technical_assets:\n  Web:\n    encrypton: transparent\n",
+			err:     "field encrypton not found",
+		},
+		"includes": {
+			overlay: "includes:\n  - other.yaml\n",
+			err:     "model overlays must not include other files",
+		},
+		"invalid yaml": {
+			overlay: "technical_assets: [",
+			err:     "unable to parse model overlay",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := writeModelFiles(t, map[string]string{"threagile.yaml": overlayBaseModel, "overlay.yaml": testCase.overlay})
+
+			model, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+			assert.NoError(t, err)
+
+			assert.ErrorContains(t, model.ApplyOverlay(filepath.Join(dir, "overlay.yaml")), testCase.err)
+			assert.Equal(t, "none", model.TechnicalAssets["Web"].Encryption)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package input
+
+type SecurityInvariant struct {
+	Type            string `yaml:"type,omitempty" json:"type,omitempty"`
+	FromBoundaryTag string `yaml:"from_boundary_tag,omitempty" json:"from_boundary_tag,omitempty"`
+	ToBoundaryTag   string `yaml:"to_boundary_tag,omitempty" json:"to_boundary_tag,omitempty"`
+	BoundaryTag     string `yaml:"boundary_tag,omitempty" json:"boundary_tag,omitempty"`
+}
+
+func (what *SecurityInvariant) MergeList(first []SecurityInvariant, second []SecurityInvariant) []SecurityInvariant {
+	for _, invariant := range second {
+		found := false
+		for _, existing := range first {
+			if existing == invariant {
+				found = true
+				break
+			}
+		}
+		if !found {
+			first = append(first, invariant)
+		}
+	}
+	return first
+}
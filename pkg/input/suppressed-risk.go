@@ -0,0 +1,23 @@
+package input
+
+type SuppressedRisk struct {
+	Category      string `yaml:"category,omitempty" json:"category,omitempty"`
+	Justification string `yaml:"justification,omitempty" json:"justification,omitempty"`
+	Expires       string `yaml:"expires,omitempty" json:"expires,omitempty"`
+}
+
+func (what *SuppressedRisk) MergeList(first []SuppressedRisk, second []SuppressedRisk) []SuppressedRisk {
+	for _, suppressedRisk := range second {
+		found := false
+		for _, existing := range first {
+			if existing == suppressedRisk {
+				found = true
+				break
+			}
+		}
+		if !found {
+			first = append(first, suppressedRisk)
+		}
+	}
+	return first
+}
@@ -18,6 +18,7 @@ type TechnicalAsset struct {
 	Machine                 string                       `yaml:"machine,omitempty" json:"machine,omitempty"`
 	Encryption              string                       `yaml:"encryption,omitempty" json:"encryption,omitempty"`
 	Owner                   string                       `yaml:"owner,omitempty" json:"owner,omitempty"`
+	Location                string                       `yaml:"location,omitempty" json:"location,omitempty"`
 	Confidentiality         string                       `yaml:"confidentiality,omitempty" json:"confidentiality,omitempty"`
 	Integrity               string                       `yaml:"integrity,omitempty" json:"integrity,omitempty"`
 	Availability            string                       `yaml:"availability,omitempty" json:"availability,omitempty"`
@@ -30,6 +31,7 @@ type TechnicalAsset struct {
 	DataFormatsAccepted     []string                     `yaml:"data_formats_accepted,omitempty" json:"data_formats_accepted,omitempty"`
 	DiagramTweakOrder       int                          `yaml:"diagram_tweak_order,omitempty" json:"diagram_tweak_order,omitempty"`
 	CommunicationLinks      map[string]CommunicationLink `yaml:"communication_links,omitempty" json:"communication_links,omitempty"`
+	SuppressedRisks         []SuppressedRisk             `yaml:"suppressed_risks,omitempty" json:"suppressed_risks,omitempty"`
 }
 
 func (what *TechnicalAsset) Merge(other TechnicalAsset) error {
@@ -95,6 +97,11 @@ func (what *TechnicalAsset) Merge(other TechnicalAsset) error {
 		return fmt.Errorf("failed to merge owner: %w", mergeError)
 	}
 
+	what.Location, mergeError = new(Strings).MergeSingleton(what.Location, other.Location)
+	if mergeError != nil {
+		return fmt.Errorf("failed to merge location: %w", mergeError)
+	}
+
 	what.Confidentiality, mergeError = new(Strings).MergeSingleton(what.Confidentiality, other.Confidentiality)
 	if mergeError != nil {
 		return fmt.Errorf("failed to merge confidentiality: %w", mergeError)
@@ -139,6 +146,8 @@ func (what *TechnicalAsset) Merge(other TechnicalAsset) error {
 		return fmt.Errorf("failed to merge communication_links: %w", mergeError)
 	}
 
+	what.SuppressedRisks = new(SuppressedRisk).MergeList(what.SuppressedRisks, other.SuppressedRisks)
+
 	return nil
 }
 
@@ -0,0 +1,69 @@
+package input
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// variableExpression matches "${name}" as well as the escaped "$${name}", which is kept as literal "${name}"
+var variableExpression = regexp.MustCompile(`\$?\$\{([^}]*)\}`)
+
+var variableNameExpression = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// fileVariables returns the variables of the model file: the variables defined in the file itself,
+// overridden by the variables given (those of the including file and the ones set via command line)
+func fileVariables(modelYaml []byte, variables map[string]string) map[string]string {
+	var file struct {
+		Variables map[string]string `yaml:"variables"`
+	}
+
+	// a file not parsable before the substitution is reported when parsing it afterward
+	_ = yaml.Unmarshal(modelYaml, &file)
+
+	result := make(map[string]string)
+	for name, value := range file.Variables {
+		result[name] = value
+	}
+
+	for name, value := range variables {
+		result[name] = value
+	}
+
+	return result
+}
+
+// substituteVariables replaces each "${name}" in the model text by the value of the variable; unknown variables are an error
+func substituteVariables(modelYaml []byte, variables map[string]string) ([]byte, error) {
+	problems := make([]string, 0)
+	result := variableExpression.ReplaceAllFunc(modelYaml, func(match []byte) []byte {
+		if strings.HasPrefix(string(match), "$$") {
+			return match[1:]
+		}
+
+		name := string(variableExpression.FindSubmatch(match)[1])
+		if !variableNameExpression.MatchString(name) {
+			problems = append(problems, fmt.Sprintf("invalid variable name %q", name))
+			return match
+		}
+
+		value, ok := variables[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("undefined variable %q", name))
+			return match
+		}
+
+		return []byte(value)
+	})
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%v", strings.Join(slices.Compact(problems), ", "))
+	}
+
+	return result, nil
+}
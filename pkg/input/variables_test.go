@@ -0,0 +1,87 @@
+package input
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubstituteVariables(t *testing.T) {
+	variables := map[string]string{"team": "checkout", "owner.name": "Jane", "count": "3"}
+
+	result, err := substituteVariables([]byte("title: ${team} service\nowner: ${owner.name}\nreplicas: ${count}\nscript: echo $${HOME}\n"), variables)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "title: checkout service\nowner: Jane\nreplicas: 3\nscript: echo ${HOME}\n", string(result))
+}
+
+func TestSubstituteVariablesErrors(t *testing.T) {
+	_, err := substituteVariables([]byte("title: ${team} ${unknown} ${unknown}\nowner: ${}\n"), map[string]string{"team": "checkout"})
+
+	assert.EqualError(t, err, `invalid variable name "", undefined variable "unknown"`)
+}
+
+func TestLoadWithVariables(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.yaml": `
+variables:
+  product: Shop
+  owner: Team Checkout
+includes:
+  - shared.yaml
+title: ${product}
+technical_assets:
+  ${product} Web:
+    id: web
+    owner: ${owner}
+    tags:
+      - ${environment}
+`,
+		// the variables of the including file and the command line override the defaults of included files
+		"shared.yaml": `
+variables:
+  owner: unknown
+  quantity: many
+data_assets:
+  Customer Data:
+    id: customer-data
+    owner: ${owner}
+    quantity: ${quantity}
+`,
+	})
+
+	model := new(Model).Defaults()
+	err := model.LoadWithVariables(filepath.Join(dir, "threagile.yaml"), map[string]string{"environment": "prod", "product": "Store"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Store", model.Title)
+	assert.Equal(t, map[string]string{"product": "Store", "owner": "Team Checkout", "environment": "prod"}, model.Variables)
+	assert.Contains(t, model.TechnicalAssets, "Store Web")
+	assert.Equal(t, "Team Checkout", model.TechnicalAssets["Store Web"].Owner)
+	assert.Equal(t, []string{"prod"}, model.TechnicalAssets["Store Web"].Tags)
+	assert.Equal(t, "Team Checkout", model.DataAssets["Customer Data"].Owner)
+	assert.Equal(t, "many", model.DataAssets["Customer Data"].Quantity)
+}
+
+func TestLoadWithUndefinedVariable(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{"threagile.yaml": "title: ${product}\n"})
+
+	_, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+
+	assert.EqualError(t, err, `unable to substitute model variables: undefined variable "product"`)
+}
+
+func TestApplyOverlayWithVariables(t *testing.T) {
+	dir := writeModelFiles(t, map[string]string{
+		"threagile.yaml": "variables:\n  product: Shop\ntitle: ${product}\n",
+		"prod.yaml":      "variables:\n  environment: prod\ntitle: ${product} (${environment})\n",
+	})
+
+	model, err := loadModel(t, filepath.Join(dir, "threagile.yaml"))
+	assert.NoError(t, err)
+	assert.NoError(t, model.ApplyOverlay(filepath.Join(dir, "prod.yaml")))
+
+	assert.Equal(t, "Shop (prod)", model.Title)
+	assert.Equal(t, map[string]string{"product": "Shop"}, model.Variables)
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// consolidateRisks groups the generated risks of the same communication link, or of the same technical asset for risks
+// not bound to a communication link, under the highest rated risk of the group; the other risks of the group are moved
+// to the consolidated risks of that primary risk and are no longer reported on their own
+func consolidateRisks(parsedModel *types.Model, progressReporter types.ProgressReporter) {
+	groups := make(map[string][]*types.Risk)
+	keys := make([]string, 0)
+	for _, risks := range parsedModel.GeneratedRisksByCategory {
+		for _, risk := range risks {
+			key := consolidationKey(risk)
+			if len(key) == 0 {
+				continue
+			}
+
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], risk)
+		}
+	}
+	sort.Strings(keys)
+
+	consolidated := make(map[*types.Risk]bool)
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+
+		sort.Slice(group, func(i, j int) bool {
+			return isRatedHigher(group[i], group[j])
+		})
+
+		primary := group[0]
+		for _, risk := range group[1:] {
+			primary.ConsolidatedRisks = append(primary.ConsolidatedRisks, risk)
+			consolidated[risk] = true
+		}
+
+		progressReporter.Infof("Consolidated %d risks under risk %v", len(group)-1, primary.SyntheticId)
+	}
+
+	for categoryId, risks := range parsedModel.GeneratedRisksByCategory {
+		remainingRisks := make([]*types.Risk, 0)
+		for _, risk := range risks {
+			if !consolidated[risk] {
+				remainingRisks = append(remainingRisks, risk)
+			}
+		}
+
+		if len(remainingRisks) > 0 {
+			parsedModel.GeneratedRisksByCategory[categoryId] = remainingRisks
+		} else {
+			delete(parsedModel.GeneratedRisksByCategory, categoryId)
+		}
+	}
+
+	for syntheticId, risk := range parsedModel.GeneratedRisksBySyntheticId {
+		if consolidated[risk] {
+			delete(parsedModel.GeneratedRisksBySyntheticId, syntheticId)
+		}
+	}
+}
+
+func consolidationKey(risk *types.Risk) string {
+	if len(risk.MostRelevantCommunicationLinkId) > 0 {
+		return "communication link " + risk.MostRelevantCommunicationLinkId
+	}
+
+	if len(risk.MostRelevantTechnicalAssetId) > 0 {
+		return "technical asset " + risk.MostRelevantTechnicalAssetId
+	}
+
+	return ""
+}
+
+// isRatedHigher orders risks by severity, exploitation impact and likelihood, ties are broken by the synthetic id
+func isRatedHigher(risk *types.Risk, other *types.Risk) bool {
+	if risk.Severity != other.Severity {
+		return risk.Severity > other.Severity
+	}
+
+	if risk.ExploitationImpact != other.ExploitationImpact {
+		return risk.ExploitationImpact > other.ExploitationImpact
+	}
+
+	if risk.ExploitationLikelihood != other.ExploitationLikelihood {
+		return risk.ExploitationLikelihood > other.ExploitationLikelihood
+	}
+
+	return strings.ToLower(risk.SyntheticId) < strings.ToLower(other.SyntheticId)
+}
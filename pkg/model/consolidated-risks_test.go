@@ -0,0 +1,67 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func testConsolidationModel(risks ...*types.Risk) *types.Model {
+	parsedModel := &types.Model{
+		GeneratedRisksByCategory:    make(map[string][]*types.Risk),
+		GeneratedRisksBySyntheticId: make(map[string]*types.Risk),
+	}
+
+	for _, risk := range risks {
+		parsedModel.GeneratedRisksByCategory[risk.CategoryId] = append(parsedModel.GeneratedRisksByCategory[risk.CategoryId], risk)
+		parsedModel.GeneratedRisksBySyntheticId[risk.SyntheticId] = risk
+	}
+
+	return parsedModel
+}
+
+func TestConsolidateRisksOfCommunicationLink(t *testing.T) {
+	unencrypted := &types.Risk{CategoryId: "unencrypted-communication", SyntheticId: "unencrypted-communication@web>db@web@db",
+		Severity: types.ElevatedSeverity, MostRelevantTechnicalAssetId: "web", MostRelevantCommunicationLinkId: "web>db"}
+	authentication := &types.Risk{CategoryId: "missing-authentication", SyntheticId: "missing-authentication@web>db@web@db",
+		Severity: types.HighSeverity, MostRelevantTechnicalAssetId: "db", MostRelevantCommunicationLinkId: "web>db"}
+	hardening := &types.Risk{CategoryId: "missing-hardening", SyntheticId: "missing-hardening@web",
+		Severity: types.LowSeverity, MostRelevantTechnicalAssetId: "web"}
+	otherLink := &types.Risk{CategoryId: "unencrypted-communication", SyntheticId: "unencrypted-communication@client>web@client@web",
+		Severity: types.ElevatedSeverity, MostRelevantTechnicalAssetId: "client", MostRelevantCommunicationLinkId: "client>web"}
+
+	parsedModel := testConsolidationModel(unencrypted, authentication, hardening, otherLink)
+	consolidateRisks(parsedModel, new(testProgressReporter))
+
+	assert.Equal(t, []*types.Risk{unencrypted}, authentication.ConsolidatedRisks)
+	assert.Empty(t, hardening.ConsolidatedRisks)
+	assert.Empty(t, otherLink.ConsolidatedRisks)
+
+	assert.Equal(t, []*types.Risk{otherLink}, parsedModel.GeneratedRisksByCategory["unencrypted-communication"])
+	assert.Equal(t, []*types.Risk{authentication}, parsedModel.GeneratedRisksByCategory["missing-authentication"])
+	assert.Len(t, parsedModel.GeneratedRisksBySyntheticId, 3)
+	assert.NotContains(t, parsedModel.GeneratedRisksBySyntheticId, unencrypted.SyntheticId)
+}
+
+func TestConsolidateRisksOfTechnicalAsset(t *testing.T) {
+	hardening := &types.Risk{CategoryId: "missing-hardening", SyntheticId: "missing-hardening@web",
+		Severity: types.MediumSeverity, ExploitationImpact: types.LowImpact, MostRelevantTechnicalAssetId: "web"}
+	monitoring := &types.Risk{CategoryId: "missing-monitoring", SyntheticId: "missing-monitoring@web",
+		Severity: types.MediumSeverity, ExploitationImpact: types.MediumImpact, MostRelevantTechnicalAssetId: "web"}
+	vault := &types.Risk{CategoryId: "missing-vault", SyntheticId: "missing-vault@web",
+		Severity: types.MediumSeverity, ExploitationImpact: types.LowImpact, MostRelevantTechnicalAssetId: "web"}
+	model := &types.Risk{CategoryId: "missing-build-infrastructure", SyntheticId: "missing-build-infrastructure"}
+
+	parsedModel := testConsolidationModel(hardening, monitoring, vault, model)
+	consolidateRisks(parsedModel, new(testProgressReporter))
+
+	// ties are broken by the synthetic id
+	assert.Equal(t, []*types.Risk{hardening, vault}, monitoring.ConsolidatedRisks)
+	assert.NotContains(t, parsedModel.GeneratedRisksByCategory, "missing-hardening")
+	assert.NotContains(t, parsedModel.GeneratedRisksByCategory, "missing-vault")
+
+	// risks without technical asset or communication link are never consolidated
+	assert.Equal(t, []*types.Risk{model}, parsedModel.GeneratedRisksByCategory["missing-build-infrastructure"])
+	assert.Len(t, parsedModel.GeneratedRisksBySyntheticId, 2)
+}
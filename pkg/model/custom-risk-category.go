@@ -2,17 +2,22 @@ package model
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/threagile/threagile/pkg/risks/cel"
+	"github.com/threagile/threagile/pkg/risks/starlark"
 	"github.com/threagile/threagile/pkg/types"
 )
 
 type CustomRiskCategory struct {
 	types.RiskCategory `json:"risk_category" yaml:"risk_category,omitempty"`
 
-	Tags   []string `json:"tags,omitempty" yaml:"tags,omitempty"`
-	runner *runner
+	Tags     []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	runner   pluginRunner
+	filename string
 }
 
 func (what *CustomRiskCategory) Init(category *types.RiskCategory, tags []string) *CustomRiskCategory {
@@ -40,13 +45,13 @@ func (what *CustomRiskCategory) GenerateRisks(parsedModel *types.Model) ([]*type
 	generatedRisks := make([]*types.Risk, 0)
 	runError := what.runner.Run(parsedModel, &generatedRisks, "-generate-risks")
 	if runError != nil {
-		return nil, fmt.Errorf("failed to generate risks for custom risk rule %q: %w", what.runner.Filename, runError)
+		return nil, fmt.Errorf("failed to generate risks for custom risk rule %q: %w", what.filename, runError)
 	}
 
 	return generatedRisks, nil
 }
 
-func LoadCustomRiskRules(pluginDir string, pluginFiles []string, reporter types.ProgressReporter) types.RiskRules {
+func LoadCustomRiskRules(pluginDir string, pluginFiles []string, rulesDir string, grpcPluginDir string, grpcPluginTimeout time.Duration, reporter types.ProgressReporter) types.RiskRules {
 	customRiskRuleList := make([]string, 0)
 	customRiskRules := make(types.RiskRules)
 	if len(pluginFiles) > 0 {
@@ -54,7 +59,8 @@ func LoadCustomRiskRules(pluginDir string, pluginFiles []string, reporter types.
 
 		for _, pluginFile := range pluginFiles {
 			if len(pluginFile) > 0 {
-				newRunner, loadError := new(runner).Load(filepath.Join(pluginDir, pluginFile))
+				filename := filepath.Join(pluginDir, pluginFile)
+				newRunner, loadError := newPluginRunner(filename)
 				if loadError != nil {
 					reporter.Error(fmt.Sprintf("WARNING: Custom risk rule %q not loaded: %v\n", pluginFile, loadError))
 				}
@@ -66,6 +72,7 @@ func LoadCustomRiskRules(pluginDir string, pluginFiles []string, reporter types.
 				}
 
 				risk.runner = newRunner
+				risk.filename = filename
 				customRiskRules[risk.ID] = risk
 				customRiskRuleList = append(customRiskRuleList, risk.ID)
 				reporter.Info("Custom risk rule loaded:", risk.ID)
@@ -75,5 +82,83 @@ func LoadCustomRiskRules(pluginDir string, pluginFiles []string, reporter types.
 		reporter.Info("Loaded custom risk rules:", strings.Join(customRiskRuleList, ", "))
 	}
 
+	if len(rulesDir) > 0 {
+		reporter.Info("Loading CEL risk rules from:", rulesDir)
+
+		celRiskRules, loadError := cel.LoadRiskRules(rulesDir)
+		if loadError != nil {
+			reporter.Error(fmt.Sprintf("WARNING: CEL risk rules not loaded: %v\n", loadError))
+		}
+
+		for id, rule := range celRiskRules {
+			customRiskRules[id] = rule
+			reporter.Info("CEL risk rule loaded:", id)
+		}
+
+		reporter.Info("Loading Starlark risk rules from:", rulesDir)
+
+		starlarkRiskRules, starlarkLoadError := starlark.LoadRiskRules(rulesDir)
+		if starlarkLoadError != nil {
+			reporter.Error(fmt.Sprintf("WARNING: Starlark risk rules not loaded: %v\n", starlarkLoadError))
+		}
+
+		for id, rule := range starlarkRiskRules {
+			customRiskRules[id] = rule
+			reporter.Info("Starlark risk rule loaded:", id)
+		}
+	}
+
+	if len(grpcPluginDir) > 0 {
+		reporter.Info("Loading grpc risk rule plugins from:", grpcPluginDir)
+
+		for id, rule := range loadGrpcRiskRules(grpcPluginDir, grpcPluginTimeout, reporter) {
+			customRiskRules[id] = rule
+			reporter.Info("Grpc risk rule plugin loaded:", id)
+		}
+	}
+
 	return customRiskRules
 }
+
+// loadGrpcRiskRules loads all executables in the folder as grpc risk rule plugins;
+// plugins failing to load are skipped, so they don't prevent the other rules from being applied
+func loadGrpcRiskRules(grpcPluginDir string, grpcPluginTimeout time.Duration, reporter types.ProgressReporter) types.RiskRules {
+	rules := make(types.RiskRules)
+	entries, readError := os.ReadDir(grpcPluginDir)
+	if readError != nil {
+		reporter.Error(fmt.Sprintf("WARNING: Grpc risk rule plugins not loaded: %v\n", readError))
+		return rules
+	}
+
+	for _, entry := range entries {
+		fileInfo, infoError := entry.Info()
+		if infoError != nil || !fileInfo.Mode().IsRegular() || (fileInfo.Mode().Perm()&0111 == 0 && !strings.EqualFold(filepath.Ext(entry.Name()), ".exe")) {
+			continue
+		}
+
+		filename := filepath.Join(grpcPluginDir, entry.Name())
+		newRunner, loadError := new(grpcRunner).Load(filename, grpcPluginTimeout)
+		if loadError != nil {
+			reporter.Error(fmt.Sprintf("WARNING: Grpc risk rule plugin %q not loaded: %v\n", entry.Name(), loadError))
+			continue
+		}
+
+		risk := new(CustomRiskCategory)
+		runError := newRunner.Run(nil, &risk, "-get-info")
+		if runError != nil {
+			reporter.Error(fmt.Sprintf("WARNING: Failed to get info for grpc risk rule plugin %q: %v\n", entry.Name(), runError))
+			continue
+		}
+
+		if len(risk.ID) == 0 {
+			reporter.Error(fmt.Sprintf("WARNING: Grpc risk rule plugin %q has no risk category id\n", entry.Name()))
+			continue
+		}
+
+		risk.runner = newRunner
+		risk.filename = filename
+		rules[risk.ID] = risk
+	}
+
+	return rules
+}
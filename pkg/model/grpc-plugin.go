@@ -0,0 +1,146 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// gRPC risk rule plugins are executables implementing the service below, started by threagile for each call:
+//
+//	service RiskRule {
+//	  rpc GetInfo(google.protobuf.Empty) returns (google.protobuf.BytesValue);
+//	  rpc GenerateRisks(google.protobuf.BytesValue) returns (google.protobuf.BytesValue);
+//	}
+//
+// GetInfo returns the risk category and supported tags as JSON, GenerateRisks gets the parsed model
+// and returns the generated risks as JSON. Similar to hashicorp/go-plugin, the plugin is started with
+// the magic cookie set in its environment, listens on a local address and announces it by writing the
+// handshake line "1|1|tcp|<address>|grpc" to stdout.
+const (
+	GrpcPluginMagicCookieKey   = "THREAGILE_PLUGIN_MAGIC_COOKIE"
+	GrpcPluginMagicCookieValue = "7e3f0a9c1b5d4e2a8f6c0d9b3a1e5f7c"
+
+	grpcPluginCoreProtocolVersion = 1
+	grpcPluginAppProtocolVersion  = 1
+	grpcPluginServiceName         = "threagile.plugin.RiskRule"
+	grpcPluginMaxMessageSize      = 256 * 1024 * 1024
+)
+
+type grpcPluginServer interface {
+	getInfo() ([]byte, error)
+	generateRisks(model []byte) ([]byte, error)
+}
+
+var grpcPluginServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcPluginServiceName,
+	HandlerType: (*grpcPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInfo",
+			Handler: func(srv any, ctx context.Context, decode func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				in := new(emptypb.Empty)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+
+				return grpcPluginHandle(ctx, in, "GetInfo", interceptor, func(any) ([]byte, error) {
+					return srv.(grpcPluginServer).getInfo()
+				})
+			},
+		},
+		{
+			MethodName: "GenerateRisks",
+			Handler: func(srv any, ctx context.Context, decode func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				in := new(wrapperspb.BytesValue)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+
+				return grpcPluginHandle(ctx, in, "GenerateRisks", interceptor, func(request any) ([]byte, error) {
+					return srv.(grpcPluginServer).generateRisks(request.(*wrapperspb.BytesValue).GetValue())
+				})
+			},
+		},
+	},
+	Metadata: "risk-rule-plugin.proto",
+}
+
+func grpcPluginHandle(ctx context.Context, in any, method string, interceptor grpc.UnaryServerInterceptor, handle func(request any) ([]byte, error)) (any, error) {
+	handler := func(_ context.Context, request any) (any, error) {
+		out, err := handle(request)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return wrapperspb.Bytes(out), nil
+	}
+
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{FullMethod: "/" + grpcPluginServiceName + "/" + method}, handler)
+}
+
+type riskRulePluginServer struct {
+	rule types.RiskRule
+}
+
+func (what *riskRulePluginServer) getInfo() ([]byte, error) {
+	return json.Marshal(new(CustomRiskCategory).Init(what.rule.Category(), what.rule.SupportedTags()))
+}
+
+func (what *riskRulePluginServer) generateRisks(model []byte) (result []byte, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic generating risks: %v", recovered)
+		}
+	}()
+
+	var parsedModel types.Model
+	unmarshalError := json.Unmarshal(model, &parsedModel)
+	if unmarshalError != nil {
+		return nil, fmt.Errorf("failed to parse model: %w", unmarshalError)
+	}
+
+	generatedRisks, riskError := what.rule.GenerateRisks(&parsedModel)
+	if riskError != nil {
+		return nil, riskError
+	}
+
+	return json.Marshal(generatedRisks)
+}
+
+// ServeGrpcPlugin serves the risk rule as gRPC plugin; it is meant to be called from the main function of the plugin
+// and returns once the plugin is stopped by threagile
+func ServeGrpcPlugin(rule types.RiskRule) error {
+	if os.Getenv(GrpcPluginMagicCookieKey) != GrpcPluginMagicCookieValue {
+		return fmt.Errorf("this binary is a threagile risk rule plugin and is not meant to be executed directly")
+	}
+
+	listener, listenError := net.Listen("tcp", "127.0.0.1:0")
+	if listenError != nil {
+		return fmt.Errorf("failed to listen: %w", listenError)
+	}
+
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(grpcPluginMaxMessageSize), grpc.MaxSendMsgSize(grpcPluginMaxMessageSize))
+	server.RegisterService(&grpcPluginServiceDesc, &riskRulePluginServer{rule: rule})
+
+	_, printError := fmt.Printf("%d|%d|%v|%v|grpc\n", grpcPluginCoreProtocolVersion, grpcPluginAppProtocolVersion, listener.Addr().Network(), listener.Addr().String())
+	if printError != nil {
+		return printError
+	}
+
+	return server.Serve(listener)
+}
@@ -0,0 +1,153 @@
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const DefaultGrpcPluginTimeout = 5 * time.Minute
+
+// grpcRunner runs a gRPC risk rule plugin (see ServeGrpcPlugin); the plugin process is started for each call
+// and stopped afterward, so a crashing or hanging plugin cannot affect threagile or other plugins
+type grpcRunner struct {
+	Filename    string
+	Timeout     time.Duration
+	ErrorOutput string
+}
+
+func (p *grpcRunner) Load(filename string, timeout time.Duration) (*grpcRunner, error) {
+	*p = grpcRunner{
+		Filename: filename,
+		Timeout:  timeout,
+	}
+
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultGrpcPluginTimeout
+	}
+
+	fileInfo, statError := os.Stat(filename)
+	if statError != nil {
+		return p, statError
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return p, fmt.Errorf("grpc plugin %q is not a regular file", filename)
+	}
+
+	return p, nil
+}
+
+func (p *grpcRunner) Run(in any, out any, parameters ...string) error {
+	if len(parameters) != 1 {
+		return fmt.Errorf("expected exactly one command for grpc plugin %q, got %v", p.Filename, parameters)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.Timeout)
+	defer cancel()
+
+	var request any
+	var method string
+	switch parameters[0] {
+	case "-get-info":
+		request, method = new(emptypb.Empty), "GetInfo"
+
+	case "-generate-risks":
+		inData, inError := json.Marshal(in)
+		if inError != nil {
+			return fmt.Errorf("error encoding input data: %w", inError)
+		}
+
+		request, method = wrapperspb.Bytes(inData), "GenerateRisks"
+
+	default:
+		return fmt.Errorf("unknown command %q for grpc plugin %q", parameters[0], p.Filename)
+	}
+
+	plugin := exec.CommandContext(ctx, p.Filename) // #nosec G204
+	plugin.Env = append(os.Environ(), GrpcPluginMagicCookieKey+"="+GrpcPluginMagicCookieValue)
+
+	var stderrBuf bytes.Buffer
+	plugin.Stderr = &stderrBuf
+
+	stdout, stdoutError := plugin.StdoutPipe()
+	if stdoutError != nil {
+		return stdoutError
+	}
+
+	startError := plugin.Start()
+	if startError != nil {
+		return startError
+	}
+
+	defer func() {
+		_ = plugin.Process.Kill()
+		_ = plugin.Wait()
+		p.ErrorOutput = stderrBuf.String()
+	}()
+
+	address, handshakeError := p.handshake(ctx, stdout)
+	if handshakeError != nil {
+		return handshakeError
+	}
+
+	connection, connectError := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(grpcPluginMaxMessageSize), grpc.MaxCallSendMsgSize(grpcPluginMaxMessageSize)))
+	if connectError != nil {
+		return fmt.Errorf("error connecting to grpc plugin %q: %w", p.Filename, connectError)
+	}
+	defer func() { _ = connection.Close() }()
+
+	response := new(wrapperspb.BytesValue)
+	invokeError := connection.Invoke(ctx, "/"+grpcPluginServiceName+"/"+method, request, response)
+	if invokeError != nil {
+		return fmt.Errorf("error calling %q of grpc plugin %q: %w", method, p.Filename, invokeError)
+	}
+
+	return json.Unmarshal(response.GetValue(), out)
+}
+
+// handshake reads the address announced by the plugin process
+func (p *grpcRunner) handshake(ctx context.Context, stdout io.Reader) (string, error) {
+	lines := make(chan string, 1)
+	go func() {
+		line, _ := bufio.NewReader(stdout).ReadString('\n')
+		lines <- line
+	}()
+
+	var line string
+	select {
+	case line = <-lines:
+	case <-ctx.Done():
+		return "", fmt.Errorf("timeout waiting for handshake of grpc plugin %q", p.Filename)
+	}
+
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return "", fmt.Errorf("invalid handshake %q of grpc plugin %q", strings.TrimSpace(line), p.Filename)
+	}
+
+	if parts[0] != strconv.Itoa(grpcPluginCoreProtocolVersion) || parts[1] != strconv.Itoa(grpcPluginAppProtocolVersion) {
+		return "", fmt.Errorf("unsupported protocol version %v.%v of grpc plugin %q", parts[0], parts[1], p.Filename)
+	}
+
+	if parts[2] != "tcp" || parts[4] != "grpc" {
+		return "", fmt.Errorf("unsupported network %q or protocol %q of grpc plugin %q", parts[2], parts[4], p.Filename)
+	}
+
+	return parts[3], nil
+}
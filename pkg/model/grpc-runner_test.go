@@ -0,0 +1,196 @@
+package model
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testGrpcPluginModeKey = "THREAGILE_TEST_GRPC_PLUGIN_MODE"
+
+// TestMain lets the test binary serve as grpc plugin when started by the grpc runner
+func TestMain(m *testing.M) {
+	if os.Getenv(GrpcPluginMagicCookieKey) == GrpcPluginMagicCookieValue {
+		if err := ServeGrpcPlugin(testGrpcRule(os.Getenv(testGrpcPluginModeKey))); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+type testGrpcRule string
+
+func (r testGrpcRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{ID: "grpc-rule", Title: "gRPC Rule"}
+}
+
+func (r testGrpcRule) SupportedTags() []string {
+	return []string{"grpc"}
+}
+
+func (r testGrpcRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	switch r {
+	case "error":
+		return nil, fmt.Errorf("rule failed")
+
+	case "panic":
+		panic("rule panicked")
+
+	case "crash":
+		os.Exit(3)
+
+	case "hang":
+		time.Sleep(time.Hour)
+	}
+
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		risks = append(risks, &types.Risk{
+			CategoryId:                   "grpc-rule",
+			Severity:                     types.HighSeverity,
+			ExploitationLikelihood:       types.Likely,
+			ExploitationImpact:           types.HighImpact,
+			Title:                        "gRPC Risk at " + parsedModel.TechnicalAssets[id].Title,
+			SyntheticId:                  "grpc-rule@" + id,
+			MostRelevantTechnicalAssetId: id,
+		})
+	}
+
+	return risks, nil
+}
+
+type testProgressReporter struct {
+	warnings []string
+	errors   []string
+}
+
+func (r *testProgressReporter) Info(a ...any) {}
+
+func (r *testProgressReporter) Warn(a ...any) {
+	r.warnings = append(r.warnings, fmt.Sprint(a...))
+}
+
+func (r *testProgressReporter) Error(a ...any) {
+	r.errors = append(r.errors, fmt.Sprint(a...))
+}
+
+func (r *testProgressReporter) Infof(format string, a ...any) {}
+
+func (r *testProgressReporter) Warnf(format string, a ...any) {
+	r.warnings = append(r.warnings, fmt.Sprintf(format, a...))
+}
+
+func (r *testProgressReporter) Errorf(format string, a ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, a...))
+}
+
+func testGrpcModel() *types.Model {
+	return &types.Model{
+		Title: "Model",
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"frontend": {Id: "frontend", Title: "Frontend"},
+			"backend":  {Id: "backend", Title: "Backend"},
+		},
+	}
+}
+
+func testGrpcRunner(t *testing.T, mode string, timeout time.Duration) *grpcRunner {
+	t.Setenv(testGrpcPluginModeKey, mode)
+
+	pluginRunner, err := new(grpcRunner).Load(os.Args[0], timeout)
+	assert.Nil(t, err)
+	return pluginRunner
+}
+
+func TestGrpcRunnerGetInfo(t *testing.T) {
+	pluginRunner := testGrpcRunner(t, "", time.Minute)
+
+	risk := new(CustomRiskCategory)
+	err := pluginRunner.Run(nil, &risk, "-get-info")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "grpc-rule", risk.ID)
+	assert.Equal(t, "gRPC Rule", risk.Title)
+	assert.Equal(t, []string{"grpc"}, risk.SupportedTags())
+}
+
+func TestGrpcRunnerGenerateRisks(t *testing.T) {
+	pluginRunner := testGrpcRunner(t, "", time.Minute)
+
+	rule := &CustomRiskCategory{runner: pluginRunner, filename: pluginRunner.Filename}
+	risks, err := rule.GenerateRisks(testGrpcModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "grpc-rule@backend", risks[0].SyntheticId)
+	assert.Equal(t, "grpc-rule@frontend", risks[1].SyntheticId)
+	assert.Equal(t, types.HighImpact, risks[1].ExploitationImpact)
+}
+
+func TestGrpcRunnerFailingPlugin(t *testing.T) {
+	for _, mode := range []string{"error", "panic", "crash"} {
+		t.Run(mode, func(t *testing.T) {
+			pluginRunner := testGrpcRunner(t, mode, time.Minute)
+
+			rule := &CustomRiskCategory{runner: pluginRunner, filename: pluginRunner.Filename}
+			_, err := rule.GenerateRisks(testGrpcModel())
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestGrpcRunnerTimeout(t *testing.T) {
+	pluginRunner := testGrpcRunner(t, "hang", 2*time.Second)
+
+	start := time.Now()
+	rule := &CustomRiskCategory{runner: pluginRunner, filename: pluginRunner.Filename}
+	_, err := rule.GenerateRisks(testGrpcModel())
+
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(start), time.Minute)
+}
+
+func TestGrpcRunnerUnknownCommand(t *testing.T) {
+	pluginRunner := testGrpcRunner(t, "", time.Minute)
+
+	err := pluginRunner.Run(nil, new(CustomRiskCategory), "-explain-risk")
+
+	assert.NotNil(t, err)
+}
+
+func TestServeGrpcPluginWithoutMagicCookie(t *testing.T) {
+	assert.NotNil(t, ServeGrpcPlugin(testGrpcRule("")))
+}
+
+func TestLoadGrpcRiskRules(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin wrapper scripts require a unix shell")
+	}
+
+	t.Setenv(testGrpcPluginModeKey, "")
+	folder := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "plugin.sh"), []byte("#!/bin/sh\nexec '"+os.Args[0]+"' \"$@\"\n"), 0700)) // #nosec G306
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "no-plugin.sh"), []byte("#!/bin/sh\necho hello\n"), 0700))                // #nosec G306
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "readme.md"), []byte("not a plugin"), 0600))
+
+	reporter := new(testProgressReporter)
+	rules := LoadCustomRiskRules("", nil, "", folder, time.Minute, reporter)
+
+	assert.Len(t, rules, 1)
+	assert.Contains(t, rules, "grpc-rule")
+	assert.Len(t, reporter.errors, 1)
+
+	risks, err := rules["grpc-rule"].GenerateRisks(testGrpcModel())
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+}
@@ -0,0 +1,371 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const riskCacheVersion = 2
+
+// riskCache holds the risks generated by the risk rules in a previous run along with the hashes of the model inputs
+type riskCache struct {
+	Version              int                      `json:"version"`
+	ModelHash            string                   `json:"model_hash"`
+	TechnicalAssetHashes map[string]string        `json:"technical_asset_hashes"`
+	Risks                map[string][]*types.Risk `json:"risks"`
+}
+
+// incrementalGeneration reuses the risks of a previous run stored in the risk cache file.
+//
+// Each technical asset is hashed together with its neighborhood (communication links, communication partners and
+// containing trust boundary), everything else the rules may look at is part of the model hash.
+// If the model hash or the set of technical assets changed, all rules are evaluated on the full model.
+// Otherwise, the cached risks of a rule are reused as long as no technical asset changed. Asset-scoped rules
+// (see types.AssetScopedRiskRule) are re-evaluated on a model reduced to the changed technical assets and their
+// neighborhood, the risks of all unchanged technical assets are taken from the cache.
+// Only cacheable rules (built-in rules, whose logic is covered by the cache key) are taken from the cache at all.
+type incrementalGeneration struct {
+	filename         string
+	parsedModel      *types.Model
+	rules            types.RiskRules
+	cacheableRuleIds map[string]bool
+	previous         *riskCache
+	current          *riskCache
+	changedAssetIds  map[string]bool
+	reducedModel     *types.Model
+}
+
+func newIncrementalGeneration(filename string, cacheKey string, parsedModel *types.Model, rules types.RiskRules,
+	cacheableRuleIds map[string]bool, progressReporter types.ProgressReporter) (*incrementalGeneration, error) {
+	what := &incrementalGeneration{
+		filename:         filename,
+		parsedModel:      parsedModel,
+		rules:            rules,
+		cacheableRuleIds: cacheableRuleIds,
+		current: &riskCache{
+			Version:              riskCacheVersion,
+			TechnicalAssetHashes: make(map[string]string),
+			Risks:                make(map[string][]*types.Risk),
+		},
+		changedAssetIds: make(map[string]bool),
+	}
+
+	var hashError error
+	what.current.ModelHash, hashError = modelHash(cacheKey, parsedModel)
+	if hashError != nil {
+		return nil, hashError
+	}
+
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		what.current.TechnicalAssetHashes[id], hashError = technicalAssetHash(parsedModel, parsedModel.TechnicalAssets[id])
+		if hashError != nil {
+			return nil, hashError
+		}
+	}
+
+	what.previous = loadRiskCache(filename)
+	if what.previous == nil || !what.previous.matches(what.current) {
+		what.previous = nil
+		progressReporter.Infof("Risk cache %q missing or outdated, evaluating all risk rules", filename)
+		return what, nil
+	}
+
+	for id, hash := range what.current.TechnicalAssetHashes {
+		if what.previous.TechnicalAssetHashes[id] != hash {
+			what.changedAssetIds[id] = true
+		}
+	}
+
+	if len(what.changedAssetIds) > 0 {
+		what.reducedModel = reduceModel(parsedModel, what.changedAssetIds)
+	}
+
+	progressReporter.Infof("Risk cache %q loaded, %d of %d technical assets changed", filename, len(what.changedAssetIds), len(what.current.TechnicalAssetHashes))
+	return what, nil
+}
+
+// generateRisks generates the risks of the rule, reusing the cached risks where possible
+func (what *incrementalGeneration) generateRisks(id string) ([]*types.Risk, error) {
+	cachedRisks, cached := what.cachedRisks(id)
+	if !cached {
+		return what.rules[id].GenerateRisks(what.parsedModel)
+	}
+
+	if len(what.changedAssetIds) == 0 {
+		return cachedRisks, nil
+	}
+
+	newRisks, riskError := what.rules[id].GenerateRisks(what.reducedModel)
+	if riskError != nil {
+		return nil, riskError
+	}
+
+	risks := make([]*types.Risk, 0)
+	for _, risk := range cachedRisks {
+		if !what.changedAssetIds[risk.MostRelevantTechnicalAssetId] {
+			risks = append(risks, risk)
+		}
+	}
+
+	for _, risk := range newRisks {
+		if what.changedAssetIds[risk.MostRelevantTechnicalAssetId] {
+			risks = append(risks, risk)
+		}
+	}
+
+	sort.SliceStable(risks, func(i, j int) bool {
+		return risks[i].SyntheticId < risks[j].SyntheticId
+	})
+
+	return risks, nil
+}
+
+// assetsScanned returns the number of technical assets the rule is run on, which is zero if its risks are fully taken from the cache
+func (what *incrementalGeneration) assetsScanned(id string) int {
+	if _, cached := what.cachedRisks(id); !cached {
+		return len(what.parsedModel.TechnicalAssets)
+	}
+
+	if len(what.changedAssetIds) == 0 {
+		return 0
+	}
+
+	return len(what.reducedModel.TechnicalAssets)
+}
+
+// cachedRisks returns the cached risks of the rule if they can be used for this run
+func (what *incrementalGeneration) cachedRisks(id string) ([]*types.Risk, bool) {
+	if what.previous == nil || !what.cacheableRuleIds[id] {
+		return nil, false
+	}
+
+	cachedRisks, ok := what.previous.Risks[id]
+	if !ok {
+		return nil, false
+	}
+
+	if len(what.changedAssetIds) == 0 {
+		return cachedRisks, true
+	}
+
+	scopedRule, isScoped := what.rules[id].(types.AssetScopedRiskRule)
+	if !isScoped || !scopedRule.IsAssetScoped() {
+		return nil, false
+	}
+
+	for _, risk := range cachedRisks {
+		if len(risk.MostRelevantTechnicalAssetId) == 0 {
+			return nil, false
+		}
+	}
+
+	return cachedRisks, true
+}
+
+// save writes the risks generated in this run to the risk cache file; risks of failed rules are not cached.
+// It has to be called before the generated risks are changed (like by rating overrides).
+func (what *incrementalGeneration) save(ruleIds []string, results []ruleResult) error {
+	for index, result := range results {
+		if result.err == nil && what.cacheableRuleIds[ruleIds[index]] {
+			what.current.Risks[ruleIds[index]] = result.risks
+		}
+	}
+
+	data, marshalError := json.Marshal(what.current)
+	if marshalError != nil {
+		return marshalError
+	}
+
+	_ = os.MkdirAll(filepath.Dir(what.filename), 0750)
+	return os.WriteFile(what.filename, data, 0600)
+}
+
+func loadRiskCache(filename string) *riskCache {
+	data, readError := os.ReadFile(filepath.Clean(filename))
+	if readError != nil {
+		return nil
+	}
+
+	cache := new(riskCache)
+	if json.Unmarshal(data, cache) != nil {
+		return nil
+	}
+
+	return cache
+}
+
+// matches checks if the cache was written by a compatible run on a model with the same (unhashed) inputs and technical assets
+func (what *riskCache) matches(current *riskCache) bool {
+	if what.Version != current.Version || what.ModelHash != current.ModelHash || len(what.TechnicalAssetHashes) != len(current.TechnicalAssetHashes) {
+		return false
+	}
+
+	for id := range current.TechnicalAssetHashes {
+		if _, ok := what.TechnicalAssetHashes[id]; !ok {
+			return false
+		}
+	}
+
+	return what.Risks != nil
+}
+
+func modelHash(cacheKey string, parsedModel *types.Model) (string, error) {
+	return hashOf(struct {
+		CacheKey            string                          `json:"cache_key"`
+		BusinessCriticality types.Criticality               `json:"business_criticality"`
+		DataAssets          map[string]*types.DataAsset     `json:"data_assets"`
+		TrustBoundaries     map[string]*types.TrustBoundary `json:"trust_boundaries"`
+		SharedRuntimes      map[string]*types.SharedRuntime `json:"shared_runtimes"`
+		TagsAvailable       []string                        `json:"tags_available"`
+	}{
+		CacheKey:            cacheKey,
+		BusinessCriticality: parsedModel.BusinessCriticality,
+		DataAssets:          parsedModel.DataAssets,
+		TrustBoundaries:     parsedModel.TrustBoundaries,
+		SharedRuntimes:      parsedModel.SharedRuntimes,
+		TagsAvailable:       parsedModel.TagsAvailable,
+	})
+}
+
+// technicalAssetHash hashes the technical asset (including its outgoing communication links), its incoming
+// communication links, its communication partners and the trust boundaries containing any of them
+func technicalAssetHash(parsedModel *types.Model, techAsset *types.TechnicalAsset) (string, error) {
+	neighbors := make(map[string]*types.TechnicalAsset)
+	for _, neighborId := range neighborIds(parsedModel, techAsset.Id) {
+		neighbors[neighborId] = parsedModel.TechnicalAssets[neighborId]
+	}
+
+	trustBoundaryIds := make(map[string]string)
+	for id := range neighbors {
+		if trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[id]; ok {
+			trustBoundaryIds[id] = trustBoundary.Id
+		}
+	}
+
+	if trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]; ok {
+		trustBoundaryIds[techAsset.Id] = trustBoundary.Id
+	}
+
+	// the order of the incoming communication links depends on the order the model was parsed in
+	incomingLinks := slices.Clone(parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id])
+	sort.Slice(incomingLinks, func(i, j int) bool {
+		return incomingLinks[i].Id < incomingLinks[j].Id
+	})
+
+	return hashOf(struct {
+		TechnicalAsset   *types.TechnicalAsset            `json:"technical_asset"`
+		IncomingLinks    []*types.CommunicationLink       `json:"incoming_links"`
+		Neighbors        map[string]*types.TechnicalAsset `json:"neighbors"`
+		TrustBoundaryIds map[string]string                `json:"trust_boundary_ids"`
+	}{
+		TechnicalAsset:   techAsset,
+		IncomingLinks:    incomingLinks,
+		Neighbors:        neighbors,
+		TrustBoundaryIds: trustBoundaryIds,
+	})
+}
+
+// neighborIds returns the ids of all technical assets communicating with the given one, in either direction
+func neighborIds(parsedModel *types.Model, techAssetId string) []string {
+	ids := make(map[string]bool)
+	for _, commLink := range parsedModel.TechnicalAssets[techAssetId].CommunicationLinks {
+		ids[commLink.TargetId] = true
+	}
+
+	for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAssetId] {
+		ids[commLink.SourceId] = true
+	}
+
+	delete(ids, techAssetId)
+
+	result := make([]string, 0)
+	for id := range ids {
+		if _, ok := parsedModel.TechnicalAssets[id]; ok {
+			result = append(result, id)
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// reduceModel returns a model containing only the changed technical assets and their communication partners;
+// communication links of the partners leading outside the reduced model are left out, everything else is shared
+func reduceModel(parsedModel *types.Model, changedAssetIds map[string]bool) *types.Model {
+	reducedModel := &types.Model{
+		ThreagileVersion:      parsedModel.ThreagileVersion,
+		Title:                 parsedModel.Title,
+		BusinessCriticality:   parsedModel.BusinessCriticality,
+		SecurityRequirements:  parsedModel.SecurityRequirements,
+		TagsAvailable:         parsedModel.TagsAvailable,
+		DataAssets:            parsedModel.DataAssets,
+		TechnicalAssets:       make(map[string]*types.TechnicalAsset),
+		TrustBoundaries:       parsedModel.TrustBoundaries,
+		SharedRuntimes:        parsedModel.SharedRuntimes,
+		CustomRiskCategories:  parsedModel.CustomRiskCategories,
+		BuiltInRiskCategories: parsedModel.BuiltInRiskCategories,
+		CommunicationLinks:    make(map[string]*types.CommunicationLink),
+		AllSupportedTags:      parsedModel.AllSupportedTags,
+
+		IncomingTechnicalCommunicationLinksMappedByTargetId:   make(map[string][]*types.CommunicationLink),
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: make(map[string]*types.TrustBoundary),
+		GeneratedRisksByCategory:                              make(map[string][]*types.Risk),
+		GeneratedRisksBySyntheticId:                           make(map[string]*types.Risk),
+	}
+
+	for id := range changedAssetIds {
+		reducedModel.TechnicalAssets[id] = parsedModel.TechnicalAssets[id]
+		for _, neighborId := range neighborIds(parsedModel, id) {
+			if _, ok := reducedModel.TechnicalAssets[neighborId]; !ok && !changedAssetIds[neighborId] {
+				reducedModel.TechnicalAssets[neighborId] = nil
+			}
+		}
+	}
+
+	for id := range reducedModel.TechnicalAssets {
+		if changedAssetIds[id] {
+			continue
+		}
+
+		neighbor := *parsedModel.TechnicalAssets[id]
+		neighbor.CommunicationLinks = make([]*types.CommunicationLink, 0)
+		for _, commLink := range parsedModel.TechnicalAssets[id].CommunicationLinks {
+			if _, ok := reducedModel.TechnicalAssets[commLink.TargetId]; ok {
+				neighbor.CommunicationLinks = append(neighbor.CommunicationLinks, commLink)
+			}
+		}
+
+		reducedModel.TechnicalAssets[id] = &neighbor
+	}
+
+	for _, id := range reducedModel.SortedTechnicalAssetIDs() {
+		for _, commLink := range reducedModel.TechnicalAssets[id].CommunicationLinks {
+			reducedModel.CommunicationLinks[commLink.Id] = commLink
+			reducedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[commLink.TargetId] = append(
+				reducedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[commLink.TargetId], commLink)
+		}
+
+		if trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[id]; ok {
+			reducedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[id] = trustBoundary
+		}
+	}
+
+	return reducedModel
+}
+
+func hashOf(value any) (string, error) {
+	data, marshalError := json.Marshal(value)
+	if marshalError != nil {
+		return "", marshalError
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
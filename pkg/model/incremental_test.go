@@ -0,0 +1,228 @@
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/risks/builtin"
+	risktesting "github.com/threagile/threagile/pkg/risks/testing"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// recordingRule records the models it generates risks for
+type recordingRule struct {
+	types.RiskRule
+	scoped bool
+	models []*types.Model
+}
+
+func (what *recordingRule) IsAssetScoped() bool {
+	return what.scoped
+}
+
+func (what *recordingRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	what.models = append(what.models, parsedModel)
+	return what.RiskRule.GenerateRisks(parsedModel)
+}
+
+func testIncrementalModel(clientConfidentiality types.Confidentiality) *types.Model {
+	return risktesting.NewModel().
+		DataAsset("customer-data", func(dataAsset *types.DataAsset) {
+			dataAsset.Confidentiality = types.StrictlyConfidential
+		}).
+		TechnicalAsset("client", func(techAsset *types.TechnicalAsset) {
+			techAsset.Confidentiality = clientConfidentiality
+		}).
+		TechnicalAsset("web").
+		TechnicalAsset("db", func(techAsset *types.TechnicalAsset) {
+			techAsset.Type = types.Datastore
+			techAsset.Technologies = types.TechnologyList{risktesting.Technology("database", types.IsVulnerableToQueryInjection)}
+			techAsset.DataAssetsStored = []string{"customer-data"}
+		}).
+		TechnicalAsset("other-db", func(techAsset *types.TechnicalAsset) {
+			techAsset.Type = types.Datastore
+			techAsset.Technologies = types.TechnologyList{risktesting.Technology("database", types.IsVulnerableToQueryInjection)}
+		}).
+		TechnicalAsset("other").
+		CommunicationLink("client", "web", func(commLink *types.CommunicationLink) {
+			commLink.Protocol = types.HTTP
+			commLink.DataAssetsSent = []string{"customer-data"}
+		}).
+		CommunicationLink("web", "db", func(commLink *types.CommunicationLink) {
+			commLink.Protocol = types.JDBC
+			commLink.DataAssetsSent = []string{"customer-data"}
+		}).
+		CommunicationLink("other", "other-db", func(commLink *types.CommunicationLink) {
+			commLink.Protocol = types.JDBC
+			commLink.DataAssetsSent = []string{"customer-data"}
+		}).
+		Build()
+}
+
+func testIncrementalRules() (types.RiskRules, *recordingRule, *recordingRule) {
+	scopedRule := &recordingRule{RiskRule: builtin.NewSqlNoSqlInjectionRule(), scoped: true}
+	unscopedRule := &recordingRule{RiskRule: builtin.NewUnencryptedCommunicationRule()}
+	rules := types.RiskRules{
+		scopedRule.Category().ID:   scopedRule,
+		unscopedRule.Category().ID: unscopedRule,
+	}
+
+	return rules, scopedRule, unscopedRule
+}
+
+func runIncremental(t *testing.T, filename string, cacheKey string, parsedModel *types.Model, rules types.RiskRules, cacheableRuleIds map[string]bool) map[string][]string {
+	incremental, err := newIncrementalGeneration(filename, cacheKey, parsedModel, rules, cacheableRuleIds, &testProgressReporter{})
+	assert.NoError(t, err)
+
+	ruleIds := rules.SortedIds()
+	results := generateRisksConcurrently(ruleIds, 1, incremental.generateRisks)
+	assert.NoError(t, incremental.save(ruleIds, results))
+
+	return syntheticIds(ruleIds, results)
+}
+
+func syntheticIds(ruleIds []string, results []ruleResult) map[string][]string {
+	ids := make(map[string][]string)
+	for index, result := range results {
+		ids[ruleIds[index]] = make([]string, 0)
+		for _, risk := range result.risks {
+			ids[ruleIds[index]] = append(ids[ruleIds[index]], risk.SyntheticId)
+		}
+		sort.Strings(ids[ruleIds[index]])
+	}
+
+	return ids
+}
+
+func allCacheable(rules types.RiskRules) map[string]bool {
+	cacheableRuleIds := make(map[string]bool)
+	for id := range rules {
+		cacheableRuleIds[id] = true
+	}
+
+	return cacheableRuleIds
+}
+
+func TestIncrementalGenerationReusesCachedRisks(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "risk-cache.json")
+	rules, scopedRule, unscopedRule := testIncrementalRules()
+
+	first := runIncremental(t, filename, "key", testIncrementalModel(types.Internal), rules, allCacheable(rules))
+	assert.FileExists(t, filename)
+	assert.Len(t, scopedRule.models, 1)
+	assert.Len(t, unscopedRule.models, 1)
+
+	second := runIncremental(t, filename, "key", testIncrementalModel(types.Internal), rules, allCacheable(rules))
+	assert.Equal(t, first, second)
+	assert.Len(t, scopedRule.models, 1)
+	assert.Len(t, unscopedRule.models, 1)
+}
+
+func TestIncrementalGenerationReevaluatesChangedTechnicalAssets(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "risk-cache.json")
+	rules, scopedRule, unscopedRule := testIncrementalRules()
+	runIncremental(t, filename, "key", testIncrementalModel(types.Internal), rules, allCacheable(rules))
+
+	changedModel := testIncrementalModel(types.Confidential)
+	incremental := runIncremental(t, filename, "key", changedModel, rules, allCacheable(rules))
+
+	// the scoped rule only sees the changed client, its partner web and the partners of web
+	assert.Len(t, scopedRule.models, 2)
+	reducedModel := scopedRule.models[1]
+	assert.ElementsMatch(t, []string{"client", "web", "db"}, reducedModel.SortedTechnicalAssetIDs())
+	assert.Len(t, reducedModel.TechnicalAssets["db"].CommunicationLinks, 0)
+	assert.Same(t, changedModel.TechnicalAssets["client"], reducedModel.TechnicalAssets["client"])
+
+	// rules not scoped to assets run on the full model
+	assert.Len(t, unscopedRule.models, 2)
+	assert.Same(t, changedModel, unscopedRule.models[1])
+
+	fullRules, _, _ := testIncrementalRules()
+	ruleIds := fullRules.SortedIds()
+	full := syntheticIds(ruleIds, generateRisksConcurrently(ruleIds, 1, func(id string) ([]*types.Risk, error) {
+		return fullRules[id].GenerateRisks(testIncrementalModel(types.Confidential))
+	}))
+	assert.Equal(t, full, incremental)
+	assert.Len(t, incremental["sql-nosql-injection"], 2)
+}
+
+func TestIncrementalGenerationOutdatedCache(t *testing.T) {
+	testCases := map[string]func(filename string) (string, *types.Model){
+		"cache key changed": func(string) (string, *types.Model) {
+			return "other-key", testIncrementalModel(types.Internal)
+		},
+		"data asset changed": func(string) (string, *types.Model) {
+			parsedModel := testIncrementalModel(types.Internal)
+			parsedModel.DataAssets["customer-data"].Integrity = types.MissionCritical
+			return "key", parsedModel
+		},
+		"technical asset added": func(string) (string, *types.Model) {
+			parsedModel := testIncrementalModel(types.Internal)
+			parsedModel.TechnicalAssets["new"] = &types.TechnicalAsset{Id: "new", Title: "new"}
+			return "key", parsedModel
+		},
+		"invalid cache file": func(filename string) (string, *types.Model) {
+			assert.NoError(t, os.WriteFile(filename, []byte("{invalid"), 0600))
+			return "key", testIncrementalModel(types.Internal)
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "risk-cache.json")
+			rules, scopedRule, unscopedRule := testIncrementalRules()
+			runIncremental(t, filename, "key", testIncrementalModel(types.Internal), rules, allCacheable(rules))
+
+			cacheKey, parsedModel := testCase(filename)
+			runIncremental(t, filename, cacheKey, parsedModel, rules, allCacheable(rules))
+
+			assert.Len(t, scopedRule.models, 2)
+			assert.Same(t, parsedModel, scopedRule.models[1])
+			assert.Len(t, unscopedRule.models, 2)
+			assert.Same(t, parsedModel, unscopedRule.models[1])
+		})
+	}
+}
+
+func TestIncrementalGenerationSkipsRulesNotCacheable(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "risk-cache.json")
+	rules, scopedRule, unscopedRule := testIncrementalRules()
+	cacheableRuleIds := map[string]bool{scopedRule.Category().ID: true}
+
+	runIncremental(t, filename, "key", testIncrementalModel(types.Internal), rules, cacheableRuleIds)
+	runIncremental(t, filename, "key", testIncrementalModel(types.Internal), rules, cacheableRuleIds)
+
+	assert.Len(t, scopedRule.models, 1)
+	assert.Len(t, unscopedRule.models, 2)
+}
+
+func TestIncrementalGenerationAssetsScanned(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "risk-cache.json")
+	rules, scopedRule, unscopedRule := testIncrementalRules()
+
+	assetsScanned := func(parsedModel *types.Model) map[string]int {
+		incremental, err := newIncrementalGeneration(filename, "key", parsedModel, rules, allCacheable(rules), &testProgressReporter{})
+		assert.NoError(t, err)
+
+		ruleIds := rules.SortedIds()
+		results := generateRisksConcurrently(ruleIds, 1, incremental.generateRisks)
+		assert.NoError(t, incremental.save(ruleIds, results))
+
+		return map[string]int{
+			scopedRule.Category().ID:   incremental.assetsScanned(scopedRule.Category().ID),
+			unscopedRule.Category().ID: incremental.assetsScanned(unscopedRule.Category().ID),
+		}
+	}
+
+	// no cache yet, all rules scan all technical assets
+	assert.Equal(t, map[string]int{"sql-nosql-injection": 5, "unencrypted-communication": 5}, assetsScanned(testIncrementalModel(types.Internal)))
+
+	// nothing changed, all risks are taken from the cache
+	assert.Equal(t, map[string]int{"sql-nosql-injection": 0, "unencrypted-communication": 0}, assetsScanned(testIncrementalModel(types.Internal)))
+
+	// the scoped rule only scans the reduced model
+	assert.Equal(t, map[string]int{"sql-nosql-injection": 3, "unencrypted-communication": 5}, assetsScanned(testIncrementalModel(types.Confidential)))
+}
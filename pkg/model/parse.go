@@ -301,6 +301,10 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 				if err != nil {
 					return nil, err
 				}
+				suppressedRisks, err := parseSuppressedRisks(commLink.SuppressedRisks, "communication link '"+commLinkTitle+"' of technical asset '"+title+"'")
+				if err != nil {
+					return nil, err
+				}
 				commLink := &types.CommunicationLink{
 					Id:                     commLinkId,
 					SourceId:               id,
@@ -319,6 +323,7 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 					DataAssetsReceived:     dataAssetsReceived,
 					DiagramTweakWeight:     weight,
 					DiagramTweakConstraint: !commLink.DiagramTweakConstraint,
+					SuppressedRisks:        suppressedRisks,
 				}
 				communicationLinks = append(communicationLinks, commLink)
 				// track all comm links
@@ -340,6 +345,10 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 		if err != nil {
 			return nil, err
 		}
+		suppressedRisks, err := parseSuppressedRisks(asset.SuppressedRisks, fmt.Sprintf("technical asset %q", title))
+		if err != nil {
+			return nil, err
+		}
 		parsedModel.TechnicalAssets[id] = &types.TechnicalAsset{
 			Id:                      id,
 			Usage:                   usage,
@@ -359,6 +368,7 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			OutOfScope:              asset.OutOfScope,
 			JustificationOutOfScope: fmt.Sprintf("%v", asset.JustificationOutOfScope),
 			Owner:                   fmt.Sprintf("%v", asset.Owner),
+			Location:                strings.TrimSpace(asset.Location),
 			Confidentiality:         confidentiality,
 			Integrity:               integrity,
 			Availability:            availability,
@@ -368,6 +378,7 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			DataFormatsAccepted:     dataFormatsAccepted,
 			CommunicationLinks:      communicationLinks,
 			DiagramTweakOrder:       asset.DiagramTweakOrder,
+			SuppressedRisks:         suppressedRisks,
 		}
 	}
 
@@ -704,6 +715,17 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 		parsedModel.RiskTracking[syntheticRiskId] = tracking
 	}
 
+	// Security Invariants =========================================================================
+	parsedModel.SecurityInvariants = make([]types.SecurityInvariant, 0)
+	for i, securityInvariant := range modelInput.SecurityInvariants {
+		invariant, err := parseSecurityInvariant(&parsedModel, securityInvariant, fmt.Sprintf("security invariant #%d", i+1))
+		if err != nil {
+			return nil, err
+		}
+
+		parsedModel.SecurityInvariants = append(parsedModel.SecurityInvariants, invariant)
+	}
+
 	// ====================== model consistency check (linking)
 	for _, technicalAsset := range parsedModel.TechnicalAssets {
 		for _, commLink := range technicalAsset.CommunicationLinks {
@@ -711,6 +733,16 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 			if err != nil {
 				return nil, err
 			}
+
+			err = checkSuppressedRiskCategories(commLink.SuppressedRisks, "communication link '"+commLink.Title+"' of technical asset '"+technicalAsset.Title+"'", builtinRiskRules, customRiskRules)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err := checkSuppressedRiskCategories(technicalAsset.SuppressedRisks, fmt.Sprintf("technical asset %q", technicalAsset.Title), builtinRiskRules, customRiskRules)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -736,6 +768,80 @@ func ParseModel(config technologyMapConfigReader, modelInput *input.Model, built
 	return &parsedModel, nil
 }
 
+func parseSecurityInvariant(parsedModel *types.Model, invariant input.SecurityInvariant, where string) (types.SecurityInvariant, error) {
+	fromBoundaryTag := strings.ToLower(strings.TrimSpace(invariant.FromBoundaryTag))
+	toBoundaryTag := strings.ToLower(strings.TrimSpace(invariant.ToBoundaryTag))
+	boundaryTag := strings.ToLower(strings.TrimSpace(invariant.BoundaryTag))
+
+	var result types.SecurityInvariant
+	var requiredTags []string
+	switch strings.ToLower(strings.TrimSpace(invariant.Type)) {
+	case types.RequireEncryptionAcrossBoundaryInvariant:
+		requiredTags = []string{fromBoundaryTag, toBoundaryTag}
+		result = types.RequireEncryptionAcrossBoundary(fromBoundaryTag, toBoundaryTag)
+
+	case types.RequireAuthenticationInvariant:
+		requiredTags = []string{boundaryTag}
+		result = types.RequireAuthentication(boundaryTag)
+
+	case types.ProhibitDirectLinkInvariant:
+		requiredTags = []string{fromBoundaryTag, toBoundaryTag}
+		result = types.ProhibitDirectLink(fromBoundaryTag, toBoundaryTag)
+
+	default:
+		return nil, fmt.Errorf("unknown 'type' value of %v: %v", where, invariant.Type)
+	}
+
+	for _, tag := range requiredTags {
+		err := parsedModel.CheckTagExists(tag, where)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func parseSuppressedRisks(suppressedRisks []input.SuppressedRisk, where string) ([]*types.SuppressedRisk, error) {
+	result := make([]*types.SuppressedRisk, 0)
+	for i, suppressedRisk := range suppressedRisks {
+		categoryId := strings.TrimSpace(suppressedRisk.Category)
+		if len(categoryId) == 0 {
+			return nil, fmt.Errorf("missing 'category' of suppressed risk #%d of %v", i+1, where)
+		}
+
+		justification := strings.TrimSpace(suppressedRisk.Justification)
+		if len(justification) == 0 {
+			return nil, fmt.Errorf("missing 'justification' of suppressed risk %q of %v", categoryId, where)
+		}
+
+		expires, parseError := time.Parse("2006-01-02", suppressedRisk.Expires)
+		if parseError != nil {
+			return nil, fmt.Errorf("unable to parse 'expires' of suppressed risk %q of %v: %v", categoryId, where, suppressedRisk.Expires)
+		}
+
+		result = append(result, &types.SuppressedRisk{
+			CategoryId:    categoryId,
+			Justification: justification,
+			Expires:       types.Date{Time: expires},
+		})
+	}
+
+	return result, nil
+}
+
+func checkSuppressedRiskCategories(suppressedRisks []*types.SuppressedRisk, where string, builtinRiskRules types.RiskRules, customRiskRules types.RiskRules) error {
+	for _, suppressedRisk := range suppressedRisks {
+		_, isBuiltin := builtinRiskRules[suppressedRisk.CategoryId]
+		_, isCustom := customRiskRules[suppressedRisk.CategoryId]
+		if !isBuiltin && !isCustom {
+			return fmt.Errorf("unknown risk category %q of suppressed risk of %v", suppressedRisk.CategoryId, where)
+		}
+	}
+
+	return nil
+}
+
 func convertAuthor(author input.Author) *types.Author {
 	return &types.Author{
 		Name:     author.Name,
@@ -149,6 +149,77 @@ func TestInferAvailability_ExpectHighestAvailability(t *testing.T) {
 	assert.Equal(t, types.Operational, parsedModel.TechnicalAssets[taWithArchiveAvailabilityDataAsset.ID].Availability)
 }
 
+func TestParseSecurityInvariants(t *testing.T) {
+	modelInput := createInputModel(make(map[string]input.TechnicalAsset), make(map[string]input.DataAsset))
+	modelInput.TagsAvailable = []string{"pci", "internet"}
+	modelInput.SecurityInvariants = []input.SecurityInvariant{
+		{Type: "require-encryption-across-boundary", FromBoundaryTag: "PCI", ToBoundaryTag: "internet"},
+		{Type: "require-authentication", BoundaryTag: "pci"},
+		{Type: "prohibit-direct-link", FromBoundaryTag: "internet", ToBoundaryTag: "pci"},
+	}
+
+	parsedModel, err := ParseModel(&mockConfig{}, modelInput, make(types.RiskRules), make(types.RiskRules))
+
+	assert.NoError(t, err)
+	assert.Len(t, parsedModel.SecurityInvariants, 3)
+}
+
+func TestParseSecurityInvariantUnknownType(t *testing.T) {
+	modelInput := createInputModel(make(map[string]input.TechnicalAsset), make(map[string]input.DataAsset))
+	modelInput.SecurityInvariants = []input.SecurityInvariant{{Type: "unknown"}}
+
+	_, err := ParseModel(&mockConfig{}, modelInput, make(types.RiskRules), make(types.RiskRules))
+
+	assert.Error(t, err)
+}
+
+func TestParseSecurityInvariantMissingTag(t *testing.T) {
+	modelInput := createInputModel(make(map[string]input.TechnicalAsset), make(map[string]input.DataAsset))
+	modelInput.TagsAvailable = []string{"pci"}
+	modelInput.SecurityInvariants = []input.SecurityInvariant{{Type: "prohibit-direct-link", FromBoundaryTag: "pci", ToBoundaryTag: "internet"}}
+
+	_, err := ParseModel(&mockConfig{}, modelInput, make(types.RiskRules), make(types.RiskRules))
+
+	assert.Error(t, err)
+}
+
+func TestParseSuppressedRisks(t *testing.T) {
+	ta := make(map[string]input.TechnicalAsset)
+	asset := createTechnicalAsset(types.Internal, types.Operational, types.Operational)
+	asset.SuppressedRisks = []input.SuppressedRisk{{Category: "grpc-rule", Justification: "accepted by design", Expires: "2030-01-31"}}
+	ta[asset.ID] = asset
+
+	parsedModel, err := ParseModel(&mockConfig{}, createInputModel(ta, make(map[string]input.DataAsset)), types.RiskRules{"grpc-rule": testGrpcRule("")}, make(types.RiskRules))
+
+	assert.NoError(t, err)
+	assert.Len(t, parsedModel.TechnicalAssets[asset.ID].SuppressedRisks, 1)
+	assert.Equal(t, "grpc-rule", parsedModel.TechnicalAssets[asset.ID].SuppressedRisks[0].CategoryId)
+	assert.Equal(t, "2030-01-31", parsedModel.TechnicalAssets[asset.ID].SuppressedRisks[0].Expires.Format("2006-01-02"))
+}
+
+func TestParseSuppressedRisksInvalid(t *testing.T) {
+	testCases := map[string]input.SuppressedRisk{
+		"unknown category":      {Category: "unknown", Justification: "accepted by design", Expires: "2030-01-31"},
+		"missing category":      {Justification: "accepted by design", Expires: "2030-01-31"},
+		"missing justification": {Category: "grpc-rule", Expires: "2030-01-31"},
+		"missing expiry":        {Category: "grpc-rule", Justification: "accepted by design"},
+		"invalid expiry":        {Category: "grpc-rule", Justification: "accepted by design", Expires: "soon"},
+	}
+
+	for name, suppressedRisk := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ta := make(map[string]input.TechnicalAsset)
+			asset := createTechnicalAsset(types.Internal, types.Operational, types.Operational)
+			asset.SuppressedRisks = []input.SuppressedRisk{suppressedRisk}
+			ta[asset.ID] = asset
+
+			_, err := ParseModel(&mockConfig{}, createInputModel(ta, make(map[string]input.DataAsset)), types.RiskRules{"grpc-rule": testGrpcRule("")}, make(types.RiskRules))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
 func createInputModel(technicalAssets map[string]input.TechnicalAsset, dataAssets map[string]input.DataAsset) *input.Model {
 	return &input.Model{
 		TechnicalAssets: technicalAssets,
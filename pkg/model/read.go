@@ -1,13 +1,18 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/threagile/threagile/pkg/input"
+	"github.com/threagile/threagile/pkg/risks"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -17,16 +22,44 @@ type ReadResult struct {
 	IntroTextRAA     string
 	BuiltinRiskRules types.RiskRules
 	CustomRiskRules  types.RiskRules
+	SkippedRiskRules []string
+	RuleTraces       *RuleTraces
 }
 
 type explainRiskConfig interface {
 }
 
 type explainRiskReporter interface {
+	Println(i ...interface{})
 }
 
+// ExplainRisk reports why the risk was flagged; the risk is given by its synthetic id, or as "<category>@*" for all risks of a category
 func (what ReadResult) ExplainRisk(cfg explainRiskConfig, risk string, reporter explainRiskReporter) error {
-	return fmt.Errorf("not implemented")
+	categoryId, _, _ := strings.Cut(risk, "@")
+	rule, ok := what.CustomRiskRules[categoryId]
+	if !ok {
+		rule, ok = what.BuiltinRiskRules[categoryId]
+	}
+
+	if !ok {
+		return fmt.Errorf("unknown risk category %q", categoryId)
+	}
+
+	explainableRule, ok := rule.(types.ExplainableRiskRule)
+	if !ok {
+		return fmt.Errorf("risk rule %q does not support explaining risks", categoryId)
+	}
+
+	explanation := explainableRule.ExplainRisk(what.ParsedModel, risk)
+	if len(explanation) == 0 {
+		return fmt.Errorf("risk %q not found", risk)
+	}
+
+	for _, line := range explanation {
+		reporter.Println(line)
+	}
+
+	return nil
 }
 
 // TODO: consider about splitting this function into smaller ones for better reusability
@@ -44,6 +77,8 @@ type configReader interface {
 	GetKeyFolder() string
 	GetInputFile() string
 	GetImportedInputFile() string
+	GetModelOverlays() []string
+	GetModelVariables() map[string]string
 	GetDataFlowDiagramFilenamePNG() string
 	GetDataAssetDiagramFilenamePNG() string
 	GetDataFlowDiagramFilenameDOT() string
@@ -57,7 +92,17 @@ type configReader interface {
 	GetTemplateFilename() string
 	GetTechnologyFilename() string
 	GetRiskRulePlugins() []string
+	GetRiskRulesFolder() string
+	GetGrpcPluginFolder() string
+	GetGrpcPluginTimeout() time.Duration
+	GetRiskRulesConfigFilename() string
+	GetRiskRulesProfile() string
 	GetSkipRiskRules() []string
+	GetRiskRuleWorkers() int
+	GetRiskCacheFilename() string
+	GetConsolidateRisks() bool
+	GetRiskLabels() []string
+	GetRuleTracesFilename() string
 	GetExecuteModelMacro() string
 	GetRiskExcelConfigHideColumns() []string
 	GetRiskExcelConfigSortByColumns() []string
@@ -80,14 +125,22 @@ func ReadAndAnalyzeModel(config configReader, builtinRiskRules types.RiskRules,
 	progressReporter.Infof("Writing into output directory: %v", config.GetOutputFolder())
 	progressReporter.Infof("Parsing model: %v", config.GetInputFile())
 
-	customRiskRules := LoadCustomRiskRules(config.GetPluginFolder(), config.GetRiskRulePlugins(), progressReporter)
+	customRiskRules := LoadCustomRiskRules(config.GetPluginFolder(), config.GetRiskRulePlugins(), config.GetRiskRulesFolder(), config.GetGrpcPluginFolder(), config.GetGrpcPluginTimeout(), progressReporter)
 
 	modelInput := new(input.Model).Defaults()
-	loadError := modelInput.Load(config.GetInputFile())
+	loadError := modelInput.LoadWithVariables(config.GetInputFile(), config.GetModelVariables())
 	if loadError != nil {
 		return nil, fmt.Errorf("unable to load model yaml: %w", loadError)
 	}
 
+	for _, overlay := range config.GetModelOverlays() {
+		progressReporter.Infof("Applying model overlay: %v", overlay)
+		overlayError := modelInput.ApplyOverlay(overlay)
+		if overlayError != nil {
+			return nil, fmt.Errorf("unable to apply model overlay %q: %w", overlay, overlayError)
+		}
+	}
+
 	result, analysisError := AnalyzeModel(modelInput, config, builtinRiskRules, customRiskRules, progressReporter)
 	if analysisError == nil {
 		writeToFile("model yaml", result.ParsedModel, config.GetImportedInputFile(), progressReporter)
@@ -98,14 +151,79 @@ func ReadAndAnalyzeModel(config configReader, builtinRiskRules types.RiskRules,
 
 func AnalyzeModel(modelInput *input.Model, config configReader, builtinRiskRules types.RiskRules, customRiskRules types.RiskRules, progressReporter types.ProgressReporter) (*ReadResult, error) {
 
+	rulesConfig, rulesConfigError := LoadRulesConfig(config.GetRiskRulesConfigFilename())
+	if rulesConfigError != nil {
+		return nil, rulesConfigError
+	}
+
+	parametersError := rulesConfig.ApplyParameters(make(types.RiskRules).Merge(builtinRiskRules).Merge(customRiskRules))
+	if parametersError != nil {
+		return nil, fmt.Errorf("unable to apply rules config: %w", parametersError)
+	}
+
+	skippedRiskRules, profileError := rulesConfig.SkippedRules(config.GetRiskRulesProfile(), risks.GetBuiltInRiskRuleProfiles(), make(types.RiskRules).Merge(builtinRiskRules).Merge(customRiskRules))
+	if profileError != nil {
+		return nil, fmt.Errorf("unable to apply rules config: %w", profileError)
+	}
+	skippedRiskRules = append(skippedRiskRules, config.GetSkipRiskRules()...)
+
 	parsedModel, parseError := ParseModel(config, modelInput, builtinRiskRules, customRiskRules)
 	if parseError != nil {
 		return nil, fmt.Errorf("unable to parse model yaml: %w", parseError)
 	}
 
+	for _, warning := range types.ValidateTrustBoundaryTypes(parsedModel) {
+		_, _ = fmt.Fprintln(os.Stderr, warning.String())
+	}
+
 	introTextRAA := applyRAA(parsedModel, progressReporter)
 
-	applyRiskGeneration(parsedModel, builtinRiskRules.Merge(customRiskRules), config.GetSkipRiskRules(), progressReporter)
+	var incremental *incrementalGeneration
+	if len(config.GetRiskCacheFilename()) > 0 {
+		// custom rules may change without changing the cache key, so only the risks of built-in rules are cached
+		cacheableRuleIds := make(map[string]bool)
+		for id := range builtinRiskRules {
+			if _, isCustom := customRiskRules[id]; !isCustom {
+				cacheableRuleIds[id] = true
+			}
+		}
+
+		cacheKey, cacheKeyError := json.Marshal(struct {
+			BuildTimestamp string       `json:"build_timestamp"`
+			RulesConfig    *RulesConfig `json:"rules_config"`
+		}{config.GetBuildTimestamp(), rulesConfig})
+		if cacheKeyError != nil {
+			return nil, fmt.Errorf("unable to create risk cache key: %w", cacheKeyError)
+		}
+
+		var incrementalError error
+		incremental, incrementalError = newIncrementalGeneration(config.GetRiskCacheFilename(), string(cacheKey), parsedModel,
+			make(types.RiskRules).Merge(builtinRiskRules).Merge(customRiskRules), cacheableRuleIds, progressReporter)
+		if incrementalError != nil {
+			return nil, fmt.Errorf("unable to prepare incremental risk generation: %w", incrementalError)
+		}
+	}
+
+	ruleTraces := applyRiskGeneration(parsedModel, builtinRiskRules.Merge(customRiskRules), skippedRiskRules, config.GetRiskRuleWorkers(), incremental, progressReporter)
+	if len(config.GetRuleTracesFilename()) > 0 {
+		writeError := ruleTraces.write(config.GetRuleTracesFilename())
+		if writeError != nil {
+			progressReporter.Warnf("Unable to write rule traces to %q: %v", config.GetRuleTracesFilename(), writeError)
+		} else {
+			progressReporter.Infof("Wrote rule traces to %q", config.GetRuleTracesFilename())
+		}
+	}
+
+	overridesError := rulesConfig.ApplyOverrides(parsedModel, builtinRiskRules.Merge(customRiskRules))
+	if overridesError != nil {
+		return nil, fmt.Errorf("unable to apply rules config: %w", overridesError)
+	}
+
+	parsedModel.InvariantViolations = parsedModel.CheckSecurityInvariants()
+	for _, violation := range parsedModel.InvariantViolations {
+		progressReporter.Warn(violation.String())
+	}
+
 	err := parsedModel.ApplyWildcardRiskTrackingEvaluation(config.GetIgnoreOrphanedRiskTracking(), progressReporter)
 	if err != nil {
 		return nil, fmt.Errorf("unable to apply wildcard risk tracking evaluation: %w", err)
@@ -116,20 +234,34 @@ func AnalyzeModel(modelInput *input.Model, config configReader, builtinRiskRules
 		return nil, fmt.Errorf("unable to check risk tracking: %w", err)
 	}
 
+	// risk tracking may refer to risks filtered out or consolidated under another risk, so it is checked before
+	if len(config.GetRiskLabels()) > 0 {
+		filterRisksByLabels(parsedModel, config.GetRiskLabels(), progressReporter)
+	}
+
+	if config.GetConsolidateRisks() {
+		consolidateRisks(parsedModel, progressReporter)
+	}
+
 	return &ReadResult{
 		ModelInput:       modelInput,
 		ParsedModel:      parsedModel,
 		IntroTextRAA:     introTextRAA,
 		BuiltinRiskRules: builtinRiskRules,
 		CustomRiskRules:  customRiskRules,
+		SkippedRiskRules: skippedRiskRules,
+		RuleTraces:       ruleTraces,
 	}, nil
 }
 
 func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
-	skipRiskRules []string,
-	progressReporter types.ProgressReporter) {
+	skipRiskRules []string, workers int, incremental *incrementalGeneration,
+	progressReporter types.ProgressReporter) *RuleTraces {
 	progressReporter.Info("Applying risk generation")
 
+	now := time.Now()
+	warnExpiredSuppressedRisks(parsedModel, now, progressReporter)
+
 	skippedRules := make(map[string]bool)
 	if len(skipRiskRules) > 0 {
 		for _, id := range skipRiskRules {
@@ -137,7 +269,8 @@ func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
 		}
 	}
 
-	for id, rule := range rules {
+	ruleIds := make([]string, 0)
+	for _, id := range rules.SortedIds() {
 		_, ok := skippedRules[id]
 		if ok {
 			progressReporter.Infof("Skipping risk rule: %v", id)
@@ -145,15 +278,54 @@ func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
 			continue
 		}
 
-		parsedModel.AddToListOfSupportedTags(rule.SupportedTags())
-		newRisks, riskError := rule.GenerateRisks(parsedModel)
-		if riskError != nil {
-			progressReporter.Warnf("Error generating risks for %q: %v", id, riskError)
+		parsedModel.AddToListOfSupportedTags(rules[id].SupportedTags())
+		ruleIds = append(ruleIds, id)
+	}
+
+	// compute the lookups shared by the rules once, before they run concurrently
+	parsedModel.AnalysisContext()
+
+	generate := func(id string) ([]*types.Risk, error) {
+		return rules[id].GenerateRisks(parsedModel)
+	}
+	if incremental != nil {
+		generate = incremental.generateRisks
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	start := time.Now()
+	results := generateRisksConcurrently(ruleIds, workers, generate)
+	for index := range results {
+		results[index].assetsScanned = len(parsedModel.TechnicalAssets)
+		if incremental != nil {
+			results[index].assetsScanned = incremental.assetsScanned(ruleIds[index])
+		}
+	}
+
+	ruleTraces := newRuleTraces(ruleIds, results, time.Since(start), workers, len(parsedModel.TechnicalAssets))
+	ruleTraces.report(progressReporter)
+
+	if incremental != nil {
+		saveError := incremental.save(ruleIds, results)
+		if saveError != nil {
+			progressReporter.Warnf("Unable to write risk cache %q: %v", incremental.filename, saveError)
+		}
+	}
+
+	// merge in the order of the rule ids, so the result does not depend on which rule finishes first
+	for index, result := range results {
+		if result.err != nil {
+			progressReporter.Warnf("Error generating risks for %q: %v", ruleIds[index], result.err)
 			continue
 		}
 
+		labelRisks(rules[ruleIds[index]].Category(), result.risks)
+		newRisks := acceptSuppressedRisks(parsedModel, result.risks, now)
 		if len(newRisks) > 0 {
-			parsedModel.GeneratedRisksByCategory[id] = newRisks
+			parsedModel.GeneratedRisksByCategory[ruleIds[index]] = newRisks
 		}
 	}
 
@@ -167,6 +339,8 @@ func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
 		}
 	}
 
+	sortAcceptedRisks(parsedModel.AcceptedRisks)
+
 	// save also in map keyed by synthetic risk-id
 	for _, category := range parsedModel.SortedRiskCategories() {
 		someRisks := parsedModel.SortedRisksOfCategory(category)
@@ -174,6 +348,49 @@ func applyRiskGeneration(parsedModel *types.Model, rules types.RiskRules,
 			parsedModel.GeneratedRisksBySyntheticId[strings.ToLower(risk.SyntheticId)] = risk
 		}
 	}
+
+	return ruleTraces
+}
+
+type ruleResult struct {
+	risks         []*types.Risk
+	err           error
+	duration      time.Duration
+	assetsScanned int
+}
+
+// generateRisksConcurrently runs the rules on a pool of workers (one per CPU if workers is not positive);
+// the rules only read the model, the results are returned in the order of the rule ids
+func generateRisksConcurrently(ruleIds []string, workers int, generate func(id string) ([]*types.Risk, error)) []ruleResult {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	results := make([]ruleResult, len(ruleIds))
+	indexes := make(chan int)
+
+	var waitGroup sync.WaitGroup
+	for range min(workers, len(ruleIds)) {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			for index := range indexes {
+				start := time.Now()
+				newRisks, riskError := generate(ruleIds[index])
+				results[index] = ruleResult{risks: newRisks, err: riskError, duration: time.Since(start)}
+			}
+		}()
+	}
+
+	for index := range ruleIds {
+		indexes <- index
+	}
+
+	close(indexes)
+	waitGroup.Wait()
+
+	return results
 }
 
 func writeToFile(name string, item any, filename string, progressReporter types.ProgressReporter) {
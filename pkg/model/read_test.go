@@ -0,0 +1,187 @@
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/input"
+	"github.com/threagile/threagile/pkg/risks"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type concurrentTestRule struct {
+	id      string
+	delay   time.Duration
+	err     error
+	running *atomic.Int32
+	maximum *atomic.Int32
+}
+
+func (what *concurrentTestRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{ID: what.id}
+}
+
+func (what *concurrentTestRule) SupportedTags() []string {
+	return []string{what.id}
+}
+
+func (what *concurrentTestRule) GenerateRisks(*types.Model) ([]*types.Risk, error) {
+	running := what.running.Add(1)
+	defer what.running.Add(-1)
+
+	for {
+		maximum := what.maximum.Load()
+		if running <= maximum || what.maximum.CompareAndSwap(maximum, running) {
+			break
+		}
+	}
+
+	time.Sleep(what.delay)
+	if what.err != nil {
+		return nil, what.err
+	}
+
+	return []*types.Risk{{CategoryId: what.id, SyntheticId: what.id + "@asset"}}, nil
+}
+
+func generateWith(rules types.RiskRules) func(id string) ([]*types.Risk, error) {
+	return func(id string) ([]*types.Risk, error) {
+		return rules[id].GenerateRisks(&types.Model{})
+	}
+}
+
+func testConcurrentRules(count int, running *atomic.Int32, maximum *atomic.Int32) (types.RiskRules, []string) {
+	rules := make(types.RiskRules)
+	for n := range count {
+		id := fmt.Sprintf("rule-%02d", n)
+		// earlier rules take longer, so they finish last
+		rules[id] = &concurrentTestRule{id: id, delay: time.Duration(count-n) * time.Millisecond, running: running, maximum: maximum}
+	}
+
+	return rules, rules.SortedIds()
+}
+
+func TestGenerateRisksConcurrentlyKeepsRuleOrder(t *testing.T) {
+	var running, maximum atomic.Int32
+	rules, ruleIds := testConcurrentRules(20, &running, &maximum)
+
+	results := generateRisksConcurrently(ruleIds, 4, generateWith(rules))
+
+	assert.Len(t, results, len(ruleIds))
+	for index, result := range results {
+		assert.NoError(t, result.err)
+		assert.Len(t, result.risks, 1)
+		assert.Equal(t, ruleIds[index], result.risks[0].CategoryId)
+	}
+}
+
+func TestGenerateRisksConcurrentlyLimitsWorkers(t *testing.T) {
+	var running, maximum atomic.Int32
+	rules, ruleIds := testConcurrentRules(20, &running, &maximum)
+
+	generateRisksConcurrently(ruleIds, 3, generateWith(rules))
+
+	assert.LessOrEqual(t, maximum.Load(), int32(3))
+	assert.Greater(t, maximum.Load(), int32(0))
+}
+
+func TestGenerateRisksConcurrentlyReportsErrorsPerRule(t *testing.T) {
+	var running, maximum atomic.Int32
+	rules, ruleIds := testConcurrentRules(3, &running, &maximum)
+	rules["rule-01"].(*concurrentTestRule).err = fmt.Errorf("rule failed")
+
+	results := generateRisksConcurrently(ruleIds, 0, generateWith(rules))
+
+	assert.NoError(t, results[0].err)
+	assert.EqualError(t, results[1].err, "rule failed")
+	assert.Empty(t, results[1].risks)
+	assert.NoError(t, results[2].err)
+}
+
+func TestApplyRiskGenerationMergesInRuleOrder(t *testing.T) {
+	var running, maximum atomic.Int32
+	rules, _ := testConcurrentRules(10, &running, &maximum)
+	rules["rule-03"].(*concurrentTestRule).err = fmt.Errorf("rule failed")
+	parsedModel := &types.Model{
+		AllSupportedTags:            make(map[string]bool),
+		GeneratedRisksByCategory:    make(map[string][]*types.Risk),
+		GeneratedRisksBySyntheticId: make(map[string]*types.Risk),
+	}
+
+	reporter := &testProgressReporter{}
+	applyRiskGeneration(parsedModel, rules, []string{"rule-05", "unknown-rule"}, 4, nil, reporter)
+
+	assert.Len(t, parsedModel.GeneratedRisksByCategory, 8)
+	assert.NotContains(t, parsedModel.GeneratedRisksByCategory, "rule-03")
+	assert.NotContains(t, parsedModel.GeneratedRisksByCategory, "rule-05")
+	assert.Equal(t, "rule-09@asset", parsedModel.GeneratedRisksByCategory["rule-09"][0].SyntheticId)
+	assert.True(t, parsedModel.AllSupportedTags["rule-04"])
+	assert.False(t, parsedModel.AllSupportedTags["rule-05"])
+	assert.Equal(t, []string{`Error generating risks for "rule-03": rule failed`}, reporter.warnings)
+}
+
+type explainTestReporter struct {
+	lines []string
+}
+
+func (what *explainTestReporter) Println(i ...interface{}) {
+	what.lines = append(what.lines, fmt.Sprint(i...))
+}
+
+func testExplainResult(t *testing.T) ReadResult {
+	modelInput := new(input.Model).Defaults()
+	assert.NoError(t, modelInput.Load(filepath.Join("..", "..", "demo", "example", "threagile.yaml")))
+
+	builtinRiskRules := risks.GetBuiltInRiskRules()
+	parsedModel, err := ParseModel(&mockConfig{}, modelInput, builtinRiskRules, make(types.RiskRules))
+	assert.NoError(t, err)
+
+	return ReadResult{ParsedModel: parsedModel, BuiltinRiskRules: builtinRiskRules, CustomRiskRules: make(types.RiskRules)}
+}
+
+func TestExplainRiskExplainsEveryGeneratedRisk(t *testing.T) {
+	result := testExplainResult(t)
+
+	for _, id := range result.BuiltinRiskRules.SortedIds() {
+		generatedRisks, err := result.BuiltinRiskRules[id].GenerateRisks(result.ParsedModel)
+		assert.NoError(t, err)
+
+		for _, risk := range generatedRisks {
+			reporter := new(explainTestReporter)
+			assert.NoError(t, result.ExplainRisk(nil, risk.SyntheticId, reporter), risk.SyntheticId)
+			assert.NotEmpty(t, reporter.lines, risk.SyntheticId)
+		}
+	}
+}
+
+func TestExplainRiskAllRisksOfCategory(t *testing.T) {
+	result := testExplainResult(t)
+
+	reporter := new(explainTestReporter)
+	assert.NoError(t, result.ExplainRisk(nil, "unencrypted-communication@*", reporter))
+	assert.Contains(t, reporter.lines, `communication link "erp-system>database-traffic"`)
+	assert.Contains(t, reporter.lines, "")
+}
+
+func TestExplainRiskErrors(t *testing.T) {
+	result := testExplainResult(t)
+	result.CustomRiskRules["custom"] = &concurrentTestRule{id: "custom"}
+
+	testCases := map[string]string{
+		"unknown category":     "unknown@something",
+		"rule not explainable": "custom@something",
+		"risk not found":       "unencrypted-communication@unknown",
+	}
+
+	for name, risk := range testCases {
+		t.Run(name, func(t *testing.T) {
+			reporter := new(explainTestReporter)
+			assert.Error(t, result.ExplainRisk(nil, risk, reporter))
+			assert.Empty(t, reporter.lines)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"slices"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// labelRisks adds the labels of the risk category to the labels the risk rule set on its risks
+func labelRisks(category *types.RiskCategory, risks []*types.Risk) {
+	for _, risk := range risks {
+		labels := make([]string, 0, len(category.Labels)+len(risk.Labels))
+		for _, label := range slices.Concat(category.Labels, risk.Labels) {
+			if !slices.Contains(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+
+		risk.Labels = labels
+	}
+}
+
+// filterRisksByLabels removes the generated and accepted risks having none of the labels, so all outputs only contain the risks of interest
+func filterRisksByLabels(parsedModel *types.Model, labels []string, progressReporter types.ProgressReporter) {
+	progressReporter.Infof("Reporting only risks labeled with any of: %v", labels)
+
+	for categoryId, risks := range parsedModel.GeneratedRisksByCategory {
+		remainingRisks := make([]*types.Risk, 0)
+		for _, risk := range risks {
+			if isLabeledWithAny(risk, labels) {
+				remainingRisks = append(remainingRisks, risk)
+			}
+		}
+
+		if len(remainingRisks) > 0 {
+			parsedModel.GeneratedRisksByCategory[categoryId] = remainingRisks
+		} else {
+			delete(parsedModel.GeneratedRisksByCategory, categoryId)
+		}
+	}
+
+	for syntheticId, risk := range parsedModel.GeneratedRisksBySyntheticId {
+		if !isLabeledWithAny(risk, labels) {
+			delete(parsedModel.GeneratedRisksBySyntheticId, syntheticId)
+		}
+	}
+
+	acceptedRisks := make([]*types.AcceptedRisk, 0)
+	for _, acceptedRisk := range parsedModel.AcceptedRisks {
+		if isLabeledWithAny(acceptedRisk.Risk, labels) {
+			acceptedRisks = append(acceptedRisks, acceptedRisk)
+		}
+	}
+	parsedModel.AcceptedRisks = acceptedRisks
+}
+
+func isLabeledWithAny(risk *types.Risk, labels []string) bool {
+	for _, label := range labels {
+		if slices.Contains(risk.Labels, label) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestLabelRisks(t *testing.T) {
+	category := &types.RiskCategory{ID: "test", Labels: []string{"network", "compliance:pci"}}
+	risks := []*types.Risk{
+		{SyntheticId: "test@a"},
+		{SyntheticId: "test@b", Labels: []string{"compliance:pci", "cloud"}},
+	}
+
+	labelRisks(category, risks)
+
+	assert.Equal(t, []string{"network", "compliance:pci"}, risks[0].Labels)
+	assert.Equal(t, []string{"network", "compliance:pci", "cloud"}, risks[1].Labels)
+	assert.Equal(t, []string{"network", "compliance:pci"}, category.Labels)
+}
+
+func TestFilterRisksByLabels(t *testing.T) {
+	network := &types.Risk{CategoryId: "unencrypted-communication", SyntheticId: "unencrypted-communication@a", Labels: []string{"network", "compliance:pci"}}
+	appsec := &types.Risk{CategoryId: "sql-nosql-injection", SyntheticId: "sql-nosql-injection@a", Labels: []string{"appsec"}}
+	unlabeled := &types.Risk{CategoryId: "custom", SyntheticId: "custom@a"}
+	acceptedAppsec := &types.Risk{CategoryId: "sql-nosql-injection", SyntheticId: "sql-nosql-injection@b", Labels: []string{"appsec"}}
+	acceptedNetwork := &types.Risk{CategoryId: "unencrypted-communication", SyntheticId: "unencrypted-communication@b", Labels: []string{"network"}}
+
+	parsedModel := testConsolidationModel(network, appsec, unlabeled)
+	parsedModel.AcceptedRisks = []*types.AcceptedRisk{{Risk: acceptedAppsec}, {Risk: acceptedNetwork}}
+
+	filterRisksByLabels(parsedModel, []string{"compliance:pci", "cloud"}, new(testProgressReporter))
+
+	assert.Equal(t, map[string][]*types.Risk{"unencrypted-communication": {network}}, parsedModel.GeneratedRisksByCategory)
+	assert.Equal(t, map[string]*types.Risk{network.SyntheticId: network}, parsedModel.GeneratedRisksBySyntheticId)
+	assert.Empty(t, parsedModel.AcceptedRisks)
+
+	parsedModel = testConsolidationModel(network, appsec, unlabeled)
+	parsedModel.AcceptedRisks = []*types.AcceptedRisk{{Risk: acceptedAppsec}, {Risk: acceptedNetwork}}
+
+	filterRisksByLabels(parsedModel, []string{"appsec"}, new(testProgressReporter))
+
+	assert.Equal(t, map[string][]*types.Risk{"sql-nosql-injection": {appsec}}, parsedModel.GeneratedRisksByCategory)
+	assert.Len(t, parsedModel.AcceptedRisks, 1)
+	assert.Same(t, acceptedAppsec, parsedModel.AcceptedRisks[0].Risk)
+}
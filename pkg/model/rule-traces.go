@@ -0,0 +1,90 @@
+package model
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// RuleTraces is the timing telemetry of a risk generation run, the rules are sorted by duration, slowest first
+type RuleTraces struct {
+	TotalDurationMs float64      `json:"total_duration_ms"`
+	Workers         int          `json:"workers"`
+	TechnicalAssets int          `json:"technical_assets"`
+	Rules           []*RuleTrace `json:"rules"`
+}
+
+// RuleTrace is the timing of a single risk rule; assets scanned is the number of technical assets of the model the rule
+// was run on, which is zero if its risks were taken from the risk cache
+type RuleTrace struct {
+	RuleId        string  `json:"rule_id"`
+	DurationMs    float64 `json:"duration_ms"`
+	AssetsScanned int     `json:"assets_scanned"`
+	RisksProduced int     `json:"risks_produced"`
+	Error         string  `json:"error,omitempty"`
+}
+
+func newRuleTraces(ruleIds []string, results []ruleResult, totalDuration time.Duration, workers int, technicalAssets int) *RuleTraces {
+	traces := &RuleTraces{
+		TotalDurationMs: milliseconds(totalDuration),
+		Workers:         workers,
+		TechnicalAssets: technicalAssets,
+		Rules:           make([]*RuleTrace, 0, len(ruleIds)),
+	}
+
+	for index, result := range results {
+		trace := &RuleTrace{
+			RuleId:        ruleIds[index],
+			DurationMs:    milliseconds(result.duration),
+			AssetsScanned: result.assetsScanned,
+			RisksProduced: len(result.risks),
+		}
+
+		if result.err != nil {
+			trace.Error = result.err.Error()
+		}
+
+		traces.Rules = append(traces.Rules, trace)
+	}
+
+	sort.SliceStable(traces.Rules, func(i, j int) bool {
+		return traces.Rules[i].DurationMs > traces.Rules[j].DurationMs
+	})
+
+	return traces
+}
+
+func (what *RuleTraces) report(progressReporter types.ProgressReporter) {
+	progressReporter.Infof("Generated risks in %.1f ms with %d workers on %d technical assets", what.TotalDurationMs, what.Workers, what.TechnicalAssets)
+	for _, trace := range what.Rules {
+		if len(trace.Error) > 0 {
+			progressReporter.Infof("Risk rule %q failed after %.1f ms (%d technical assets scanned): %v", trace.RuleId, trace.DurationMs, trace.AssetsScanned, trace.Error)
+			continue
+		}
+
+		progressReporter.Infof("Risk rule %q took %.1f ms (%d technical assets scanned, %d risks produced)", trace.RuleId, trace.DurationMs, trace.AssetsScanned, trace.RisksProduced)
+	}
+}
+
+// write exports the traces as JSON
+func (what *RuleTraces) write(filename string) error {
+	exported, exportError := json.MarshalIndent(what, "", "  ")
+	if exportError != nil {
+		return exportError
+	}
+
+	mkdirError := os.MkdirAll(filepath.Dir(filename), 0750)
+	if mkdirError != nil {
+		return mkdirError
+	}
+
+	return os.WriteFile(filename, exported, 0600)
+}
+
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration.Microseconds()) / 1000
+}
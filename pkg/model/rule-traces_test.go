@@ -0,0 +1,86 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestNewRuleTracesSortsByDuration(t *testing.T) {
+	results := []ruleResult{
+		{risks: []*types.Risk{{}}, duration: 2 * time.Millisecond, assetsScanned: 4},
+		{err: fmt.Errorf("rule failed"), duration: 5 * time.Millisecond, assetsScanned: 4},
+		{risks: []*types.Risk{{}, {}, {}}, duration: 1500 * time.Microsecond, assetsScanned: 0},
+	}
+
+	traces := newRuleTraces([]string{"rule-a", "rule-b", "rule-c"}, results, 7*time.Millisecond, 2, 4)
+
+	assert.Equal(t, 7.0, traces.TotalDurationMs)
+	assert.Equal(t, 2, traces.Workers)
+	assert.Equal(t, 4, traces.TechnicalAssets)
+	assert.Equal(t, []*RuleTrace{
+		{RuleId: "rule-b", DurationMs: 5, AssetsScanned: 4, RisksProduced: 0, Error: "rule failed"},
+		{RuleId: "rule-a", DurationMs: 2, AssetsScanned: 4, RisksProduced: 1},
+		{RuleId: "rule-c", DurationMs: 1.5, AssetsScanned: 0, RisksProduced: 3},
+	}, traces.Rules)
+}
+
+func TestApplyRiskGenerationTracesRules(t *testing.T) {
+	var running, maximum atomic.Int32
+	rules, _ := testConcurrentRules(4, &running, &maximum)
+	rules["rule-02"].(*concurrentTestRule).err = fmt.Errorf("rule failed")
+	parsedModel := &types.Model{
+		TechnicalAssets:             map[string]*types.TechnicalAsset{"web": {Id: "web"}, "db": {Id: "db"}},
+		AllSupportedTags:            make(map[string]bool),
+		GeneratedRisksByCategory:    make(map[string][]*types.Risk),
+		GeneratedRisksBySyntheticId: make(map[string]*types.Risk),
+	}
+
+	traces := applyRiskGeneration(parsedModel, rules, []string{"rule-03"}, 2, nil, &testProgressReporter{})
+
+	assert.Equal(t, 2, traces.Workers)
+	assert.Equal(t, 2, traces.TechnicalAssets)
+	assert.Len(t, traces.Rules, 3)
+
+	// the earlier rules take longer, see testConcurrentRules
+	assert.Equal(t, "rule-00", traces.Rules[0].RuleId)
+	assert.GreaterOrEqual(t, traces.Rules[0].DurationMs, 4.0)
+	assert.GreaterOrEqual(t, traces.TotalDurationMs, traces.Rules[0].DurationMs)
+	for _, trace := range traces.Rules {
+		assert.Equal(t, 2, trace.AssetsScanned)
+		if trace.RuleId == "rule-02" {
+			assert.Equal(t, "rule failed", trace.Error)
+			assert.Equal(t, 0, trace.RisksProduced)
+		} else {
+			assert.Empty(t, trace.Error)
+			assert.Equal(t, 1, trace.RisksProduced)
+		}
+	}
+}
+
+func TestRuleTracesWrite(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "traces", "rule-traces.json")
+	traces := &RuleTraces{
+		TotalDurationMs: 12.5,
+		Workers:         4,
+		TechnicalAssets: 10,
+		Rules:           []*RuleTrace{{RuleId: "rule-a", DurationMs: 12.25, AssetsScanned: 10, RisksProduced: 2}},
+	}
+
+	assert.NoError(t, traces.write(filename))
+
+	content, readError := os.ReadFile(filename)
+	assert.NoError(t, readError)
+	assert.NotContains(t, string(content), "error")
+
+	var written RuleTraces
+	assert.NoError(t, json.Unmarshal(content, &written))
+	assert.Equal(t, traces, &written)
+}
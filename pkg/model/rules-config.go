@@ -0,0 +1,192 @@
+package model
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// RulesConfig holds the settings of risk rules read from the rules config file (like rules-config.yaml)
+type RulesConfig struct {
+	// Parameters of risk rules keyed by risk rule id, like thresholds or the tags a rule is triggered by
+	Parameters map[string]map[string]any `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+
+	// Profiles naming the risk rules (by their ID) to run, in addition to or replacing the built-in profiles
+	Profiles map[string][]string `yaml:"profiles,omitempty" json:"profiles,omitempty"`
+
+	// Overrides of the likelihood and impact of generated risks, applied in the given order
+	Overrides []RiskRatingOverride `yaml:"overrides,omitempty" json:"overrides,omitempty"`
+}
+
+// RiskRatingOverride replaces the exploitation likelihood and/or impact of the risks of a risk rule,
+// optionally only for risks whose most relevant model elements are tagged with any of the given tags
+type RiskRatingOverride struct {
+	Category   string                            `yaml:"category" json:"category"`
+	Tags       []string                          `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Likelihood *types.RiskExploitationLikelihood `yaml:"likelihood,omitempty" json:"likelihood,omitempty"`
+	Impact     *types.RiskExploitationImpact     `yaml:"impact,omitempty" json:"impact,omitempty"`
+}
+
+// LoadRulesConfig reads the rules config file; no filename results in an empty config
+func LoadRulesConfig(filename string) (*RulesConfig, error) {
+	config := new(RulesConfig)
+	if len(filename) == 0 {
+		return config, nil
+	}
+
+	data, readError := os.ReadFile(filepath.Clean(filename))
+	if readError != nil {
+		return nil, fmt.Errorf("unable to read rules config %q: %w", filename, readError)
+	}
+
+	unmarshalError := yaml.Unmarshal(data, config)
+	if unmarshalError != nil {
+		return nil, fmt.Errorf("unable to parse rules config %q: %w", filename, unmarshalError)
+	}
+
+	for index, override := range config.Overrides {
+		if len(override.Category) == 0 {
+			return nil, fmt.Errorf("override #%d in rules config %q has no category", index+1, filename)
+		}
+
+		if override.Likelihood == nil && override.Impact == nil {
+			return nil, fmt.Errorf("override #%d in rules config %q for %q has neither likelihood nor impact", index+1, filename, override.Category)
+		}
+	}
+
+	return config, nil
+}
+
+// ApplyParameters configures the risk rules with the parameters given in the rules config
+func (what *RulesConfig) ApplyParameters(rules types.RiskRules) error {
+	ids := make([]string, 0)
+	for id := range what.Parameters {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		rule, ok := rules[id]
+		if !ok {
+			return fmt.Errorf("parameters given for unknown risk rule %q", id)
+		}
+
+		configurableRule, isConfigurable := rule.(types.ConfigurableRiskRule)
+		if !isConfigurable {
+			return fmt.Errorf("risk rule %q has no parameters", id)
+		}
+
+		parameters := types.NewRiskRuleParameters(what.Parameters[id])
+		configureError := configurableRule.Configure(parameters)
+		if configureError != nil {
+			return fmt.Errorf("invalid parameters for risk rule %q: %w", id, configureError)
+		}
+
+		unused := parameters.Unused()
+		if len(unused) > 0 {
+			return fmt.Errorf("unknown parameters for risk rule %q: %v", id, strings.Join(unused, ", "))
+		}
+	}
+
+	return nil
+}
+
+// SkippedRules returns the IDs of the risk rules not enabled by the given profile, sorted;
+// profiles of the rules config take precedence over built-in profiles of the same name, and no profile enables all risk rules
+func (what *RulesConfig) SkippedRules(profile string, builtinProfiles map[string][]string, rules types.RiskRules) ([]string, error) {
+	if len(profile) == 0 {
+		return make([]string, 0), nil
+	}
+
+	enabledRules, ok := what.Profiles[profile]
+	if !ok {
+		enabledRules, ok = builtinProfiles[profile]
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("unknown risk rule profile %q", profile)
+	}
+
+	enabled := make(map[string]bool)
+	for _, id := range enabledRules {
+		if _, known := rules[id]; !known {
+			return nil, fmt.Errorf("risk rule profile %q contains unknown risk rule %q", profile, id)
+		}
+
+		enabled[id] = true
+	}
+
+	skipped := make([]string, 0)
+	for id := range rules {
+		if !enabled[id] {
+			skipped = append(skipped, id)
+		}
+	}
+	sort.Strings(skipped)
+
+	return skipped, nil
+}
+
+// ApplyOverrides changes the likelihood and impact of the generated risks according to the overrides in the rules config
+// and recalculates their severity; later overrides take precedence over earlier ones
+func (what *RulesConfig) ApplyOverrides(parsedModel *types.Model, rules types.RiskRules) error {
+	for _, override := range what.Overrides {
+		if _, ok := rules[override.Category]; !ok {
+			return fmt.Errorf("override given for unknown risk rule %q", override.Category)
+		}
+
+		for _, risk := range parsedModel.GeneratedRisksByCategory[override.Category] {
+			if !override.matches(parsedModel, risk) {
+				continue
+			}
+
+			if override.Likelihood != nil {
+				risk.ExploitationLikelihood = *override.Likelihood
+			}
+
+			if override.Impact != nil {
+				risk.ExploitationImpact = *override.Impact
+			}
+
+			risk.Severity = types.CalculateSeverity(risk.ExploitationLikelihood, risk.ExploitationImpact)
+		}
+	}
+
+	return nil
+}
+
+// matches checks whether the override applies to the risk, which is the case if no tags are given
+// or any of the most relevant model elements of the risk is tagged with any of the tags
+func (what *RiskRatingOverride) matches(parsedModel *types.Model, risk *types.Risk) bool {
+	if len(what.Tags) == 0 {
+		return true
+	}
+
+	if techAsset, ok := parsedModel.TechnicalAssets[risk.MostRelevantTechnicalAssetId]; ok && techAsset.IsTaggedWithAny(what.Tags...) {
+		return true
+	}
+
+	if commLink, ok := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; ok && commLink.IsTaggedWithAny(what.Tags...) {
+		return true
+	}
+
+	if dataAsset, ok := parsedModel.DataAssets[risk.MostRelevantDataAssetId]; ok && dataAsset.IsTaggedWithAny(what.Tags...) {
+		return true
+	}
+
+	if trustBoundary, ok := parsedModel.TrustBoundaries[risk.MostRelevantTrustBoundaryId]; ok && trustBoundary.IsTaggedWithAny(what.Tags...) {
+		return true
+	}
+
+	if sharedRuntime, ok := parsedModel.SharedRuntimes[risk.MostRelevantSharedRuntimeId]; ok && sharedRuntime.IsTaggedWithAny(what.Tags...) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type testConfigurableRule struct {
+	testGrpcRule
+	limit int
+}
+
+func (r *testConfigurableRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Int("limit", &r.limit)
+}
+
+func writeTestRulesConfig(t *testing.T, content string) string {
+	filename := filepath.Join(t.TempDir(), "rules-config.yaml")
+	assert.Nil(t, os.WriteFile(filename, []byte(content), 0600))
+	return filename
+}
+
+func TestLoadRulesConfigNoFile(t *testing.T) {
+	config, err := LoadRulesConfig("")
+
+	assert.Nil(t, err)
+	assert.Empty(t, config.Parameters)
+}
+
+func TestLoadRulesConfigInvalidFile(t *testing.T) {
+	_, err := LoadRulesConfig(writeTestRulesConfig(t, "parameters: [\n"))
+	assert.NotNil(t, err)
+
+	_, err = LoadRulesConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
+
+func TestRulesConfigApplyParameters(t *testing.T) {
+	config, err := LoadRulesConfig(writeTestRulesConfig(t, "parameters:\n  configurable:\n    limit: 7\n"))
+	assert.Nil(t, err)
+
+	rule := &testConfigurableRule{limit: 3}
+	err = config.ApplyParameters(types.RiskRules{"configurable": rule, "other": testGrpcRule("")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 7, rule.limit)
+}
+
+func TestRulesConfigApplyParametersInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"unknown rule":      "parameters:\n  unknown:\n    limit: 7\n",
+		"not configurable":  "parameters:\n  other:\n    limit: 7\n",
+		"invalid value":     "parameters:\n  configurable:\n    limit: many\n",
+		"unknown parameter": "parameters:\n  configurable:\n    limt: 7\n",
+	}
+
+	for name, content := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config, err := LoadRulesConfig(writeTestRulesConfig(t, content))
+			assert.Nil(t, err)
+
+			err = config.ApplyParameters(types.RiskRules{"configurable": &testConfigurableRule{limit: 3}, "other": testGrpcRule("")})
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func testOverridesModel() *types.Model {
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"frontend": {Id: "frontend", Title: "Frontend"},
+			"backend":  {Id: "backend", Title: "Backend", Tags: []string{"pci"}},
+		},
+		GeneratedRisksByCategory: map[string][]*types.Risk{
+			"grpc-rule": {
+				{CategoryId: "grpc-rule", SyntheticId: "grpc-rule@frontend", MostRelevantTechnicalAssetId: "frontend", ExploitationLikelihood: types.Unlikely, ExploitationImpact: types.LowImpact, Severity: types.LowSeverity},
+				{CategoryId: "grpc-rule", SyntheticId: "grpc-rule@backend", MostRelevantTechnicalAssetId: "backend", ExploitationLikelihood: types.Unlikely, ExploitationImpact: types.LowImpact, Severity: types.LowSeverity},
+			},
+		},
+	}
+}
+
+func TestRulesConfigApplyOverrides(t *testing.T) {
+	config, err := LoadRulesConfig(writeTestRulesConfig(t, `
+overrides:
+  - category: grpc-rule
+    likelihood: likely
+  - category: grpc-rule
+    tags:
+      - PCI
+    impact: very-high
+`))
+	assert.Nil(t, err)
+
+	parsedModel := testOverridesModel()
+	err = config.ApplyOverrides(parsedModel, types.RiskRules{"grpc-rule": testGrpcRule("")})
+	assert.Nil(t, err)
+
+	frontendRisk := parsedModel.GeneratedRisksByCategory["grpc-rule"][0]
+	assert.Equal(t, types.Likely, frontendRisk.ExploitationLikelihood)
+	assert.Equal(t, types.LowImpact, frontendRisk.ExploitationImpact)
+	assert.Equal(t, types.CalculateSeverity(types.Likely, types.LowImpact), frontendRisk.Severity)
+
+	backendRisk := parsedModel.GeneratedRisksByCategory["grpc-rule"][1]
+	assert.Equal(t, types.Likely, backendRisk.ExploitationLikelihood)
+	assert.Equal(t, types.VeryHighImpact, backendRisk.ExploitationImpact)
+	assert.Equal(t, types.CalculateSeverity(types.Likely, types.VeryHighImpact), backendRisk.Severity)
+}
+
+func TestRulesConfigApplyOverridesUnknownRule(t *testing.T) {
+	config, err := LoadRulesConfig(writeTestRulesConfig(t, "overrides:\n  - category: unknown\n    impact: high\n"))
+	assert.Nil(t, err)
+
+	err = config.ApplyOverrides(testOverridesModel(), types.RiskRules{"grpc-rule": testGrpcRule("")})
+
+	assert.NotNil(t, err)
+}
+
+func TestLoadRulesConfigInvalidOverrides(t *testing.T) {
+	testCases := map[string]string{
+		"no category":        "overrides:\n  - impact: high\n",
+		"no rating":          "overrides:\n  - category: grpc-rule\n",
+		"invalid impact":     "overrides:\n  - category: grpc-rule\n    impact: huge\n",
+		"invalid likelihood": "overrides:\n  - category: grpc-rule\n    likelihood: sometimes\n",
+	}
+
+	for name, content := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadRulesConfig(writeTestRulesConfig(t, content))
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestRulesConfigSkippedRules(t *testing.T) {
+	config, err := LoadRulesConfig(writeTestRulesConfig(t, "profiles:\n  custom:\n    - configurable\n  builtin:\n    - other\n"))
+	assert.Nil(t, err)
+
+	rules := types.RiskRules{"configurable": &testConfigurableRule{}, "other": testGrpcRule(""), "third": testGrpcRule("")}
+	builtinProfiles := map[string][]string{"builtin": {"third"}, "minimal": {"other", "third"}}
+
+	skipped, err := config.SkippedRules("", builtinProfiles, rules)
+	assert.Nil(t, err)
+	assert.Empty(t, skipped)
+
+	skipped, err = config.SkippedRules("custom", builtinProfiles, rules)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"other", "third"}, skipped)
+
+	skipped, err = config.SkippedRules("builtin", builtinProfiles, rules)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"configurable", "third"}, skipped)
+
+	skipped, err = config.SkippedRules("minimal", builtinProfiles, rules)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"configurable"}, skipped)
+}
+
+func TestRulesConfigSkippedRulesInvalid(t *testing.T) {
+	config, err := LoadRulesConfig(writeTestRulesConfig(t, "profiles:\n  typo:\n    - configurabel\n"))
+	assert.Nil(t, err)
+
+	rules := types.RiskRules{"configurable": &testConfigurableRule{}}
+
+	_, err = config.SkippedRules("unknown", nil, rules)
+	assert.NotNil(t, err)
+
+	_, err = config.SkippedRules("typo", nil, rules)
+	assert.NotNil(t, err)
+}
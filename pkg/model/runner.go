@@ -8,8 +8,25 @@ import (
 	"gopkg.in/yaml.v3"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 )
 
+// pluginRunner runs a single command (like "-get-info" or "-generate-risks") of a custom risk rule plugin
+type pluginRunner interface {
+	Run(in any, out any, parameters ...string) error
+}
+
+// newPluginRunner selects the runner by the plugin file: WebAssembly modules are run sandboxed in-process,
+// everything else is executed as a separate process
+func newPluginRunner(filename string) (pluginRunner, error) {
+	if strings.EqualFold(filepath.Ext(filename), ".wasm") {
+		return new(wasmRunner).Load(filename)
+	}
+
+	return new(runner).Load(filename)
+}
+
 type runner struct {
 	Filename    string
 	Parameters  []string
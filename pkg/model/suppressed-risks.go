@@ -0,0 +1,83 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// acceptSuppressedRisks moves the risks suppressed at their most relevant communication link or technical asset
+// to the accepted risks of the model and returns the remaining risks; expired suppressions are ignored
+func acceptSuppressedRisks(parsedModel *types.Model, risks []*types.Risk, now time.Time) []*types.Risk {
+	remainingRisks := make([]*types.Risk, 0)
+	for _, risk := range risks {
+		suppressedRisk := findSuppressedRisk(parsedModel, risk, now)
+		if suppressedRisk == nil {
+			remainingRisks = append(remainingRisks, risk)
+			continue
+		}
+
+		parsedModel.AcceptedRisks = append(parsedModel.AcceptedRisks, &types.AcceptedRisk{
+			Risk:          risk,
+			Justification: suppressedRisk.Justification,
+			Expires:       suppressedRisk.Expires,
+		})
+	}
+
+	return remainingRisks
+}
+
+func findSuppressedRisk(parsedModel *types.Model, risk *types.Risk, now time.Time) *types.SuppressedRisk {
+	if commLink, ok := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; ok {
+		if suppressedRisk := activeSuppressedRisk(commLink.SuppressedRisks, risk.CategoryId, now); suppressedRisk != nil {
+			return suppressedRisk
+		}
+	}
+
+	if techAsset, ok := parsedModel.TechnicalAssets[risk.MostRelevantTechnicalAssetId]; ok {
+		return activeSuppressedRisk(techAsset.SuppressedRisks, risk.CategoryId, now)
+	}
+
+	return nil
+}
+
+func activeSuppressedRisk(suppressedRisks []*types.SuppressedRisk, categoryId string, now time.Time) *types.SuppressedRisk {
+	for _, suppressedRisk := range suppressedRisks {
+		if suppressedRisk.CategoryId == categoryId && !suppressedRisk.IsExpired(now) {
+			return suppressedRisk
+		}
+	}
+
+	return nil
+}
+
+// warnExpiredSuppressedRisks reports suppressions that have expired, as their risks are reported again
+func warnExpiredSuppressedRisks(parsedModel *types.Model, now time.Time, progressReporter types.ProgressReporter) {
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, suppressedRisk := range techAsset.SuppressedRisks {
+			if suppressedRisk.IsExpired(now) {
+				progressReporter.Warnf("Suppression of risk category %q at technical asset %q expired on %v", suppressedRisk.CategoryId, techAsset.Title, suppressedRisk.Expires.Format("2006-01-02"))
+			}
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			for _, suppressedRisk := range commLink.SuppressedRisks {
+				if suppressedRisk.IsExpired(now) {
+					progressReporter.Warnf("Suppression of risk category %q at communication link %q of technical asset %q expired on %v", suppressedRisk.CategoryId, commLink.Title, techAsset.Title, suppressedRisk.Expires.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+}
+
+func sortAcceptedRisks(acceptedRisks []*types.AcceptedRisk) {
+	sort.Slice(acceptedRisks, func(i, j int) bool {
+		if acceptedRisks[i].Risk.CategoryId != acceptedRisks[j].Risk.CategoryId {
+			return acceptedRisks[i].Risk.CategoryId < acceptedRisks[j].Risk.CategoryId
+		}
+
+		return acceptedRisks[i].Risk.SyntheticId < acceptedRisks[j].Risk.SyntheticId
+	})
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func testSuppressedRisksModel() *types.Model {
+	expires := types.Date{Time: time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC)}
+	commLink := &types.CommunicationLink{
+		Id:              "frontend>backend",
+		Title:           "Backend Access",
+		SourceId:        "frontend",
+		TargetId:        "backend",
+		SuppressedRisks: []*types.SuppressedRisk{{CategoryId: "link-rule", Justification: "encrypted by vpn", Expires: expires}},
+	}
+
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"frontend": {Id: "frontend", Title: "Frontend", CommunicationLinks: []*types.CommunicationLink{commLink}},
+			"backend": {Id: "backend", Title: "Backend", SuppressedRisks: []*types.SuppressedRisk{
+				{CategoryId: "grpc-rule", Justification: "internal only", Expires: expires},
+			}},
+		},
+		CommunicationLinks: map[string]*types.CommunicationLink{commLink.Id: commLink},
+	}
+}
+
+func TestAcceptSuppressedRisks(t *testing.T) {
+	parsedModel := testSuppressedRisksModel()
+	risks := []*types.Risk{
+		{CategoryId: "grpc-rule", SyntheticId: "grpc-rule@frontend", MostRelevantTechnicalAssetId: "frontend"},
+		{CategoryId: "grpc-rule", SyntheticId: "grpc-rule@backend", MostRelevantTechnicalAssetId: "backend"},
+		{CategoryId: "link-rule", SyntheticId: "link-rule@frontend>backend", MostRelevantTechnicalAssetId: "frontend", MostRelevantCommunicationLinkId: "frontend>backend"},
+	}
+
+	remainingRisks := acceptSuppressedRisks(parsedModel, risks, time.Date(2030, 1, 31, 23, 0, 0, 0, time.UTC))
+
+	assert.Len(t, remainingRisks, 1)
+	assert.Equal(t, "grpc-rule@frontend", remainingRisks[0].SyntheticId)
+	assert.Len(t, parsedModel.AcceptedRisks, 2)
+	assert.Equal(t, "grpc-rule@backend", parsedModel.AcceptedRisks[0].Risk.SyntheticId)
+	assert.Equal(t, "internal only", parsedModel.AcceptedRisks[0].Justification)
+	assert.Equal(t, "link-rule@frontend>backend", parsedModel.AcceptedRisks[1].Risk.SyntheticId)
+	assert.Equal(t, "encrypted by vpn", parsedModel.AcceptedRisks[1].Justification)
+}
+
+func TestAcceptSuppressedRisksExpired(t *testing.T) {
+	parsedModel := testSuppressedRisksModel()
+	risks := []*types.Risk{
+		{CategoryId: "grpc-rule", SyntheticId: "grpc-rule@backend", MostRelevantTechnicalAssetId: "backend"},
+	}
+
+	now := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+	remainingRisks := acceptSuppressedRisks(parsedModel, risks, now)
+
+	assert.Len(t, remainingRisks, 1)
+	assert.Empty(t, parsedModel.AcceptedRisks)
+
+	reporter := new(testProgressReporter)
+	warnExpiredSuppressedRisks(parsedModel, now, reporter)
+	assert.Len(t, reporter.warnings, 2)
+}
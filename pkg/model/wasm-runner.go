@@ -0,0 +1,121 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+const wasmPluginTimeout = 5 * time.Minute
+
+// wasmRunner runs custom risk rule plugins compiled to WebAssembly in a sandbox.
+//
+// The module has to export its memory along with the functions below, exchanging JSON documents
+// located in the module memory:
+//
+//	allocate(size: i32) -> i32                    returns a pointer to a buffer of size bytes for the input
+//	get_info(ptr: i32, len: i32) -> i64           returns the risk category and supported tags
+//	generate_risks(ptr: i32, len: i32) -> i64     returns the risks generated for the model passed as input
+//
+// The i64 results contain the pointer to the output in the upper and its length in the lower 32 bits.
+// Each call runs in a fresh instance of the module, which has no access to the file system or network.
+type wasmRunner struct {
+	Filename    string
+	ErrorOutput string
+	runtime     wazero.Runtime
+	module      wazero.CompiledModule
+}
+
+func (p *wasmRunner) Load(filename string) (*wasmRunner, error) {
+	*p = wasmRunner{
+		Filename: filename,
+	}
+
+	code, readError := os.ReadFile(filepath.Clean(filename))
+	if readError != nil {
+		return p, readError
+	}
+
+	ctx := context.Background()
+	p.runtime = wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithCloseOnContextDone(true))
+
+	_, wasiError := wasi_snapshot_preview1.Instantiate(ctx, p.runtime)
+	if wasiError != nil {
+		return p, fmt.Errorf("error providing wasi to %q: %w", filename, wasiError)
+	}
+
+	module, compileError := p.runtime.CompileModule(ctx, code)
+	if compileError != nil {
+		return p, fmt.Errorf("error compiling %q: %w", filename, compileError)
+	}
+
+	p.module = module
+	return p, nil
+}
+
+func (p *wasmRunner) Run(in any, out any, parameters ...string) error {
+	if p.module == nil {
+		return fmt.Errorf("wasm module %q not loaded", p.Filename)
+	}
+
+	if len(parameters) != 1 {
+		return fmt.Errorf("expected exactly one command for wasm module %q, got %v", p.Filename, parameters)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wasmPluginTimeout)
+	defer cancel()
+
+	var stderrBuf bytes.Buffer
+	instance, instantiateError := p.runtime.InstantiateModule(ctx, p.module, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize").
+		WithStderr(&stderrBuf))
+	if instantiateError != nil {
+		return fmt.Errorf("error instantiating wasm module: %w", instantiateError)
+	}
+	defer func() { _ = instance.Close(ctx) }()
+
+	functionName := strings.ReplaceAll(strings.TrimPrefix(parameters[0], "-"), "-", "_")
+	allocate := instance.ExportedFunction("allocate")
+	function := instance.ExportedFunction(functionName)
+	if allocate == nil || function == nil || instance.Memory() == nil {
+		return fmt.Errorf("wasm module does not export memory, %q and %q", "allocate", functionName)
+	}
+
+	inData, inError := json.Marshal(in)
+	if inError != nil {
+		return fmt.Errorf("error encoding input data: %w", inError)
+	}
+
+	allocated, allocateError := allocate.Call(ctx, uint64(len(inData)))
+	if allocateError != nil {
+		return fmt.Errorf("error allocating %d bytes for input data: %w", len(inData), allocateError)
+	}
+
+	inPointer := uint32(allocated[0])
+	if !instance.Memory().Write(inPointer, inData) {
+		return fmt.Errorf("error writing input data: out of range")
+	}
+
+	result, callError := function.Call(ctx, uint64(inPointer), uint64(len(inData)))
+	p.ErrorOutput = stderrBuf.String()
+	if callError != nil {
+		return fmt.Errorf("%w: %v", callError, p.ErrorOutput)
+	}
+
+	outPointer, outLength := uint32(result[0]>>32), uint32(result[0])
+	outData, readOk := instance.Memory().Read(outPointer, outLength)
+	if !readOk {
+		return fmt.Errorf("error reading output data: out of range")
+	}
+
+	return json.Unmarshal(outData, out)
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const (
+	testWasmInfo  = `{"risk_category":{"id":"wasm-rule","title":"WASM Rule"},"tags":["wasm"]}`
+	testWasmRisks = `[{"category":"wasm-rule","risk_status":"unchecked","severity":"high","exploitation_likelihood":"likely","exploitation_impact":"high","title":"WASM Risk","synthetic_id":"wasm-rule@asset","most_relevant_technical_asset":"asset","data_breach_probability":"possible"}]`
+
+	testWasmInfoOffset  = 0
+	testWasmRisksOffset = 1024
+	testWasmInputOffset = 8192
+)
+
+// testWasmModule assembles a minimal WebAssembly module implementing the plugin ABI by returning constant outputs
+func testWasmModule() []byte {
+	section := func(id byte, content ...[]byte) []byte {
+		body := make([]byte, 0)
+		for _, part := range content {
+			body = append(body, part...)
+		}
+		return append(append([]byte{id}, unsignedLEB(uint64(len(body)))...), body...)
+	}
+	name := func(text string) []byte {
+		return append(unsignedLEB(uint64(len(text))), text...)
+	}
+	body := func(instructions ...byte) []byte {
+		code := append([]byte{0x00}, instructions...)
+		code = append(code, 0x0b)
+		return append(unsignedLEB(uint64(len(code))), code...)
+	}
+	data := func(offset int64, text string) []byte {
+		segment := append([]byte{0x00, 0x41}, signedLEB(offset)...)
+		segment = append(segment, 0x0b)
+		return append(segment, name(text)...)
+	}
+	packed := func(offset int, text string) []byte {
+		return append([]byte{0x42}, signedLEB(int64(offset)<<32|int64(len(text)))...)
+	}
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(0x01, []byte{0x02, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e})...)
+	module = append(module, section(0x03, []byte{0x03, 0x00, 0x01, 0x01})...)
+	module = append(module, section(0x05, []byte{0x01, 0x00, 0x01})...)
+	module = append(module, section(0x07, []byte{0x04},
+		name("memory"), []byte{0x02, 0x00},
+		name("allocate"), []byte{0x00, 0x00},
+		name("get_info"), []byte{0x00, 0x01},
+		name("generate_risks"), []byte{0x00, 0x02})...)
+	module = append(module, section(0x0a, []byte{0x03},
+		body(append([]byte{0x41}, signedLEB(testWasmInputOffset)...)...),
+		body(packed(testWasmInfoOffset, testWasmInfo)...),
+		body(packed(testWasmRisksOffset, testWasmRisks)...))...)
+	module = append(module, section(0x0b, []byte{0x02},
+		data(testWasmInfoOffset, testWasmInfo),
+		data(testWasmRisksOffset, testWasmRisks))...)
+
+	return module
+}
+
+func unsignedLEB(value uint64) []byte {
+	result := make([]byte, 0)
+	for {
+		part := byte(value & 0x7f)
+		value >>= 7
+		if value == 0 {
+			return append(result, part)
+		}
+		result = append(result, part|0x80)
+	}
+}
+
+func signedLEB(value int64) []byte {
+	result := make([]byte, 0)
+	for {
+		part := byte(value & 0x7f)
+		value >>= 7
+		if (value == 0 && part&0x40 == 0) || (value == -1 && part&0x40 != 0) {
+			return append(result, part)
+		}
+		result = append(result, part|0x80)
+	}
+}
+
+func writeTestWasmModule(t *testing.T, content []byte) string {
+	filename := filepath.Join(t.TempDir(), "rule.wasm")
+	assert.Nil(t, os.WriteFile(filename, content, 0600))
+	return filename
+}
+
+func TestNewPluginRunnerSelectsWasmRunner(t *testing.T) {
+	filename := writeTestWasmModule(t, testWasmModule())
+
+	pluginRunner, err := newPluginRunner(filename)
+
+	assert.Nil(t, err)
+	assert.IsType(t, &wasmRunner{}, pluginRunner)
+}
+
+func TestWasmRunnerGetInfo(t *testing.T) {
+	pluginRunner, err := new(wasmRunner).Load(writeTestWasmModule(t, testWasmModule()))
+	assert.Nil(t, err)
+
+	risk := new(CustomRiskCategory)
+	err = pluginRunner.Run(nil, &risk, "-get-info")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "wasm-rule", risk.ID)
+	assert.Equal(t, "WASM Rule", risk.Title)
+	assert.Equal(t, []string{"wasm"}, risk.SupportedTags())
+}
+
+func TestWasmRunnerGenerateRisks(t *testing.T) {
+	pluginRunner, err := new(wasmRunner).Load(writeTestWasmModule(t, testWasmModule()))
+	assert.Nil(t, err)
+
+	rule := &CustomRiskCategory{runner: pluginRunner, filename: pluginRunner.Filename}
+	risks, err := rule.GenerateRisks(&types.Model{Title: "Model"})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "wasm-rule@asset", risks[0].SyntheticId)
+	assert.Equal(t, types.HighSeverity, risks[0].Severity)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+}
+
+func TestWasmRunnerInvalidModule(t *testing.T) {
+	pluginRunner, err := new(wasmRunner).Load(writeTestWasmModule(t, []byte("not a wasm module")))
+
+	assert.NotNil(t, err)
+	assert.NotNil(t, pluginRunner.Run(nil, new(CustomRiskCategory), "-get-info"))
+}
+
+func TestWasmRunnerMissingExport(t *testing.T) {
+	pluginRunner, err := new(wasmRunner).Load(writeTestWasmModule(t, testWasmModule()))
+	assert.Nil(t, err)
+
+	err = pluginRunner.Run(nil, new(CustomRiskCategory), "-explain-risk")
+
+	assert.NotNil(t, err)
+}
@@ -317,6 +317,12 @@ func (adoc adocReport) WriteReport(model *types.Model,
 	if err != nil {
 		return fmt.Errorf("error creating shared runtimes: %w", err)
 	}
+	if len(model.AcceptedRisks) > 0 {
+		err = adoc.writeAcceptedRisks()
+		if err != nil {
+			return fmt.Errorf("error creating accepted risks: %w", err)
+		}
+	}
 	if val := hideChapters[RiskRulesCheckedByThreagile]; !val {
 		err = adoc.writeRiskRulesChecked(modelFilename, skipRiskRules, buildTimestamp, threagileVersion, modelHash, customRiskRules)
 		if err != nil {
@@ -1615,7 +1621,7 @@ func (adoc adocReport) riskCategories(f *os.File) {
 				colorPrefix = ""
 				colorSuffix = ""
 			}
-			writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+colorSuffix)
+			writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+confidenceNote(risk, "_", "_")+colorSuffix)
 			linkId := ""
 			if len(risk.MostRelevantSharedRuntimeId) > 0 {
 				linkId = risk.MostRelevantSharedRuntimeId
@@ -1742,7 +1748,7 @@ func (adoc adocReport) technicalAssets(f *os.File) {
 					colorSuffix = ""
 				}
 				writeLine(f, "\n==== "+colorPrefix+titleOfSeverity(risk.Severity)+colorSuffix+"\n")
-				writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+colorSuffix)
+				writeLine(f, colorPrefix+fixBasicHtml(risk.Title)+": Exploitation likelihood is _"+risk.ExploitationLikelihood.Title()+"_ with _"+risk.ExploitationImpact.Title()+"_ impact."+confidenceNote(risk, "_", "_")+colorSuffix)
 				writeLine(f, "")
 
 				writeLine(f, "<<"+risk.CategoryId+",[SmallGrey]#"+risk.SyntheticId+"#>>")
@@ -2087,6 +2093,47 @@ func (adoc adocReport) writeSharedRuntimes() error {
 	return nil
 }
 
+func (adoc adocReport) acceptedRisks(f *os.File) {
+	risks := "Risks"
+	count := len(adoc.model.AcceptedRisks)
+	if count == 1 {
+		risks = "Risk"
+	}
+	writeLine(f, "= Accepted by Design: "+strconv.Itoa(count)+" "+risks)
+	writeLine(f, "")
+	writeLine(f, `
+This chapter lists all risks that have been suppressed in the model as accepted by design,
+together with the justification and the date the suppression expires.
+Once expired, these risks are reported again and should be re-evaluated:
+`)
+	writeLine(f, "")
+
+	for _, acceptedRisk := range adoc.model.AcceptedRisks {
+		categoryTitle := acceptedRisk.Risk.CategoryId
+		if category := adoc.model.GetRiskCategory(acceptedRisk.Risk.CategoryId); category != nil {
+			categoryTitle = category.Title
+		}
+
+		writeLine(f, fixBasicHtml(acceptedRisk.Risk.Title)+"::")
+		writeLine(f, "  "+categoryTitle+", accepted until "+acceptedRisk.Expires.Format("2006-01-02")+": "+acceptedRisk.Justification)
+		writeLine(f, "")
+	}
+}
+
+func (adoc adocReport) writeAcceptedRisks() error {
+	filename := "215_AcceptedByDesign.adoc"
+	f, err := os.Create(filepath.Join(adoc.targetDirectory, filename))
+	defer func() { _ = f.Close() }()
+	if err != nil {
+		return err
+	}
+	adoc.writeMainLine("<<<")
+	adoc.writeMainLine("include::" + filename + "[leveloffset=+1]")
+
+	adoc.acceptedRisks(f)
+	return nil
+}
+
 func (adoc adocReport) riskRulesChecked(f *os.File, modelFilename string, skipRiskRules []string, buildTimestamp string, threagileVersion string, modelHash string, customRiskRules types.RiskRules) {
 	writeLine(f, "= Risk Rules Checked by Threagile")
 	writeLine(f, "")
@@ -29,6 +29,8 @@ func (what *ExcelColumns) GetColumns() ExcelColumns {
 		"R": {Title: "Date", Width: 18},
 		"S": {Title: "Checked by", Width: 20},
 		"T": {Title: "Ticket", Width: 20},
+		"U": {Title: "Confidence", Width: 15},
+		"V": {Title: "Labels", Width: 25},
 	}
 
 	return *what
@@ -542,10 +542,10 @@ func (what *ExcelStyles) Get(column string, status types.RiskStatus, severity ty
 	case "Q":
 		return what.blackSmall
 
-	case "R", "S":
+	case "R", "S", "U":
 		return what.blackCenter
 
-	case "T":
+	case "T", "V":
 		return what.blackLeft
 	}
 
@@ -125,6 +125,8 @@ func WriteRisksExcelToFile(parsedModel *types.Model, filename string, config rep
 					date,
 					riskTracking.CheckedBy,
 					riskTracking.Ticket,
+					risk.Confidence.Title(),
+					strings.Join(risk.Labels, ", "),
 				},
 				Status:   riskTracking.Status,
 				Severity: risk.Severity,
@@ -145,7 +147,7 @@ func WriteRisksExcelToFile(parsedModel *types.Model, filename string, config rep
 	}
 
 	// set header style
-	setCellStyleError := excel.SetCellStyle(sheetName, "A1", "T1", cellStyles.headCenterBoldItalic)
+	setCellStyleError := excel.SetCellStyle(sheetName, "A1", "V1", cellStyles.headCenterBoldItalic)
 	if setCellStyleError != nil {
 		return fmt.Errorf("unable to set cell style: %w", setCellStyleError)
 	}
@@ -220,7 +220,7 @@ func Generate(config reportConfigReader, readResult *model.ReadResult, commands
 			filepath.Join(config.GetOutputFolder(), config.GetDataFlowDiagramFilenamePNG()),
 			filepath.Join(config.GetOutputFolder(), config.GetDataAssetDiagramFilenamePNG()),
 			config.GetInputFile(),
-			config.GetSkipRiskRules(),
+			readResult.SkippedRiskRules,
 			config.GetBuildTimestamp(),
 			config.GetThreagileVersion(),
 			modelHash,
@@ -254,7 +254,7 @@ func Generate(config reportConfigReader, readResult *model.ReadResult, commands
 			filepath.Join(config.GetOutputFolder(), config.GetDataFlowDiagramFilenamePNG()),
 			filepath.Join(config.GetOutputFolder(), config.GetDataAssetDiagramFilenamePNG()),
 			config.GetInputFile(),
-			config.GetSkipRiskRules(),
+			readResult.SkippedRiskRules,
 			config.GetBuildTimestamp(),
 			config.GetThreagileVersion(),
 			modelHash,
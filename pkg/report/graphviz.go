@@ -724,7 +724,7 @@ func determineShapeFillColor(ta *types.TechnicalAsset, parsedModel *types.Model)
 		fillColor = CustomDevelopedParts
 	}
 	switch ta.Machine {
-	case types.Physical:
+	case types.Physical, types.Embedded:
 		fillColor = darkenHexColor(fillColor)
 	case types.Container:
 		fillColor = brightenHexColor(fillColor)
@@ -112,6 +112,9 @@ func (r *pdfReporter) WriteReportPDF(reportFilename string,
 	r.createDataAssets(model)
 	r.createTrustBoundaries(model)
 	r.createSharedRuntimes(model)
+	if len(model.AcceptedRisks) > 0 {
+		r.createAcceptedRisks(model)
+	}
 	if val := hideChapters[RiskRulesCheckedByThreagile]; !val {
 		r.createRiskRulesChecked(model, modelFilename, skipRiskRules, buildTimestamp, threagileVersion, modelHash, customRiskRules)
 	}
@@ -615,6 +618,34 @@ func (r *pdfReporter) createTableOfContents(parsedModel *types.Model) {
 
 	// ===============
 
+	if len(parsedModel.AcceptedRisks) > 0 {
+		y += 6
+		y += 6
+		if y > 260 { // 260 instead of 275 for major group headlines to avoid "Schusterjungen"
+			r.pageBreakInLists()
+			y = 40
+		}
+		r.pdfColorBlack()
+		r.pdf.SetFont("Helvetica", "B", fontSizeBody)
+		r.pdf.Text(11, y, "Appendix")
+		r.pdf.SetFont("Helvetica", "", fontSizeBody)
+		y += 6
+		if y > 275 {
+			r.pageBreakInLists()
+			y = 40
+		}
+		risksStr := "Risks"
+		if len(parsedModel.AcceptedRisks) == 1 {
+			risksStr = "Risk"
+		}
+		r.pdf.Text(11, y, "    "+"Accepted by Design: "+strconv.Itoa(len(parsedModel.AcceptedRisks))+" "+risksStr)
+		r.pdf.Text(175, y, "{accepted-by-design}")
+		r.pdf.Line(15.6, y+1.3, 11+171.5, y+1.3)
+		r.pdf.Link(10, y-5, 172.5, 6.5, r.pdf.AddLink())
+	}
+
+	// ===============
+
 	y += 6
 	y += 6
 	if y > 260 { // 260 instead of 275 for major group headlines to avoid "Schusterjungen"
@@ -1557,6 +1588,56 @@ func (r *pdfReporter) createOutOfScopeAssets(parsedModel *types.Model) {
 	r.pdf.SetDashPattern([]float64{}, 0)
 }
 
+func (r *pdfReporter) createAcceptedRisks(parsedModel *types.Model) {
+	uni := r.pdf.UnicodeTranslatorFromDescriptor("")
+	r.pdf.SetTextColor(0, 0, 0)
+	risksStr := "Risks"
+	count := len(parsedModel.AcceptedRisks)
+	if count == 1 {
+		risksStr = "Risk"
+	}
+	chapTitle := "Accepted by Design: " + strconv.Itoa(count) + " " + risksStr
+	r.addHeadline(chapTitle, false)
+	r.defineLinkTarget("{accepted-by-design}")
+	r.currentChapterTitleBreadcrumb = chapTitle
+
+	html := r.pdf.HTMLBasicNew()
+	var strBuilder strings.Builder
+	strBuilder.WriteString("This chapter lists all risks that have been suppressed in the model as accepted by design, " +
+		"together with the justification and the date the suppression expires. " +
+		"Once expired, these risks are reported again and should be re-evaluated:<br>")
+	html.Write(5, strBuilder.String())
+	strBuilder.Reset()
+
+	for _, acceptedRisk := range parsedModel.AcceptedRisks {
+		if r.pdf.GetY() > 250 {
+			r.pageBreak()
+			r.pdf.SetY(36)
+		} else {
+			strBuilder.WriteString("<br><br>")
+		}
+		categoryTitle := acceptedRisk.Risk.CategoryId
+		if category := parsedModel.GetRiskCategory(acceptedRisk.Risk.CategoryId); category != nil {
+			categoryTitle = category.Title
+		}
+		strBuilder.WriteString(uni(acceptedRisk.Risk.Title))
+		strBuilder.WriteString("<br>")
+		html.Write(5, strBuilder.String())
+		strBuilder.Reset()
+		r.pdfColorGray()
+		strBuilder.WriteString(uni(categoryTitle) + ", accepted until " + acceptedRisk.Expires.Format("2006-01-02") + ": ")
+		html.Write(5, strBuilder.String())
+		strBuilder.Reset()
+		r.pdf.SetTextColor(0, 0, 0)
+		strBuilder.WriteString(uni(acceptedRisk.Justification))
+		html.Write(5, strBuilder.String())
+		strBuilder.Reset()
+	}
+
+	r.pdf.SetDrawColor(0, 0, 0)
+	r.pdf.SetDashPattern([]float64{}, 0)
+}
+
 func (r *pdfReporter) createModelFailures(parsedModel *types.Model) {
 	r.pdf.SetTextColor(0, 0, 0)
 	modelFailures := flattenRiskSlice(filterByModelFailures(parsedModel, parsedModel.GeneratedRisksByCategory))
@@ -1871,6 +1952,15 @@ func (r *pdfReporter) addCategories(parsedModel *types.Model, riskCategories []*
 	}
 }
 
+// confidenceNote mentions the confidence of risks not detected with high confidence, these need to be reviewed
+func confidenceNote(risk *types.Risk, emphasisPrefix string, emphasisSuffix string) string {
+	if risk.Confidence == types.HighConfidence {
+		return ""
+	}
+
+	return " Detection confidence is " + emphasisPrefix + risk.Confidence.Title() + emphasisSuffix + ", please review."
+}
+
 func highestExploitationLikelihood(risks []*types.Risk) types.RiskExploitationLikelihood {
 	result := types.Unlikely
 	for _, risk := range risks {
@@ -2726,7 +2816,7 @@ func (r *pdfReporter) createRiskCategories(parsedModel *types.Model) {
 			posY := r.pdf.GetY()
 			r.pdf.SetLeftMargin(oldLeft + 10)
 			r.pdf.SetFont("Helvetica", "", fontSizeBody)
-			text.WriteString(uni(risk.Title) + ": Exploitation likelihood is <i>" + risk.ExploitationLikelihood.Title() + "</i> with <i>" + risk.ExploitationImpact.Title() + "</i> impact.")
+			text.WriteString(uni(risk.Title) + ": Exploitation likelihood is <i>" + risk.ExploitationLikelihood.Title() + "</i> with <i>" + risk.ExploitationImpact.Title() + "</i> impact." + confidenceNote(risk, "<i>", "</i>"))
 			text.WriteString("<br>")
 			html.Write(5, text.String())
 			text.Reset()
@@ -2950,7 +3040,7 @@ func (r *pdfReporter) createTechnicalAssets(parsedModel *types.Model) {
 				posY := r.pdf.GetY()
 				r.pdf.SetLeftMargin(oldLeft + 10)
 				r.pdf.SetFont("Helvetica", "", fontSizeBody)
-				text.WriteString(uni(risk.Title) + ": Exploitation likelihood is <i>" + risk.ExploitationLikelihood.Title() + "</i> with <i>" + risk.ExploitationImpact.Title() + "</i> impact.")
+				text.WriteString(uni(risk.Title) + ": Exploitation likelihood is <i>" + risk.ExploitationLikelihood.Title() + "</i> with <i>" + risk.ExploitationImpact.Title() + "</i> impact." + confidenceNote(risk, "<i>", "</i>"))
 				text.WriteString("<br>")
 				html.Write(5, text.String())
 				text.Reset()
@@ -7,10 +7,16 @@ import (
 	"github.com/threagile/threagile/pkg/types"
 )
 
-type AccidentalSecretLeakRule struct{}
+type AccidentalSecretLeakRule struct {
+	gitTags []string
+}
 
 func NewAccidentalSecretLeakRule() *AccidentalSecretLeakRule {
-	return &AccidentalSecretLeakRule{}
+	return &AccidentalSecretLeakRule{gitTags: []string{"git"}}
+}
+
+func (r *AccidentalSecretLeakRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Strings("git-tags", &r.gitTags)
 }
 
 func (*AccidentalSecretLeakRule) Category() *types.RiskCategory {
@@ -36,12 +42,13 @@ func (*AccidentalSecretLeakRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives.",
 		ModelFailurePossibleReason: false,
 		CWE:                        200,
+		Labels:                     []string{"data"},
 	}
 }
 
-func (*AccidentalSecretLeakRule) SupportedTags() []string {
+func (r *AccidentalSecretLeakRule) SupportedTags() []string {
 	// todo: how is 'nexus' being used?
-	return []string{"git", "nexus"}
+	return append(append([]string{}, r.gitTags...), "nexus")
 }
 
 func (r *AccidentalSecretLeakRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
@@ -53,7 +60,7 @@ func (r *AccidentalSecretLeakRule) GenerateRisks(parsedModel *types.Model) ([]*t
 		}
 
 		var risk *types.Risk
-		if techAsset.IsTaggedWithAny("git") {
+		if techAsset.IsTaggedWithAny(r.gitTags...) {
 			risk = r.createRisk(parsedModel, techAsset, "Git", "Git Leak Prevention")
 		} else {
 			risk = r.createRisk(parsedModel, techAsset, "", "")
@@ -104,11 +111,20 @@ func (r *AccidentalSecretLeakRule) createRisk(parsedModel *types.Model, technica
 	return risk
 }
 
+// MatchRisk checks if any risk generated by the rule matches the risk query (see types.RiskQuery)
 func (r *AccidentalSecretLeakRule) MatchRisk(parsedModel *types.Model, risk string) bool {
-	categoryId := r.Category().ID
-	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
-		techAsset := parsedModel.TechnicalAssets[id]
-		if strings.EqualFold(risk, categoryId+"@"+techAsset.Id) || strings.EqualFold(risk, categoryId+"@*") {
+	query, queryError := types.ParseRiskQuery(risk)
+	if queryError != nil {
+		return false
+	}
+
+	generatedRisks, riskError := r.GenerateRisks(parsedModel)
+	if riskError != nil {
+		return false
+	}
+
+	for _, generatedRisk := range generatedRisks {
+		if query.MatchRisk(parsedModel, generatedRisk) {
 			return true
 		}
 	}
@@ -135,8 +151,8 @@ func (r *AccidentalSecretLeakRule) ExplainRisk(parsedModel *types.Model, risk st
 						fmt.Sprintf("  - technology: %v (has either [%q, %q])", techAsset.Technologies.String(), types.SourcecodeRepository, types.ArtifactRegistry),
 					}...)
 
-					if techAsset.IsTaggedWithAny("git") {
-						explanation = append(explanation, "  is tagged with 'git'")
+					if techAsset.IsTaggedWithAny(r.gitTags...) {
+						explanation = append(explanation, fmt.Sprintf("  is tagged with any of %q", r.gitTags))
 					}
 
 					explanation = append(explanation, riskExplanation...)
@@ -131,3 +131,58 @@ func TestAccidentalSecretLeakRuleGenerateRisksTechAssetProcessStrictlyConfidenti
 	assert.Equal(t, len(risks), 1)
 	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
 }
+
+func TestAccidentalSecretLeakRuleConfiguredGitTagsRisksCreated(t *testing.T) {
+	rule := NewAccidentalSecretLeakRule()
+	err := rule.Configure(types.NewRiskRuleParameters(map[string]any{"git-tags": []any{"gitlab"}}))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Technologies: types.TechnologyList{
+					{
+						Name: "git repository",
+						Attributes: map[string]bool{
+							types.MayContainSecrets: true,
+						},
+					},
+				},
+				Tags: []string{"gitlab"},
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, len(risks), 1)
+	assert.Contains(t, risks[0].Title, "Accidental Secret Leak (Git)")
+	assert.Equal(t, []string{"gitlab", "nexus"}, rule.SupportedTags())
+}
+
+func TestAccidentalSecretLeakRuleMatchRisk(t *testing.T) {
+	rule := NewAccidentalSecretLeakRule()
+	parsedModel := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Id: "ta1",
+				Technologies: types.TechnologyList{
+					{
+						Name: "git repository",
+						Attributes: map[string]bool{
+							types.MayContainSecrets: true,
+						},
+					},
+				},
+				Tags: []string{"git"},
+			},
+			"ta2": {Id: "ta2"},
+		},
+	}
+
+	assert.True(t, rule.MatchRisk(parsedModel, "accidental-secret-leak@ta1"))
+	assert.True(t, rule.MatchRisk(parsedModel, "accidental-secret-leak@*"))
+	assert.True(t, rule.MatchRisk(parsedModel, "*@ta1 tag=git"))
+	assert.False(t, rule.MatchRisk(parsedModel, "accidental-secret-leak@ta2"))
+	assert.False(t, rule.MatchRisk(parsedModel, "*@ta1 severity=critical"))
+	assert.False(t, rule.MatchRisk(parsedModel, "*@ta1 severity=unknown"))
+}
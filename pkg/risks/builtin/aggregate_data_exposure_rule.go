@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type AggregateDataExposureRule struct{}
+
+func NewAggregateDataExposureRule() *AggregateDataExposureRule {
+	return &AggregateDataExposureRule{}
+}
+
+func (*AggregateDataExposureRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "aggregate-data-exposure",
+		Title: "Aggregate Data Exposure",
+		Description: "Wide aggregate query endpoints returning entire object graphs violate the principle of least data access, " +
+			"as consumers receive much more (and more sensitive) data than they actually need.",
+		Impact: "If this risk is unmitigated, attackers compromising a less sensitive consumer of an aggregate API might " +
+			"obtain large amounts of sensitive data in a single request.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/REST_Security_Cheat_Sheet.html",
+		Action:     "Least Data Access",
+		Mitigation: "Apply field-level projection so that consumers only receive the fields they actually need, " +
+			"for example by using GraphQL or sparse fieldset patterns (like JSON:API sparse fieldsets) instead of " +
+			"generic aggregate or bulk endpoints.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Architecture,
+		STRIDE:                     types.InformationDisclosure,
+		DetectionLogic:             "In-scope REST or SOAP web services tagged with 'aggregate-api' or 'bulk-endpoint' returning data assets rated at least as " + types.Confidential.String() + " to callers in a different trust boundary with a lower confidentiality rating.",
+		RiskAssessment:             "The risk rating depends on the confidentiality of the data assets returned by the aggregate endpoint.",
+		FalsePositives:             "Aggregate endpoints already applying field-level projection can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        213,
+		Labels:                     []string{"appsec", "data"},
+	}
+}
+
+func (*AggregateDataExposureRule) SupportedTags() []string {
+	return []string{"aggregate-api", "bulk-endpoint"}
+}
+
+func (r *AggregateDataExposureRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		technicalAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(technicalAsset) {
+			continue
+		}
+
+		for _, incomingFlow := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			caller, ok := parsedModel.TechnicalAssets[incomingFlow.SourceId]
+			if !ok || isSameTrustBoundaryNetworkOnly(parsedModel, technicalAsset, caller.Id) {
+				continue
+			}
+
+			highestServed := r.highestServedConfidentiality(parsedModel, incomingFlow)
+			if highestServed < types.Confidential || caller.Confidentiality >= highestServed {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(technicalAsset, caller, incomingFlow, highestServed))
+		}
+	}
+	return risks, nil
+}
+
+func (r *AggregateDataExposureRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.Technologies.GetAttribute(types.WebServiceREST, types.WebServiceSOAP) ||
+		!techAsset.IsTaggedWithAny("aggregate-api", "bulk-endpoint")
+}
+
+func (r *AggregateDataExposureRule) highestServedConfidentiality(parsedModel *types.Model, incomingFlow *types.CommunicationLink) types.Confidentiality {
+	highest := types.Public
+	for _, dataAssetId := range incomingFlow.DataAssetsReceived {
+		if dataAsset, ok := parsedModel.DataAssets[dataAssetId]; ok && dataAsset.Confidentiality > highest {
+			highest = dataAsset.Confidentiality
+		}
+	}
+	return highest
+}
+
+func (r *AggregateDataExposureRule) createRisk(technicalAsset *types.TechnicalAsset, caller *types.TechnicalAsset, incomingFlow *types.CommunicationLink, highestServed types.Confidentiality) *types.Risk {
+	impact := types.MediumImpact
+	if highestServed == types.StrictlyConfidential {
+		impact = types.HighImpact
+	}
+	title := "<b>Aggregate Data Exposure</b> risk at <b>" + technicalAsset.Title + "</b> serving <b>" + caller.Title + "</b> " +
+		"via <b>" + incomingFlow.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    technicalAsset.Id,
+		MostRelevantCommunicationLinkId: incomingFlow.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{caller.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + caller.Id + "@" + technicalAsset.Id + "@" + incomingFlow.Id
+	return risk
+}
+
+func (r *AggregateDataExposureRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[incomingFlow.TargetId]
+		caller := parsedModel.TechnicalAssets[incomingFlow.SourceId]
+		highestServed := r.highestServedConfidentiality(parsedModel, incomingFlow)
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q or %q)", technicalAsset.Technologies.String(), types.WebServiceREST, types.WebServiceSOAP),
+			fmt.Sprintf("  - tags: %v (has %q or %q)", technicalAsset.Tags, "aggregate-api", "bulk-endpoint"),
+			fmt.Sprintf("  - communication link %q from technical asset %q", incomingFlow.Id, caller.Id),
+			"    - crosses a network trust boundary",
+			fmt.Sprintf("    - highest confidentiality of data assets received: %v (>=%v)", highestServed, types.Confidential),
+			fmt.Sprintf("    - caller confidentiality: %v (<%v)", caller.Confidentiality, highestServed),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("%v data is served", types.StrictlyConfidential)))
+	}
+
+	return explanation
+}
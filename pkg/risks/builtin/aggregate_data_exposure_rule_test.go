@@ -0,0 +1,144 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestAggregateDataExposureRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewAggregateDataExposureRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type AggregateDataExposureRuleTest struct {
+	technology            string
+	tags                  []string
+	servedConfidentiality types.Confidentiality
+	callerConfidentiality types.Confidentiality
+	isInSameTrustBoundary bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestAggregateDataExposureRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]AggregateDataExposureRuleTest{
+		"not a web service": {
+			technology:            types.Database,
+			tags:                  []string{"aggregate-api"},
+			servedConfidentiality: types.Confidential,
+			callerConfidentiality: types.Internal,
+			riskCreated:           false,
+		},
+		"not an aggregate api": {
+			technology:            types.WebServiceREST,
+			servedConfidentiality: types.Confidential,
+			callerConfidentiality: types.Internal,
+			riskCreated:           false,
+		},
+		"served data not confidential": {
+			technology:            types.WebServiceREST,
+			tags:                  []string{"aggregate-api"},
+			servedConfidentiality: types.Restricted,
+			callerConfidentiality: types.Internal,
+			riskCreated:           false,
+		},
+		"caller equally sensitive": {
+			technology:            types.WebServiceREST,
+			tags:                  []string{"aggregate-api"},
+			servedConfidentiality: types.Confidential,
+			callerConfidentiality: types.Confidential,
+			riskCreated:           false,
+		},
+		"caller in same trust boundary": {
+			technology:            types.WebServiceREST,
+			tags:                  []string{"aggregate-api"},
+			servedConfidentiality: types.Confidential,
+			callerConfidentiality: types.Internal,
+			isInSameTrustBoundary: true,
+			riskCreated:           false,
+		},
+		"confidential data served medium impact": {
+			technology:            types.WebServiceSOAP,
+			tags:                  []string{"bulk-endpoint"},
+			servedConfidentiality: types.Confidential,
+			callerConfidentiality: types.Internal,
+			riskCreated:           true,
+			expectedImpact:        types.MediumImpact,
+		},
+		"strictly confidential data served high impact": {
+			technology:            types.WebServiceREST,
+			tags:                  []string{"aggregate-api"},
+			servedConfidentiality: types.StrictlyConfidential,
+			callerConfidentiality: types.Confidential,
+			riskCreated:           true,
+			expectedImpact:        types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewAggregateDataExposureRule()
+			incomingFlow := &types.CommunicationLink{
+				Id:                 "caller>api",
+				Title:              "Query",
+				SourceId:           "caller",
+				TargetId:           "api",
+				DataAssetsReceived: []string{"data"},
+			}
+			apiBoundary := &types.TrustBoundary{Id: "tb1", Type: types.NetworkCloudProvider}
+			callerBoundary := &types.TrustBoundary{Id: "tb2", Type: types.NetworkCloudProvider}
+			if testCase.isInSameTrustBoundary {
+				callerBoundary = apiBoundary
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"api": {
+						Id:    "api",
+						Title: "API",
+						Tags:  testCase.tags,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+					},
+					"caller": {
+						Id:                 "caller",
+						Title:              "Caller",
+						Confidentiality:    testCase.callerConfidentiality,
+						CommunicationLinks: []*types.CommunicationLink{incomingFlow},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.servedConfidentiality},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"api": {incomingFlow},
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"api":    apiBoundary,
+					"caller": callerBoundary,
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Aggregate Data Exposure</b> risk at <b>API</b> serving <b>Caller</b> via <b>Query</b>", risks[0].Title)
+				assert.Equal(t, "aggregate-data-exposure@caller@api@caller>api", risks[0].SyntheticId)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ApiVersioningRegressionRule struct{}
+
+func NewApiVersioningRegressionRule() *ApiVersioningRegressionRule {
+	return &ApiVersioningRegressionRule{}
+}
+
+func (*ApiVersioningRegressionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "api-versioning-regression",
+		Title: "API Versioning Regression",
+		Description: "APIs maintaining multiple active versions often neglect security patches on deprecated versions, " +
+			"creating a security regression path for attackers who simply call the older version.",
+		Impact: "If this risk is unmitigated, attackers might bypass security fixes of the current API version " +
+			"by calling a deprecated API version which is still reachable.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/REST_Security_Cheat_Sheet.html",
+		Action:     "API Version Decommissioning",
+		Mitigation: "Decommission deprecated API versions or at least apply the same security patches to them " +
+			"and block internet access to them once all legitimate clients have migrated.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Architecture,
+		STRIDE:                     types.Tampering,
+		DetectionLogic:             "In-scope technical assets tagged with 'api-v1' or 'deprecated-api-version' having incoming communication links from internet-facing technical assets.",
+		RiskAssessment:             "The risk rating depends on whether the deprecated API version is known to have unpatched vulnerabilities (tagged with 'known-vulnerable').",
+		FalsePositives:             "Deprecated API versions which receive the same security patches as the current version can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1329,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*ApiVersioningRegressionRule) SupportedTags() []string {
+	return []string{"api-v1", "deprecated-api-version", "known-vulnerable"}
+}
+
+func (r *ApiVersioningRegressionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		internetCallers := r.internetCallers(parsedModel, techAsset)
+		if len(internetCallers) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset))
+	}
+	return risks, nil
+}
+
+func (r *ApiVersioningRegressionRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("api-v1", "deprecated-api-version")
+}
+
+func (r *ApiVersioningRegressionRule) internetCallers(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		caller, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if ok && caller.Internet && !contains(result, caller.Id) {
+			result = append(result, caller.Id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *ApiVersioningRegressionRule) createRisk(techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if techAsset.IsTaggedWithAny("known-vulnerable") {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>API Versioning Regression</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *ApiVersioningRegressionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		internetCallers := r.internetCallers(parsedModel, techAsset)
+		if len(internetCallers) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("deprecated API technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q])", techAsset.Tags, "api-v1", "deprecated-api-version"),
+			"  - still called by internet-facing technical assets:",
+		}...)
+
+		for _, callerId := range internetCallers {
+			explanation = append(explanation, fmt.Sprintf("    - %q", callerId))
+		}
+
+		if techAsset.IsTaggedWithAny("known-vulnerable") {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the API version is tagged with %q", types.HighImpact, "known-vulnerable"))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
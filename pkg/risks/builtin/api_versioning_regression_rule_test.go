@@ -0,0 +1,98 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestApiVersioningRegressionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewApiVersioningRegressionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ApiVersioningRegressionRuleTest struct {
+	tags           []string
+	outOfScope     bool
+	callerInternet bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestApiVersioningRegressionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ApiVersioningRegressionRuleTest{
+		"current api version": {
+			tags:           []string{"api-v2"},
+			callerInternet: true,
+			riskCreated:    false,
+		},
+		"out of scope": {
+			tags:           []string{"api-v1"},
+			outOfScope:     true,
+			callerInternet: true,
+			riskCreated:    false,
+		},
+		"internal callers only": {
+			tags:        []string{"deprecated-api-version"},
+			riskCreated: false,
+		},
+		"deprecated version called from internet": {
+			tags:           []string{"api-v1"},
+			callerInternet: true,
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+		},
+		"known vulnerable deprecated version": {
+			tags:           []string{"deprecated-api-version", "known-vulnerable"},
+			callerInternet: true,
+			riskCreated:    true,
+			expectedImpact: types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewApiVersioningRegressionRule()
+			incomingLink := &types.CommunicationLink{Id: "client>api", SourceId: "client", TargetId: "api"}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:                 "client",
+						Internet:           testCase.callerInternet,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"api": {
+						Id:         "api",
+						Title:      "API",
+						Tags:       testCase.tags,
+						OutOfScope: testCase.outOfScope,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"api": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>API Versioning Regression</b> risk at <b>API</b>", risks[0].Title)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `deprecated API technical asset "api"`)
+				assert.Contains(t, explanation, `    - "client"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
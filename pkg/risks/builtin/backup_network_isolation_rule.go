@@ -0,0 +1,149 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type BackupNetworkIsolationRule struct{}
+
+func NewBackupNetworkIsolationRule() *BackupNetworkIsolationRule {
+	return &BackupNetworkIsolationRule{}
+}
+
+func (*BackupNetworkIsolationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "backup-network-isolation",
+		Title: "Backup Network Isolation",
+		Description: "Backup storage which is directly accessible from the same network as the systems it backs up means a " +
+			"ransomware attack can encrypt both the primary data and its backups simultaneously.",
+		Impact:     "If this risk is unmitigated, attackers might destroy or encrypt the backups together with the production data, rendering recovery impossible.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Database_Security_Cheat_Sheet.html",
+		Action:     "Backup Isolation",
+		Mitigation: "Place backup storage in a separate network segment (or account) with write-only or pull-based access " +
+			"and use immutable or offline backup copies.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.DenialOfService,
+		DetectionLogic:             "In-scope technical assets of technology '" + types.BackupStorage + "' (or tagged with 'backup') sharing the same network trust boundary with production technical assets they back up (i.e. production technical assets communicating with the backup storage).",
+		RiskAssessment:             "The risk rating depends on the availability rating of the backed-up data.",
+		FalsePositives:             "Backup storage with immutable (WORM) retention enabled can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        400,
+		Labels:                     []string{"network", "operations"},
+	}
+}
+
+func (*BackupNetworkIsolationRule) SupportedTags() []string {
+	return []string{"backup", "production"}
+}
+
+func (r *BackupNetworkIsolationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		backupAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(backupAsset) {
+			continue
+		}
+
+		productionAssets := r.productionAssetsInSameNetwork(parsedModel, backupAsset)
+		if len(productionAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, backupAsset, productionAssets))
+	}
+	return risks, nil
+}
+
+func (r *BackupNetworkIsolationRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !isBackupAsset(techAsset)
+}
+
+// productionAssetsInSameNetwork returns the sorted IDs of production technical assets communicating with the backup
+// storage which are located within the same network trust boundary
+func (r *BackupNetworkIsolationRule) productionAssetsInSameNetwork(parsedModel *types.Model, backupAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[backupAsset.Id] {
+		sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if !ok || contains(result, sourceAsset.Id) {
+			continue
+		}
+		if isProductionAsset(parsedModel, sourceAsset) && isSameTrustBoundaryNetworkOnly(parsedModel, backupAsset, sourceAsset.Id) {
+			result = append(result, sourceAsset.Id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *BackupNetworkIsolationRule) isMissionCritical(parsedModel *types.Model, backupAsset *types.TechnicalAsset) bool {
+	return parsedModel.HighestStoredAvailability(backupAsset) == types.MissionCritical ||
+		parsedModel.HighestProcessedAvailability(backupAsset) == types.MissionCritical
+}
+
+func (r *BackupNetworkIsolationRule) createRisk(parsedModel *types.Model, backupAsset *types.TechnicalAsset, productionAssets []string) *types.Risk {
+	impact := types.MediumImpact
+	if r.isMissionCritical(parsedModel, backupAsset) {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Backup Network Isolation</b> risk at <b>" + backupAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: backupAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  append([]string{backupAsset.Id}, productionAssets...),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + backupAsset.Id
+	return risk
+}
+
+func (r *BackupNetworkIsolationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		backupAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+backupAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(backupAsset) {
+			continue
+		}
+
+		productionAssets := r.productionAssetsInSameNetwork(parsedModel, backupAsset)
+		if len(productionAssets) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("backup technical asset %q", backupAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", backupAsset.OutOfScope),
+			fmt.Sprintf("  - technologies: %v (has %q or tagged with %q)", backupAsset.Technologies, types.BackupStorage, "backup"),
+			"  - shares a network trust boundary with production technical assets:",
+		}...)
+
+		for _, productionAssetId := range productionAssets {
+			explanation = append(explanation, fmt.Sprintf("    - %q", productionAssetId))
+		}
+
+		if r.isMissionCritical(parsedModel, backupAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the backed-up data is %v", types.HighImpact, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
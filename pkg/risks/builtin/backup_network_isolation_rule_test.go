@@ -0,0 +1,130 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestBackupNetworkIsolationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewBackupNetworkIsolationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type BackupNetworkIsolationRuleTest struct {
+	technology         string
+	tags               []string
+	callerInProduction bool
+	separateNetwork    bool
+	availability       types.Criticality
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestBackupNetworkIsolationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]BackupNetworkIsolationRuleTest{
+		"not a backup storage": {
+			technology:         types.FileServer,
+			callerInProduction: true,
+			riskCreated:        false,
+		},
+		"caller not in production": {
+			technology:  types.BackupStorage,
+			riskCreated: false,
+		},
+		"separate network": {
+			technology:         types.BackupStorage,
+			callerInProduction: true,
+			separateNetwork:    true,
+			riskCreated:        false,
+		},
+		"backup storage in production network": {
+			technology:         types.BackupStorage,
+			callerInProduction: true,
+			availability:       types.Critical,
+			riskCreated:        true,
+			expectedImpact:     types.MediumImpact,
+		},
+		"tagged backup with mission-critical data": {
+			technology:         types.FileServer,
+			tags:               []string{"backup"},
+			callerInProduction: true,
+			availability:       types.MissionCritical,
+			riskCreated:        true,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewBackupNetworkIsolationRule()
+			backupLink := &types.CommunicationLink{Id: "app>backup", SourceId: "app", TargetId: "backup"}
+			appTags := []string{}
+			if testCase.callerInProduction {
+				appTags = []string{"production"}
+			}
+			appNetwork := &types.TrustBoundary{Id: "app-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"app", "backup"}}
+			backupNetwork := appNetwork
+			if testCase.separateNetwork {
+				appNetwork.TechnicalAssetsInside = []string{"app"}
+				backupNetwork = &types.TrustBoundary{Id: "backup-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"backup"}}
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:                 "app",
+						Tags:               appTags,
+						CommunicationLinks: []*types.CommunicationLink{backupLink},
+					},
+					"backup": {
+						Id:    "backup",
+						Title: "Backup",
+						Tags:  testCase.tags,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+						DataAssetsStored: []string{"orders"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"orders": {Id: "orders", Availability: testCase.availability},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					appNetwork.Id:    appNetwork,
+					backupNetwork.Id: backupNetwork,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"app":    appNetwork,
+					"backup": backupNetwork,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"backup": {backupLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Backup Network Isolation</b> risk at <b>Backup</b>", risks[0].Title)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `backup technical asset "backup"`)
+				assert.Contains(t, explanation, `    - "app"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type BrokenObjectLevelAuthorizationRule struct{}
+
+func NewBrokenObjectLevelAuthorizationRule() *BrokenObjectLevelAuthorizationRule {
+	return &BrokenObjectLevelAuthorizationRule{}
+}
+
+func (*BrokenObjectLevelAuthorizationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "broken-object-level-authorization",
+		Title: "Broken Object Level Authorization",
+		Description: "Web services processing per-user confidential data for multiple clients of different trust levels are prone to " +
+			"Broken Object Level Authorization (BOLA), also known as Insecure Direct Object References (IDOR), as listed first in the OWASP API Security Top 10.",
+		Impact: "If this risk is unmitigated, attackers might be able to access or modify the data of other users " +
+			"by manipulating object identifiers passed to the API.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Insecure_Direct_Object_Reference_Prevention_Cheat_Sheet.html",
+		Action:     "Object Level Authorization",
+		Mitigation: "Check for every request accessing an object by its identifier that the authenticated user is authorized to access this specific object, " +
+			"preferably in a central authorization layer. Use random and unpredictable object identifiers as defense in depth.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope web services (not tagged with 'object-level-authorization') processing confidential or strictly-confidential data assets " +
+			"tagged with 'personal-data' or 'user-data', which are accessed by client technical assets of at least two different trust levels " +
+			"(i.e. from the public network or from different network trust boundaries).",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the per-user data processed. " +
+			"The likelihood is higher when a client accesses the web service from the public network.",
+		FalsePositives:             "Web services with object level authorization checks verified by tests can be tagged with 'object-level-authorization'.",
+		ModelFailurePossibleReason: false,
+		CWE:                        639,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*BrokenObjectLevelAuthorizationRule) SupportedTags() []string {
+	return []string{"object-level-authorization", "personal-data", "user-data"}
+}
+
+func (r *BrokenObjectLevelAuthorizationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		perUserDataAssets := r.perUserDataAssets(parsedModel, techAsset)
+		if len(perUserDataAssets) == 0 {
+			continue
+		}
+
+		trustLevels := r.clientTrustLevels(parsedModel, techAsset)
+		if len(trustLevels) < 2 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, perUserDataAssets, trustLevels))
+	}
+	return risks, nil
+}
+
+func (r *BrokenObjectLevelAuthorizationRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.IsWebService) || techAsset.IsTaggedWithAny("object-level-authorization")
+}
+
+// perUserDataAssets returns the sorted confidential or strictly-confidential data assets processed by the web service
+// which are tagged as per-user data
+func (r *BrokenObjectLevelAuthorizationRule) perUserDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		if dataAsset.Confidentiality >= types.Confidential && dataAsset.IsTaggedWithAny("personal-data", "user-data") {
+			result = append(result, dataAsset.Id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// clientTrustLevels returns the sorted distinct trust levels of the clients calling the web service, which is either
+// the public network or the network trust boundary the client is placed in
+func (r *BrokenObjectLevelAuthorizationRule) clientTrustLevels(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		trustLevel := "network trust boundary " + networkTrustBoundaryId(parsedModel, incomingLink.SourceId)
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			trustLevel = "public network"
+		}
+		if !contains(result, trustLevel) {
+			result = append(result, trustLevel)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *BrokenObjectLevelAuthorizationRule) impact(parsedModel *types.Model, perUserDataAssets []string) types.RiskExploitationImpact {
+	for _, id := range perUserDataAssets {
+		if parsedModel.DataAssets[id].Confidentiality == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *BrokenObjectLevelAuthorizationRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, perUserDataAssets []string, trustLevels []string) *types.Risk {
+	likelihood := types.Likely
+	if contains(trustLevels, "public network") {
+		likelihood = types.VeryLikely
+	}
+	impact := r.impact(parsedModel, perUserDataAssets)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Broken Object Level Authorization</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *BrokenObjectLevelAuthorizationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		perUserDataAssets := r.perUserDataAssets(parsedModel, techAsset)
+		if len(perUserDataAssets) == 0 {
+			continue
+		}
+
+		trustLevels := r.clientTrustLevels(parsedModel, techAsset)
+		if len(trustLevels) < 2 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (is web service)", techAsset.Technologies.String()),
+			fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "object-level-authorization"),
+			fmt.Sprintf("  - processes per-user data assets %q", perUserDataAssets),
+			fmt.Sprintf("  - called from clients of trust levels %q", trustLevels),
+		}...)
+
+		if contains(trustLevels, "public network") {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because a client calls from the public network", types.VeryLikely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v (default)", types.Likely))
+		}
+		if impact := r.impact(parsedModel, perUserDataAssets); impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because per-user data is %v", impact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
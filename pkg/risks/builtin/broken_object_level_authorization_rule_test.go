@@ -0,0 +1,154 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestBrokenObjectLevelAuthorizationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewBrokenObjectLevelAuthorizationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type BrokenObjectLevelAuthorizationRuleTest struct {
+	isWebService        bool
+	tags                []string
+	dataConfidentiality types.Confidentiality
+	dataTags            []string
+	partnerInternet     bool
+	partnerInternal     bool
+
+	riskCreated         bool
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestBrokenObjectLevelAuthorizationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]BrokenObjectLevelAuthorizationRuleTest{
+		"not a web service": {
+			dataConfidentiality: types.StrictlyConfidential,
+			dataTags:            []string{"personal-data"},
+			partnerInternet:     true,
+			riskCreated:         false,
+		},
+		"object level authorization verified": {
+			isWebService:        true,
+			tags:                []string{"object-level-authorization"},
+			dataConfidentiality: types.StrictlyConfidential,
+			dataTags:            []string{"personal-data"},
+			partnerInternet:     true,
+			riskCreated:         false,
+		},
+		"no per-user data": {
+			isWebService:        true,
+			dataConfidentiality: types.StrictlyConfidential,
+			partnerInternet:     true,
+			riskCreated:         false,
+		},
+		"per-user data not confidential": {
+			isWebService:        true,
+			dataConfidentiality: types.Internal,
+			dataTags:            []string{"user-data"},
+			partnerInternet:     true,
+			riskCreated:         false,
+		},
+		"all clients of same trust level": {
+			isWebService:        true,
+			dataConfidentiality: types.Confidential,
+			dataTags:            []string{"user-data"},
+			partnerInternal:     true,
+			riskCreated:         false,
+		},
+		"clients from different network trust boundaries": {
+			isWebService:        true,
+			dataConfidentiality: types.Confidential,
+			dataTags:            []string{"user-data"},
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"client from public network": {
+			isWebService:        true,
+			dataConfidentiality: types.StrictlyConfidential,
+			dataTags:            []string{"personal-data"},
+			partnerInternet:     true,
+			riskCreated:         true,
+			expectedLikelihood:  types.VeryLikely,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because per-user data is strictly-confidential",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewBrokenObjectLevelAuthorizationRule()
+			frontendLink := &types.CommunicationLink{Id: "frontend>api", SourceId: "frontend", TargetId: "api"}
+			partnerLink := &types.CommunicationLink{Id: "partner>api", SourceId: "partner", TargetId: "api"}
+			internalBoundary := &types.TrustBoundary{Id: "internal", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"frontend", "api"}}
+			partnerBoundary := &types.TrustBoundary{Id: "partner-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"partner"}}
+			if testCase.partnerInternal {
+				partnerBoundary = internalBoundary
+			}
+			technology := ""
+			if testCase.isWebService {
+				technology = types.WebServiceREST
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"frontend": {
+						Id:                 "frontend",
+						CommunicationLinks: []*types.CommunicationLink{frontendLink},
+					},
+					"partner": {
+						Id:                 "partner",
+						Internet:           testCase.partnerInternet,
+						CommunicationLinks: []*types.CommunicationLink{partnerLink},
+					},
+					"api": {
+						Id:                  "api",
+						Title:               "API",
+						Tags:                testCase.tags,
+						Technologies:        types.TechnologyList{{Name: technology, Attributes: map[string]bool{types.IsWebService: testCase.isWebService}}},
+						DataAssetsProcessed: []string{"user"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"user": {Id: "user", Confidentiality: testCase.dataConfidentiality, Tags: testCase.dataTags},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					internalBoundary.Id: internalBoundary,
+					partnerBoundary.Id:  partnerBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"frontend": internalBoundary,
+					"api":      internalBoundary,
+					"partner":  partnerBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"api": {frontendLink, partnerLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Broken Object Level Authorization</b> risk at <b>API</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
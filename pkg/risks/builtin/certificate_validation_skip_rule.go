@@ -0,0 +1,126 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type CertificateValidationSkipRule struct{}
+
+func NewCertificateValidationSkipRule() *CertificateValidationSkipRule {
+	return &CertificateValidationSkipRule{}
+}
+
+func (*CertificateValidationSkipRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "certificate-validation-skip",
+		Title: "Certificate Validation Skip",
+		Description: "Service-to-service communication skipping the certificate chain validation (like InsecureSkipVerify in Go or " +
+			"verify=False in Python requests) is as bad as plaintext communication.",
+		Impact: "If this risk is unmitigated, attackers in a man-in-the-middle position might impersonate the called service and " +
+			"read or modify the data transferred.",
+		ASVS:       "V9 - Communication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Transport_Layer_Security_Cheat_Sheet.html",
+		Action:     "Certificate Validation",
+		Mitigation: "Always validate the certificate chain and hostname of the called service. For internal certificate authorities " +
+			"configure the trusted CA certificates instead of disabling the validation.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Development,
+		STRIDE:                     types.Spoofing,
+		DetectionLogic:             "Communication links tagged with 'tls-skip-verify' or 'insecure-skip-verify', regardless of the protocol being encrypted.",
+		RiskAssessment:             "The risk rating depends on the highest confidentiality rating of the data assets transferred via the communication link.",
+		FalsePositives:             "None, as disabled certificate validation is a misconfiguration in any case.",
+		ModelFailurePossibleReason: false,
+		CWE:                        295,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*CertificateValidationSkipRule) SupportedTags() []string {
+	return []string{"tls-skip-verify", "insecure-skip-verify"}
+}
+
+func (r *CertificateValidationSkipRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			if !commLink.IsTaggedWithAny(r.SupportedTags()...) {
+				continue
+			}
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok {
+				continue
+			}
+			risks = append(risks, r.createRisk(parsedModel, sourceAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *CertificateValidationSkipRule) createRisk(parsedModel *types.Model, sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if parsedModel.HighestCommunicationLinkConfidentiality(commLink) == types.StrictlyConfidential {
+		impact = types.HighImpact
+	}
+	title := "<b>Certificate Validation Skip</b> risk at <b>" + sourceAsset.Title + "</b> calling <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    sourceAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{sourceAsset.Id, targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *CertificateValidationSkipRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+sourceAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if !commLink.IsTaggedWithAny(r.SupportedTags()...) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - tags: %v (has either [%q, %q])", commLink.Tags, "tls-skip-verify", "insecure-skip-verify"),
+				fmt.Sprintf("  - source: technical asset %q", sourceAsset.Id),
+				fmt.Sprintf("  - target: technical asset %q", commLink.TargetId),
+			}...)
+
+			for _, dataAsset := range parsedModel.DataAssetsSentSorted(commLink) {
+				explanation = append(explanation, fmt.Sprintf("  - sends data asset %q (confidentiality %v)", dataAsset.Id, dataAsset.Confidentiality))
+			}
+			for _, dataAsset := range parsedModel.DataAssetsReceivedSorted(commLink) {
+				explanation = append(explanation, fmt.Sprintf("  - receives data asset %q (confidentiality %v)", dataAsset.Id, dataAsset.Confidentiality))
+			}
+
+			if parsedModel.HighestCommunicationLinkConfidentiality(commLink) == types.StrictlyConfidential {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is transferred", types.HighImpact, types.StrictlyConfidential))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+			}
+		}
+	}
+
+	return explanation
+}
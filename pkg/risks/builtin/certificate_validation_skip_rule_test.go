@@ -0,0 +1,106 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestCertificateValidationSkipRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewCertificateValidationSkipRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type CertificateValidationSkipRuleTest struct {
+	linkTags        []string
+	protocol        types.Protocol
+	confidentiality types.Confidentiality
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestCertificateValidationSkipRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]CertificateValidationSkipRuleTest{
+		"certificate validated": {
+			protocol:        types.HTTPS,
+			confidentiality: types.Confidential,
+			riskCreated:     false,
+		},
+		"tls skip verify": {
+			linkTags:        []string{"tls-skip-verify"},
+			protocol:        types.HTTPS,
+			confidentiality: types.Confidential,
+			riskCreated:     true,
+			expectedImpact:  types.MediumImpact,
+		},
+		"insecure skip verify on unencrypted protocol": {
+			linkTags:        []string{"insecure-skip-verify"},
+			protocol:        types.HTTP,
+			confidentiality: types.Internal,
+			riskCreated:     true,
+			expectedImpact:  types.MediumImpact,
+		},
+		"strictly confidential data": {
+			linkTags:        []string{"tls-skip-verify"},
+			protocol:        types.HTTPS,
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     true,
+			expectedImpact:  types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewCertificateValidationSkipRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:    "client",
+						Title: "Client",
+						CommunicationLinks: []*types.CommunicationLink{
+							{
+								Id:             "client>service",
+								Title:          "Call",
+								SourceId:       "client",
+								TargetId:       "service",
+								Protocol:       testCase.protocol,
+								Tags:           testCase.linkTags,
+								DataAssetsSent: []string{"data"},
+							},
+						},
+					},
+					"service": {
+						Id:    "service",
+						Title: "Service",
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Title: "Data", Confidentiality: testCase.confidentiality},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Certificate Validation Skip</b> risk at <b>Client</b> calling <b>Service</b> via <b>Call</b>", risks[0].Title)
+				assert.Equal(t, "certificate-validation-skip@client>service@client@service", risks[0].SyntheticId)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `  - source: technical asset "client"`)
+				assert.Contains(t, explanation, `  - target: technical asset "service"`)
+				assert.Contains(t, explanation, `  - sends data asset "data" (confidentiality `+testCase.confidentiality.String()+`)`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
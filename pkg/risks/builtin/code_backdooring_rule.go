@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -39,6 +40,7 @@ func (*CodeBackdooringRule) Category() *types.RiskCategory {
 			"after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        912,
+		Labels:                     []string{"supply-chain"},
 	}
 }
 
@@ -113,3 +115,35 @@ func (r *CodeBackdooringRule) createRisk(input *types.Model, technicalAsset *typ
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *CodeBackdooringRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsDevelopmentRelevant))
+		if technicalAsset.Internet {
+			explanation = append(explanation, fmt.Sprintf("  - internet: %v (=true)", technicalAsset.Internet))
+		} else {
+			for _, callerLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+				if caller := parsedModel.TechnicalAssets[callerLink.SourceId]; !callerLink.VPN && caller.Internet {
+					explanation = append(explanation, fmt.Sprintf("  - accessed by internet-facing technical asset %q via communication link %q without VPN", caller.Id, callerLink.Id))
+					break
+				}
+			}
+		}
+		explanation = append(explanation, fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)))
+		switch generatedRisk.ExploitationImpact {
+		case types.LowImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the technical asset has %q", types.LowImpact, types.CodeInspectionPlatform))
+		case types.HighImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v or higher confidentiality or %v or higher integrity is processed", types.HighImpact, types.Confidential, types.Critical))
+		default:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
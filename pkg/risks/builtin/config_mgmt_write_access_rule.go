@@ -0,0 +1,174 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ConfigMgmtWriteAccessRule struct{}
+
+func NewConfigMgmtWriteAccessRule() *ConfigMgmtWriteAccessRule {
+	return &ConfigMgmtWriteAccessRule{}
+}
+
+func (*ConfigMgmtWriteAccessRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "config-mgmt-write-access",
+		Title: "Config Management Write Access",
+		Description: "Configuration management systems (like Ansible, Chef, Puppet, or Terraform Cloud) with write access from " +
+			"multiple teams and without an audit log represent an integrity risk: malicious or accidental changes can affect all managed systems simultaneously.",
+		Impact: "If this risk is unmitigated, attackers or careless operators might push unreviewed and untraceable changes " +
+			"to all systems managed by the configuration management system.",
+		ASVS:       "V7 - Error Handling and Logging Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Logging_Cheat_Sheet.html",
+		Action:     "Change Approval and Audit Logging",
+		Mitigation: "Enforce a change approval workflow for configuration changes and record all write access to the " +
+			"configuration management system in a tamper-resistant audit log.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.Tampering,
+		DetectionLogic: "In-scope technical assets tagged with 'ansible', 'chef', 'puppet', or 'terraform-cloud' having incoming non-readonly communication links from more than one distinct trust boundary and not being tagged with 'change-approval-workflow' or 'audit-log'.",
+		RiskAssessment: "The risk rating depends on whether the managed technical assets include production systems processing mission-critical data.",
+		FalsePositives: "Configuration management systems where write access is audited by other means " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        778,
+		Labels:                     []string{"supply-chain", "operations"},
+	}
+}
+
+func (*ConfigMgmtWriteAccessRule) SupportedTags() []string {
+	return []string{"ansible", "chef", "puppet", "terraform-cloud", "change-approval-workflow", "audit-log"}
+}
+
+func (r *ConfigMgmtWriteAccessRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(r.writingTrustBoundaryIDs(parsedModel, techAsset)) <= 1 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *ConfigMgmtWriteAccessRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.IsTaggedWithAny("ansible", "chef", "puppet", "terraform-cloud") ||
+		techAsset.IsTaggedWithAny("change-approval-workflow", "audit-log")
+}
+
+// writingTrustBoundaryIDs returns the sorted distinct trust boundaries (empty string meaning none) from which
+// the technical asset receives non-readonly communication links
+func (r *ConfigMgmtWriteAccessRule) writingTrustBoundaryIDs(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	trustBoundaryIDs := make(map[string]bool)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Readonly {
+			continue
+		}
+		sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if !ok {
+			continue
+		}
+		trustBoundaryIDs[parsedModel.AnalysisContext().TrustBoundaryId(sourceAsset.Id)] = true
+	}
+
+	result := make([]string, 0, len(trustBoundaryIDs))
+	for id := range trustBoundaryIDs {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// missionCriticalProductionAssets returns the production technical assets managed (called) by the technical asset
+// which process or store mission-critical data
+func (r *ConfigMgmtWriteAccessRule) missionCriticalProductionAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, outgoingLink := range techAsset.CommunicationLinksSorted() {
+		managedAsset, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]
+		if !ok || contains(result, managedAsset.Id) {
+			continue
+		}
+		if isProductionAsset(parsedModel, managedAsset) && parsedModel.HighestIntegrity(managedAsset) == types.MissionCritical {
+			result = append(result, managedAsset.Id)
+		}
+	}
+	return result
+}
+
+func (r *ConfigMgmtWriteAccessRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if len(r.missionCriticalProductionAssets(parsedModel, techAsset)) > 0 {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Config Management Write Access</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *ConfigMgmtWriteAccessRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		trustBoundaryIDs := r.writingTrustBoundaryIDs(parsedModel, techAsset)
+		if len(trustBoundaryIDs) <= 1 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q, %q], has neither [%q, %q])", techAsset.Tags, "ansible", "chef", "puppet", "terraform-cloud", "change-approval-workflow", "audit-log"),
+			"  - write access from trust boundaries:",
+		}...)
+
+		for _, trustBoundaryId := range trustBoundaryIDs {
+			if trustBoundaryId == "" {
+				explanation = append(explanation, "    - (none)")
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - %q", trustBoundaryId))
+			}
+		}
+
+		missionCriticalAssets := r.missionCriticalProductionAssets(parsedModel, techAsset)
+		if len(missionCriticalAssets) > 0 {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because managed production technical assets %q process mission-critical data", types.HighImpact, missionCriticalAssets))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
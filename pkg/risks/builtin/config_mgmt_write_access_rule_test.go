@@ -0,0 +1,126 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestConfigMgmtWriteAccessRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewConfigMgmtWriteAccessRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ConfigMgmtWriteAccessRuleTest struct {
+	tags                []string
+	secondReadonly      bool
+	sameTrustBoundary   bool
+	managedIntegrity    types.Criticality
+	managedInProduction bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestConfigMgmtWriteAccessRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ConfigMgmtWriteAccessRuleTest{
+		"not a config management system": {
+			tags:        []string{"jenkins"},
+			riskCreated: false,
+		},
+		"audit log": {
+			tags:        []string{"ansible", "audit-log"},
+			riskCreated: false,
+		},
+		"change approval workflow": {
+			tags:        []string{"puppet", "change-approval-workflow"},
+			riskCreated: false,
+		},
+		"writers from a single trust boundary": {
+			tags:              []string{"chef"},
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"second caller is readonly": {
+			tags:           []string{"chef"},
+			secondReadonly: true,
+			riskCreated:    false,
+		},
+		"writers from multiple trust boundaries": {
+			tags:             []string{"terraform-cloud"},
+			managedIntegrity: types.MissionCritical,
+			riskCreated:      true,
+			expectedImpact:   types.MediumImpact,
+		},
+		"manages mission-critical production systems": {
+			tags:                []string{"ansible"},
+			managedIntegrity:    types.MissionCritical,
+			managedInProduction: true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewConfigMgmtWriteAccessRule()
+			firstLink := &types.CommunicationLink{Id: "team-a>cm", SourceId: "team-a", TargetId: "cm"}
+			secondLink := &types.CommunicationLink{Id: "team-b>cm", SourceId: "team-b", TargetId: "cm", Readonly: testCase.secondReadonly}
+			managedTags := []string{}
+			if testCase.managedInProduction {
+				managedTags = []string{"production"}
+			}
+			trustBoundaries := map[string]*types.TrustBoundary{
+				"team-a-network": {Id: "team-a-network", TechnicalAssetsInside: []string{"team-a"}},
+				"team-b-network": {Id: "team-b-network", TechnicalAssetsInside: []string{"team-b"}},
+			}
+			if testCase.sameTrustBoundary {
+				trustBoundaries = map[string]*types.TrustBoundary{
+					"team-network": {Id: "team-network", TechnicalAssetsInside: []string{"team-a", "team-b"}},
+				}
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"team-a": {Id: "team-a", CommunicationLinks: []*types.CommunicationLink{firstLink}},
+					"team-b": {Id: "team-b", CommunicationLinks: []*types.CommunicationLink{secondLink}},
+					"cm": {
+						Id:    "cm",
+						Title: "Config Management",
+						Tags:  testCase.tags,
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "cm>server", SourceId: "cm", TargetId: "server"},
+						},
+					},
+					"server": {
+						Id:                  "server",
+						Tags:                managedTags,
+						DataAssetsProcessed: []string{"orders"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"orders": {Id: "orders", Integrity: testCase.managedIntegrity},
+				},
+				TrustBoundaries: trustBoundaries,
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"cm": {firstLink, secondLink},
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Config Management Write Access</b> risk at <b>Config Management</b>", risks[0].Title)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
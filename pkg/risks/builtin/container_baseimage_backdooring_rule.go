@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*ContainerBaseImageBackdooringRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        912,
+		Labels:                     []string{"container", "supply-chain"},
 	}
 }
 
@@ -73,3 +75,18 @@ func (r *ContainerBaseImageBackdooringRule) createRisk(parsedModel *types.Model,
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *ContainerBaseImageBackdooringRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - machine: %v (=%v)", technicalAsset.Machine, types.Container),
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("%v data or %v integrity or availability is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,156 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ContainerBreakoutLateralMovementRule struct{}
+
+func NewContainerBreakoutLateralMovementRule() *ContainerBreakoutLateralMovementRule {
+	return &ContainerBreakoutLateralMovementRule{}
+}
+
+func (*ContainerBreakoutLateralMovementRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "container-breakout-lateral-movement",
+		Title: "Container Breakout and Lateral Movement",
+		Description: "Containers sharing an execution environment (like the same container host or cluster node) with technical assets " +
+			"of higher sensitivity allow attackers to move laterally after breaking out of a compromised container.",
+		Impact: "If this risk is unmitigated, attackers which have successfully compromised a less sensitive container (via other vulnerabilities) " +
+			"might escape the container and access more sensitive technical assets running within the same execution environment.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Kubernetes_Security_Cheat_Sheet.html",
+		Action:     "Container Isolation",
+		Mitigation: "Separate containers of different sensitivity onto different execution environments (like dedicated nodes via node affinity or taints), " +
+			"run containers without privileges and with read-only filesystems, and apply sandboxed container runtimes where appropriate.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope technical assets of machine type container placed directly inside an execution environment trust boundary " +
+			"together with other in-scope technical assets of higher confidentiality or higher RAA value.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the technical assets reachable by breaking out of the container.",
+		FalsePositives: "Execution environments with strong container isolation (like sandboxed runtimes or micro VMs) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        653,
+		Labels:                     []string{"container"},
+	}
+}
+
+func (*ContainerBreakoutLateralMovementRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *ContainerBreakoutLateralMovementRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		moreSensitiveAssets := r.moreSensitiveColocatedAssets(parsedModel, techAsset)
+		if len(moreSensitiveAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, moreSensitiveAssets))
+	}
+	return risks, nil
+}
+
+func (r *ContainerBreakoutLateralMovementRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.Machine != types.Container {
+		return true
+	}
+	trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+	return !ok || trustBoundary.Type != types.ExecutionEnvironment
+}
+
+// moreSensitiveColocatedAssets returns the sorted in-scope technical assets sharing the execution environment
+// of the container which have a higher confidentiality or a higher RAA value than the container itself
+func (r *ContainerBreakoutLateralMovementRule) moreSensitiveColocatedAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	confidentiality := parsedModel.HighestProcessedConfidentiality(techAsset)
+	assets := make([]string, 0)
+	for _, otherId := range parsedModel.SortedTechnicalAssetIDs() {
+		otherAsset := parsedModel.TechnicalAssets[otherId]
+		if otherId == techAsset.Id || otherAsset.OutOfScope || !isSameExecutionEnvironment(parsedModel, techAsset, otherId) {
+			continue
+		}
+		if parsedModel.HighestProcessedConfidentiality(otherAsset) > confidentiality || otherAsset.RAA > techAsset.RAA {
+			assets = append(assets, otherId)
+		}
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+func (r *ContainerBreakoutLateralMovementRule) impact(parsedModel *types.Model, moreSensitiveAssets []string) types.RiskExploitationImpact {
+	for _, id := range moreSensitiveAssets {
+		if parsedModel.HighestProcessedConfidentiality(parsedModel.TechnicalAssets[id]) == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *ContainerBreakoutLateralMovementRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, moreSensitiveAssets []string) *types.Risk {
+	impact := r.impact(parsedModel, moreSensitiveAssets)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Container Breakout and Lateral Movement</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  moreSensitiveAssets,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *ContainerBreakoutLateralMovementRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		moreSensitiveAssets := r.moreSensitiveColocatedAssets(parsedModel, techAsset)
+		if len(moreSensitiveAssets) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - machine: %v (=%v)", techAsset.Machine, types.Container),
+			fmt.Sprintf("  - trust boundary: %q (type %v)", parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id].Id, types.ExecutionEnvironment),
+			fmt.Sprintf("  - shared with more sensitive technical assets %q", moreSensitiveAssets),
+		}...)
+
+		impact := r.impact(parsedModel, moreSensitiveAssets)
+		if impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because a colocated technical asset is %v", impact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
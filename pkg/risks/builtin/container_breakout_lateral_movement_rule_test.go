@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestContainerBreakoutLateralMovementRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewContainerBreakoutLateralMovementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ContainerBreakoutLateralMovementRuleTest struct {
+	machine              types.TechnicalAssetMachine
+	trustBoundaryType    types.TrustBoundaryType
+	otherConfidentiality types.Confidentiality
+	otherRAA             float64
+	otherOutOfScope      bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestContainerBreakoutLateralMovementRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ContainerBreakoutLateralMovementRuleTest{
+		"not a container": {
+			machine:              types.Virtual,
+			trustBoundaryType:    types.ExecutionEnvironment,
+			otherConfidentiality: types.StrictlyConfidential,
+			riskCreated:          false,
+		},
+		"not in execution environment": {
+			machine:              types.Container,
+			trustBoundaryType:    types.NetworkCloudProvider,
+			otherConfidentiality: types.StrictlyConfidential,
+			riskCreated:          false,
+		},
+		"colocated asset not more sensitive": {
+			machine:              types.Container,
+			trustBoundaryType:    types.ExecutionEnvironment,
+			otherConfidentiality: types.Internal,
+			riskCreated:          false,
+		},
+		"colocated asset out of scope": {
+			machine:              types.Container,
+			trustBoundaryType:    types.ExecutionEnvironment,
+			otherConfidentiality: types.StrictlyConfidential,
+			otherOutOfScope:      true,
+			riskCreated:          false,
+		},
+		"colocated asset with higher RAA": {
+			machine:              types.Container,
+			trustBoundaryType:    types.ExecutionEnvironment,
+			otherConfidentiality: types.Internal,
+			otherRAA:             50,
+			riskCreated:          true,
+			expectedImpact:       types.MediumImpact,
+			expectedExplanation:  "    - impact is medium (default)",
+		},
+		"colocated asset strictly confidential": {
+			machine:              types.Container,
+			trustBoundaryType:    types.ExecutionEnvironment,
+			otherConfidentiality: types.StrictlyConfidential,
+			riskCreated:          true,
+			expectedImpact:       types.HighImpact,
+			expectedExplanation:  "    - impact is high because a colocated technical asset is strictly-confidential",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewContainerBreakoutLateralMovementRule()
+			trustBoundary := &types.TrustBoundary{
+				Id:                    "node",
+				Type:                  testCase.trustBoundaryType,
+				TechnicalAssetsInside: []string{"container", "other"},
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"container": {
+						Id:              "container",
+						Title:           "Container",
+						Machine:         testCase.machine,
+						Confidentiality: types.Internal,
+						RAA:             10,
+					},
+					"other": {
+						Id:              "other",
+						Title:           "Other",
+						Machine:         types.Virtual,
+						Confidentiality: testCase.otherConfidentiality,
+						RAA:             testCase.otherRAA,
+						OutOfScope:      testCase.otherOutOfScope,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{"node": trustBoundary},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"container": trustBoundary,
+					"other":     trustBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, []string{"other"}, risks[0].DataBreachTechnicalAssetIDs)
+				assert.Equal(t, "<b>Container Breakout and Lateral Movement</b> risk at <b>Container</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
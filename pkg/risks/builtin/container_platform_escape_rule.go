@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -39,6 +40,7 @@ func (*ContainerPlatformEscapeRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"container"},
 	}
 }
 
@@ -95,3 +97,18 @@ func (r *ContainerPlatformEscapeRule) createRisk(parsedModel *types.Model, techn
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *ContainerPlatformEscapeRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.ContainerPlatform),
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("%v data or %v integrity or availability is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,118 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type CorsMisconfigurationRule struct{}
+
+func NewCorsMisconfigurationRule() *CorsMisconfigurationRule {
+	return &CorsMisconfigurationRule{}
+}
+
+func (*CorsMisconfigurationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "cors-misconfiguration",
+		Title: "CORS Misconfiguration",
+		Description: "APIs configured with a wildcard 'Access-Control-Allow-Origin' header expose their responses to any origin. " +
+			"Combined with 'Access-Control-Allow-Credentials: true' this violates the CORS specification and allows credential theft from any origin.",
+		Impact: "If this risk is unmitigated, attackers might read responses of the API (including authenticated ones) via a " +
+			"malicious web page visited by a victim.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/HTML5_Security_Cheat_Sheet.html#cross-origin-resource-sharing",
+		Action:     "CORS Configuration",
+		Mitigation: "Configure an explicit allowlist of trusted origins instead of a wildcard and only allow credentials for those " +
+			"trusted origins.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Development,
+		STRIDE:         types.InformationDisclosure,
+		DetectionLogic: "In-scope REST web services or web applications tagged with 'cors-wildcard' (and not with 'cors-restricted'), being more severe when also tagged with 'cors-credentials'.",
+		RiskAssessment: "The risk rating depends on whether credentials are allowed for the wildcard origin and on whether " +
+			"the API is accessed with session IDs or tokens.",
+		FalsePositives:             "APIs only serving public data without any credentials can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        942,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*CorsMisconfigurationRule) SupportedTags() []string {
+	return []string{"cors-wildcard", "cors-credentials", "cors-restricted"}
+}
+
+func (r *CorsMisconfigurationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *CorsMisconfigurationRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.Technologies.GetAttribute(types.WebServiceREST, types.WebApplication) ||
+		!techAsset.IsTaggedWithAny("cors-wildcard") ||
+		techAsset.IsTaggedWithAny("cors-restricted")
+}
+
+func (r *CorsMisconfigurationRule) processesSessionsOrTokens(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Authentication == types.SessionId || incomingLink.Authentication == types.Token {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CorsMisconfigurationRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	title := "<b>CORS Misconfiguration</b> risk at <b>" + techAsset.Title + "</b>"
+	impact := types.LowImpact
+	if techAsset.IsTaggedWithAny("cors-credentials") {
+		title += ": <u>wildcard origin with credentials</u>"
+		impact = types.MediumImpact
+		if r.processesSessionsOrTokens(parsedModel, techAsset) {
+			impact = types.HighImpact
+		}
+	} else {
+		title += ": <u>wildcard origin</u>"
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *CorsMisconfigurationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q or %q)", techAsset.Technologies.String(), types.WebServiceREST, types.WebApplication),
+			fmt.Sprintf("  - tags: %v (has %q, has not %q)", techAsset.Tags, "cors-wildcard", "cors-restricted"))
+		if !techAsset.IsTaggedWithAny("cors-credentials") {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+		} else if generatedRisk.ExploitationImpact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the technical asset is tagged with %q and accessed with %v or %v authentication",
+				types.HighImpact, "cors-credentials", types.SessionId, types.Token))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the technical asset is tagged with %q", types.MediumImpact, "cors-credentials"))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,116 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestCorsMisconfigurationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewCorsMisconfigurationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type CorsMisconfigurationRuleTest struct {
+	technology     string
+	tags           []string
+	authentication types.Authentication
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+	expectedTitle  string
+}
+
+func TestCorsMisconfigurationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]CorsMisconfigurationRuleTest{
+		"not a web service": {
+			technology:  types.Database,
+			tags:        []string{"cors-wildcard", "cors-credentials"},
+			riskCreated: false,
+		},
+		"no wildcard": {
+			technology:  types.WebServiceREST,
+			tags:        []string{"cors-credentials"},
+			riskCreated: false,
+		},
+		"restricted": {
+			technology:  types.WebServiceREST,
+			tags:        []string{"cors-wildcard", "cors-restricted"},
+			riskCreated: false,
+		},
+		"wildcard only": {
+			technology:     types.WebApplication,
+			tags:           []string{"cors-wildcard"},
+			authentication: types.SessionId,
+			riskCreated:    true,
+			expectedImpact: types.LowImpact,
+			expectedTitle:  "<b>CORS Misconfiguration</b> risk at <b>API</b>: <u>wildcard origin</u>",
+		},
+		"wildcard with credentials": {
+			technology:     types.WebServiceREST,
+			tags:           []string{"cors-wildcard", "cors-credentials"},
+			authentication: types.Credentials,
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+			expectedTitle:  "<b>CORS Misconfiguration</b> risk at <b>API</b>: <u>wildcard origin with credentials</u>",
+		},
+		"wildcard with credentials and tokens": {
+			technology:     types.WebServiceREST,
+			tags:           []string{"cors-wildcard", "cors-credentials"},
+			authentication: types.Token,
+			riskCreated:    true,
+			expectedImpact: types.HighImpact,
+			expectedTitle:  "<b>CORS Misconfiguration</b> risk at <b>API</b>: <u>wildcard origin with credentials</u>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewCorsMisconfigurationRule()
+			incomingLink := &types.CommunicationLink{
+				Id:             "browser>api",
+				SourceId:       "browser",
+				TargetId:       "api",
+				Authentication: testCase.authentication,
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"browser": {
+						Id:                 "browser",
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"api": {
+						Id:    "api",
+						Title: "API",
+						Tags:  testCase.tags,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"api": {incomingLink},
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
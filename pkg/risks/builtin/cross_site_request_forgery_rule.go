@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -35,6 +36,7 @@ func (*CrossSiteRequestForgeryRule) Category() *types.RiskCategory {
 			"gets passed through all components until it reaches the web application) this can be considered a false positive.",
 		ModelFailurePossibleReason: false,
 		CWE:                        352,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -93,3 +95,26 @@ func (*CrossSiteRequestForgeryRule) likelihoodFromUsage(cl *types.CommunicationL
 	}
 	return types.VeryLikely
 }
+
+func (r *CrossSiteRequestForgeryRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.WebApplication),
+			fmt.Sprintf("  - communication link %q from technical asset %q", incomingFlow.Id, incomingFlow.SourceId),
+			fmt.Sprintf("    - protocol: %v (is potential web access protocol)", incomingFlow.Protocol),
+			fmt.Sprintf("    - highest integrity: %v", parsedModel.HighestCommunicationLinkIntegrity(incomingFlow)),
+			explainedLikelihood(generatedRisk, types.VeryLikely, fmt.Sprintf("the usage is %v", incomingFlow.Usage)),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the communication link transfers %v integrity data", types.MissionCritical)))
+	}
+
+	return explanation
+}
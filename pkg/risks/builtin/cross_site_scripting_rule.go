@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -33,6 +34,7 @@ func (*CrossSiteScriptingRule) Category() *types.RiskCategory {
 			"gets passed through all components until it reaches the web application) this can be considered a false positive.",
 		ModelFailurePossibleReason: false,
 		CWE:                        79,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -40,6 +42,10 @@ func (*CrossSiteScriptingRule) SupportedTags() []string {
 	return []string{}
 }
 
+func (*CrossSiteScriptingRule) IsAssetScoped() bool {
+	return true
+}
+
 func (r *CrossSiteScriptingRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
 	risks := make([]*types.Risk, 0)
 	for _, id := range input.SortedTechnicalAssetIDs() {
@@ -52,7 +58,6 @@ func (r *CrossSiteScriptingRule) GenerateRisks(input *types.Model) ([]*types.Ris
 	return risks, nil
 }
 
-
 func (asl CrossSiteScriptingRule) skipAsset(technicalAsset *types.TechnicalAsset) bool {
 	return technicalAsset.OutOfScope || !technicalAsset.Technologies.GetAttribute(types.WebApplication) // TODO: also mobile clients or rich-clients as long as they use web-view...
 }
@@ -60,7 +65,7 @@ func (asl CrossSiteScriptingRule) skipAsset(technicalAsset *types.TechnicalAsset
 func (r *CrossSiteScriptingRule) createRisk(parsedModel *types.Model, technicalAsset *types.TechnicalAsset) *types.Risk {
 	title := "<b>Cross-Site Scripting (XSS)</b> risk at <b>" + technicalAsset.Title + "</b>"
 	impact := types.MediumImpact
-	if parsedModel.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || parsedModel.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical {
+	if parsedModel.AnalysisContext().IsHighlySensitive(technicalAsset) {
 		impact = types.HighImpact
 	}
 	risk := &types.Risk{
@@ -76,3 +81,18 @@ func (r *CrossSiteScriptingRule) createRisk(parsedModel *types.Model, technicalA
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *CrossSiteScriptingRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.WebApplication),
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("%v data or %v integrity is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,149 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type CrossTenantDataLeakRule struct{}
+
+func NewCrossTenantDataLeakRule() *CrossTenantDataLeakRule {
+	return &CrossTenantDataLeakRule{}
+}
+
+func (*CrossTenantDataLeakRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "cross-tenant-data-leak",
+		Title: "Cross-Tenant Data Leak",
+		Description: "Multi-tenant applications using shared database schemas (row-level separation rather than schema-per-tenant) " +
+			"risk cross-tenant data leakage if the tenant isolation is missing or misconfigured.",
+		Impact:     "If this risk is unmitigated, tenants might access data of other tenants stored within the shared database schema.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authorization_Cheat_Sheet.html",
+		Action:     "Tenant Isolation",
+		Mitigation: "Enforce row-level security policies on all tenant-specific tables of the shared schema and verify the " +
+			"tenant isolation with automated tests, or use a schema (or database) per tenant.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Development,
+		STRIDE:                     types.InformationDisclosure,
+		DetectionLogic:             "In-scope technical assets of technology '" + types.Database + "' tagged with 'multi-tenant-shared-schema' and neither tagged with 'row-level-security' nor 'tenant-isolation-verified' processing or storing data assets tagged with 'tenant-data' or 'personal-data'.",
+		RiskAssessment:             "The risk rating depends on the highest confidentiality rating of the tenant data assets stored in the shared schema.",
+		FalsePositives:             "Shared schemas where tenant isolation is enforced on the application layer and thoroughly tested can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        284,
+		Labels:                     []string{"cloud", "data"},
+	}
+}
+
+func (*CrossTenantDataLeakRule) SupportedTags() []string {
+	return []string{"multi-tenant-shared-schema", "row-level-security", "tenant-isolation-verified", "tenant-data", "personal-data"}
+}
+
+func (r *CrossTenantDataLeakRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		tenantData := r.tenantDataAssets(parsedModel, techAsset)
+		if len(tenantData) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, tenantData))
+	}
+	return risks, nil
+}
+
+func (r *CrossTenantDataLeakRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.Technologies.GetAttribute(types.Database) ||
+		!techAsset.IsTaggedWithAny("multi-tenant-shared-schema") ||
+		techAsset.IsTaggedWithAny("row-level-security", "tenant-isolation-verified")
+}
+
+func (r *CrossTenantDataLeakRule) tenantDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.DataAsset {
+	result := make([]*types.DataAsset, 0)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		if dataAsset.IsTaggedWithAny("tenant-data", "personal-data") {
+			result = append(result, dataAsset)
+		}
+	}
+	return result
+}
+
+func (r *CrossTenantDataLeakRule) hasStrictlyConfidentialData(tenantData []*types.DataAsset) bool {
+	for _, dataAsset := range tenantData {
+		if dataAsset.Confidentiality == types.StrictlyConfidential {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CrossTenantDataLeakRule) createRisk(techAsset *types.TechnicalAsset, tenantData []*types.DataAsset) *types.Risk {
+	impact := types.MediumImpact
+	if r.hasStrictlyConfidentialData(tenantData) {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Cross-Tenant Data Leak</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *CrossTenantDataLeakRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		tenantData := r.tenantDataAssets(parsedModel, techAsset)
+		if len(tenantData) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technologies: %v (has %q)", techAsset.Technologies, types.Database),
+			fmt.Sprintf("  - tags: %v (has %q, has neither [%q, %q])", techAsset.Tags, "multi-tenant-shared-schema", "row-level-security", "tenant-isolation-verified"),
+			"  - tenant data assets in the shared schema:",
+		}...)
+
+		for _, dataAsset := range tenantData {
+			explanation = append(explanation, fmt.Sprintf("    - %q (confidentiality %v)", dataAsset.Id, dataAsset.Confidentiality))
+		}
+
+		if r.hasStrictlyConfidentialData(tenantData) {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v tenant data is stored", types.HighImpact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
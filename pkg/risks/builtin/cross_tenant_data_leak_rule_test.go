@@ -0,0 +1,115 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestCrossTenantDataLeakRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewCrossTenantDataLeakRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type CrossTenantDataLeakRuleTest struct {
+	technology      string
+	tags            []string
+	dataTags        []string
+	confidentiality types.Confidentiality
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestCrossTenantDataLeakRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]CrossTenantDataLeakRuleTest{
+		"not a database": {
+			technology:  types.FileServer,
+			tags:        []string{"multi-tenant-shared-schema"},
+			dataTags:    []string{"tenant-data"},
+			riskCreated: false,
+		},
+		"schema per tenant": {
+			technology:  types.Database,
+			dataTags:    []string{"tenant-data"},
+			riskCreated: false,
+		},
+		"row level security": {
+			technology:  types.Database,
+			tags:        []string{"multi-tenant-shared-schema", "row-level-security"},
+			dataTags:    []string{"tenant-data"},
+			riskCreated: false,
+		},
+		"tenant isolation verified": {
+			technology:  types.Database,
+			tags:        []string{"multi-tenant-shared-schema", "tenant-isolation-verified"},
+			dataTags:    []string{"personal-data"},
+			riskCreated: false,
+		},
+		"no tenant data": {
+			technology:  types.Database,
+			tags:        []string{"multi-tenant-shared-schema"},
+			dataTags:    []string{"reference-data"},
+			riskCreated: false,
+		},
+		"shared schema with tenant data": {
+			technology:      types.Database,
+			tags:            []string{"multi-tenant-shared-schema"},
+			dataTags:        []string{"tenant-data"},
+			confidentiality: types.Confidential,
+			riskCreated:     true,
+			expectedImpact:  types.MediumImpact,
+		},
+		"shared schema with strictly confidential personal data": {
+			technology:      types.Database,
+			tags:            []string{"multi-tenant-shared-schema"},
+			dataTags:        []string{"personal-data"},
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     true,
+			expectedImpact:  types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewCrossTenantDataLeakRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"db": {
+						Id:    "db",
+						Title: "Database",
+						Tags:  testCase.tags,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+						DataAssetsStored: []string{"customers"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"customers": {Id: "customers", Tags: testCase.dataTags, Confidentiality: testCase.confidentiality},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Cross-Tenant Data Leak</b> risk at <b>Database</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), `    - "customers" (confidentiality `+testCase.confidentiality.String()+`)`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
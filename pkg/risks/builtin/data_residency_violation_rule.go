@@ -0,0 +1,182 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type DataResidencyViolationRule struct{}
+
+func NewDataResidencyViolationRule() *DataResidencyViolationRule {
+	return &DataResidencyViolationRule{}
+}
+
+func (*DataResidencyViolationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "data-residency-violation",
+		Title: "Data Residency Violation",
+		Description: "Data assets subject to data residency or sovereignty requirements (like personal data which must remain within the EU under GDPR) " +
+			"must only be stored or processed by technical assets located within the required jurisdiction.",
+		Impact: "If this risk is unmitigated, the data might be accessible to foreign authorities or providers, " +
+			"resulting in regulatory fines and loss of customer trust.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/User_Privacy_Protection_Cheat_Sheet.html",
+		Action:     "Data Residency",
+		Mitigation: "Host the technical assets processing or storing the data within the required jurisdiction, " +
+			"or remove the residency-bound data from the technical assets located elsewhere (like by anonymization or pseudonymization).",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.BusinessSide,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets with a location set, which process or store data assets tagged with a residency requirement " +
+			"of the form '<prefix>-<jurisdiction>-only' (like 'gdpr-eu-only') not matching the location. " +
+			"A location matches a jurisdiction when it is equal to it or starts with it followed by a dash (like 'eu-west-1' for 'eu'). " +
+			"Data assets with multiple residency tags are fine within either of the jurisdictions.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the residency-bound data assets processed or stored in the wrong jurisdiction.",
+		FalsePositives: "Data transfers covered by appropriate legal safeguards (like adequacy decisions or standard contractual clauses) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        359,
+		Labels:                     []string{"data", "compliance:gdpr"},
+	}
+}
+
+func (*DataResidencyViolationRule) SupportedTags() []string {
+	return []string{"gdpr-eu-only"}
+}
+
+func (r *DataResidencyViolationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		violatedDataAssets := r.violatedDataAssets(parsedModel, techAsset)
+		if len(violatedDataAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, violatedDataAssets))
+	}
+	return risks, nil
+}
+
+func (r *DataResidencyViolationRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || len(techAsset.Location) == 0
+}
+
+// requiredJurisdictions returns the jurisdictions the data asset is bound to by its residency tags
+func (r *DataResidencyViolationRule) requiredJurisdictions(dataAsset *types.DataAsset) []string {
+	jurisdictions := make([]string, 0)
+	for _, tag := range dataAsset.Tags {
+		parts := strings.Split(strings.ToLower(tag), "-")
+		if len(parts) < 2 || parts[len(parts)-1] != "only" || len(parts[len(parts)-2]) == 0 {
+			continue
+		}
+		jurisdictions = append(jurisdictions, parts[len(parts)-2])
+	}
+	return jurisdictions
+}
+
+func (r *DataResidencyViolationRule) isLocatedIn(techAsset *types.TechnicalAsset, jurisdiction string) bool {
+	location := strings.ToLower(techAsset.Location)
+	return location == jurisdiction || strings.HasPrefix(location, jurisdiction+"-")
+}
+
+// violatedDataAssets returns the sorted ids of the residency-bound data assets processed or stored by the technical asset
+// outside all of their required jurisdictions
+func (r *DataResidencyViolationRule) violatedDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		jurisdictions := r.requiredJurisdictions(dataAsset)
+		if len(jurisdictions) == 0 || contains(result, dataAsset.Id) {
+			continue
+		}
+
+		locatedInJurisdiction := false
+		for _, jurisdiction := range jurisdictions {
+			if r.isLocatedIn(techAsset, jurisdiction) {
+				locatedInJurisdiction = true
+				break
+			}
+		}
+		if !locatedInJurisdiction {
+			result = append(result, dataAsset.Id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *DataResidencyViolationRule) impact(parsedModel *types.Model, violatedDataAssets []string) types.RiskExploitationImpact {
+	for _, id := range violatedDataAssets {
+		if parsedModel.DataAssets[id].Confidentiality == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *DataResidencyViolationRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, violatedDataAssets []string) *types.Risk {
+	impact := r.impact(parsedModel, violatedDataAssets)
+	title := "<b>Data Residency Violation</b> risk at <b>" + techAsset.Title + "</b> located in <b>" + techAsset.Location + "</b>"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *DataResidencyViolationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		violatedDataAssets := r.violatedDataAssets(parsedModel, techAsset)
+		if len(violatedDataAssets) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - location: %q", techAsset.Location),
+		}...)
+
+		for _, dataAssetId := range violatedDataAssets {
+			explanation = append(explanation, fmt.Sprintf("  - data asset %q is bound to jurisdictions %q", dataAssetId, r.requiredJurisdictions(parsedModel.DataAssets[dataAssetId])))
+		}
+
+		impact := r.impact(parsedModel, violatedDataAssets)
+		if impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because a residency-bound data asset is %v", impact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,123 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestDataResidencyViolationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewDataResidencyViolationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type DataResidencyViolationRuleTest struct {
+	location        string
+	outOfScope      bool
+	dataTags        []string
+	stored          bool
+	confidentiality types.Confidentiality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestDataResidencyViolationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]DataResidencyViolationRuleTest{
+		"no location": {
+			dataTags:    []string{"gdpr-eu-only"},
+			riskCreated: false,
+		},
+		"out of scope": {
+			location:    "us",
+			outOfScope:  true,
+			dataTags:    []string{"gdpr-eu-only"},
+			riskCreated: false,
+		},
+		"no residency requirement": {
+			location:    "us",
+			dataTags:    []string{"pii"},
+			riskCreated: false,
+		},
+		"located in required jurisdiction": {
+			location:    "EU",
+			dataTags:    []string{"gdpr-eu-only"},
+			riskCreated: false,
+		},
+		"located in region of required jurisdiction": {
+			location:    "eu-west-1",
+			dataTags:    []string{"gdpr-eu-only"},
+			riskCreated: false,
+		},
+		"located in one of multiple required jurisdictions": {
+			location:    "ch",
+			dataTags:    []string{"gdpr-eu-only", "residency-ch-only"},
+			riskCreated: false,
+		},
+		"processed in other jurisdiction": {
+			location:            "us-east-1",
+			dataTags:            []string{"gdpr-eu-only"},
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "  - data asset \"customer-data\" is bound to jurisdictions [\"eu\"]",
+		},
+		"strictly confidential data stored in other jurisdiction": {
+			location:            "us",
+			dataTags:            []string{"gdpr-eu-only"},
+			stored:              true,
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because a residency-bound data asset is strictly-confidential",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewDataResidencyViolationRule()
+			techAsset := &types.TechnicalAsset{
+				Id:         "ta",
+				Title:      "Technical Asset",
+				Location:   testCase.location,
+				OutOfScope: testCase.outOfScope,
+			}
+			if testCase.stored {
+				techAsset.DataAssetsStored = []string{"customer-data"}
+			} else {
+				techAsset.DataAssetsProcessed = []string{"customer-data"}
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"ta": techAsset,
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"customer-data": {
+						Id:              "customer-data",
+						Tags:            testCase.dataTags,
+						Confidentiality: testCase.confidentiality,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Data Residency Violation</b> risk at <b>Technical Asset</b> located in <b>"+testCase.location+"</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
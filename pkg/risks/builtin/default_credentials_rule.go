@@ -0,0 +1,113 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type DefaultCredentialsRule struct{}
+
+func NewDefaultCredentialsRule() *DefaultCredentialsRule {
+	return &DefaultCredentialsRule{}
+}
+
+func (*DefaultCredentialsRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "default-credentials",
+		Title: "Default Credentials",
+		Description: "Network devices, databases, and services shipped with default credentials which are never changed are " +
+			"trivially exploitable, as those credentials are published in the vendor documentation.",
+		Impact:     "If this risk is unmitigated, attackers might log in with the default credentials and take over the affected technical asset.",
+		ASVS:       "V2 - Authentication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
+		Action:     "Credential Hardening",
+		Mitigation: "Change all default and factory passwords before putting the technical asset into operation and configure " +
+			"authentication where it is left unconfigured.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.Spoofing,
+		DetectionLogic:             "In-scope technical assets tagged with 'default-credentials', 'factory-password', or 'unconfigured-auth' not being tagged with 'credentials-changed'.",
+		RiskAssessment:             "The risk rating depends on whether the technical asset is internet-facing.",
+		FalsePositives:             "Technical assets whose default credentials have been changed can be tagged with 'credentials-changed'.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1392,
+		Labels:                     []string{"identity"},
+	}
+}
+
+func (*DefaultCredentialsRule) SupportedTags() []string {
+	return []string{"default-credentials", "factory-password", "unconfigured-auth", "credentials-changed"}
+}
+
+func (r *DefaultCredentialsRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(techAsset))
+	}
+	return risks, nil
+}
+
+func (r *DefaultCredentialsRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.IsTaggedWithAny("default-credentials", "factory-password", "unconfigured-auth") ||
+		techAsset.IsTaggedWithAny("credentials-changed")
+}
+
+func (r *DefaultCredentialsRule) createRisk(techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if techAsset.Internet {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.VeryLikely, impact),
+		ExploitationLikelihood:       types.VeryLikely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Default Credentials</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *DefaultCredentialsRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q], has not %q)", techAsset.Tags, "default-credentials", "factory-password", "unconfigured-auth", "credentials-changed"),
+			fmt.Sprintf("  - internet-facing: %v", techAsset.Internet),
+		}...)
+
+		if techAsset.Internet {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the technical asset is internet-facing", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the technical asset is internal-only", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,88 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestDefaultCredentialsRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewDefaultCredentialsRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type DefaultCredentialsRuleTest struct {
+	tags       []string
+	outOfScope bool
+	internet   bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestDefaultCredentialsRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]DefaultCredentialsRuleTest{
+		"no default credentials": {
+			tags:        []string{"linux"},
+			riskCreated: false,
+		},
+		"out of scope": {
+			tags:        []string{"default-credentials"},
+			outOfScope:  true,
+			riskCreated: false,
+		},
+		"credentials changed": {
+			tags:        []string{"factory-password", "credentials-changed"},
+			riskCreated: false,
+		},
+		"internal default credentials": {
+			tags:                []string{"default-credentials"},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium because the technical asset is internal-only",
+		},
+		"internet-facing unconfigured auth": {
+			tags:                []string{"unconfigured-auth"},
+			internet:            true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the technical asset is internet-facing",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewDefaultCredentialsRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"router": {
+						Id:         "router",
+						Title:      "Router",
+						Tags:       testCase.tags,
+						OutOfScope: testCase.outOfScope,
+						Internet:   testCase.internet,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.VeryLikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Default Credentials</b> risk at <b>Router</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type DependencyConfusionRule struct{}
+
+func NewDependencyConfusionRule() *DependencyConfusionRule {
+	return &DependencyConfusionRule{}
+}
+
+func (*DependencyConfusionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "dependency-confusion",
+		Title: "Dependency Confusion",
+		Description: "When build pipelines or other technical assets using package managers (like npm, Maven, PyPI, or NuGet) pull their dependencies " +
+			"directly from public registries across a network trust boundary, Dependency Confusion (aka supply chain substitution) risks might arise: " +
+			"attackers might publish malicious packages with the names of internal packages (or typo-squatted names) to the public registries.",
+		Impact: "If this risk is unmitigated, attackers might be able to inject malicious code into the build artifacts, " +
+			"which is then deployed to the target systems.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Vulnerable_Dependency_Management_Cheat_Sheet.html",
+		Action:     "Private Package Registry",
+		Mitigation: "Pull all dependencies via a private registry (proxy) which is configured to resolve internal package names " +
+			"exclusively from internal sources. Additionally pin dependency versions and verify their checksums.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope technical assets tagged with 'npm', 'maven', 'pypi', or 'nuget' having outgoing communication links to technical assets across a network trust boundary or to the internet, " +
+			"where the target is neither an artifact registry nor tagged with 'private-registry'.",
+		RiskAssessment: "The risk rating depends on whether the technical asset is a build pipeline or artifact registry (whose artifacts are deployed further) " +
+			"and on the highest integrity rating of the data processed.",
+		FalsePositives: "Package managers which are configured to only resolve scoped or namespaced packages from the public registry " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        427,
+		Labels:                     []string{"supply-chain"},
+	}
+}
+
+func (*DependencyConfusionRule) SupportedTags() []string {
+	return []string{"npm", "maven", "pypi", "nuget", "private-registry"}
+}
+
+func (r *DependencyConfusionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isPublicDependencySource(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *DependencyConfusionRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("npm", "maven", "pypi", "nuget")
+}
+
+// isPublicDependencySource checks whether the dependencies are pulled across a network trust boundary (or from
+// the internet) without a private registry in between
+func (r *DependencyConfusionRule) isPublicDependencySource(parsedModel *types.Model, commLink *types.CommunicationLink, targetAsset *types.TechnicalAsset) bool {
+	if targetAsset.Technologies.GetAttribute(types.ArtifactRegistry) || targetAsset.IsTaggedWithAny("private-registry") {
+		return false
+	}
+	return targetAsset.Internet || isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink)
+}
+
+func (r *DependencyConfusionRule) isBuildAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.Technologies.GetAttribute(types.BuildPipeline) || techAsset.Technologies.GetAttribute(types.ArtifactRegistry)
+}
+
+func (r *DependencyConfusionRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if r.isBuildAsset(techAsset) || parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+		impact = types.HighImpact
+	}
+	title := "<b>Dependency Confusion</b> risk at <b>" + techAsset.Title + "</b> pulling dependencies from <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *DependencyConfusionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isPublicDependencySource(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("    - tags: %v (has either [%q, %q, %q, %q])", techAsset.Tags, "npm", "maven", "pypi", "nuget"),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - technology: %v (is not %q)", targetAsset.Technologies.String(), types.ArtifactRegistry),
+				fmt.Sprintf("    - tags: %v (has not %q)", targetAsset.Tags, "private-registry"),
+			}...)
+
+			if targetAsset.Internet {
+				explanation = append(explanation, "    - is located in the internet")
+			} else {
+				explanation = append(explanation, "    - is located across a network trust boundary")
+			}
+
+			if r.isBuildAsset(techAsset) {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because the source is a build pipeline or artifact registry", types.HighImpact))
+			} else if parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is processed", types.HighImpact, types.MissionCritical))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+			}
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,132 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestDependencyConfusionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewDependencyConfusionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type DependencyConfusionRuleTest struct {
+	outOfScope        bool
+	tags              []string
+	technology        string
+	targetTechnology  string
+	targetTags        []string
+	targetInternet    bool
+	sameTrustBoundary bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestDependencyConfusionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]DependencyConfusionRuleTest{
+		"out of scope": {
+			outOfScope:     true,
+			tags:           []string{"npm"},
+			targetInternet: true,
+			riskCreated:    false,
+		},
+		"no package manager": {
+			tags:           []string{"git"},
+			targetInternet: true,
+			riskCreated:    false,
+		},
+		"within same trust boundary": {
+			tags:              []string{"maven"},
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"via artifact registry": {
+			tags:             []string{"npm"},
+			targetTechnology: types.ArtifactRegistry,
+			targetInternet:   true,
+			riskCreated:      false,
+		},
+		"via private registry": {
+			tags:           []string{"pypi"},
+			targetTags:     []string{"private-registry"},
+			targetInternet: true,
+			riskCreated:    false,
+		},
+		"pulling across trust boundary": {
+			tags:                []string{"nuget"},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"build pipeline pulling from internet": {
+			tags:                []string{"npm"},
+			technology:          types.BuildPipeline,
+			targetInternet:      true,
+			sameTrustBoundary:   true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the source is a build pipeline or artifact registry",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewDependencyConfusionRule()
+			commLink := &types.CommunicationLink{Id: "build>registry", Title: "Pull", SourceId: "build", TargetId: "registry"}
+			buildBoundary := &types.TrustBoundary{Id: "ci", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"build"}}
+			registryBoundary := &types.TrustBoundary{Id: "public", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"registry"}}
+			if testCase.sameTrustBoundary {
+				registryBoundary = buildBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"build": {
+						Id:                 "build",
+						Title:              "Build",
+						OutOfScope:         testCase.outOfScope,
+						Tags:               testCase.tags,
+						Technologies:       types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{commLink},
+					},
+					"registry": {
+						Id:           "registry",
+						Title:        "Registry",
+						Internet:     testCase.targetInternet,
+						Tags:         testCase.targetTags,
+						Technologies: types.TechnologyList{{Name: testCase.targetTechnology, Attributes: map[string]bool{testCase.targetTechnology: true}}},
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					buildBoundary.Id:    buildBoundary,
+					registryBoundary.Id: registryBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"build":    buildBoundary,
+					"registry": registryBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Dependency Confusion</b> risk at <b>Build</b> pulling dependencies from <b>Registry</b> via <b>Pull</b>", risks[0].Title)
+				assert.Equal(t, "dependency-confusion@build>registry@build@registry", risks[0].SyntheticId)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
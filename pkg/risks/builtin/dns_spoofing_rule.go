@@ -0,0 +1,169 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type DNSSpoofingRule struct{}
+
+func NewDNSSpoofingRule() *DNSSpoofingRule {
+	return &DNSSpoofingRule{}
+}
+
+func (*DNSSpoofingRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "dns-spoofing",
+		Title: "DNS Spoofing",
+		Description: "Technical assets resolving names via DNS servers located in the public network are prone to DNS spoofing and cache poisoning, " +
+			"which redirects their traffic to attacker-controlled systems.",
+		Impact: "If this risk is unmitigated, attackers might be able to redirect the traffic of the technical asset to systems under their control, " +
+			"intercepting the data sent or making the resolved targets unavailable.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Transport_Layer_Security_Cheat_Sheet.html",
+		Action:     "DNS Hardening",
+		Mitigation: "Use DNSSEC validating resolvers or encrypted DNS (DNS over TLS or DNS over HTTPS) towards trusted resolvers, " +
+			"and authenticate the resolved targets (like via certificate validation) so that spoofed answers are detected.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.Spoofing,
+		DetectionLogic: "In-scope technical assets having outgoing communication links to technical assets located in the public network, " +
+			"where either the communication link or the target is tagged with 'dns' and neither is tagged with 'dnssec'.",
+		RiskAssessment: "The risk rating depends on the highest availability rating of the other targets the technical asset communicates with, " +
+			"as those are resolved via DNS.",
+		FalsePositives: "Technical assets only communicating with targets addressed via fixed IP addresses or pinned certificates " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        346,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*DNSSpoofingRule) SupportedTags() []string {
+	return []string{"dns", "dnssec"}
+}
+
+func (r *DNSSpoofingRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isExternalDNSLookup(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *DNSSpoofingRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope
+}
+
+func (r *DNSSpoofingRule) isExternalDNSLookup(parsedModel *types.Model, commLink *types.CommunicationLink, targetAsset *types.TechnicalAsset) bool {
+	if !commLink.IsTaggedWithAny("dns") && !targetAsset.IsTaggedWithAny("dns") {
+		return false
+	}
+	if commLink.IsTaggedWithAny("dnssec") || targetAsset.IsTaggedWithAny("dnssec") {
+		return false
+	}
+	return isOnPublicNetwork(parsedModel, targetAsset)
+}
+
+// resolvedTargets returns the sorted targets of all other outgoing communication links of the technical asset,
+// which are resolved via the DNS server
+func (r *DNSSpoofingRule) resolvedTargets(parsedModel *types.Model, techAsset *types.TechnicalAsset, dnsServer *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, commLink := range techAsset.CommunicationLinks {
+		if _, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && commLink.TargetId != dnsServer.Id && !contains(result, commLink.TargetId) {
+			result = append(result, commLink.TargetId)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *DNSSpoofingRule) impact(parsedModel *types.Model, resolvedTargets []string) types.RiskExploitationImpact {
+	for _, id := range resolvedTargets {
+		if parsedModel.HighestProcessedAvailability(parsedModel.TechnicalAssets[id]) == types.MissionCritical {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *DNSSpoofingRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	resolvedTargets := r.resolvedTargets(parsedModel, techAsset, targetAsset)
+	impact := r.impact(parsedModel, resolvedTargets)
+	title := "<b>DNS Spoofing</b> risk at <b>" + techAsset.Title + "</b> resolving via <b>" + targetAsset.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *DNSSpoofingRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isExternalDNSLookup(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			resolvedTargets := r.resolvedTargets(parsedModel, techAsset, targetAsset)
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - tags: %v", commLink.Tags),
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - tags: %v", targetAsset.Tags),
+				fmt.Sprintf("    - link or target tagged with %q and not with %q", "dns", "dnssec"),
+				"    - is located in the public network",
+				fmt.Sprintf("  - resolved targets %q", resolvedTargets),
+			}...)
+
+			if impact := r.impact(parsedModel, resolvedTargets); impact == types.HighImpact {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because a resolved target processes %v data", impact, types.MissionCritical))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+			}
+		}
+	}
+
+	return explanation
+}
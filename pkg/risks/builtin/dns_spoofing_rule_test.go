@@ -0,0 +1,103 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestDNSSpoofingRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewDNSSpoofingRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type DNSSpoofingRuleTest struct {
+	linkTags           []string
+	resolverTags       []string
+	resolverInternet   bool
+	targetAvailability types.Criticality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestDNSSpoofingRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]DNSSpoofingRuleTest{
+		"no dns": {
+			resolverInternet: true,
+			riskCreated:      false,
+		},
+		"internal dns": {
+			linkTags:    []string{"dns"},
+			riskCreated: false,
+		},
+		"dnssec": {
+			resolverTags:     []string{"dns", "dnssec"},
+			resolverInternet: true,
+			riskCreated:      false,
+		},
+		"external dns via link tag": {
+			linkTags:            []string{"dns"},
+			resolverInternet:    true,
+			targetAvailability:  types.Critical,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"external dns resolving mission-critical target": {
+			resolverTags:        []string{"dns"},
+			resolverInternet:    true,
+			targetAvailability:  types.MissionCritical,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because a resolved target processes mission-critical data",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewDNSSpoofingRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:    "app",
+						Title: "App",
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "app>resolver", SourceId: "app", TargetId: "resolver", Tags: testCase.linkTags},
+							{Id: "app>backend", SourceId: "app", TargetId: "backend"},
+						},
+					},
+					"resolver": {
+						Id:       "resolver",
+						Title:    "Resolver",
+						Tags:     testCase.resolverTags,
+						Internet: testCase.resolverInternet,
+					},
+					"backend": {
+						Id:           "backend",
+						Availability: testCase.targetAvailability,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>DNS Spoofing</b> risk at <b>App</b> resolving via <b>Resolver</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
+	"strings"
 )
 
 type DosRiskyAccessAcrossTrustBoundaryRule struct{}
@@ -37,6 +39,7 @@ func (*DosRiskyAccessAcrossTrustBoundaryRule) Category() *types.RiskCategory {
 		FalsePositives:             "When the accessed target operations are not time- or resource-consuming.",
 		ModelFailurePossibleReason: false,
 		CWE:                        400,
+		Labels:                     []string{"network"},
 	}
 }
 
@@ -121,3 +124,30 @@ func (r *DosRiskyAccessAcrossTrustBoundaryRule) createRisk(techAsset *types.Tech
 
 	return risk
 }
+
+func (r *DosRiskyAccessAcrossTrustBoundaryRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		dataFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if dataFlow == nil {
+			continue
+		}
+
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v, availability: %v (has %q or availability >=%v)", techAsset.Technologies.String(), techAsset.Availability, types.LoadBalancer, types.Critical),
+			fmt.Sprintf("  - communication link %q from technical asset %q", dataFlow.Id, dataFlow.SourceId))
+		if _, forwardingLinkId, _ := strings.Cut(generatedRisk.SyntheticId, "->"); len(forwardingLinkId) > 0 {
+			explanation = append(explanation, fmt.Sprintf("    - forwarded via communication link %q of a traffic forwarding technical asset", forwardingLinkId))
+		}
+		explanation = append(explanation,
+			"    - crosses a network trust boundary",
+			fmt.Sprintf("    - usage: %v (not %v), protocol: %v (not process local)", dataFlow.Usage, types.DevOps, dataFlow.Protocol),
+			fmt.Sprintf("    - VPN: %v, IP filtered: %v, target redundant: %v", dataFlow.VPN, dataFlow.IpFiltered, techAsset.Redundant),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the availability is %v and the access is neither VPN-protected nor IP-filtered nor redundant", types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,137 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ExcessiveDataFlowRule struct{}
+
+func NewExcessiveDataFlowRule() *ExcessiveDataFlowRule {
+	return &ExcessiveDataFlowRule{}
+}
+
+func (*ExcessiveDataFlowRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "excessive-data-flow",
+		Title: "Excessive Data Flow",
+		Description: "When a communication link crossing a trust boundary transfers personal data to a technical asset which does not " +
+			"declare any need to process such data, it violates the data minimization principle (GDPR Art. 5).",
+		Impact: "If this risk is unmitigated, personal data is spread to more places than necessary, increasing the exposure " +
+			"in case any of the receiving assets is compromised.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/User_Privacy_Protection_Cheat_Sheet.html",
+		Action:     "Data Minimization",
+		Mitigation: "Only transfer personal data to technical assets which actually need to process it. Remove or pseudonymize " +
+			"personal data fields before sending them across trust boundaries.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Architecture,
+		STRIDE:         types.InformationDisclosure,
+		DetectionLogic: "Communication links crossing a network trust boundary sending data assets tagged with 'personal-data' or 'pii' to target technical assets not tagged with 'pii-processing'.",
+		RiskAssessment: types.MediumImpact.String(),
+		FalsePositives: "Target assets which process personal data for a legitimate purpose but are not tagged accordingly " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        359,
+		Labels:                     []string{"data"},
+	}
+}
+
+func (*ExcessiveDataFlowRule) SupportedTags() []string {
+	return []string{"personal-data", "pii", "pii-processing"}
+}
+
+func (r *ExcessiveDataFlowRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		technicalAsset := parsedModel.TechnicalAssets[id]
+		for _, dataFlow := range technicalAsset.CommunicationLinks {
+			if len(r.personalDataSent(parsedModel, dataFlow)) == 0 {
+				continue
+			}
+			if r.skipDataFlow(parsedModel, dataFlow) {
+				continue
+			}
+			risks = append(risks, r.createRisk(parsedModel, technicalAsset, dataFlow))
+		}
+	}
+	return risks, nil
+}
+
+func (r *ExcessiveDataFlowRule) skipDataFlow(parsedModel *types.Model, dataFlow *types.CommunicationLink) bool {
+	target, ok := parsedModel.TechnicalAssets[dataFlow.TargetId]
+	if !ok || target.OutOfScope || target.IsTaggedWithAny("pii-processing") {
+		return true
+	}
+	return !isAcrossTrustBoundaryNetworkOnly(parsedModel, dataFlow)
+}
+
+func (r *ExcessiveDataFlowRule) personalDataSent(parsedModel *types.Model, dataFlow *types.CommunicationLink) []string {
+	result := make([]string, 0)
+	for _, dataAssetId := range dataFlow.DataAssetsSent {
+		dataAsset, ok := parsedModel.DataAssets[dataAssetId]
+		if ok && dataAsset.IsTaggedWithAny("personal-data", "pii") {
+			result = append(result, dataAssetId)
+		}
+	}
+	return result
+}
+
+func (r *ExcessiveDataFlowRule) createRisk(parsedModel *types.Model, technicalAsset *types.TechnicalAsset, dataFlow *types.CommunicationLink) *types.Risk {
+	target := parsedModel.TechnicalAssets[dataFlow.TargetId]
+	title := "<b>Excessive Data Flow</b> of personal data via communication link <b>" + dataFlow.Title + "</b> " +
+		"from <b>" + technicalAsset.Title + "</b> to <b>" + target.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, types.MediumImpact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              types.MediumImpact,
+		Confidence:                      types.MediumConfidence,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    target.Id,
+		MostRelevantCommunicationLinkId: dataFlow.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{target.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + dataFlow.Id + "@" + technicalAsset.Id + "@" + target.Id
+	return risk
+}
+
+func (r *ExcessiveDataFlowRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		technicalAsset := parsedModel.TechnicalAssets[id]
+		for _, dataFlow := range technicalAsset.CommunicationLinks {
+			if !strings.EqualFold(risk, categoryId+"@"+dataFlow.Id+"@"+technicalAsset.Id+"@"+dataFlow.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			personalData := r.personalDataSent(parsedModel, dataFlow)
+			if len(personalData) == 0 || r.skipDataFlow(parsedModel, dataFlow) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			target := parsedModel.TechnicalAssets[dataFlow.TargetId]
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", dataFlow.Id),
+				fmt.Sprintf("  - crosses a network trust boundary from %q to %q", technicalAsset.Id, target.Id),
+				fmt.Sprintf("  - personal data assets sent (tagged either [%q, %q]):", "personal-data", "pii"),
+			}...)
+
+			for _, dataAssetId := range personalData {
+				explanation = append(explanation, fmt.Sprintf("    - %q", dataAssetId))
+			}
+
+			explanation = append(explanation, fmt.Sprintf("  - target asset tags: %v (not tagged with %q)", target.Tags, "pii-processing"))
+		}
+	}
+
+	return explanation
+}
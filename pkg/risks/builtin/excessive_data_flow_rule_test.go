@@ -0,0 +1,126 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestExcessiveDataFlowRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewExcessiveDataFlowRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ExcessiveDataFlowRuleTest struct {
+	dataAssetTags         []string
+	targetTags            []string
+	targetOutOfScope      bool
+	isAcrossTrustBoundary bool
+
+	riskCreated bool
+}
+
+func TestExcessiveDataFlowRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ExcessiveDataFlowRuleTest{
+		"no personal data": {
+			dataAssetTags:         []string{"other"},
+			isAcrossTrustBoundary: true,
+			riskCreated:           false,
+		},
+		"personal data within same trust boundary": {
+			dataAssetTags:         []string{"personal-data"},
+			isAcrossTrustBoundary: false,
+			riskCreated:           false,
+		},
+		"target declares pii processing": {
+			dataAssetTags:         []string{"pii"},
+			targetTags:            []string{"pii-processing"},
+			isAcrossTrustBoundary: true,
+			riskCreated:           false,
+		},
+		"target out of scope": {
+			dataAssetTags:         []string{"pii"},
+			targetOutOfScope:      true,
+			isAcrossTrustBoundary: true,
+			riskCreated:           false,
+		},
+		"personal data across trust boundary": {
+			dataAssetTags:         []string{"personal-data"},
+			targetTags:            []string{"analytics"},
+			isAcrossTrustBoundary: true,
+			riskCreated:           true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewExcessiveDataFlowRule()
+			dataFlow := &types.CommunicationLink{
+				Id:             "source>target",
+				Title:          "Data Flow",
+				SourceId:       "source",
+				TargetId:       "target",
+				DataAssetsSent: []string{"data"},
+			}
+			sourceBoundary := &types.TrustBoundary{Id: "tb1", Type: types.NetworkCloudProvider}
+			targetBoundary := sourceBoundary
+			if testCase.isAcrossTrustBoundary {
+				targetBoundary = &types.TrustBoundary{Id: "tb2", Type: types.NetworkCloudProvider}
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"source": {
+						Id:                 "source",
+						Title:              "Source",
+						CommunicationLinks: []*types.CommunicationLink{dataFlow},
+					},
+					"target": {
+						Id:         "target",
+						Title:      "Target",
+						Tags:       testCase.targetTags,
+						OutOfScope: testCase.targetOutOfScope,
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Tags: testCase.dataAssetTags},
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"source": sourceBoundary,
+					"target": targetBoundary,
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, "<b>Excessive Data Flow</b> of personal data via communication link <b>Data Flow</b> from <b>Source</b> to <b>Target</b>", risks[0].Title)
+				assert.Equal(t, "excessive-data-flow@source>target@source@target", risks[0].SyntheticId)
+
+				explanation := rule.ExplainRisk(&types.Model{
+					TechnicalAssets: map[string]*types.TechnicalAsset{
+						"source": {Id: "source", CommunicationLinks: []*types.CommunicationLink{dataFlow}},
+						"target": {Id: "target", Tags: testCase.targetTags},
+					},
+					DataAssets: map[string]*types.DataAsset{
+						"data": {Id: "data", Tags: testCase.dataAssetTags},
+					},
+					DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+						"source": sourceBoundary,
+						"target": targetBoundary,
+					},
+				}, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `    - "data"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
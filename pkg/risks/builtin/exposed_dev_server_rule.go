@@ -0,0 +1,132 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ExposedDevServerRule struct{}
+
+func NewExposedDevServerRule() *ExposedDevServerRule {
+	return &ExposedDevServerRule{}
+}
+
+func (*ExposedDevServerRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "exposed-dev-server",
+		Title: "Exposed Development Server",
+		Description: "Development servers (like webpack-dev-server, hot-reload endpoints, or debug ports) accidentally exposed to the " +
+			"internet can leak source code, source maps, and internal configuration.",
+		Impact:     "If this risk is unmitigated, attackers might download the source code and learn about internal structures and secrets of the application.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Attack_Surface_Analysis_Cheat_Sheet.html",
+		Action:     "Development Server Removal",
+		Mitigation: "Never expose development servers, hot-reload endpoints, or debug ports to the internet and ensure " +
+			"production deployments use hardened release builds only.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.InformationDisclosure,
+		DetectionLogic:             "In-scope technical assets tagged with 'dev-server', 'hot-reload', 'webpack-dev', or 'debug-port' having incoming communication links from the public network (either internet-facing technical assets or technical assets within a trust boundary tagged with 'public-network').",
+		RiskAssessment:             "The risk rating depends on whether the development server is running within a production environment.",
+		FalsePositives:             "Development servers reachable from the internet only via an authenticating proxy can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        200,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*ExposedDevServerRule) SupportedTags() []string {
+	return []string{"dev-server", "hot-reload", "webpack-dev", "debug-port", "public-network", "production"}
+}
+
+func (r *ExposedDevServerRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		publicLink := r.incomingPublicLink(parsedModel, techAsset)
+		if publicLink == nil {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, publicLink))
+	}
+	return risks, nil
+}
+
+func (r *ExposedDevServerRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("dev-server", "hot-reload", "webpack-dev", "debug-port")
+}
+
+func (r *ExposedDevServerRule) incomingPublicLink(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.CommunicationLink {
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			return incomingLink
+		}
+	}
+	return nil
+}
+
+func (r *ExposedDevServerRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, publicLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if isProductionAsset(parsedModel, techAsset) {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           "<b>Exposed Development Server</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: publicLink.Id,
+		DataBreachProbability:           types.Probable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *ExposedDevServerRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		publicLink := r.incomingPublicLink(parsedModel, techAsset)
+		if publicLink == nil {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q, %q])", techAsset.Tags, "dev-server", "hot-reload", "webpack-dev", "debug-port"),
+			fmt.Sprintf("  - incoming communication link %q from public network technical asset %q", publicLink.Id, publicLink.SourceId),
+		}...)
+
+		if isProductionAsset(parsedModel, techAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the development server is running within a production environment", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the development server is running within a development environment", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
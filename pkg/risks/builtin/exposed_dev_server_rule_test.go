@@ -0,0 +1,114 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestExposedDevServerRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewExposedDevServerRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ExposedDevServerRuleTest struct {
+	tags                []string
+	callerInternet      bool
+	callerPublicNetwork bool
+	productionBoundary  bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestExposedDevServerRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ExposedDevServerRuleTest{
+		"not a dev server": {
+			tags:           []string{"nginx"},
+			callerInternet: true,
+			riskCreated:    false,
+		},
+		"not exposed": {
+			tags:        []string{"dev-server"},
+			riskCreated: false,
+		},
+		"exposed to internet in development": {
+			tags:                []string{"webpack-dev"},
+			callerInternet:      true,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium because the development server is running within a development environment",
+		},
+		"exposed to public network in production": {
+			tags:                []string{"debug-port"},
+			callerPublicNetwork: true,
+			productionBoundary:  true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the development server is running within a production environment",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewExposedDevServerRule()
+			incomingLink := &types.CommunicationLink{Id: "user>dev", SourceId: "user", TargetId: "dev"}
+			serverBoundaryTags := []string{}
+			if testCase.productionBoundary {
+				serverBoundaryTags = []string{"production"}
+			}
+			callerBoundaryTags := []string{}
+			if testCase.callerPublicNetwork {
+				callerBoundaryTags = []string{"public-network"}
+			}
+			callerBoundary := &types.TrustBoundary{Id: "public", Tags: callerBoundaryTags, TechnicalAssetsInside: []string{"user"}}
+			serverBoundary := &types.TrustBoundary{Id: "env", Tags: serverBoundaryTags, TechnicalAssetsInside: []string{"dev"}}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"user": {
+						Id:                 "user",
+						Internet:           testCase.callerInternet,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"dev": {
+						Id:    "dev",
+						Title: "Dev Server",
+						Tags:  testCase.tags,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"public": callerBoundary,
+					"env":    serverBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"user": callerBoundary,
+					"dev":  serverBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"dev": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Exposed Development Server</b> risk at <b>Dev Server</b>", risks[0].Title)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `  - incoming communication link "user>dev" from public network technical asset "user"`)
+				assert.Contains(t, explanation, testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type GraphQLAbuseRule struct{}
+
+func NewGraphQLAbuseRule() *GraphQLAbuseRule {
+	return &GraphQLAbuseRule{}
+}
+
+// graphQLWeakness describes one of the GraphQL specific weaknesses checked by the rule
+// along with the tag marking it as mitigated
+type graphQLWeakness struct {
+	id            string
+	title         string
+	mitigationTag string
+}
+
+var graphQLWeaknesses = []graphQLWeakness{
+	{id: "introspection", title: "GraphQL Introspection Exposure", mitigationTag: "graphql-introspection-disabled"},
+	{id: "query-depth", title: "GraphQL Query Depth and Batching Abuse", mitigationTag: "graphql-query-limits"},
+	{id: "persisted-queries", title: "GraphQL Missing Persisted Queries", mitigationTag: "graphql-persisted-queries"},
+}
+
+func (*GraphQLAbuseRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "graphql-abuse",
+		Title: "GraphQL Abuse",
+		Description: "GraphQL endpoints reachable from untrusted networks are prone to schema disclosure via introspection, " +
+			"resource exhaustion via deeply nested or batched queries, and abuse via arbitrary client-defined queries when persisted queries are not enforced.",
+		Impact: "If this risk is unmitigated, attackers might be able to learn the complete API schema, overload the backend with expensive queries, " +
+			"or craft queries retrieving more data than intended.",
+		ASVS:       "V13 - API and Web Service Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/GraphQL_Cheat_Sheet.html",
+		Action:     "GraphQL Hardening",
+		Mitigation: "Disable introspection in production, limit the query depth, complexity and batch size, " +
+			"and only allow persisted (allow-listed) queries from untrusted clients.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets tagged with 'graphql' having incoming communication links from the public network or across a network trust boundary. " +
+			"A separate risk is raised for each weakness not mitigated via the tags 'graphql-introspection-disabled', 'graphql-query-limits', and 'graphql-persisted-queries'.",
+		RiskAssessment: "The risk rating of introspection exposure and missing persisted queries depends on the highest confidentiality rating of the data processed, " +
+			"the one of query depth and batching abuse depends on the highest availability rating. " +
+			"The likelihood is higher when the endpoint is reachable from the public network.",
+		FalsePositives: "GraphQL endpoints having the weaknesses mitigated in a way not modeled via tags " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        770,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*GraphQLAbuseRule) SupportedTags() []string {
+	tags := []string{"graphql"}
+	for _, weakness := range graphQLWeaknesses {
+		tags = append(tags, weakness.mitigationTag)
+	}
+	return tags
+}
+
+func (r *GraphQLAbuseRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrusted, fromPublicNetwork := r.untrustedAccess(parsedModel, techAsset)
+		if !untrusted {
+			continue
+		}
+
+		for _, weakness := range graphQLWeaknesses {
+			if !techAsset.IsTaggedWithAny(weakness.mitigationTag) {
+				risks = append(risks, r.createRisk(parsedModel, techAsset, weakness, fromPublicNetwork))
+			}
+		}
+	}
+	return risks, nil
+}
+
+func (r *GraphQLAbuseRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("graphql")
+}
+
+// untrustedAccess returns whether the endpoint is reachable from outside its network trust boundary
+// and whether it is reachable from the public network in particular
+func (r *GraphQLAbuseRule) untrustedAccess(parsedModel *types.Model, techAsset *types.TechnicalAsset) (bool, bool) {
+	if techAsset.Internet {
+		return true, true
+	}
+	untrusted := false
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			return true, true
+		}
+		if isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			untrusted = true
+		}
+	}
+	return untrusted, false
+}
+
+func (r *GraphQLAbuseRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset, weakness graphQLWeakness) types.RiskExploitationImpact {
+	if weakness.id == "query-depth" {
+		switch parsedModel.HighestProcessedAvailability(techAsset) {
+		case types.MissionCritical:
+			return types.HighImpact
+		case types.Critical:
+			return types.MediumImpact
+		default:
+			return types.LowImpact
+		}
+	}
+	switch parsedModel.HighestProcessedConfidentiality(techAsset) {
+	case types.StrictlyConfidential:
+		return types.HighImpact
+	case types.Confidential:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *GraphQLAbuseRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, weakness graphQLWeakness, fromPublicNetwork bool) *types.Risk {
+	likelihood := types.Likely
+	if fromPublicNetwork {
+		likelihood = types.VeryLikely
+	}
+	impact := r.impact(parsedModel, techAsset, weakness)
+	dataBreachProbability := types.Possible
+	if weakness.id == "query-depth" {
+		dataBreachProbability = types.Improbable
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>" + weakness.title + "</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        dataBreachProbability,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + weakness.id + "@" + techAsset.Id
+	return risk
+}
+
+func (r *GraphQLAbuseRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, weakness := range graphQLWeaknesses {
+			if !strings.EqualFold(risk, categoryId+"@"+weakness.id+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) || techAsset.IsTaggedWithAny(weakness.mitigationTag) {
+				continue
+			}
+
+			untrusted, fromPublicNetwork := r.untrustedAccess(parsedModel, techAsset)
+			if !untrusted {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("technical asset %q", techAsset.Id),
+				fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("  - tags: %v (has %q, has not %q)", techAsset.Tags, "graphql", weakness.mitigationTag),
+			}...)
+
+			if fromPublicNetwork {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the endpoint is reachable from the public network", types.VeryLikely))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the endpoint is reachable across a network trust boundary", types.Likely))
+			}
+			if weakness.id == "query-depth" {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest availability %v",
+					r.impact(parsedModel, techAsset, weakness), parsedModel.HighestProcessedAvailability(techAsset)))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest confidentiality %v",
+					r.impact(parsedModel, techAsset, weakness), parsedModel.HighestProcessedConfidentiality(techAsset)))
+			}
+		}
+	}
+
+	return explanation
+}
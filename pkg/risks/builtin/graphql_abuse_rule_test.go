@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestGraphQLAbuseRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewGraphQLAbuseRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type GraphQLAbuseRuleTest struct {
+	tags              []string
+	callerInternet    bool
+	sameTrustBoundary bool
+	confidentiality   types.Confidentiality
+	availability      types.Criticality
+
+	expectedTitles     []string
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpacts    []types.RiskExploitationImpact
+}
+
+func TestGraphQLAbuseRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]GraphQLAbuseRuleTest{
+		"not a graphql endpoint": {
+			tags:           []string{"rest"},
+			callerInternet: true,
+			expectedTitles: []string{},
+		},
+		"only reachable from same trust boundary": {
+			tags:              []string{"graphql"},
+			sameTrustBoundary: true,
+			expectedTitles:    []string{},
+		},
+		"all weaknesses mitigated": {
+			tags:           []string{"graphql", "graphql-introspection-disabled", "graphql-query-limits", "graphql-persisted-queries"},
+			callerInternet: true,
+			expectedTitles: []string{},
+		},
+		"reachable across trust boundary": {
+			tags:            []string{"graphql"},
+			confidentiality: types.StrictlyConfidential,
+			availability:    types.Critical,
+			expectedTitles: []string{
+				"<b>GraphQL Introspection Exposure</b> risk at <b>API</b>",
+				"<b>GraphQL Query Depth and Batching Abuse</b> risk at <b>API</b>",
+				"<b>GraphQL Missing Persisted Queries</b> risk at <b>API</b>",
+			},
+			expectedLikelihood: types.Likely,
+			expectedImpacts:    []types.RiskExploitationImpact{types.HighImpact, types.MediumImpact, types.HighImpact},
+		},
+		"reachable from internet with introspection disabled": {
+			tags:              []string{"graphql", "graphql-introspection-disabled"},
+			callerInternet:    true,
+			sameTrustBoundary: true,
+			confidentiality:   types.Internal,
+			availability:      types.MissionCritical,
+			expectedTitles: []string{
+				"<b>GraphQL Query Depth and Batching Abuse</b> risk at <b>API</b>",
+				"<b>GraphQL Missing Persisted Queries</b> risk at <b>API</b>",
+			},
+			expectedLikelihood: types.VeryLikely,
+			expectedImpacts:    []types.RiskExploitationImpact{types.HighImpact, types.LowImpact},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewGraphQLAbuseRule()
+			incomingLink := &types.CommunicationLink{Id: "client>api", SourceId: "client", TargetId: "api"}
+			clientBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"client"}}
+			apiBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"api"}}
+			if testCase.sameTrustBoundary {
+				clientBoundary = apiBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:                 "client",
+						Internet:           testCase.callerInternet,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"api": {
+						Id:              "api",
+						Title:           "API",
+						Tags:            testCase.tags,
+						Confidentiality: testCase.confidentiality,
+						Availability:    testCase.availability,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					clientBoundary.Id: clientBoundary,
+					apiBoundary.Id:    apiBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"client": clientBoundary,
+					"api":    apiBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"api": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, len(testCase.expectedTitles))
+			for i, risk := range risks {
+				assert.Equal(t, testCase.expectedTitles[i], risk.Title)
+				assert.Equal(t, testCase.expectedLikelihood, risk.ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpacts[i], risk.ExploitationImpact)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risk.SyntheticId))
+			}
+		})
+	}
+}
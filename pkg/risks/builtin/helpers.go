@@ -1,20 +1,22 @@
 package builtin
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/threagile/threagile/pkg/types"
 )
 
 func isAcrossTrustBoundaryNetworkOnly(parsedModel *types.Model, communicationLink *types.CommunicationLink) bool {
-	trustBoundaryOfSourceAsset, trustBoundaryOfSourceAssetOk :=
-		parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[communicationLink.SourceId]
+	trustBoundaryOfSourceAsset := parsedModel.AnalysisContext().TrustBoundary(communicationLink.SourceId)
+	trustBoundaryOfSourceAssetOk := trustBoundaryOfSourceAsset != nil
 	if !isNetworkOnly(parsedModel, trustBoundaryOfSourceAssetOk, trustBoundaryOfSourceAsset) {
 		return false
 	}
 
-	trustBoundaryOfTargetAsset, trustBoundaryOfTargetAssetOk :=
-		parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[communicationLink.TargetId]
+	trustBoundaryOfTargetAsset := parsedModel.AnalysisContext().TrustBoundary(communicationLink.TargetId)
+	trustBoundaryOfTargetAssetOk := trustBoundaryOfTargetAsset != nil
 	if !isNetworkOnly(parsedModel, trustBoundaryOfTargetAssetOk, trustBoundaryOfTargetAsset) {
 		return false
 	}
@@ -32,7 +34,7 @@ func isNetworkOnly(parsedModel *types.Model, trustBoundaryOk bool, trustBoundary
 		return false
 	}
 	if !trustBoundary.Type.IsNetworkBoundary() { // find and use the parent boundary then
-		parentTrustBoundary := parsedModel.FindParentTrustBoundary(trustBoundary)
+		parentTrustBoundary := parsedModel.AnalysisContext().ParentTrustBoundary(trustBoundary)
 		if parentTrustBoundary != nil {
 			return false
 		}
@@ -61,8 +63,10 @@ func containsCaseInsensitiveAny(a []string, x ...string) bool {
 }
 
 func isSameExecutionEnvironment(parsedModel *types.Model, ta *types.TechnicalAsset, otherAssetId string) bool {
-	trustBoundaryOfMyAsset, trustBoundaryOfMyAssetOk := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[ta.Id]
-	trustBoundaryOfOtherAsset, trustBoundaryOfOtherAssetOk := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[otherAssetId]
+	trustBoundaryOfMyAsset := parsedModel.AnalysisContext().TrustBoundary(ta.Id)
+	trustBoundaryOfMyAssetOk := trustBoundaryOfMyAsset != nil
+	trustBoundaryOfOtherAsset := parsedModel.AnalysisContext().TrustBoundary(otherAssetId)
+	trustBoundaryOfOtherAssetOk := trustBoundaryOfOtherAsset != nil
 	if trustBoundaryOfMyAssetOk != trustBoundaryOfOtherAssetOk {
 		return false
 	}
@@ -76,10 +80,12 @@ func isSameExecutionEnvironment(parsedModel *types.Model, ta *types.TechnicalAss
 }
 
 func isSameTrustBoundaryNetworkOnly(parsedModel *types.Model, ta *types.TechnicalAsset, otherAssetId string) bool {
-	trustBoundaryOfMyAsset, trustBoundaryOfMyAssetOk := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[ta.Id]
+	trustBoundaryOfMyAsset := parsedModel.AnalysisContext().TrustBoundary(ta.Id)
+	trustBoundaryOfMyAssetOk := trustBoundaryOfMyAsset != nil
 	useParentBoundary(&trustBoundaryOfMyAsset, parsedModel, &trustBoundaryOfMyAssetOk)
 
-	trustBoundaryOfOtherAsset, trustBoundaryOfOtherAssetOk := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[otherAssetId]
+	trustBoundaryOfOtherAsset := parsedModel.AnalysisContext().TrustBoundary(otherAssetId)
+	trustBoundaryOfOtherAssetOk := trustBoundaryOfOtherAsset != nil
 	useParentBoundary(&trustBoundaryOfOtherAsset, parsedModel, &trustBoundaryOfOtherAssetOk)
 
 	if trustBoundaryOfMyAssetOk != trustBoundaryOfOtherAssetOk {
@@ -97,7 +103,172 @@ func useParentBoundary(trustBoundaryOfAsset **types.TrustBoundary, parsedModel *
 	}
 	tb := *trustBoundaryOfAsset
 	if tb != nil && !tb.Type.IsNetworkBoundary() {
-		*trustBoundaryOfAsset = parsedModel.FindParentTrustBoundary(tb)
+		*trustBoundaryOfAsset = parsedModel.AnalysisContext().ParentTrustBoundary(tb)
 		*trustBoundaryOfAssetOk = *trustBoundaryOfAsset != nil
 	}
 }
+
+func isProductionAsset(parsedModel *types.Model, ta *types.TechnicalAsset) bool {
+	if ta.IsTaggedWithAny("production") {
+		return true
+	}
+	trustBoundary := parsedModel.AnalysisContext().TrustBoundary(ta.Id)
+	if trustBoundary == nil {
+		return false
+	}
+	if trustBoundary.IsTaggedWithAny("production") {
+		return true
+	}
+	for _, id := range parsedModel.AnalysisContext().AllParentTrustBoundaryIDs(trustBoundary) {
+		if parentTrustBoundary, ok := parsedModel.TrustBoundaries[id]; ok && parentTrustBoundary.IsTaggedWithAny("production") {
+			return true
+		}
+	}
+	return false
+}
+
+func isOnPublicNetwork(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset == nil {
+		return false
+	}
+	if techAsset.Internet {
+		return true
+	}
+	trustBoundary := parsedModel.AnalysisContext().TrustBoundary(techAsset.Id)
+	if trustBoundary == nil {
+		return false
+	}
+	for _, id := range parsedModel.AnalysisContext().AllParentTrustBoundaryIDs(trustBoundary) {
+		if parent, found := parsedModel.TrustBoundaries[id]; found && parent.IsTaggedWithAny("public-network") {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedTrustBoundaryIDs(parsedModel *types.Model) []string {
+	result := make([]string, 0)
+	for id := range parsedModel.TrustBoundaries {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isBackupAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.Technologies.GetAttribute(types.BackupStorage) || techAsset.IsTaggedWithAny("backup")
+}
+
+// isBackedUp checks whether the technical asset communicates with a backup asset in either direction
+func isBackedUp(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, commLink := range techAsset.CommunicationLinks {
+		if targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && isBackupAsset(targetAsset) {
+			return true
+		}
+	}
+	for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if sourceAsset, ok := parsedModel.TechnicalAssets[commLink.SourceId]; ok && isBackupAsset(sourceAsset) {
+			return true
+		}
+	}
+	return false
+}
+
+// writingAssetIDs returns the sorted ids of the technical assets having non-readonly communication links to the technical asset
+func writingAssetIDs(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Readonly || contains(result, incomingLink.SourceId) {
+			continue
+		}
+		result = append(result, incomingLink.SourceId)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// networkTrustBoundaryId returns the innermost network trust boundary containing the technical asset, as the asset
+// might run in an execution environment nested within a network trust boundary
+func networkTrustBoundaryId(parsedModel *types.Model, techAssetId string) string {
+	trustBoundary := parsedModel.AnalysisContext().TrustBoundary(techAssetId)
+	for trustBoundary != nil && !trustBoundary.Type.IsNetworkBoundary() {
+		trustBoundary = parsedModel.AnalysisContext().ParentTrustBoundary(trustBoundary)
+	}
+	if trustBoundary == nil {
+		return ""
+	}
+	return trustBoundary.Id
+}
+
+// explainedRisks returns the risks generated by the rule having the synthetic id, or all risks generated by the rule for "<category>@*"
+func explainedRisks(rule types.RiskRule, parsedModel *types.Model, risk string) []*types.Risk {
+	generatedRisks, err := rule.GenerateRisks(parsedModel)
+	if err != nil {
+		return nil
+	}
+
+	// some rules generate the same risk more than once, which is explained only once
+	type explainedRisk struct {
+		syntheticId string
+		commLinkId  string
+	}
+
+	result := make([]*types.Risk, 0)
+	explained := make(map[explainedRisk]bool)
+	for _, generatedRisk := range generatedRisks {
+		key := explainedRisk{syntheticId: generatedRisk.SyntheticId, commLinkId: generatedRisk.MostRelevantCommunicationLinkId}
+		if explained[key] {
+			continue
+		}
+		if strings.EqualFold(risk, generatedRisk.SyntheticId) || strings.EqualFold(risk, rule.Category().ID+"@*") {
+			explained[key] = true
+			result = append(result, generatedRisk)
+		}
+	}
+	return result
+}
+
+// appendExplanation appends the explanation of a risk, separated by an empty line from the explanation of the previous risk
+func appendExplanation(explanation []string, lines ...string) []string {
+	if len(explanation) > 0 {
+		explanation = append(explanation, "")
+	}
+	return append(explanation, lines...)
+}
+
+// explainedCommunicationLink returns the most relevant communication link of the risk, or nil
+func explainedCommunicationLink(parsedModel *types.Model, risk *types.Risk) *types.CommunicationLink {
+	if commLink, ok := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; ok {
+		return commLink
+	}
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		for _, commLink := range parsedModel.TechnicalAssets[id].CommunicationLinks {
+			if commLink.Id == risk.MostRelevantCommunicationLinkId {
+				return commLink
+			}
+		}
+	}
+	return nil
+}
+
+// explainedImpact describes the impact of the risk, either as the default impact of the rule or as raised for the reason given
+func explainedImpact(risk *types.Risk, defaultImpact types.RiskExploitationImpact, reason string) string {
+	if risk.ExploitationImpact == defaultImpact {
+		return fmt.Sprintf("    - impact is %v (default)", risk.ExploitationImpact)
+	}
+	return fmt.Sprintf("    - impact is %v because %v", risk.ExploitationImpact, reason)
+}
+
+// explainedLikelihood describes the likelihood of the risk, either as the default likelihood of the rule or as changed for the reason given
+func explainedLikelihood(risk *types.Risk, defaultLikelihood types.RiskExploitationLikelihood, reason string) string {
+	if risk.ExploitationLikelihood == defaultLikelihood {
+		return fmt.Sprintf("    - likelihood is %v (default)", risk.ExploitationLikelihood)
+	}
+	return fmt.Sprintf("    - likelihood is %v because %v", risk.ExploitationLikelihood, reason)
+}
+
+// processedSensitivity describes the highest sensitivity of the technical asset and the data assets it processes
+func processedSensitivity(parsedModel *types.Model, techAsset *types.TechnicalAsset) string {
+	return fmt.Sprintf("highest processed confidentiality %v, integrity %v, availability %v",
+		parsedModel.HighestProcessedConfidentiality(techAsset), parsedModel.HighestProcessedIntegrity(techAsset), parsedModel.HighestProcessedAvailability(techAsset))
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -479,3 +480,76 @@ func Test_contains_EmptyDataReturnFalse(t *testing.T) {
 
 	assert.False(t, result)
 }
+
+func Test_IsProductionAsset_TaggedAssetReturnTrue(t *testing.T) {
+	ta := &types.TechnicalAsset{Id: "ta", Tags: []string{"production"}}
+
+	assert.True(t, isProductionAsset(&types.Model{}, ta))
+}
+
+func Test_IsProductionAsset_ParentBoundaryTaggedReturnTrue(t *testing.T) {
+	ta := &types.TechnicalAsset{Id: "ta"}
+	inner := &types.TrustBoundary{Id: "inner", Type: types.ExecutionEnvironment, TechnicalAssetsInside: []string{"ta"}}
+	outer := &types.TrustBoundary{Id: "outer", Type: types.NetworkCloudProvider, Tags: []string{"production"}, TrustBoundariesNested: []string{"inner"}}
+	parsedModel := &types.Model{
+		TrustBoundaries: map[string]*types.TrustBoundary{"inner": inner, "outer": outer},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"ta": inner,
+		},
+	}
+
+	assert.True(t, isProductionAsset(parsedModel, ta))
+}
+
+func Test_IsProductionAsset_NoProductionTagReturnFalse(t *testing.T) {
+	ta := &types.TechnicalAsset{Id: "ta"}
+	tb := &types.TrustBoundary{Id: "tb", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"ta"}}
+	parsedModel := &types.Model{
+		TrustBoundaries: map[string]*types.TrustBoundary{"tb": tb},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"ta": tb,
+		},
+	}
+
+	assert.False(t, isProductionAsset(parsedModel, ta))
+}
+
+func testExplainModel() *types.Model {
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"client": {
+				Id:    "client",
+				Title: "Client",
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "client>server", Title: "Updates", SourceId: "client", TargetId: "server", Tags: []string{"websocket"}, Protocol: types.HTTP},
+					{Id: "client>upgrade", Title: "Upgrade", SourceId: "client", TargetId: "server", Tags: []string{"websocket-upgrade", "no-origin-validation"}, Protocol: types.HTTPS, Authentication: types.SessionId},
+				},
+			},
+			"server": {Id: "server", Title: "Server"},
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"client": {Id: "client-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"client"}},
+			"server": {Id: "server-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"server"}},
+		},
+	}
+}
+
+func Test_explainedRisks_MatchesSyntheticIdOrCategory(t *testing.T) {
+	rule := NewInsecureWebsocketRule()
+	parsedModel := testExplainModel()
+
+	allRisks, err := rule.GenerateRisks(parsedModel)
+	assert.NoError(t, err)
+	assert.Len(t, allRisks, 2)
+
+	assert.Len(t, explainedRisks(rule, parsedModel, "insecure-websocket@*"), 2)
+	assert.Len(t, explainedRisks(rule, parsedModel, strings.ToUpper(allRisks[0].SyntheticId)), 1)
+	assert.Empty(t, explainedRisks(rule, parsedModel, "insecure-websocket@unknown"))
+}
+
+func Test_appendExplanation_SeparatesRisks(t *testing.T) {
+	explanation := appendExplanation(nil, "first")
+	explanation = appendExplanation(explanation, "second", "  - detail")
+
+	assert.Equal(t, []string{"first", "", "second", "  - detail"}, explanation)
+}
@@ -0,0 +1,183 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type IamRoleSharingRule struct{}
+
+func NewIamRoleSharingRule() *IamRoleSharingRule {
+	return &IamRoleSharingRule{}
+}
+
+func (*IamRoleSharingRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "iam-role-sharing",
+		Title: "IAM Role Sharing",
+		Description: "When multiple unrelated workloads share a single cloud IAM role or managed identity, a compromise of any " +
+			"one workload grants the attacker the permissions of all others.",
+		Impact: "If this risk is unmitigated, attackers compromising one workload might use the shared role to access " +
+			"the resources of all other workloads sharing it.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Secrets_Management_Cheat_Sheet.html",
+		Action:     "Dedicated Workload Identities",
+		Mitigation: "Assign a dedicated IAM role or managed identity with least-privilege permissions to each workload.",
+		Check:      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:   types.Operations,
+		STRIDE:     types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope technical assets tagged with the same 'iam-role:<name>' or 'managed-identity:<name>' tag " +
+			"belonging to different security domains (different trust boundaries or different 'application:<name>' tags).",
+		RiskAssessment: "The risk rating depends on whether any of the technical assets sharing the role has write access to " +
+			"production data.",
+		FalsePositives:             "Roles shared by workloads which are operated as one unit can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        250,
+		Labels:                     []string{"cloud", "identity"},
+	}
+}
+
+func (*IamRoleSharingRule) SupportedTags() []string {
+	return []string{"iam-role", "managed-identity", "application", "production"}
+}
+
+func (r *IamRoleSharingRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	assetIDsByRole := r.assetIDsByRole(parsedModel)
+	for _, role := range r.sortedRoles(assetIDsByRole) {
+		assetIDs := assetIDsByRole[role]
+		if !r.isSharedAcrossDomains(parsedModel, assetIDs) {
+			continue
+		}
+		risks = append(risks, r.createRisk(parsedModel, role, assetIDs))
+	}
+	return risks, nil
+}
+
+// assetIDsByRole groups the in-scope technical assets by the IAM roles or managed identities they use
+func (r *IamRoleSharingRule) assetIDsByRole(parsedModel *types.Model) map[string][]string {
+	result := make(map[string][]string)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if techAsset.OutOfScope {
+			continue
+		}
+		for _, baseTag := range []string{"iam-role", "managed-identity"} {
+			for _, name := range r.subTags(techAsset.Tags, baseTag) {
+				role := baseTag + ":" + name
+				result[role] = append(result[role], techAsset.Id)
+			}
+		}
+	}
+	return result
+}
+
+func (r *IamRoleSharingRule) sortedRoles(assetIDsByRole map[string][]string) []string {
+	result := make([]string, 0, len(assetIDsByRole))
+	for role := range assetIDsByRole {
+		result = append(result, role)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// subTags returns the sub-tags of the given base tag, like "payments" for "iam-role:payments"
+func (r *IamRoleSharingRule) subTags(tags []string, baseTag string) []string {
+	result := make([]string, 0)
+	prefix := baseTag + ":"
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if strings.HasPrefix(tag, prefix) && len(tag) > len(prefix) {
+			result = append(result, strings.TrimPrefix(tag, prefix))
+		}
+	}
+	return result
+}
+
+// securityDomain identifies the logical owner of a technical asset by its trust boundary and application tags
+func (r *IamRoleSharingRule) securityDomain(parsedModel *types.Model, techAsset *types.TechnicalAsset) string {
+	applications := r.subTags(techAsset.Tags, "application")
+	sort.Strings(applications)
+	return parsedModel.AnalysisContext().TrustBoundaryId(techAsset.Id) + "|" + strings.Join(applications, ",")
+}
+
+func (r *IamRoleSharingRule) isSharedAcrossDomains(parsedModel *types.Model, assetIDs []string) bool {
+	domains := make(map[string]bool)
+	for _, id := range assetIDs {
+		domains[r.securityDomain(parsedModel, parsedModel.TechnicalAssets[id])] = true
+	}
+	return len(domains) > 1
+}
+
+// productionWriters returns the technical assets sharing the role which write data to production technical assets
+func (r *IamRoleSharingRule) productionWriters(parsedModel *types.Model, assetIDs []string) []string {
+	result := make([]string, 0)
+	for _, id := range assetIDs {
+		for _, commLink := range parsedModel.TechnicalAssets[id].CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if ok && !commLink.Readonly && len(commLink.DataAssetsSent) > 0 && isProductionAsset(parsedModel, targetAsset) {
+				result = append(result, id)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func (r *IamRoleSharingRule) createRisk(parsedModel *types.Model, role string, assetIDs []string) *types.Risk {
+	impact := types.MediumImpact
+	if len(r.productionWriters(parsedModel, assetIDs)) > 0 {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>IAM Role Sharing</b> risk for <b>" + role + "</b> shared by " + fmt.Sprint(len(assetIDs)) + " technical assets",
+		MostRelevantTechnicalAssetId: assetIDs[0],
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  assetIDs,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + role
+	return risk
+}
+
+func (r *IamRoleSharingRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	assetIDsByRole := r.assetIDsByRole(parsedModel)
+	for _, role := range r.sortedRoles(assetIDsByRole) {
+		if !strings.EqualFold(risk, categoryId+"@"+role) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		assetIDs := assetIDsByRole[role]
+		if !r.isSharedAcrossDomains(parsedModel, assetIDs) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, fmt.Sprintf("role %q", role), "  - shared by technical assets:")
+		for _, id := range assetIDs {
+			techAsset := parsedModel.TechnicalAssets[id]
+			explanation = append(explanation, fmt.Sprintf("    - %q (trust boundary %q, applications %v)",
+				id, parsedModel.AnalysisContext().TrustBoundaryId(techAsset.Id), r.subTags(techAsset.Tags, "application")))
+		}
+
+		productionWriters := r.productionWriters(parsedModel, assetIDs)
+		if len(productionWriters) > 0 {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %q write production data", types.HighImpact, productionWriters))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,111 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestIamRoleSharingRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewIamRoleSharingRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type IamRoleSharingRuleTest struct {
+	firstTags          []string
+	secondTags         []string
+	secondOutOfScope   bool
+	sameTrustBoundary  bool
+	writesToProduction bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestIamRoleSharingRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]IamRoleSharingRuleTest{
+		"different roles": {
+			firstTags:   []string{"iam-role:orders"},
+			secondTags:  []string{"iam-role:billing"},
+			riskCreated: false,
+		},
+		"role without name": {
+			firstTags:   []string{"iam-role"},
+			secondTags:  []string{"iam-role"},
+			riskCreated: false,
+		},
+		"shared role within same domain": {
+			firstTags:         []string{"iam-role:orders", "application:shop"},
+			secondTags:        []string{"iam-role:orders", "application:shop"},
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"second asset out of scope": {
+			firstTags:        []string{"iam-role:orders"},
+			secondTags:       []string{"iam-role:orders"},
+			secondOutOfScope: true,
+			riskCreated:      false,
+		},
+		"shared role across trust boundaries": {
+			firstTags:      []string{"iam-role:orders"},
+			secondTags:     []string{"iam-role:orders"},
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+		},
+		"shared identity across applications with production write access": {
+			firstTags:          []string{"managed-identity:shared", "application:shop"},
+			secondTags:         []string{"managed-identity:shared", "application:reporting"},
+			sameTrustBoundary:  true,
+			writesToProduction: true,
+			riskCreated:        true,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewIamRoleSharingRule()
+			trustBoundaries := map[string]*types.TrustBoundary{
+				"first-network":  {Id: "first-network", TechnicalAssetsInside: []string{"first"}},
+				"second-network": {Id: "second-network", TechnicalAssetsInside: []string{"second"}},
+			}
+			if testCase.sameTrustBoundary {
+				trustBoundaries = map[string]*types.TrustBoundary{
+					"network": {Id: "network", TechnicalAssetsInside: []string{"first", "second"}},
+				}
+			}
+			firstLinks := make([]*types.CommunicationLink, 0)
+			if testCase.writesToProduction {
+				firstLinks = append(firstLinks, &types.CommunicationLink{Id: "first>db", SourceId: "first", TargetId: "db", DataAssetsSent: []string{"orders"}})
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"first":  {Id: "first", Tags: testCase.firstTags, CommunicationLinks: firstLinks},
+					"second": {Id: "second", Tags: testCase.secondTags, OutOfScope: testCase.secondOutOfScope},
+					"db":     {Id: "db", Tags: []string{"production"}},
+				},
+				TrustBoundaries: trustBoundaries,
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, []string{"first", "second"}, risks[0].DataBreachTechnicalAssetIDs)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, "  - shared by technical assets:")
+				assert.Len(t, explanation, 5)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
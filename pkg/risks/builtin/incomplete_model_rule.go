@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -29,6 +30,7 @@ func (*IncompleteModelRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model"},
 	}
 }
 
@@ -87,3 +89,29 @@ func (r *IncompleteModelRule) createRiskCommLink(technicalAsset *types.Technical
 	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *IncompleteModelRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		if len(generatedRisk.MostRelevantCommunicationLinkId) == 0 {
+			explanation = appendExplanation(explanation,
+				fmt.Sprintf("technical asset %q", technicalAsset.Id),
+				fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+				fmt.Sprintf("  - technology: %v (unknown)", technicalAsset.Technologies.String()))
+		} else {
+			commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+			if commLink == nil {
+				continue
+			}
+
+			explanation = appendExplanation(explanation,
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - source: technical asset %q (out of scope: %v (=false))", technicalAsset.Id, technicalAsset.OutOfScope),
+				fmt.Sprintf("  - protocol: %v (=%v)", commLink.Protocol, types.UnknownProtocol))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+	}
+
+	return explanation
+}
@@ -0,0 +1,187 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type InsecureDeserializationRule struct{}
+
+func NewInsecureDeserializationRule() *InsecureDeserializationRule {
+	return &InsecureDeserializationRule{}
+}
+
+func (*InsecureDeserializationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insecure-deserialization",
+		Title: "Insecure Deserialization",
+		Description: "When a technical asset accepts serialized object formats (like Java serialization, Python pickle, or other binary " +
+			"object serialization formats) via communication links from untrusted sources, Insecure Deserialization risks might arise.",
+		Impact: "If this risk is unmitigated, attackers sending crafted serialized objects via untrusted communication links might be able " +
+			"to execute code on the technical asset or tamper with the data processed.",
+		ASVS:       "V5 - Validation, Sanitization and Encoding Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Deserialization_Cheat_Sheet.html",
+		Action:     "Prevention of Deserialization of Untrusted Data",
+		Mitigation: "Do not accept serialized objects from untrusted sources. Prefer pure data formats like JSON or XML without type " +
+			"information, or cryptographically sign the serialized data and apply a strict allow-list of the types to deserialize.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Development,
+		STRIDE:         types.Tampering,
+		DetectionLogic: "In-scope technical assets accepting the 'serialization' data format or being tagged with 'java-serialization', 'pickle', or 'binary-serialization' and having incoming communication links from the public network or across a network trust boundary.",
+		RiskAssessment: "The risk rating depends on the sensitivity (in terms of confidentiality, integrity, and availability) of the data processed by the technical asset. " +
+			"The likelihood is higher when the untrusted communication link originates from the public network.",
+		FalsePositives: "Serialized data which is cryptographically signed before being deserialized " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        502,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*InsecureDeserializationRule) SupportedTags() []string {
+	return []string{"java-serialization", "pickle", "binary-serialization"}
+}
+
+func (r *InsecureDeserializationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrustedLinks := r.untrustedIncomingLinks(parsedModel, techAsset)
+		if len(untrustedLinks) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, untrustedLinks))
+	}
+	return risks, nil
+}
+
+func (r *InsecureDeserializationRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !r.acceptsSerializedObjects(techAsset)
+}
+
+func (r *InsecureDeserializationRule) acceptsSerializedObjects(techAsset *types.TechnicalAsset) bool {
+	for _, format := range techAsset.DataFormatsAccepted {
+		if format == types.Serialization {
+			return true
+		}
+	}
+	return techAsset.IsTaggedWithAny(r.SupportedTags()...)
+}
+
+// untrustedIncomingLinks returns the sorted incoming communication links originating from the public network
+// or crossing a network trust boundary
+func (r *InsecureDeserializationRule) untrustedIncomingLinks(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.CommunicationLink {
+	result := make([]*types.CommunicationLink, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if !ok {
+			continue
+		}
+		if isOnPublicNetwork(parsedModel, sourceAsset) || isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			result = append(result, incomingLink)
+		}
+	}
+	sort.Sort(types.ByTechnicalCommunicationLinkIdSort(result))
+	return result
+}
+
+func (r *InsecureDeserializationRule) likelihood(parsedModel *types.Model, untrustedLinks []*types.CommunicationLink) types.RiskExploitationLikelihood {
+	for _, incomingLink := range untrustedLinks {
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			return types.VeryLikely
+		}
+	}
+	return types.Likely
+}
+
+func (r *InsecureDeserializationRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	highestProcessedConfidentiality := parsedModel.HighestProcessedConfidentiality(techAsset)
+	highestProcessedIntegrity := parsedModel.HighestProcessedIntegrity(techAsset)
+	highestProcessedAvailability := parsedModel.HighestProcessedAvailability(techAsset)
+	if highestProcessedConfidentiality == types.StrictlyConfidential ||
+		highestProcessedIntegrity == types.MissionCritical ||
+		highestProcessedAvailability == types.MissionCritical {
+		return types.HighImpact
+	}
+	if highestProcessedConfidentiality >= types.Confidential ||
+		highestProcessedIntegrity >= types.Critical ||
+		highestProcessedAvailability >= types.Critical {
+		return types.MediumImpact
+	}
+	return types.LowImpact
+}
+
+func (r *InsecureDeserializationRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, untrustedLinks []*types.CommunicationLink) *types.Risk {
+	likelihood := r.likelihood(parsedModel, untrustedLinks)
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>Insecure Deserialization</b> risk at <b>" + techAsset.Title + "</b> " +
+		"(at least via communication link <b>" + untrustedLinks[0].Title + "</b>)"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: untrustedLinks[0].Id,
+		DataBreachProbability:           types.Probable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *InsecureDeserializationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrustedLinks := r.untrustedIncomingLinks(parsedModel, techAsset)
+		if len(untrustedLinks) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - data formats accepted: %v, tags: %v (accepts %q or has either [%q, %q, %q])", techAsset.DataFormatsAccepted, techAsset.Tags, types.Serialization, "java-serialization", "pickle", "binary-serialization"),
+			"  - untrusted incoming communication links:",
+		}...)
+
+		for _, incomingLink := range untrustedLinks {
+			if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+				explanation = append(explanation, fmt.Sprintf("    - %q from public network technical asset %q", incomingLink.Id, incomingLink.SourceId))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - %q from technical asset %q across a trust boundary", incomingLink.Id, incomingLink.SourceId))
+			}
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("    - likelihood is %v", r.likelihood(parsedModel, untrustedLinks)),
+			fmt.Sprintf("    - impact is %v because of highest processed confidentiality %v, integrity %v, and availability %v", r.impact(parsedModel, techAsset),
+				parsedModel.HighestProcessedConfidentiality(techAsset), parsedModel.HighestProcessedIntegrity(techAsset), parsedModel.HighestProcessedAvailability(techAsset)),
+		}...)
+	}
+
+	return explanation
+}
@@ -0,0 +1,135 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsecureDeserializationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsecureDeserializationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type InsecureDeserializationRuleTest struct {
+	outOfScope          bool
+	dataFormatsAccepted []types.DataFormat
+	tags                []string
+	callerInternet      bool
+	sameTrustBoundary   bool
+	confidentiality     types.Confidentiality
+	integrity           types.Criticality
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestInsecureDeserializationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]InsecureDeserializationRuleTest{
+		"out of scope": {
+			outOfScope:          true,
+			dataFormatsAccepted: []types.DataFormat{types.Serialization},
+			callerInternet:      true,
+			riskCreated:         false,
+		},
+		"no serialization accepted": {
+			dataFormatsAccepted: []types.DataFormat{types.JSON},
+			callerInternet:      true,
+			riskCreated:         false,
+		},
+		"serialization within same trust boundary": {
+			dataFormatsAccepted: []types.DataFormat{types.Serialization},
+			sameTrustBoundary:   true,
+			riskCreated:         false,
+		},
+		"serialization across trust boundary": {
+			dataFormatsAccepted: []types.DataFormat{types.Serialization},
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.LowImpact,
+		},
+		"pickle from internet": {
+			tags:               []string{"pickle"},
+			callerInternet:     true,
+			sameTrustBoundary:  true,
+			riskCreated:        true,
+			expectedLikelihood: types.VeryLikely,
+			expectedImpact:     types.LowImpact,
+		},
+		"java serialization processing confidential data": {
+			tags:               []string{"java-serialization"},
+			confidentiality:    types.Confidential,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"binary serialization processing mission-critical data": {
+			tags:               []string{"binary-serialization"},
+			integrity:          types.MissionCritical,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewInsecureDeserializationRule()
+			incomingLink := &types.CommunicationLink{Id: "caller>service", Title: "Caller Link", SourceId: "caller", TargetId: "service"}
+			callerBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"caller"}}
+			serviceBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"service"}}
+			if testCase.sameTrustBoundary {
+				callerBoundary = serviceBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"caller": {
+						Id:                 "caller",
+						Internet:           testCase.callerInternet,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"service": {
+						Id:                  "service",
+						Title:               "Service",
+						OutOfScope:          testCase.outOfScope,
+						DataFormatsAccepted: testCase.dataFormatsAccepted,
+						Tags:                testCase.tags,
+						Confidentiality:     testCase.confidentiality,
+						Integrity:           testCase.integrity,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					callerBoundary.Id:  callerBoundary,
+					serviceBoundary.Id: serviceBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"caller":  callerBoundary,
+					"service": serviceBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"service": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Insecure Deserialization</b> risk at <b>Service</b> (at least via communication link <b>Caller Link</b>)", risks[0].Title)
+				assert.Equal(t, "insecure-deserialization@service", risks[0].SyntheticId)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type InsecureFileUploadRule struct{}
+
+func NewInsecureFileUploadRule() *InsecureFileUploadRule {
+	return &InsecureFileUploadRule{}
+}
+
+func (*InsecureFileUploadRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insecure-file-upload",
+		Title: "Insecure File Upload",
+		Description: "When a technical asset accepts files via communication links crossing a network trust boundary without validating " +
+			"and scanning their content, malicious files (like web shells, malware, or specially crafted documents) might be uploaded.",
+		Impact: "If this risk is unmitigated, attackers might be able to upload malicious files which are executed on the technical asset, " +
+			"distributed to other users, or used to attack data stores running in the same execution environment.",
+		ASVS:       "V12 - File and Resources Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/File_Upload_Cheat_Sheet.html",
+		Action:     "File Content Validation",
+		Mitigation: "Validate the content type of uploaded files on the server-side, scan them for malware, store them outside of the web root " +
+			"and separated from data stores, and enforce limits on the maximum file size.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Development,
+		STRIDE:         types.Tampering,
+		DetectionLogic: "In-scope technical assets accepting the 'file' data format via incoming communication links crossing a network trust boundary and not being tagged with 'content-validation' or 'malware-scanning'.",
+		RiskAssessment: "The risk rating depends on whether the technical asset runs in the same execution environment (or shared runtime) as a data store.",
+		FalsePositives: "Technical assets validating and scanning the uploaded files by other means " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        434,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*InsecureFileUploadRule) SupportedTags() []string {
+	return []string{"content-validation", "malware-scanning"}
+}
+
+func (r *InsecureFileUploadRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		uploadLink := r.incomingUploadLink(parsedModel, techAsset)
+		if uploadLink == nil {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, uploadLink))
+	}
+	return risks, nil
+}
+
+func (r *InsecureFileUploadRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.IsTaggedWithAny(r.SupportedTags()...) {
+		return true
+	}
+	for _, format := range techAsset.DataFormatsAccepted {
+		if format == types.File {
+			return false
+		}
+	}
+	return true
+}
+
+// incomingUploadLink returns the first incoming communication link (sorted by id) crossing a network trust boundary
+func (r *InsecureFileUploadRule) incomingUploadLink(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.CommunicationLink {
+	var result *types.CommunicationLink
+	targetNetworkTrustBoundaryId := networkTrustBoundaryId(parsedModel, techAsset.Id)
+	if targetNetworkTrustBoundaryId == "" {
+		return nil
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if networkTrustBoundaryId(parsedModel, incomingLink.SourceId) == targetNetworkTrustBoundaryId {
+			continue
+		}
+		if result == nil || incomingLink.Id < result.Id {
+			result = incomingLink
+		}
+	}
+	return result
+}
+
+// colocatedDataStores returns the sorted data stores running in the same execution environment trust boundary
+// or on the same shared runtime as the technical asset
+func (r *InsecureFileUploadRule) colocatedDataStores(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	addDataStore := func(otherAssetId string) {
+		otherAsset, ok := parsedModel.TechnicalAssets[otherAssetId]
+		if !ok || otherAsset.Id == techAsset.Id || otherAsset.Type != types.Datastore || contains(result, otherAsset.Id) {
+			return
+		}
+		result = append(result, otherAsset.Id)
+	}
+
+	if trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]; ok && trustBoundary.Type == types.ExecutionEnvironment {
+		for _, otherAssetId := range trustBoundary.TechnicalAssetsInside {
+			addDataStore(otherAssetId)
+		}
+	}
+	for _, sharedRuntime := range parsedModel.SharedRuntimes {
+		if !contains(sharedRuntime.TechnicalAssetsRunning, techAsset.Id) {
+			continue
+		}
+		for _, otherAssetId := range sharedRuntime.TechnicalAssetsRunning {
+			addDataStore(otherAssetId)
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+func (r *InsecureFileUploadRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, uploadLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	dataBreachTechnicalAssetIDs := []string{techAsset.Id}
+	if colocatedDataStores := r.colocatedDataStores(parsedModel, techAsset); len(colocatedDataStores) > 0 {
+		impact = types.HighImpact
+		dataBreachTechnicalAssetIDs = append(dataBreachTechnicalAssetIDs, colocatedDataStores...)
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           "<b>Insecure File Upload</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: uploadLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     dataBreachTechnicalAssetIDs,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *InsecureFileUploadRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		uploadLink := r.incomingUploadLink(parsedModel, techAsset)
+		if uploadLink == nil {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - data formats accepted: %v (has %q)", techAsset.DataFormatsAccepted, types.File),
+			fmt.Sprintf("  - tags: %v (has neither [%q, %q])", techAsset.Tags, "content-validation", "malware-scanning"),
+			fmt.Sprintf("  - incoming communication link %q from technical asset %q across a network trust boundary", uploadLink.Id, uploadLink.SourceId),
+		}...)
+
+		if colocatedDataStores := r.colocatedDataStores(parsedModel, techAsset); len(colocatedDataStores) > 0 {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because data stores %q run in the same execution environment", types.HighImpact, colocatedDataStores))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
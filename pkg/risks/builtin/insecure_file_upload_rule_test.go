@@ -0,0 +1,155 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsecureFileUploadRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsecureFileUploadRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type InsecureFileUploadRuleTest struct {
+	dataFormatsAccepted []types.DataFormat
+	tags                []string
+	sameTrustBoundary   bool
+	sharedRuntimeWithDB bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestInsecureFileUploadRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]InsecureFileUploadRuleTest{
+		"no files accepted": {
+			dataFormatsAccepted: []types.DataFormat{types.JSON},
+			riskCreated:         false,
+		},
+		"files from same trust boundary": {
+			dataFormatsAccepted: []types.DataFormat{types.File},
+			sameTrustBoundary:   true,
+			riskCreated:         false,
+		},
+		"malware scanning in place": {
+			dataFormatsAccepted: []types.DataFormat{types.File},
+			tags:                []string{"malware-scanning"},
+			riskCreated:         false,
+		},
+		"files across trust boundary": {
+			dataFormatsAccepted: []types.DataFormat{types.File},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"files across trust boundary with data store on shared runtime": {
+			dataFormatsAccepted: []types.DataFormat{types.File},
+			sharedRuntimeWithDB: true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: `    - impact is high because data stores ["db"] run in the same execution environment`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewInsecureFileUploadRule()
+			incomingLink := &types.CommunicationLink{Id: "user>upload", SourceId: "user", TargetId: "upload"}
+			userBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"user"}}
+			uploadBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"upload", "db"}}
+			if testCase.sameTrustBoundary {
+				userBoundary = uploadBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"user": {
+						Id:                 "user",
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"upload": {
+						Id:                  "upload",
+						Title:               "Upload",
+						Tags:                testCase.tags,
+						DataFormatsAccepted: testCase.dataFormatsAccepted,
+					},
+					"db": {
+						Id:   "db",
+						Type: types.Datastore,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					userBoundary.Id:   userBoundary,
+					uploadBoundary.Id: uploadBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"user":   userBoundary,
+					"upload": uploadBoundary,
+					"db":     uploadBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"upload": {incomingLink},
+				},
+			}
+			if testCase.sharedRuntimeWithDB {
+				model.SharedRuntimes = map[string]*types.SharedRuntime{
+					"host": {Id: "host", TechnicalAssetsRunning: []string{"upload", "db"}},
+				}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Insecure File Upload</b> risk at <b>Upload</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
+
+func TestInsecureFileUploadRuleGenerateRisksDataStoreInSameExecutionEnvironment(t *testing.T) {
+	rule := NewInsecureFileUploadRule()
+	incomingLink := &types.CommunicationLink{Id: "user>upload", SourceId: "user", TargetId: "upload"}
+	userBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"user"}}
+	uploadBoundary := &types.TrustBoundary{Id: "host", Type: types.ExecutionEnvironment, TechnicalAssetsInside: []string{"upload", "db"}}
+	networkBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TrustBoundariesNested: []string{"host"}}
+	model := &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"user":   {Id: "user", CommunicationLinks: []*types.CommunicationLink{incomingLink}},
+			"upload": {Id: "upload", Title: "Upload", DataFormatsAccepted: []types.DataFormat{types.File}},
+			"db":     {Id: "db", Type: types.Datastore},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			userBoundary.Id:    userBoundary,
+			uploadBoundary.Id:  uploadBoundary,
+			networkBoundary.Id: networkBoundary,
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"user":   userBoundary,
+			"upload": uploadBoundary,
+			"db":     uploadBoundary,
+		},
+		IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+			"upload": {incomingLink},
+		},
+	}
+
+	risks, err := rule.GenerateRisks(model)
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, []string{"upload", "db"}, risks[0].DataBreachTechnicalAssetIDs)
+}
@@ -0,0 +1,171 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type InsecureImdsRule struct{}
+
+func NewInsecureImdsRule() *InsecureImdsRule {
+	return &InsecureImdsRule{}
+}
+
+func (*InsecureImdsRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insecure-imds",
+		Title: "Insecure IMDS",
+		Description: "Cloud VM instances using the instance metadata service version 1 (IMDSv1) without a hop limit are vulnerable to " +
+			"credential theft via server-side request forgery (SSRF) against the metadata endpoint.",
+		Impact: "If this risk is unmitigated, attackers exploiting an SSRF vulnerability might steal the IAM role credentials of " +
+			"the instance and thereby gain full access to everything the IAM role is allowed to access.",
+		ASVS:           "V14 - Configuration Verification Requirements",
+		CheatSheet:     "https://cheatsheetseries.owasp.org/cheatsheets/Server_Side_Request_Forgery_Prevention_Cheat_Sheet.html",
+		Action:         "IMDSv2 Enforcement",
+		Mitigation:     "Enforce the usage of IMDSv2 (session-oriented requests) with a hop limit of 1 and grant least-privilege IAM roles to instances.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets tagged with 'ec2' or 'cloud-vm' not being tagged with 'imdsv2-enforced' which are reachable (directly or indirectly via communication links) from internet-facing technical assets.",
+		RiskAssessment: "The risk rating depends on whether the instance is directly internet-facing.",
+		FalsePositives: "Instances with IMDSv1 disabled on account level " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        284,
+		Labels:                     []string{"cloud"},
+	}
+}
+
+func (*InsecureImdsRule) SupportedTags() []string {
+	return []string{"ec2", "cloud-vm", "imdsv2-enforced"}
+}
+
+func (r *InsecureImdsRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		attackPath := r.attackPathFromInternet(parsedModel, techAsset)
+		if attackPath == nil {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, attackPath))
+	}
+	return risks, nil
+}
+
+func (r *InsecureImdsRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("ec2", "cloud-vm") || techAsset.IsTaggedWithAny("imdsv2-enforced")
+}
+
+// attackPathFromInternet returns the shortest chain of technical asset IDs leading from an internet-facing technical
+// asset to the given one (following outgoing communication links), or nil when it is not reachable from the internet
+func (r *InsecureImdsRule) attackPathFromInternet(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	predecessors := make(map[string]string)
+	queue := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		if parsedModel.TechnicalAssets[id].Internet {
+			predecessors[id] = ""
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == techAsset.Id {
+			path := []string{current}
+			for predecessor := predecessors[current]; predecessor != ""; predecessor = predecessors[predecessor] {
+				path = append([]string{predecessor}, path...)
+			}
+			return path
+		}
+
+		currentAsset, ok := parsedModel.TechnicalAssets[current]
+		if !ok {
+			continue
+		}
+		for _, outgoingLink := range currentAsset.CommunicationLinksSorted() {
+			if _, visited := predecessors[outgoingLink.TargetId]; visited {
+				continue
+			}
+			predecessors[outgoingLink.TargetId] = current
+			queue = append(queue, outgoingLink.TargetId)
+		}
+	}
+	return nil
+}
+
+func (r *InsecureImdsRule) createRisk(techAsset *types.TechnicalAsset, attackPath []string) *types.Risk {
+	likelihood := types.Unlikely
+	if techAsset.Internet {
+		likelihood = types.Likely
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, types.HighImpact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           types.HighImpact,
+		Title:                        "<b>Insecure IMDS</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *InsecureImdsRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		attackPath := r.attackPathFromInternet(parsedModel, techAsset)
+		if attackPath == nil {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q], has not %q)", techAsset.Tags, "ec2", "cloud-vm", "imdsv2-enforced"),
+			"  - SSRF attack chain:",
+			fmt.Sprintf("    - attacker on the internet reaches internet-facing technical asset %q", attackPath[0]),
+		}...)
+
+		for n := 1; n < len(attackPath); n++ {
+			explanation = append(explanation, fmt.Sprintf("    - forged request is forwarded from %q to %q", attackPath[n-1], attackPath[n]))
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("    - %q is tricked into requesting the IMDSv1 metadata endpoint (169.254.169.254)", techAsset.Id),
+			"    - metadata service returns the IAM role credentials of the instance",
+		}...)
+
+		if techAsset.Internet {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the instance is directly internet-facing", types.Likely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the instance is internal-only", types.Unlikely))
+		}
+	}
+
+	return explanation
+}
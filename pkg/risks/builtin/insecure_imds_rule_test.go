@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsecureImdsRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsecureImdsRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type InsecureImdsRuleTest struct {
+	tags             []string
+	internet         bool
+	reachableFromWeb bool
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+}
+
+func TestInsecureImdsRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]InsecureImdsRuleTest{
+		"not a cloud vm": {
+			tags:             []string{"linux"},
+			reachableFromWeb: true,
+			riskCreated:      false,
+		},
+		"imdsv2 enforced": {
+			tags:             []string{"ec2", "imdsv2-enforced"},
+			reachableFromWeb: true,
+			riskCreated:      false,
+		},
+		"not reachable from internet": {
+			tags:        []string{"ec2"},
+			riskCreated: false,
+		},
+		"directly internet-facing": {
+			tags:               []string{"cloud-vm"},
+			internet:           true,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+		},
+		"indirectly reachable from internet": {
+			tags:               []string{"ec2"},
+			reachableFromWeb:   true,
+			riskCreated:        true,
+			expectedLikelihood: types.Unlikely,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewInsecureImdsRule()
+			webLinks := make([]*types.CommunicationLink, 0)
+			if testCase.reachableFromWeb {
+				webLinks = append(webLinks, &types.CommunicationLink{Id: "web>app", SourceId: "web", TargetId: "app"})
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"web": {
+						Id:                 "web",
+						Title:              "Web",
+						Internet:           true,
+						CommunicationLinks: webLinks,
+					},
+					"app": {
+						Id:    "app",
+						Title: "App",
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "app>vm", SourceId: "app", TargetId: "vm"},
+						},
+					},
+					"vm": {
+						Id:       "vm",
+						Title:    "VM",
+						Tags:     testCase.tags,
+						Internet: testCase.internet,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Insecure IMDS</b> risk at <b>VM</b>", risks[0].Title)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
+
+func TestInsecureImdsRuleExplainRiskDescribesAttackChain(t *testing.T) {
+	rule := NewInsecureImdsRule()
+
+	explanation := rule.ExplainRisk(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"web": {
+				Id:       "web",
+				Internet: true,
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "web>vm", SourceId: "web", TargetId: "vm"},
+				},
+			},
+			"vm": {Id: "vm", Tags: []string{"ec2"}},
+		},
+	}, "insecure-imds@vm")
+
+	assert.Contains(t, explanation, `    - attacker on the internet reaches internet-facing technical asset "web"`)
+	assert.Contains(t, explanation, `    - forged request is forwarded from "web" to "vm"`)
+	assert.Contains(t, explanation, `    - "vm" is tricked into requesting the IMDSv1 metadata endpoint (169.254.169.254)`)
+}
@@ -0,0 +1,156 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+	"strings"
+)
+
+type InsecureWebsocketRule struct{}
+
+func NewInsecureWebsocketRule() *InsecureWebsocketRule {
+	return &InsecureWebsocketRule{}
+}
+
+func (*InsecureWebsocketRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insecure-websocket",
+		Title: "Insecure WebSocket",
+		Description: "WebSocket connections established over unencrypted 'ws://' or without authentication are a frequently missed " +
+			"attack surface. Also WebSocket upgrades from HTTP endpoints which do not validate the 'Origin' header are prone to " +
+			"cross-site WebSocket hijacking (CSWSH).",
+		Impact: "If this risk is unmitigated, attackers might eavesdrop on or inject messages into WebSocket connections " +
+			"or hijack WebSocket sessions of authenticated users from malicious web pages.",
+		ASVS:       "V13 - API and Web Service Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/HTML5_Security_Cheat_Sheet.html#websockets",
+		Action:     "WebSocket Hardening",
+		Mitigation: "Use 'wss://' for all WebSocket connections, authenticate the WebSocket handshake, and validate the " +
+			"'Origin' header during WebSocket upgrades.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "Communication links tagged with 'websocket' or 'ws' crossing a network trust boundary which are either unencrypted or unauthenticated. " +
+			"Also communication links tagged with 'websocket-upgrade' and 'no-origin-validation'.",
+		RiskAssessment: "The risk rating depends on the sensitivity of the data transferred over the WebSocket connection " +
+			"and on whether the connection originates from the internet.",
+		FalsePositives: "WebSocket connections secured on a different layer (like a VPN tunnel) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        311,
+		Labels:                     []string{"network", "appsec"},
+	}
+}
+
+func (*InsecureWebsocketRule) SupportedTags() []string {
+	return []string{"websocket", "ws", "websocket-upgrade", "no-origin-validation"}
+}
+
+func (r *InsecureWebsocketRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok {
+				continue
+			}
+			if commLink.IsTaggedWithAny("websocket", "ws") &&
+				(!commLink.Protocol.IsEncrypted() || commLink.Authentication == types.NoneAuthentication) &&
+				isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink) {
+				risks = append(risks, r.createRisk(parsedModel, sourceAsset, targetAsset, commLink))
+			}
+			if commLink.IsTaggedWithAny("websocket-upgrade") && commLink.IsTaggedWithAny("no-origin-validation") {
+				risks = append(risks, r.createOriginValidationRisk(sourceAsset, targetAsset, commLink))
+			}
+		}
+	}
+	return risks, nil
+}
+
+func (r *InsecureWebsocketRule) createRisk(parsedModel *types.Model, sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	likelihood := types.Unlikely
+	if sourceAsset.Internet {
+		likelihood = types.Likely
+	}
+	impact := types.LowImpact
+	switch parsedModel.HighestCommunicationLinkConfidentiality(commLink) {
+	case types.StrictlyConfidential:
+		impact = types.HighImpact
+	case types.Confidential:
+		impact = types.MediumImpact
+	}
+	reason := "unauthenticated"
+	if !commLink.Protocol.IsEncrypted() {
+		reason = "unencrypted"
+	}
+	title := "<b>Insecure WebSocket</b> risk at <b>" + sourceAsset.Title + "</b> calling <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>: <u>" + reason + "</u>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *InsecureWebsocketRule) createOriginValidationRisk(sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	title := "<b>Insecure WebSocket</b> risk at <b>" + sourceAsset.Title + "</b> calling <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>: <u>missing origin validation (cross-site WebSocket hijacking)</u>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, types.MediumImpact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              types.MediumImpact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id + "@no-origin-validation"
+	return risk
+}
+
+func (r *InsecureWebsocketRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		sourceAsset := parsedModel.TechnicalAssets[commLink.SourceId]
+		explanation = appendExplanation(explanation, fmt.Sprintf("communication link %q", commLink.Id))
+		if strings.HasSuffix(generatedRisk.SyntheticId, "@no-origin-validation") {
+			explanation = append(explanation,
+				fmt.Sprintf("  - tags: %v (has %q and %q)", commLink.Tags, "websocket-upgrade", "no-origin-validation"),
+				fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+			continue
+		}
+
+		explanation = append(explanation,
+			fmt.Sprintf("  - tags: %v (has %q or %q)", commLink.Tags, "websocket", "ws"),
+			fmt.Sprintf("  - protocol: %v (encrypted: %v), authentication: %v (unencrypted or %v)", commLink.Protocol, commLink.Protocol.IsEncrypted(), commLink.Authentication, types.NoneAuthentication),
+			"  - crosses a network trust boundary",
+			fmt.Sprintf("  - source: technical asset %q (internet: %v)", sourceAsset.Id, sourceAsset.Internet),
+			fmt.Sprintf("  - highest confidentiality of the data assets transferred: %v", parsedModel.HighestCommunicationLinkConfidentiality(commLink)),
+			explainedLikelihood(generatedRisk, types.Unlikely, "the source is internet-facing"))
+		switch generatedRisk.ExploitationImpact {
+		case types.HighImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is transferred", types.HighImpact, types.StrictlyConfidential))
+		case types.MediumImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is transferred", types.MediumImpact, types.Confidential))
+		default:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,153 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsecureWebsocketRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsecureWebsocketRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type InsecureWebsocketRuleTest struct {
+	tags              []string
+	protocol          types.Protocol
+	authentication    types.Authentication
+	sameTrustBoundary bool
+	sourceInternet    bool
+	confidentiality   types.Confidentiality
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+	expectedTitle      string
+}
+
+func TestInsecureWebsocketRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]InsecureWebsocketRuleTest{
+		"not a websocket": {
+			tags:        []string{"rest"},
+			protocol:    types.HTTP,
+			riskCreated: false,
+		},
+		"encrypted and authenticated": {
+			tags:           []string{"websocket"},
+			protocol:       types.HTTPS,
+			authentication: types.Token,
+			riskCreated:    false,
+		},
+		"same trust boundary": {
+			tags:              []string{"ws"},
+			protocol:          types.HTTP,
+			authentication:    types.Token,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"unencrypted from internet": {
+			tags:               []string{"ws"},
+			protocol:           types.HTTP,
+			authentication:     types.Token,
+			sourceInternet:     true,
+			confidentiality:    types.StrictlyConfidential,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.HighImpact,
+			expectedTitle:      "<b>Insecure WebSocket</b> risk at <b>Client</b> calling <b>Server</b> via <b>Updates</b>: <u>unencrypted</u>",
+		},
+		"unauthenticated internal": {
+			tags:               []string{"websocket"},
+			protocol:           types.HTTPS,
+			authentication:     types.NoneAuthentication,
+			confidentiality:    types.Confidential,
+			riskCreated:        true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.MediumImpact,
+			expectedTitle:      "<b>Insecure WebSocket</b> risk at <b>Client</b> calling <b>Server</b> via <b>Updates</b>: <u>unauthenticated</u>",
+		},
+		"upgrade without origin validation": {
+			tags:               []string{"websocket-upgrade", "no-origin-validation"},
+			protocol:           types.HTTPS,
+			authentication:     types.SessionId,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+			expectedTitle:      "<b>Insecure WebSocket</b> risk at <b>Client</b> calling <b>Server</b> via <b>Updates</b>: <u>missing origin validation (cross-site WebSocket hijacking)</u>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewInsecureWebsocketRule()
+			clientBoundary := &types.TrustBoundary{Id: "client-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"client"}}
+			serverBoundary := &types.TrustBoundary{Id: "server-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"server"}}
+			if testCase.sameTrustBoundary {
+				serverBoundary = clientBoundary
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:       "client",
+						Title:    "Client",
+						Internet: testCase.sourceInternet,
+						CommunicationLinks: []*types.CommunicationLink{
+							{
+								Id:             "client>server",
+								Title:          "Updates",
+								SourceId:       "client",
+								TargetId:       "server",
+								Tags:           testCase.tags,
+								Protocol:       testCase.protocol,
+								Authentication: testCase.authentication,
+								DataAssetsSent: []string{"messages"},
+							},
+						},
+					},
+					"server": {Id: "server", Title: "Server"},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"messages": {Id: "messages", Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					clientBoundary.Id: clientBoundary,
+					serverBoundary.Id: serverBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"client": clientBoundary,
+					"server": serverBoundary,
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
+
+func TestInsecureWebsocketRuleExplainRisk(t *testing.T) {
+	rule := NewInsecureWebsocketRule()
+	parsedModel := testExplainModel()
+
+	explanation := rule.ExplainRisk(parsedModel, "insecure-websocket@client>upgrade@client@server@no-origin-validation")
+
+	assert.Equal(t, []string{
+		`communication link "client>upgrade"`,
+		`  - tags: [websocket-upgrade no-origin-validation] (has "websocket-upgrade" and "no-origin-validation")`,
+		"    - impact is medium (default)",
+	}, explanation)
+	assert.Empty(t, rule.ExplainRisk(parsedModel, "insecure-websocket@unknown"))
+}
@@ -0,0 +1,177 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type InsufficientKeyManagementRule struct{}
+
+func NewInsufficientKeyManagementRule() *InsufficientKeyManagementRule {
+	return &InsufficientKeyManagementRule{}
+}
+
+func (*InsufficientKeyManagementRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insufficient-key-management",
+		Title: "Insufficient Key Management",
+		Description: "Assets holding cryptographic keys (encryption keys, signing keys, HSMs) should rotate their keys regularly and " +
+			"be separated from the data they protect. Keys without a rotation schedule or residing in the same execution environment " +
+			"as the protected data significantly weaken the cryptographic protection.",
+		Impact: "If this risk is unmitigated, attackers compromising the execution environment of the protected data might also " +
+			"obtain the keys, or might be able to use long-lived leaked keys to decrypt or forge data.",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Key_Management_Cheat_Sheet.html",
+		Action:     "Key Management",
+		Mitigation: "Establish a key rotation schedule for all cryptographic keys and keep them in a dedicated key management " +
+			"trust boundary (ideally backed by an HSM or a cloud KMS), separated from the data they protect.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets tagged with 'encryption-key', 'signing-key', or 'hsm' which are either not tagged with 'key-rotation' or are co-located in the same execution environment as assets storing the data they protect (and not within a trust boundary tagged 'key-management').",
+		RiskAssessment: "The risk rating depends on the sensitivity of the data protected by the keys.",
+		FalsePositives: "Keys which are rotated by means not reflected in the model or which are separated from the protected data " +
+			"in other ways can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        321,
+		Labels:                     []string{"data"},
+	}
+}
+
+func (*InsufficientKeyManagementRule) SupportedTags() []string {
+	return []string{"encryption-key", "signing-key", "hsm", "key-rotation", "key-management"}
+}
+
+func (r *InsufficientKeyManagementRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		missingRotation := !techAsset.IsTaggedWithAny("key-rotation")
+		collocatedAssets := r.collocatedDataAssets(parsedModel, techAsset)
+		if !missingRotation && len(collocatedAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, missingRotation, collocatedAssets))
+	}
+	return risks, nil
+}
+
+func (r *InsufficientKeyManagementRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("encryption-key", "signing-key", "hsm")
+}
+
+// collocatedDataAssets returns the IDs of all other technical assets storing data protected by the key asset
+// which reside in the same execution environment as the key asset itself
+func (r *InsufficientKeyManagementRule) collocatedDataAssets(parsedModel *types.Model, keyAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	if trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[keyAsset.Id]; ok && trustBoundary.IsTaggedWithAny("key-management") {
+		return result
+	}
+
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		if id == keyAsset.Id {
+			continue
+		}
+
+		candidate := parsedModel.TechnicalAssets[id]
+		if !r.storesAnyOf(candidate, keyAsset.DataAssetsProcessed) {
+			continue
+		}
+
+		if isSameExecutionEnvironment(parsedModel, keyAsset, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func (r *InsufficientKeyManagementRule) storesAnyOf(techAsset *types.TechnicalAsset, dataAssetIds []string) bool {
+	for _, dataAssetId := range dataAssetIds {
+		if contains(techAsset.DataAssetsStored, dataAssetId) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *InsufficientKeyManagementRule) createRisk(parsedModel *types.Model, technicalAsset *types.TechnicalAsset, missingRotation bool, collocatedAssets []string) *types.Risk {
+	reasons := make([]string, 0)
+	if missingRotation {
+		reasons = append(reasons, "no key rotation")
+	}
+	if len(collocatedAssets) > 0 {
+		reasons = append(reasons, "co-located with protected data")
+	}
+	title := "<b>Insufficient Key Management</b> risk at <b>" + technicalAsset.Title + "</b>: <u>" + strings.Join(reasons, " and ") + "</u>"
+	impact := types.MediumImpact
+	if parsedModel.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential {
+		impact = types.HighImpact
+	}
+	dataBreachTechnicalAssetIDs := append([]string{technicalAsset.Id}, collocatedAssets...)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  dataBreachTechnicalAssetIDs,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
+	return risk
+}
+
+func (r *InsufficientKeyManagementRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		missingRotation := !techAsset.IsTaggedWithAny("key-rotation")
+		collocatedAssets := r.collocatedDataAssets(parsedModel, techAsset)
+		if !missingRotation && len(collocatedAssets) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q])", techAsset.Tags, "encryption-key", "signing-key", "hsm"),
+		}...)
+
+		if missingRotation {
+			explanation = append(explanation, fmt.Sprintf("  - missing key rotation: not tagged with %q", "key-rotation"))
+		}
+
+		for _, collocatedId := range collocatedAssets {
+			explanation = append(explanation, fmt.Sprintf("  - co-located with protected data: technical asset %q is in the same execution environment", collocatedId))
+		}
+
+		if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because highest confidentiality: %v (==%v)", types.HighImpact, parsedModel.HighestProcessedConfidentiality(techAsset), types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
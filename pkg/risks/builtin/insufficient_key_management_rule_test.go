@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsufficientKeyManagementRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsufficientKeyManagementRuleGenerateRisksOutOfScopeNotRisksCreated(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Id:         "ta1",
+				OutOfScope: true,
+				Tags:       []string{"encryption-key"},
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsufficientKeyManagementRuleGenerateRisksNotKeyRelatedNotRisksCreated(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Id:    "ta1",
+				Title: "Test Technical Asset",
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsufficientKeyManagementRuleGenerateRisksRotatedKeyInDedicatedBoundaryNotRisksCreated(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+	keyManagementBoundary := &types.TrustBoundary{
+		Id:                    "kms",
+		Type:                  types.ExecutionEnvironment,
+		Tags:                  []string{"key-management"},
+		TechnicalAssetsInside: []string{"key"},
+	}
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"key": {
+				Id:                  "key",
+				Title:               "Key Service",
+				Tags:                []string{"encryption-key", "key-rotation"},
+				DataAssetsProcessed: []string{"data"},
+			},
+			"db": {
+				Id:               "db",
+				Title:            "Database",
+				DataAssetsStored: []string{"data"},
+			},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"data": {Id: "data", Confidentiality: types.Confidential},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			"kms": keyManagementBoundary,
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"key": keyManagementBoundary,
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestInsufficientKeyManagementRuleGenerateRisksMissingRotationRiskCreated(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"key": {
+				Id:    "key",
+				Title: "Key Service",
+				Tags:  []string{"signing-key"},
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Insufficient Key Management</b> risk at <b>Key Service</b>: <u>no key rotation</u>", risks[0].Title)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "insufficient-key-management@key", risks[0].SyntheticId)
+}
+
+func TestInsufficientKeyManagementRuleGenerateRisksCollocatedStrictlyConfidentialHighImpactRiskCreated(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+	executionEnvironment := &types.TrustBoundary{
+		Id:                    "ee",
+		Type:                  types.ExecutionEnvironment,
+		TechnicalAssetsInside: []string{"key", "db"},
+	}
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"key": {
+				Id:                  "key",
+				Title:               "Key Service",
+				Tags:                []string{"hsm", "key-rotation"},
+				DataAssetsProcessed: []string{"data"},
+			},
+			"db": {
+				Id:               "db",
+				Title:            "Database",
+				DataAssetsStored: []string{"data"},
+			},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"data": {Id: "data", Confidentiality: types.StrictlyConfidential},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			"ee": executionEnvironment,
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"key": executionEnvironment,
+			"db":  executionEnvironment,
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "<b>Insufficient Key Management</b> risk at <b>Key Service</b>: <u>co-located with protected data</u>", risks[0].Title)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, []string{"key", "db"}, risks[0].DataBreachTechnicalAssetIDs)
+}
+
+func TestInsufficientKeyManagementRuleExplainRiskBothConditions(t *testing.T) {
+	rule := NewInsufficientKeyManagementRule()
+
+	explanation := rule.ExplainRisk(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"key": {
+				Id:                  "key",
+				Title:               "Key Service",
+				Tags:                []string{"encryption-key"},
+				DataAssetsProcessed: []string{"data"},
+			},
+			"db": {
+				Id:               "db",
+				Title:            "Database",
+				DataAssetsStored: []string{"data"},
+			},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"data": {Id: "data", Confidentiality: types.Confidential},
+		},
+	}, "insufficient-key-management@key")
+
+	assert.Contains(t, explanation, `  - missing key rotation: not tagged with "key-rotation"`)
+	assert.Contains(t, explanation, `  - co-located with protected data: technical asset "db" is in the same execution environment`)
+}
@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type InsufficientSecurityMonitoringRule struct{}
+
+func NewInsufficientSecurityMonitoringRule() *InsufficientSecurityMonitoringRule {
+	return &InsufficientSecurityMonitoringRule{}
+}
+
+func (*InsufficientSecurityMonitoringRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "insufficient-security-monitoring",
+		Title: "Insufficient Security Monitoring and Alerting",
+		Description: "Technical assets processing sensitive or critical data should send their security-relevant telemetry (like logs, audit events, and metrics) " +
+			"to a monitoring system (like a SIEM) in order to detect and respond to attacks.",
+		Impact: "If this risk is unmitigated, attacks against the technical asset might stay undetected for a long time, " +
+			"increasing the damage and hampering forensic analysis.",
+		ASVS:       "V7 - Error Handling and Logging Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Logging_Cheat_Sheet.html",
+		Action:     "Security Monitoring",
+		Mitigation: "Forward the security-relevant logs and events of the technical asset to a central monitoring system " +
+			"and define alerts for suspicious activities.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.Repudiation,
+		DetectionLogic: "In-scope technical assets (except external entities and " + types.Monitoring + " assets themselves) processing or storing data rated at least " +
+			types.Confidential.String() + " or " + types.Critical.String() + ", which have neither an outgoing communication link to a " + types.Monitoring +
+			" asset nor an incoming one from it (like for scraping metrics), and are not tagged with 'monitored'.",
+		RiskAssessment: "The risk rating depends on the highest sensitivity of the data processed by the technical asset.",
+		FalsePositives: "Technical assets monitored by means not modeled (like host-based agents) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        778,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*InsufficientSecurityMonitoringRule) SupportedTags() []string {
+	return []string{"monitored"}
+}
+
+func (r *InsufficientSecurityMonitoringRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) || r.isMonitored(parsedModel, techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *InsufficientSecurityMonitoringRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.Type == types.ExternalEntity || techAsset.Technologies.GetAttribute(types.Monitoring) ||
+		techAsset.IsTaggedWithAny(r.SupportedTags()...) {
+		return true
+	}
+	return parsedModel.HighestProcessedConfidentiality(techAsset) < types.Confidential &&
+		parsedModel.HighestProcessedIntegrity(techAsset) < types.Critical &&
+		parsedModel.HighestProcessedAvailability(techAsset) < types.Critical
+}
+
+func (r *InsufficientSecurityMonitoringRule) isMonitored(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, outgoingLink := range techAsset.CommunicationLinks {
+		if targetAsset, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]; ok && targetAsset.Technologies.GetAttribute(types.Monitoring) {
+			return true
+		}
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]; ok && sourceAsset.Technologies.GetAttribute(types.Monitoring) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *InsufficientSecurityMonitoringRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical ||
+		parsedModel.HighestProcessedAvailability(techAsset) == types.MissionCritical {
+		return types.MediumImpact
+	}
+	return types.LowImpact
+}
+
+func (r *InsufficientSecurityMonitoringRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Insufficient Security Monitoring and Alerting</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *InsufficientSecurityMonitoringRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) || r.isMonitored(parsedModel, techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - type: %v (!=%v)", techAsset.Type, types.ExternalEntity),
+			fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "monitored"),
+			fmt.Sprintf("  - highest confidentiality: %v, integrity: %v, availability: %v", parsedModel.HighestProcessedConfidentiality(techAsset),
+				parsedModel.HighestProcessedIntegrity(techAsset), parsedModel.HighestProcessedAvailability(techAsset)),
+			fmt.Sprintf("  - no communication link from or to a %v asset", types.Monitoring),
+		}...)
+
+		if impact := r.impact(parsedModel, techAsset); impact == types.MediumImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v or %v data is processed", impact, types.StrictlyConfidential, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
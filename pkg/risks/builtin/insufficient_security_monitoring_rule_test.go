@@ -0,0 +1,129 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestInsufficientSecurityMonitoringRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewInsufficientSecurityMonitoringRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type InsufficientSecurityMonitoringRuleTest struct {
+	assetType       types.TechnicalAssetType
+	tags            []string
+	confidentiality types.Confidentiality
+	availability    types.Criticality
+	linkToSiem      bool
+	linkFromSiem    bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestInsufficientSecurityMonitoringRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]InsufficientSecurityMonitoringRuleTest{
+		"not critical": {
+			assetType:       types.Process,
+			confidentiality: types.Internal,
+			availability:    types.Important,
+			riskCreated:     false,
+		},
+		"external entity": {
+			assetType:       types.ExternalEntity,
+			confidentiality: types.Confidential,
+			riskCreated:     false,
+		},
+		"tagged as monitored": {
+			assetType:       types.Process,
+			tags:            []string{"monitored"},
+			confidentiality: types.Confidential,
+			riskCreated:     false,
+		},
+		"sends telemetry to siem": {
+			assetType:       types.Process,
+			confidentiality: types.Confidential,
+			linkToSiem:      true,
+			riskCreated:     false,
+		},
+		"scraped by siem": {
+			assetType:       types.Datastore,
+			confidentiality: types.Confidential,
+			linkFromSiem:    true,
+			riskCreated:     false,
+		},
+		"confidential asset without monitoring": {
+			assetType:           types.Process,
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.LowImpact,
+			expectedExplanation: "    - impact is low (default)",
+		},
+		"mission critical asset without monitoring": {
+			assetType:           types.Datastore,
+			confidentiality:     types.Internal,
+			availability:        types.MissionCritical,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium because strictly-confidential or mission-critical data is processed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewInsufficientSecurityMonitoringRule()
+			techAsset := &types.TechnicalAsset{
+				Id:              "ta",
+				Title:           "Technical Asset",
+				Type:            testCase.assetType,
+				Tags:            testCase.tags,
+				Confidentiality: testCase.confidentiality,
+				Availability:    testCase.availability,
+			}
+			siem := &types.TechnicalAsset{
+				Id:           "siem",
+				Type:         types.Process,
+				Technologies: types.TechnologyList{{Name: types.Monitoring, Attributes: map[string]bool{types.Monitoring: true}}},
+			}
+			incomingLinks := map[string][]*types.CommunicationLink{}
+			if testCase.linkToSiem {
+				link := &types.CommunicationLink{Id: "ta>siem", SourceId: "ta", TargetId: "siem"}
+				techAsset.CommunicationLinks = []*types.CommunicationLink{link}
+				incomingLinks["siem"] = []*types.CommunicationLink{link}
+			}
+			if testCase.linkFromSiem {
+				link := &types.CommunicationLink{Id: "siem>ta", SourceId: "siem", TargetId: "ta"}
+				siem.CommunicationLinks = []*types.CommunicationLink{link}
+				incomingLinks["ta"] = []*types.CommunicationLink{link}
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"ta":   techAsset,
+					"siem": siem,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: incomingLinks,
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Insufficient Security Monitoring and Alerting</b> risk at <b>Technical Asset</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type IoTDeviceHardeningRule struct{}
+
+func NewIoTDeviceHardeningRule() *IoTDeviceHardeningRule {
+	return &IoTDeviceHardeningRule{}
+}
+
+const (
+	iotFirmwareTampering = "firmware-tampering"
+	iotDeviceCompromise  = "device-compromise"
+)
+
+func (*IoTDeviceHardeningRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "iot-device-hardening",
+		Title: "IoT Device Hardening",
+		Description: "IoT and edge devices communicating over the internet are physically accessible to attackers and often lack timely patching, " +
+			"making them prone to firmware tampering and device compromise, which turns them into an entry point to all the systems they connect to.",
+		Impact: "If this risk is unmitigated, attackers might be able to install manipulated firmware or take over the device, " +
+			"and abuse its credentials and connections to attack the backend systems reachable from it.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Attack_Surface_Analysis_Cheat_Sheet.html",
+		Action:     "IoT Device Hardening",
+		Mitigation: "Only accept signed firmware verified by secure boot (tag the device with 'signed-firmware'), " +
+			"and harden the device by disabling debug interfaces, using unique per-device credentials, and applying updates over the air " +
+			"(tag the device with 'device-hardened').",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope technical assets of machine type " + types.Embedded.String() + " or tagged with 'iot' or 'edge', which are internet-facing " +
+			"or have communication links from or to the public network. A firmware tampering risk is raised unless tagged with 'signed-firmware', " +
+			"a device compromise risk unless tagged with 'device-hardened'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity ratings of the device and all the technical assets reachable from it. " +
+			"The likelihood of device compromise is higher when the device is reachable from the public network.",
+		FalsePositives: "Devices having the weaknesses mitigated in a way not modeled via tags " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        494,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*IoTDeviceHardeningRule) SupportedTags() []string {
+	return []string{"iot", "edge", "signed-firmware", "device-hardened"}
+}
+
+func (r *IoTDeviceHardeningRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		if !techAsset.IsTaggedWithAny("signed-firmware") {
+			risks = append(risks, r.createRisk(parsedModel, techAsset, iotFirmwareTampering))
+		}
+		if !techAsset.IsTaggedWithAny("device-hardened") {
+			risks = append(risks, r.createRisk(parsedModel, techAsset, iotDeviceCompromise))
+		}
+	}
+	return risks, nil
+}
+
+func (r *IoTDeviceHardeningRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || (techAsset.Machine != types.Embedded && !techAsset.IsTaggedWithAny("iot", "edge")) {
+		return true
+	}
+	return !r.isReachableFromPublicNetwork(parsedModel, techAsset) && !r.isReachingPublicNetwork(parsedModel, techAsset)
+}
+
+func (r *IoTDeviceHardeningRule) isReachableFromPublicNetwork(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.Internet {
+		return true
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]; ok && isOnPublicNetwork(parsedModel, sourceAsset) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *IoTDeviceHardeningRule) isReachingPublicNetwork(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, commLink := range techAsset.CommunicationLinks {
+		if targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && isOnPublicNetwork(parsedModel, targetAsset) {
+			return true
+		}
+	}
+	return false
+}
+
+// reachedAssets returns the sorted ids of all technical assets transitively reachable via outgoing communication links of the device
+func (r *IoTDeviceHardeningRule) reachedAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	queue := []*types.TechnicalAsset{techAsset}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, commLink := range current.CommunicationLinks {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || targetAsset.Id == techAsset.Id || contains(result, targetAsset.Id) {
+				continue
+			}
+			result = append(result, targetAsset.Id)
+			queue = append(queue, targetAsset)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *IoTDeviceHardeningRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	for _, id := range append([]string{techAsset.Id}, r.reachedAssets(parsedModel, techAsset)...) {
+		asset := parsedModel.TechnicalAssets[id]
+		if parsedModel.HighestProcessedConfidentiality(asset) == types.StrictlyConfidential ||
+			parsedModel.HighestProcessedIntegrity(asset) == types.MissionCritical {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *IoTDeviceHardeningRule) likelihood(parsedModel *types.Model, techAsset *types.TechnicalAsset, kind string) types.RiskExploitationLikelihood {
+	if kind == iotFirmwareTampering {
+		return types.Unlikely
+	}
+	if r.isReachableFromPublicNetwork(parsedModel, techAsset) {
+		return types.VeryLikely
+	}
+	return types.Likely
+}
+
+func (r *IoTDeviceHardeningRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, kind string) *types.Risk {
+	likelihood := r.likelihood(parsedModel, techAsset, kind)
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>IoT Device Compromise</b> risk at <b>" + techAsset.Title + "</b>"
+	if kind == iotFirmwareTampering {
+		title = "<b>IoT Firmware Tampering</b> risk at <b>" + techAsset.Title + "</b>"
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  append([]string{techAsset.Id}, r.reachedAssets(parsedModel, techAsset)...),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + kind + "@" + techAsset.Id
+	return risk
+}
+
+func (r *IoTDeviceHardeningRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, kind := range []string{iotFirmwareTampering, iotDeviceCompromise} {
+			if !strings.EqualFold(risk, categoryId+"@"+kind+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			mitigationTag := "device-hardened"
+			if kind == iotFirmwareTampering {
+				mitigationTag = "signed-firmware"
+			}
+			if r.skipAsset(parsedModel, techAsset) || techAsset.IsTaggedWithAny(mitigationTag) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("technical asset %q", techAsset.Id),
+				fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("  - machine: %v, tags: %v (is %v or has either [%q, %q], has not %q)", techAsset.Machine, techAsset.Tags, types.Embedded, "iot", "edge", mitigationTag),
+				fmt.Sprintf("  - reaching technical assets %q", r.reachedAssets(parsedModel, techAsset)),
+			}...)
+
+			likelihood := r.likelihood(parsedModel, techAsset, kind)
+			switch {
+			case kind == iotFirmwareTampering:
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v (default)", likelihood))
+			case likelihood == types.VeryLikely:
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the device is reachable from the public network", likelihood))
+			default:
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the device communicates with the public network", likelihood))
+			}
+			if impact := r.impact(parsedModel, techAsset); impact == types.HighImpact {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because the device or a reached technical asset processes %v or %v data", impact, types.StrictlyConfidential, types.MissionCritical))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+			}
+		}
+	}
+
+	return explanation
+}
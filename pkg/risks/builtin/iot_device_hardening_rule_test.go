@@ -0,0 +1,111 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestIoTDeviceHardeningRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewIoTDeviceHardeningRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type IoTDeviceHardeningRuleTest struct {
+	machine         types.TechnicalAssetMachine
+	tags            []string
+	internet        bool
+	cloudInternet   bool
+	confidentiality types.Confidentiality
+
+	expectedTitles             []string
+	expectedLikelihoods        []types.RiskExploitationLikelihood
+	expectedImpact             types.RiskExploitationImpact
+	expectedDataBreachAssetIDs []string
+}
+
+func TestIoTDeviceHardeningRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]IoTDeviceHardeningRuleTest{
+		"not a device": {
+			machine:       types.Virtual,
+			cloudInternet: true,
+		},
+		"device without internet communication": {
+			machine: types.Embedded,
+		},
+		"hardened device with signed firmware": {
+			tags:          []string{"iot", "signed-firmware", "device-hardened"},
+			cloudInternet: true,
+		},
+		"device communicating with cloud over internet": {
+			machine:       types.Embedded,
+			cloudInternet: true,
+			expectedTitles: []string{
+				"<b>IoT Firmware Tampering</b> risk at <b>Device</b>",
+				"<b>IoT Device Compromise</b> risk at <b>Device</b>",
+			},
+			expectedLikelihoods:        []types.RiskExploitationLikelihood{types.Unlikely, types.Likely},
+			expectedImpact:             types.MediumImpact,
+			expectedDataBreachAssetIDs: []string{"device", "cloud", "database"},
+		},
+		"internet-facing edge device with signed firmware reaching strictly confidential data": {
+			tags:            []string{"edge", "signed-firmware"},
+			internet:        true,
+			confidentiality: types.StrictlyConfidential,
+			expectedTitles: []string{
+				"<b>IoT Device Compromise</b> risk at <b>Device</b>",
+			},
+			expectedLikelihoods:        []types.RiskExploitationLikelihood{types.VeryLikely},
+			expectedImpact:             types.HighImpact,
+			expectedDataBreachAssetIDs: []string{"device", "cloud", "database"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewIoTDeviceHardeningRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"device": {
+						Id:       "device",
+						Title:    "Device",
+						Machine:  testCase.machine,
+						Tags:     testCase.tags,
+						Internet: testCase.internet,
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "device>cloud", SourceId: "device", TargetId: "cloud"},
+						},
+					},
+					"cloud": {
+						Id:       "cloud",
+						Internet: testCase.cloudInternet,
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "cloud>database", SourceId: "cloud", TargetId: "database"},
+						},
+					},
+					"database": {
+						Id:              "database",
+						Confidentiality: testCase.confidentiality,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, len(testCase.expectedTitles))
+			for i, expectedTitle := range testCase.expectedTitles {
+				assert.Equal(t, expectedTitle, risks[i].Title)
+				assert.Equal(t, testCase.expectedLikelihoods[i], risks[i].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[i].ExploitationImpact)
+				assert.ElementsMatch(t, testCase.expectedDataBreachAssetIDs, risks[i].DataBreachTechnicalAssetIDs)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[i].SyntheticId))
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type KubernetesClusterSecurityRule struct{}
+
+func NewKubernetesClusterSecurityRule() *KubernetesClusterSecurityRule {
+	return &KubernetesClusterSecurityRule{}
+}
+
+const (
+	kubernetesControlPlaneExposure         = "control-plane-exposure"
+	kubernetesOverPrivilegedServiceAccount = "over-privileged-service-accounts"
+)
+
+func (*KubernetesClusterSecurityRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "kubernetes-cluster-security",
+		Title: "Kubernetes Cluster Security",
+		Description: "Kubernetes clusters whose API server is reachable from outside of their network trust boundary expose the control plane to attackers, " +
+			"and clusters running workloads of multiple data classifications are prone to over-privileged service accounts granting access across these classifications.",
+		Impact: "If this risk is unmitigated, attackers might be able to take over the cluster via the exposed control plane " +
+			"or to access highly sensitive workloads via the service account of a compromised less sensitive workload.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Kubernetes_Security_Cheat_Sheet.html",
+		Action:     "Kubernetes Hardening",
+		Mitigation: "Restrict the access to the API server to trusted networks (like via private endpoints and authorized networks), " +
+			"apply least-privilege RBAC roles to dedicated service accounts per workload, disable the automounting of service account tokens " +
+			"where not required, and separate workloads of different data classifications into different namespaces or clusters.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope technical assets tagged with 'kubernetes' or with container platform technology, having incoming communication links " +
+			"from the public network or across a network trust boundary (control-plane exposure), or spawning workloads via container spawning " +
+			"communication links which process data of different confidentiality ratings (over-privileged service accounts).",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity ratings of the cluster and its workloads. " +
+			"The likelihood of control-plane exposure is higher when the API server is reachable from the public network.",
+		FalsePositives: "Clusters whose API server is only reachable via a strongly authenticated bastion or VPN, or whose workloads use " +
+			"dedicated least-privilege service accounts, can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        250,
+		Labels:                     []string{"container", "cloud"},
+	}
+}
+
+func (*KubernetesClusterSecurityRule) SupportedTags() []string {
+	return []string{"kubernetes"}
+}
+
+func (r *KubernetesClusterSecurityRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		exposed, fromPublicNetwork := r.controlPlaneExposure(parsedModel, techAsset)
+		if exposed {
+			risks = append(risks, r.createControlPlaneRisk(parsedModel, techAsset, fromPublicNetwork))
+		}
+
+		if len(r.workloadClassifications(parsedModel, techAsset)) > 1 {
+			risks = append(risks, r.createServiceAccountRisk(parsedModel, techAsset))
+		}
+	}
+	return risks, nil
+}
+
+func (r *KubernetesClusterSecurityRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || (!techAsset.Technologies.GetAttribute(types.ContainerPlatform) && !techAsset.IsTaggedWithAny(r.SupportedTags()...))
+}
+
+// controlPlaneExposure returns whether the cluster is reachable from outside its network trust boundary
+// and whether it is reachable from the public network in particular
+func (r *KubernetesClusterSecurityRule) controlPlaneExposure(parsedModel *types.Model, techAsset *types.TechnicalAsset) (bool, bool) {
+	exposed := false
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			return true, true
+		}
+		if isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			exposed = true
+		}
+	}
+	return exposed, false
+}
+
+// workloads returns the sorted technical assets spawned by the cluster
+func (r *KubernetesClusterSecurityRule) workloads(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	workloads := make([]string, 0)
+	for _, commLink := range techAsset.CommunicationLinks {
+		if _, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && commLink.Protocol == types.ContainerSpawning && !contains(workloads, commLink.TargetId) {
+			workloads = append(workloads, commLink.TargetId)
+		}
+	}
+	sort.Strings(workloads)
+	return workloads
+}
+
+// workloadClassifications returns the distinct highest confidentiality ratings of the workloads spawned by the cluster
+func (r *KubernetesClusterSecurityRule) workloadClassifications(parsedModel *types.Model, techAsset *types.TechnicalAsset) []types.Confidentiality {
+	classifications := make([]types.Confidentiality, 0)
+	for _, workload := range r.workloads(parsedModel, techAsset) {
+		confidentiality := parsedModel.HighestProcessedConfidentiality(parsedModel.TechnicalAssets[workload])
+		found := false
+		for _, classification := range classifications {
+			if classification == confidentiality {
+				found = true
+				break
+			}
+		}
+		if !found {
+			classifications = append(classifications, confidentiality)
+		}
+	}
+	sort.Slice(classifications, func(i, j int) bool {
+		return classifications[i] < classifications[j]
+	})
+	return classifications
+}
+
+func (r *KubernetesClusterSecurityRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	assets := append([]string{techAsset.Id}, r.workloads(parsedModel, techAsset)...)
+	for _, id := range assets {
+		asset := parsedModel.TechnicalAssets[id]
+		if parsedModel.HighestProcessedConfidentiality(asset) == types.StrictlyConfidential ||
+			parsedModel.HighestProcessedIntegrity(asset) == types.MissionCritical {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *KubernetesClusterSecurityRule) createControlPlaneRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, fromPublicNetwork bool) *types.Risk {
+	likelihood := types.Likely
+	if fromPublicNetwork {
+		likelihood = types.VeryLikely
+	}
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Kubernetes Control-Plane Exposure</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  append([]string{techAsset.Id}, r.workloads(parsedModel, techAsset)...),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + kubernetesControlPlaneExposure + "@" + techAsset.Id
+	return risk
+}
+
+func (r *KubernetesClusterSecurityRule) createServiceAccountRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Kubernetes Over-Privileged Service Accounts</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  r.workloads(parsedModel, techAsset),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + kubernetesOverPrivilegedServiceAccount + "@" + techAsset.Id
+	return risk
+}
+
+func (r *KubernetesClusterSecurityRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		controlPlaneRisk := categoryId + "@" + kubernetesControlPlaneExposure + "@" + techAsset.Id
+		serviceAccountRisk := categoryId + "@" + kubernetesOverPrivilegedServiceAccount + "@" + techAsset.Id
+		all := strings.EqualFold(risk, categoryId+"@*")
+		if !all && !strings.EqualFold(risk, controlPlaneRisk) && !strings.EqualFold(risk, serviceAccountRisk) {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if exposed, fromPublicNetwork := r.controlPlaneExposure(parsedModel, techAsset); exposed && (all || strings.EqualFold(risk, controlPlaneRisk)) {
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, r.explainAsset(techAsset)...)
+			if fromPublicNetwork {
+				explanation = append(explanation, "  - control plane reachable from the public network",
+					fmt.Sprintf("    - likelihood is %v because the control plane is reachable from the public network", types.VeryLikely))
+			} else {
+				explanation = append(explanation, "  - control plane reachable across a network trust boundary",
+					fmt.Sprintf("    - likelihood is %v (default)", types.Likely))
+			}
+			explanation = append(explanation, r.explainImpact(parsedModel, techAsset))
+		}
+
+		if classifications := r.workloadClassifications(parsedModel, techAsset); len(classifications) > 1 && (all || strings.EqualFold(risk, serviceAccountRisk)) {
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, r.explainAsset(techAsset)...)
+			explanation = append(explanation, fmt.Sprintf("  - workloads %q span data classifications %v", r.workloads(parsedModel, techAsset), classifications))
+			explanation = append(explanation, r.explainImpact(parsedModel, techAsset))
+		}
+	}
+
+	return explanation
+}
+
+func (r *KubernetesClusterSecurityRule) explainAsset(techAsset *types.TechnicalAsset) []string {
+	return []string{
+		fmt.Sprintf("technical asset %q", techAsset.Id),
+		fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+		fmt.Sprintf("  - technology: %v, tags: %v (has %q or tag %q)", techAsset.Technologies.String(), techAsset.Tags, types.ContainerPlatform, "kubernetes"),
+	}
+}
+
+func (r *KubernetesClusterSecurityRule) explainImpact(parsedModel *types.Model, techAsset *types.TechnicalAsset) string {
+	impact := r.impact(parsedModel, techAsset)
+	if impact == types.HighImpact {
+		return fmt.Sprintf("    - impact is %v because the cluster or its workloads process %v or %v data", impact, types.StrictlyConfidential, types.MissionCritical)
+	}
+	return fmt.Sprintf("    - impact is %v (default)", impact)
+}
@@ -0,0 +1,145 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestKubernetesClusterSecurityRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewKubernetesClusterSecurityRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type KubernetesClusterSecurityRuleTest struct {
+	technology                    string
+	tags                          []string
+	callerInternet                bool
+	callerInSameTrustBoundary     bool
+	firstWorkloadConfidentiality  types.Confidentiality
+	secondWorkloadConfidentiality types.Confidentiality
+
+	expectedTitles      []string
+	expectedLikelihoods []types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+}
+
+func TestKubernetesClusterSecurityRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]KubernetesClusterSecurityRuleTest{
+		"not a kubernetes cluster": {
+			technology:                    types.WebServer,
+			callerInternet:                true,
+			secondWorkloadConfidentiality: types.StrictlyConfidential,
+			expectedTitles:                []string{},
+		},
+		"cluster only reachable internally with uniform workloads": {
+			tags:                      []string{"kubernetes"},
+			callerInSameTrustBoundary: true,
+			expectedTitles:            []string{},
+		},
+		"control plane reachable across trust boundary": {
+			technology:          types.ContainerPlatform,
+			expectedTitles:      []string{"<b>Kubernetes Control-Plane Exposure</b> risk at <b>Cluster</b>"},
+			expectedLikelihoods: []types.RiskExploitationLikelihood{types.Likely},
+			expectedImpact:      types.MediumImpact,
+		},
+		"control plane reachable from internet": {
+			tags:                      []string{"kubernetes"},
+			callerInternet:            true,
+			callerInSameTrustBoundary: true,
+			expectedTitles:            []string{"<b>Kubernetes Control-Plane Exposure</b> risk at <b>Cluster</b>"},
+			expectedLikelihoods:       []types.RiskExploitationLikelihood{types.VeryLikely},
+			expectedImpact:            types.MediumImpact,
+		},
+		"workloads span multiple classifications": {
+			tags:                          []string{"kubernetes"},
+			callerInSameTrustBoundary:     true,
+			firstWorkloadConfidentiality:  types.Internal,
+			secondWorkloadConfidentiality: types.StrictlyConfidential,
+			expectedTitles:                []string{"<b>Kubernetes Over-Privileged Service Accounts</b> risk at <b>Cluster</b>"},
+			expectedLikelihoods:           []types.RiskExploitationLikelihood{types.Unlikely},
+			expectedImpact:                types.HighImpact,
+		},
+		"both risks": {
+			technology:                    types.ContainerPlatform,
+			firstWorkloadConfidentiality:  types.Public,
+			secondWorkloadConfidentiality: types.Confidential,
+			expectedTitles: []string{
+				"<b>Kubernetes Control-Plane Exposure</b> risk at <b>Cluster</b>",
+				"<b>Kubernetes Over-Privileged Service Accounts</b> risk at <b>Cluster</b>",
+			},
+			expectedLikelihoods: []types.RiskExploitationLikelihood{types.Likely, types.Unlikely},
+			expectedImpact:      types.MediumImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewKubernetesClusterSecurityRule()
+			incomingLink := &types.CommunicationLink{Id: "admin>cluster", SourceId: "admin", TargetId: "cluster"}
+			adminBoundary := &types.TrustBoundary{Id: "office", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"admin"}}
+			clusterBoundary := &types.TrustBoundary{Id: "cloud", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"cluster", "first", "second"}}
+			if testCase.callerInSameTrustBoundary {
+				adminBoundary = clusterBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"admin": {
+						Id:                 "admin",
+						Internet:           testCase.callerInternet,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"cluster": {
+						Id:           "cluster",
+						Title:        "Cluster",
+						Tags:         testCase.tags,
+						Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "cluster>first", SourceId: "cluster", TargetId: "first", Protocol: types.ContainerSpawning},
+							{Id: "cluster>second", SourceId: "cluster", TargetId: "second", Protocol: types.ContainerSpawning},
+						},
+					},
+					"first": {
+						Id:              "first",
+						Machine:         types.Container,
+						Confidentiality: testCase.firstWorkloadConfidentiality,
+					},
+					"second": {
+						Id:              "second",
+						Machine:         types.Container,
+						Confidentiality: testCase.secondWorkloadConfidentiality,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					adminBoundary.Id:   adminBoundary,
+					clusterBoundary.Id: clusterBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"admin":   adminBoundary,
+					"cluster": clusterBoundary,
+					"first":   clusterBoundary,
+					"second":  clusterBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"cluster": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, len(testCase.expectedTitles))
+			for i, risk := range risks {
+				assert.Equal(t, testCase.expectedTitles[i], risk.Title)
+				assert.Equal(t, testCase.expectedLikelihoods[i], risk.ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risk.ExploitationImpact)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risk.SyntheticId))
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type KubernetesServiceAccountTokenRule struct{}
+
+func NewKubernetesServiceAccountTokenRule() *KubernetesServiceAccountTokenRule {
+	return &KubernetesServiceAccountTokenRule{}
+}
+
+func (*KubernetesServiceAccountTokenRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "kubernetes-service-account-token",
+		Title: "Kubernetes Service Account Token",
+		Description: "Kubernetes pods mounting the default service account token can access the Kubernetes API with whatever " +
+			"permissions that service account holds, which are frequently over-broad.",
+		Impact: "If this risk is unmitigated, attackers who compromised a pod might use its service account token to access the " +
+			"Kubernetes API and escalate their privileges within the cluster.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Kubernetes_Security_Cheat_Sheet.html",
+		Action:     "Service Account Hardening",
+		Mitigation: "Disable the automounting of service account tokens (automountServiceAccountToken: false) for pods not requiring " +
+			"access to the Kubernetes API and use dedicated least-privilege service accounts for those which do.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope technical assets within a Kubernetes namespace (trust boundaries of type " + types.NetworkPolicyNamespaceIsolation.String() + " or tagged with 'kubernetes-namespace') having outgoing communication links to the Kubernetes API server (technical assets tagged with 'kubernetes-api-server' or of technology " + types.ContainerPlatform + ") while not being tagged with 'automount-service-account-false' or 'dedicated-service-account'.",
+		RiskAssessment: "The risk rating depends on whether the service account is bound to an admin role (tagged with 'sa-cluster-admin').",
+		FalsePositives: "Pods whose service account tokens are not mounted or bound to least-privilege roles outside of the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        250,
+		Labels:                     []string{"container", "identity"},
+	}
+}
+
+func (*KubernetesServiceAccountTokenRule) SupportedTags() []string {
+	return []string{"kubernetes-namespace", "kubernetes-api-server", "automount-service-account-false", "dedicated-service-account", "sa-cluster-admin"}
+}
+
+func (r *KubernetesServiceAccountTokenRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		for _, outgoingLink := range techAsset.CommunicationLinksSorted() {
+			if r.isKubernetesApiServer(parsedModel.TechnicalAssets[outgoingLink.TargetId]) {
+				risks = append(risks, r.createRisk(techAsset, outgoingLink))
+				break
+			}
+		}
+	}
+	return risks, nil
+}
+
+func (r *KubernetesServiceAccountTokenRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.IsTaggedWithAny("automount-service-account-false", "dedicated-service-account") {
+		return true
+	}
+	trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+	if !ok {
+		return true
+	}
+	return trustBoundary.Type != types.NetworkPolicyNamespaceIsolation && !trustBoundary.IsTaggedWithAny("kubernetes-namespace")
+}
+
+func (r *KubernetesServiceAccountTokenRule) isKubernetesApiServer(techAsset *types.TechnicalAsset) bool {
+	if techAsset == nil {
+		return false
+	}
+	return techAsset.IsTaggedWithAny("kubernetes-api-server") || techAsset.Technologies.GetAttribute(types.ContainerPlatform)
+}
+
+func (r *KubernetesServiceAccountTokenRule) createRisk(techAsset *types.TechnicalAsset, apiServerLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if techAsset.IsTaggedWithAny("sa-cluster-admin") {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Confidence:                      types.MediumConfidence,
+		Title:                           "<b>Kubernetes Service Account Token</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: apiServerLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id, apiServerLink.TargetId},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *KubernetesServiceAccountTokenRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		apiServerLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if apiServerLink == nil {
+			continue
+		}
+
+		trustBoundary := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+		apiServer := parsedModel.TechnicalAssets[apiServerLink.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has neither %q nor %q)", techAsset.Tags, "automount-service-account-false", "dedicated-service-account"),
+			fmt.Sprintf("  - trust boundary: %q (type: %v, tags: %v) (is %v or tagged with %q)",
+				trustBoundary.Id, trustBoundary.Type, trustBoundary.Tags, types.NetworkPolicyNamespaceIsolation, "kubernetes-namespace"),
+			fmt.Sprintf("  - calls technical asset %q via communication link %q", apiServer.Id, apiServerLink.Id),
+			fmt.Sprintf("    - technology: %v, tags: %v (has %q or tag %q)", apiServer.Technologies.String(), apiServer.Tags, types.ContainerPlatform, "kubernetes-api-server"),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the technical asset is tagged with %q", "sa-cluster-admin")))
+	}
+
+	return explanation
+}
@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestKubernetesServiceAccountTokenRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewKubernetesServiceAccountTokenRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type KubernetesServiceAccountTokenRuleTest struct {
+	podTags             []string
+	boundaryType        types.TrustBoundaryType
+	boundaryTags        []string
+	apiServerTags       []string
+	apiServerTechnology string
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestKubernetesServiceAccountTokenRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]KubernetesServiceAccountTokenRuleTest{
+		"not in kubernetes namespace": {
+			boundaryType:  types.NetworkCloudProvider,
+			apiServerTags: []string{"kubernetes-api-server"},
+			riskCreated:   false,
+		},
+		"no link to api server": {
+			boundaryType: types.NetworkPolicyNamespaceIsolation,
+			riskCreated:  false,
+		},
+		"automount disabled": {
+			podTags:       []string{"automount-service-account-false"},
+			boundaryType:  types.NetworkPolicyNamespaceIsolation,
+			apiServerTags: []string{"kubernetes-api-server"},
+			riskCreated:   false,
+		},
+		"dedicated service account": {
+			podTags:       []string{"dedicated-service-account"},
+			boundaryType:  types.NetworkPolicyNamespaceIsolation,
+			apiServerTags: []string{"kubernetes-api-server"},
+			riskCreated:   false,
+		},
+		"default service account in namespace": {
+			boundaryType:   types.NetworkPolicyNamespaceIsolation,
+			apiServerTags:  []string{"kubernetes-api-server"},
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+		},
+		"tagged namespace with container platform": {
+			boundaryType:        types.ExecutionEnvironment,
+			boundaryTags:        []string{"kubernetes-namespace"},
+			apiServerTechnology: types.ContainerPlatform,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+		},
+		"cluster admin service account": {
+			podTags:        []string{"sa-cluster-admin"},
+			boundaryType:   types.NetworkPolicyNamespaceIsolation,
+			apiServerTags:  []string{"kubernetes-api-server"},
+			riskCreated:    true,
+			expectedImpact: types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewKubernetesServiceAccountTokenRule()
+			namespace := &types.TrustBoundary{
+				Id:                    "namespace",
+				Type:                  testCase.boundaryType,
+				Tags:                  testCase.boundaryTags,
+				TechnicalAssetsInside: []string{"pod"},
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"pod": {
+						Id:    "pod",
+						Title: "Pod",
+						Tags:  testCase.podTags,
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "pod>api-server", SourceId: "pod", TargetId: "api-server"},
+						},
+					},
+					"api-server": {
+						Id:    "api-server",
+						Title: "API Server",
+						Tags:  testCase.apiServerTags,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.apiServerTechnology,
+								Attributes: map[string]bool{testCase.apiServerTechnology: true},
+							},
+						},
+					},
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"pod": namespace,
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Kubernetes Service Account Token</b> risk at <b>Pod</b>", risks[0].Title)
+				assert.Equal(t, "pod>api-server", risks[0].MostRelevantCommunicationLinkId)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -32,6 +33,7 @@ func (*LdapInjectionRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        90,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -64,7 +66,7 @@ func (r *LdapInjectionRule) createRisk(input *types.Model, technicalAsset *types
 	title := "<b>LDAP-Injection</b> risk at <b>" + caller.Title + "</b> against LDAP server <b>" + technicalAsset.Title + "</b>" +
 		" via <b>" + incomingFlow.Title + "</b>"
 	impact := types.MediumImpact
-	if input.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || input.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical {
+	if input.AnalysisContext().IsHighlySensitive(technicalAsset) {
 		impact = types.HighImpact
 	}
 	risk := &types.Risk{
@@ -81,3 +83,24 @@ func (r *LdapInjectionRule) createRisk(input *types.Model, technicalAsset *types
 	risk.SyntheticId = risk.CategoryId + "@" + caller.Id + "@" + technicalAsset.Id + "@" + incomingFlow.Id
 	return risk
 }
+
+func (r *LdapInjectionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[incomingFlow.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", incomingFlow.Id),
+			fmt.Sprintf("  - protocol: %v (is %v or %v)", incomingFlow.Protocol, types.LDAP, types.LDAPS),
+			fmt.Sprintf("  - caller: technical asset %q (out of scope: %v (=false))", incomingFlow.SourceId, parsedModel.TechnicalAssets[incomingFlow.SourceId].OutOfScope),
+			fmt.Sprintf("  - LDAP server: technical asset %q (%v)", technicalAsset.Id, processedSensitivity(parsedModel, technicalAsset)),
+			explainedLikelihood(generatedRisk, types.Likely, fmt.Sprintf("the usage is %v", incomingFlow.Usage)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the LDAP server processes %v data or %v integrity", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
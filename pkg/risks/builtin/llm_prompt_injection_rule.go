@@ -0,0 +1,244 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type LLMPromptInjectionRule struct{}
+
+func NewLLMPromptInjectionRule() *LLMPromptInjectionRule {
+	return &LLMPromptInjectionRule{}
+}
+
+const (
+	llmPromptInjection  = "prompt-injection"
+	llmTrainingDataLeak = "training-data-leak"
+)
+
+func (*LLMPromptInjectionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "llm-prompt-injection",
+		Title: "LLM Prompt Injection",
+		Description: "AI components (like LLM-based chatbots or agents) consuming untrusted user input while having access to internal data stores or tools " +
+			"are prone to prompt injection, where crafted input overrides the instructions of the model, and to leaking the data the model was trained " +
+			"or grounded with.",
+		Impact: "If this risk is unmitigated, attackers might be able to make the AI component read or modify data in connected data stores, " +
+			"invoke connected tools on their behalf, or disclose confidential training or context data.",
+		ASVS:       "V5 - Validation, Sanitization and Encoding Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/LLM_Prompt_Injection_Prevention_Cheat_Sheet.html",
+		Action:     "AI Component Hardening",
+		Mitigation: "Treat the output of the model as untrusted, grant the AI component only least-privilege access to data stores and tools, " +
+			"require human confirmation for sensitive actions, separate system instructions from user input, " +
+			"and do not train or ground the model with data the users are not allowed to see.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope technical assets tagged with 'llm', 'ai', or 'chatbot' having incoming communication links from human clients, from the public network, " +
+			"or across a network trust boundary, and outgoing communication links to internal data stores or processes (prompt injection). " +
+			"When such assets also process confidential or strictly-confidential data, a training data leak risk is raised as well.",
+		RiskAssessment: "The risk rating of prompt injection depends on the sensitivity of the data stores and tools reachable, " +
+			"the one of training data leaks depends on the highest confidentiality rating of the data processed by the AI component. " +
+			"The likelihood is higher when the AI component is reachable from the public network.",
+		FalsePositives:             "AI components only having read access to public data can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1427,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*LLMPromptInjectionRule) SupportedTags() []string {
+	return []string{"llm", "ai", "chatbot"}
+}
+
+func (r *LLMPromptInjectionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrusted, fromPublicNetwork := r.untrustedInput(parsedModel, techAsset)
+		if !untrusted {
+			continue
+		}
+
+		connectedAssets := r.connectedInternalAssets(parsedModel, techAsset)
+		if len(connectedAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createPromptInjectionRisk(parsedModel, techAsset, connectedAssets, fromPublicNetwork))
+		if parsedModel.HighestProcessedConfidentiality(techAsset) >= types.Confidential {
+			risks = append(risks, r.createTrainingDataLeakRisk(parsedModel, techAsset, fromPublicNetwork))
+		}
+	}
+	return risks, nil
+}
+
+func (r *LLMPromptInjectionRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny(r.SupportedTags()...)
+}
+
+// untrustedInput returns whether the AI component consumes untrusted user input
+// and whether this input stems from the public network in particular
+func (r *LLMPromptInjectionRule) untrustedInput(parsedModel *types.Model, techAsset *types.TechnicalAsset) (bool, bool) {
+	if techAsset.Internet {
+		return true, true
+	}
+	untrusted := false
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		sourceAsset := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if isOnPublicNetwork(parsedModel, sourceAsset) {
+			return true, true
+		}
+		if sourceAsset.UsedAsClientByHuman || isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			untrusted = true
+		}
+	}
+	return untrusted, false
+}
+
+// connectedInternalAssets returns the sorted data stores and processes (tools) not on the internet
+// which the AI component has outgoing communication links to
+func (r *LLMPromptInjectionRule) connectedInternalAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, commLink := range techAsset.CommunicationLinks {
+		targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+		if !ok || targetAsset.Internet || targetAsset.Type == types.ExternalEntity || contains(result, targetAsset.Id) {
+			continue
+		}
+		result = append(result, targetAsset.Id)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *LLMPromptInjectionRule) promptInjectionImpact(parsedModel *types.Model, connectedAssets []string) types.RiskExploitationImpact {
+	impact := types.LowImpact
+	for _, id := range connectedAssets {
+		connectedAsset := parsedModel.TechnicalAssets[id]
+		if parsedModel.HighestProcessedConfidentiality(connectedAsset) == types.StrictlyConfidential ||
+			parsedModel.HighestProcessedIntegrity(connectedAsset) == types.MissionCritical {
+			return types.HighImpact
+		}
+		if parsedModel.HighestProcessedConfidentiality(connectedAsset) == types.Confidential ||
+			parsedModel.HighestProcessedIntegrity(connectedAsset) == types.Critical {
+			impact = types.MediumImpact
+		}
+	}
+	return impact
+}
+
+func (r *LLMPromptInjectionRule) trainingDataLeakImpact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *LLMPromptInjectionRule) likelihood(fromPublicNetwork bool) types.RiskExploitationLikelihood {
+	if fromPublicNetwork {
+		return types.VeryLikely
+	}
+	return types.Likely
+}
+
+func (r *LLMPromptInjectionRule) createPromptInjectionRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, connectedAssets []string, fromPublicNetwork bool) *types.Risk {
+	likelihood := r.likelihood(fromPublicNetwork)
+	impact := r.promptInjectionImpact(parsedModel, connectedAssets)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>LLM Prompt Injection</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  connectedAssets,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + llmPromptInjection + "@" + techAsset.Id
+	return risk
+}
+
+func (r *LLMPromptInjectionRule) createTrainingDataLeakRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, fromPublicNetwork bool) *types.Risk {
+	likelihood := r.likelihood(fromPublicNetwork)
+	impact := r.trainingDataLeakImpact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>LLM Training Data Leak</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + llmTrainingDataLeak + "@" + techAsset.Id
+	return risk
+}
+
+func (r *LLMPromptInjectionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		promptInjectionRisk := categoryId + "@" + llmPromptInjection + "@" + techAsset.Id
+		trainingDataLeakRisk := categoryId + "@" + llmTrainingDataLeak + "@" + techAsset.Id
+		all := strings.EqualFold(risk, categoryId+"@*")
+		if !all && !strings.EqualFold(risk, promptInjectionRisk) && !strings.EqualFold(risk, trainingDataLeakRisk) {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrusted, fromPublicNetwork := r.untrustedInput(parsedModel, techAsset)
+		if !untrusted {
+			continue
+		}
+
+		connectedAssets := r.connectedInternalAssets(parsedModel, techAsset)
+		if len(connectedAssets) == 0 {
+			continue
+		}
+
+		details := []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q])", techAsset.Tags, "llm", "ai", "chatbot"),
+			fmt.Sprintf("  - connected to internal technical assets %q", connectedAssets),
+		}
+		if fromPublicNetwork {
+			details = append(details, fmt.Sprintf("    - likelihood is %v because the input stems from the public network", types.VeryLikely))
+		} else {
+			details = append(details, fmt.Sprintf("    - likelihood is %v because the input stems from humans or across a network trust boundary", types.Likely))
+		}
+
+		if all || strings.EqualFold(risk, promptInjectionRisk) {
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+			explanation = append(explanation, details...)
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because of the sensitivity of the connected technical assets",
+				r.promptInjectionImpact(parsedModel, connectedAssets)))
+		}
+
+		confidentiality := parsedModel.HighestProcessedConfidentiality(techAsset)
+		if confidentiality >= types.Confidential && (all || strings.EqualFold(risk, trainingDataLeakRisk)) {
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+			explanation = append(explanation, details...)
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest confidentiality %v",
+				r.trainingDataLeakImpact(parsedModel, techAsset), confidentiality))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,119 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestLLMPromptInjectionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewLLMPromptInjectionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type LLMPromptInjectionRuleTest struct {
+	tags                    []string
+	confidentiality         types.Confidentiality
+	userInternet            bool
+	userIsHuman             bool
+	connectedToDatabase     bool
+	databaseConfidentiality types.Confidentiality
+
+	expectedTitles     []string
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpacts    []types.RiskExploitationImpact
+}
+
+func TestLLMPromptInjectionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]LLMPromptInjectionRuleTest{
+		"not an ai component": {
+			tags:                []string{"web"},
+			userInternet:        true,
+			connectedToDatabase: true,
+			expectedTitles:      []string{},
+		},
+		"no untrusted input": {
+			tags:                []string{"llm"},
+			connectedToDatabase: true,
+			expectedTitles:      []string{},
+		},
+		"not connected to internal assets": {
+			tags:           []string{"chatbot"},
+			userInternet:   true,
+			expectedTitles: []string{},
+		},
+		"human input with connected database": {
+			tags:                    []string{"ai"},
+			userIsHuman:             true,
+			connectedToDatabase:     true,
+			databaseConfidentiality: types.Confidential,
+			expectedTitles:          []string{"<b>LLM Prompt Injection</b> risk at <b>Assistant</b>"},
+			expectedLikelihood:      types.Likely,
+			expectedImpacts:         []types.RiskExploitationImpact{types.MediumImpact},
+		},
+		"internet input with confidential training data": {
+			tags:                    []string{"llm"},
+			confidentiality:         types.StrictlyConfidential,
+			userInternet:            true,
+			connectedToDatabase:     true,
+			databaseConfidentiality: types.Internal,
+			expectedTitles: []string{
+				"<b>LLM Prompt Injection</b> risk at <b>Assistant</b>",
+				"<b>LLM Training Data Leak</b> risk at <b>Assistant</b>",
+			},
+			expectedLikelihood: types.VeryLikely,
+			expectedImpacts:    []types.RiskExploitationImpact{types.LowImpact, types.HighImpact},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewLLMPromptInjectionRule()
+			userLink := &types.CommunicationLink{Id: "user>assistant", SourceId: "user", TargetId: "assistant"}
+			assistant := &types.TechnicalAsset{
+				Id:              "assistant",
+				Title:           "Assistant",
+				Tags:            testCase.tags,
+				Confidentiality: testCase.confidentiality,
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"user": {
+						Id:                  "user",
+						Internet:            testCase.userInternet,
+						UsedAsClientByHuman: testCase.userIsHuman,
+						CommunicationLinks:  []*types.CommunicationLink{userLink},
+					},
+					"assistant": assistant,
+					"database": {
+						Id:              "database",
+						Type:            types.Datastore,
+						Confidentiality: testCase.databaseConfidentiality,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"assistant": {userLink},
+				},
+			}
+			if testCase.connectedToDatabase {
+				assistant.CommunicationLinks = []*types.CommunicationLink{{Id: "assistant>database", SourceId: "assistant", TargetId: "database"}}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, len(testCase.expectedTitles))
+			for i, risk := range risks {
+				assert.Equal(t, testCase.expectedTitles[i], risk.Title)
+				assert.Equal(t, testCase.expectedLikelihood, risk.ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpacts[i], risk.ExploitationImpact)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risk.SyntheticId))
+			}
+		})
+	}
+}
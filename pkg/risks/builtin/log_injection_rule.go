@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type LogInjectionRule struct{}
+
+func NewLogInjectionRule() *LogInjectionRule {
+	return &LogInjectionRule{}
+}
+
+func (*LogInjectionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "log-injection",
+		Title: "Log Injection",
+		Description: "When user-supplied input is written to logs without sanitization, attackers can inject fake log entries " +
+			"(for example via CRLF sequences) or forge audit trails.",
+		Impact: "If this risk is unmitigated, attackers might forge log entries to cover their tracks or to mislead incident " +
+			"response, and might exploit vulnerabilities in log viewers.",
+		ASVS:       "V7 - Error Handling and Logging Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Logging_Cheat_Sheet.html",
+		Action:     "Log Sanitization",
+		Mitigation: "Sanitize or encode all untrusted data before writing it to logs (especially CR and LF characters) and prefer " +
+			"structured logging formats.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Development,
+		STRIDE:         types.Repudiation,
+		DetectionLogic: "In-scope technical assets tagged with 'logging', 'audit-log', or 'siem' without being tagged with 'log-sanitization', which receive data via communication links across a network trust boundary.",
+		RiskAssessment: "The risk rating depends on whether the logger directly ingests HTTP request data and on whether the log " +
+			"store serves as an audit trail for compliance (tagged with 'audit-trail').",
+		FalsePositives: "Loggers receiving only data already sanitized by the sender " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        117,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*LogInjectionRule) SupportedTags() []string {
+	return []string{"logging", "audit-log", "siem", "log-sanitization", "audit-trail"}
+}
+
+func (r *LogInjectionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		crossBoundaryLinks := make([]*types.CommunicationLink, 0)
+		for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+			if len(incomingLink.DataAssetsSent) > 0 && isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+				crossBoundaryLinks = append(crossBoundaryLinks, incomingLink)
+			}
+		}
+		if len(crossBoundaryLinks) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, crossBoundaryLinks))
+	}
+	return risks, nil
+}
+
+func (r *LogInjectionRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.IsTaggedWithAny("logging", "audit-log", "siem") ||
+		techAsset.IsTaggedWithAny("log-sanitization")
+}
+
+func (r *LogInjectionRule) createRisk(techAsset *types.TechnicalAsset, crossBoundaryLinks []*types.CommunicationLink) *types.Risk {
+	likelihood := types.Unlikely
+	mostRelevantLink := crossBoundaryLinks[0]
+	for _, incomingLink := range crossBoundaryLinks {
+		if incomingLink.Protocol == types.HTTP || incomingLink.Protocol == types.HTTPS {
+			// directly ingesting HTTP request data
+			likelihood = types.Likely
+			mostRelevantLink = incomingLink
+			break
+		}
+	}
+	impact := types.MediumImpact
+	if techAsset.IsTaggedWithAny("audit-trail") {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           "<b>Log Injection</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: mostRelevantLink.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *LogInjectionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has %q, %q or %q, has not %q)", techAsset.Tags, "logging", "audit-log", "siem", "log-sanitization"))
+		for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+			if len(incomingLink.DataAssetsSent) > 0 && isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+				explanation = append(explanation, fmt.Sprintf("  - communication link %q crosses a network trust boundary (protocol: %v, data assets sent: %v)",
+					incomingLink.Id, incomingLink.Protocol, incomingLink.DataAssetsSent))
+			}
+		}
+		explanation = append(explanation,
+			explainedLikelihood(generatedRisk, types.Unlikely, fmt.Sprintf("HTTP request data is ingested directly via communication link %q", generatedRisk.MostRelevantCommunicationLinkId)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the technical asset is tagged with %q", "audit-trail")))
+	}
+
+	return explanation
+}
@@ -0,0 +1,119 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestLogInjectionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewLogInjectionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type LogInjectionRuleTest struct {
+	tags                  []string
+	protocol              types.Protocol
+	isInSameTrustBoundary bool
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestLogInjectionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]LogInjectionRuleTest{
+		"not a logger": {
+			protocol:    types.HTTPS,
+			riskCreated: false,
+		},
+		"log sanitization": {
+			tags:        []string{"logging", "log-sanitization"},
+			protocol:    types.HTTPS,
+			riskCreated: false,
+		},
+		"same trust boundary": {
+			tags:                  []string{"siem"},
+			protocol:              types.HTTPS,
+			isInSameTrustBoundary: true,
+			riskCreated:           false,
+		},
+		"internal logger": {
+			tags:               []string{"logging"},
+			protocol:           types.TextEncrypted,
+			riskCreated:        true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"logger ingesting http requests": {
+			tags:               []string{"audit-log"},
+			protocol:           types.HTTPS,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"audit trail": {
+			tags:               []string{"audit-log", "audit-trail"},
+			protocol:           types.HTTP,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewLogInjectionRule()
+			incomingLink := &types.CommunicationLink{
+				Id:             "app>logger",
+				SourceId:       "app",
+				TargetId:       "logger",
+				Protocol:       testCase.protocol,
+				DataAssetsSent: []string{"log-entries"},
+			}
+			loggerBoundary := &types.TrustBoundary{Id: "tb1", Type: types.NetworkCloudProvider}
+			appBoundary := &types.TrustBoundary{Id: "tb2", Type: types.NetworkCloudProvider}
+			if testCase.isInSameTrustBoundary {
+				appBoundary = loggerBoundary
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:                 "app",
+						Title:              "App",
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"logger": {
+						Id:    "logger",
+						Title: "Logger",
+						Tags:  testCase.tags,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"logger": {incomingLink},
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"logger": loggerBoundary,
+					"app":    appBoundary,
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Log Injection</b> risk at <b>Logger</b>", risks[0].Title)
+				assert.Equal(t, "log-injection@logger", risks[0].SyntheticId)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MessageQueuePoisoningRule struct{}
+
+func NewMessageQueuePoisoningRule() *MessageQueuePoisoningRule {
+	return &MessageQueuePoisoningRule{}
+}
+
+func (*MessageQueuePoisoningRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "message-queue-poisoning",
+		Title: "Message Queue Poisoning",
+		Description: "Message brokers accepting unauthenticated writes from technical assets outside of their network trust boundary " +
+			"allow attackers to inject forged or malicious messages into topics and queues, which are then processed by the consumers.",
+		Impact: "If this risk is unmitigated, attackers might be able to tamper with the data processed by the consumers " +
+			"of the message broker or trigger unintended actions within them.",
+		ASVS:       "V13 - API and Web Service Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
+		Action:     "Message Broker Authentication",
+		Mitigation: "Require authentication for all producers writing to the message broker, authorize producers per topic or queue, " +
+			"and let consumers validate the messages (like via signatures) before processing them.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope technical assets with message queue technology having incoming non-readonly communication links without authentication " +
+			"from technical assets located in the public network or across a network trust boundary.",
+		RiskAssessment: "The risk rating depends on the highest integrity rating of the data processed by the consumers of the message broker. " +
+			"The likelihood is higher when the producer is located in the public network.",
+		FalsePositives: "Message brokers where the messages are authenticated on message level (like via signatures verified by all consumers) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        306,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*MessageQueuePoisoningRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *MessageQueuePoisoningRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, incomingLink := range r.untrustedWrites(parsedModel, techAsset) {
+			risks = append(risks, r.createRisk(parsedModel, techAsset, incomingLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *MessageQueuePoisoningRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.MessageQueue)
+}
+
+// untrustedWrites returns the sorted unauthenticated communication links writing to the message broker
+// from the public network or across a network trust boundary
+func (r *MessageQueuePoisoningRule) untrustedWrites(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.CommunicationLink {
+	result := make([]*types.CommunicationLink, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Readonly || incomingLink.Authentication != types.NoneAuthentication {
+			continue
+		}
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) || isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			result = append(result, incomingLink)
+		}
+	}
+	sort.Sort(types.ByTechnicalCommunicationLinkIdSort(result))
+	return result
+}
+
+// consumers returns the sorted technical assets reading from the message broker, either by receiving data via
+// their own communication links to the broker or by being called by the broker
+func (r *MessageQueuePoisoningRule) consumers(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if len(incomingLink.DataAssetsReceived) > 0 && !contains(result, incomingLink.SourceId) {
+			result = append(result, incomingLink.SourceId)
+		}
+	}
+	for _, commLink := range techAsset.CommunicationLinks {
+		if _, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && !contains(result, commLink.TargetId) {
+			result = append(result, commLink.TargetId)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *MessageQueuePoisoningRule) consumerIntegrity(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.Criticality {
+	integrity := types.Archive
+	for _, id := range r.consumers(parsedModel, techAsset) {
+		if consumerIntegrity := parsedModel.HighestProcessedIntegrity(parsedModel.TechnicalAssets[id]); consumerIntegrity > integrity {
+			integrity = consumerIntegrity
+		}
+	}
+	return integrity
+}
+
+func (r *MessageQueuePoisoningRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	switch r.consumerIntegrity(parsedModel, techAsset) {
+	case types.MissionCritical:
+		return types.HighImpact
+	case types.Critical:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *MessageQueuePoisoningRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, incomingLink *types.CommunicationLink) *types.Risk {
+	sourceAsset := parsedModel.TechnicalAssets[incomingLink.SourceId]
+	likelihood := types.Likely
+	if isOnPublicNetwork(parsedModel, sourceAsset) {
+		likelihood = types.VeryLikely
+	}
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>Message Queue Poisoning</b> risk at <b>" + techAsset.Title + "</b> written to by <b>" + sourceAsset.Title + "</b> " +
+		"via <b>" + incomingLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: incomingLink.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     r.consumers(parsedModel, techAsset),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + incomingLink.Id + "@" + sourceAsset.Id + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MessageQueuePoisoningRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, incomingLink := range r.untrustedWrites(parsedModel, techAsset) {
+			if !strings.EqualFold(risk, categoryId+"@"+incomingLink.Id+"@"+incomingLink.SourceId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			sourceAsset := parsedModel.TechnicalAssets[incomingLink.SourceId]
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", incomingLink.Id),
+				fmt.Sprintf("  - readonly: %v (=false)", incomingLink.Readonly),
+				fmt.Sprintf("  - authentication: %v (=%v)", incomingLink.Authentication, types.NoneAuthentication),
+				fmt.Sprintf("  - source: technical asset %q", sourceAsset.Id),
+				fmt.Sprintf("  - target: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("    - technology: %v (has %q)", techAsset.Technologies.String(), types.MessageQueue),
+			}...)
+
+			if isOnPublicNetwork(parsedModel, sourceAsset) {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the source is located in the public network", types.VeryLikely))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the source is located across a network trust boundary", types.Likely))
+			}
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest integrity %v of consumers %q",
+				r.impact(parsedModel, techAsset), r.consumerIntegrity(parsedModel, techAsset), r.consumers(parsedModel, techAsset)))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMessageQueuePoisoningRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMessageQueuePoisoningRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MessageQueuePoisoningRuleTest struct {
+	technology        string
+	authentication    types.Authentication
+	readonly          bool
+	producerInternet  bool
+	sameTrustBoundary bool
+	consumerIntegrity types.Criticality
+
+	riskCreated         bool
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestMessageQueuePoisoningRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MessageQueuePoisoningRuleTest{
+		"not a message queue": {
+			technology:       types.Database,
+			producerInternet: true,
+			riskCreated:      false,
+		},
+		"authenticated producer": {
+			technology:     types.MessageQueue,
+			authentication: types.ClientCertificate,
+			riskCreated:    false,
+		},
+		"readonly link": {
+			technology:  types.MessageQueue,
+			readonly:    true,
+			riskCreated: false,
+		},
+		"producer in same trust boundary": {
+			technology:        types.MessageQueue,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"unauthenticated producer across trust boundary": {
+			technology:          types.MessageQueue,
+			consumerIntegrity:   types.Critical,
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium because of highest integrity critical of consumers [\"consumer\"]",
+		},
+		"unauthenticated producer from internet": {
+			technology:          types.MessageQueue,
+			producerInternet:    true,
+			sameTrustBoundary:   true,
+			consumerIntegrity:   types.MissionCritical,
+			riskCreated:         true,
+			expectedLikelihood:  types.VeryLikely,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - likelihood is very-likely because the source is located in the public network",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMessageQueuePoisoningRule()
+			producerLink := &types.CommunicationLink{
+				Id:             "producer>broker",
+				Title:          "Publish",
+				SourceId:       "producer",
+				TargetId:       "broker",
+				Authentication: testCase.authentication,
+				Readonly:       testCase.readonly,
+			}
+			consumerLink := &types.CommunicationLink{
+				Id:                 "consumer>broker",
+				SourceId:           "consumer",
+				TargetId:           "broker",
+				Readonly:           true,
+				DataAssetsReceived: []string{"event"},
+			}
+			producerBoundary := &types.TrustBoundary{Id: "partner", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"producer"}}
+			brokerBoundary := &types.TrustBoundary{Id: "internal", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"broker", "consumer"}}
+			if testCase.sameTrustBoundary {
+				producerBoundary = brokerBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"producer": {
+						Id:                 "producer",
+						Title:              "Producer",
+						Internet:           testCase.producerInternet,
+						CommunicationLinks: []*types.CommunicationLink{producerLink},
+					},
+					"consumer": {
+						Id:                 "consumer",
+						Integrity:          testCase.consumerIntegrity,
+						CommunicationLinks: []*types.CommunicationLink{consumerLink},
+					},
+					"broker": {
+						Id:           "broker",
+						Title:        "Broker",
+						Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					producerBoundary.Id: producerBoundary,
+					brokerBoundary.Id:   brokerBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"producer": producerBoundary,
+					"consumer": brokerBoundary,
+					"broker":   brokerBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"broker": {producerLink, consumerLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, []string{"consumer"}, risks[0].DataBreachTechnicalAssetIDs)
+				assert.Equal(t, "<b>Message Queue Poisoning</b> risk at <b>Broker</b> written to by <b>Producer</b> via <b>Publish</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
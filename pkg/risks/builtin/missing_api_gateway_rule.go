@@ -0,0 +1,152 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingApiGatewayRule struct{}
+
+func NewMissingApiGatewayRule() *MissingApiGatewayRule {
+	return &MissingApiGatewayRule{}
+}
+
+func (*MissingApiGatewayRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-api-gateway",
+		Title: "Missing API Gateway",
+		Description: "Web services exposed to the internet should be published via an API gateway (or at least a reverse proxy), " +
+			"which centrally enforces authentication, authorization, schema validation, quotas, and versioning for all APIs.",
+		Impact: "If this risk is unmitigated, every web service has to implement these controls on its own, " +
+			"and external clients might be able to reach undocumented or unprotected API endpoints directly.",
+		ASVS:       "V13 - API and Web Service Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/REST_Security_Cheat_Sheet.html",
+		Action:     "API Gateway",
+		Mitigation: "Publish the web services to external clients only via an API gateway or reverse proxy enforcing authentication, " +
+			"authorization, request validation, and quotas centrally.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope web services (which are neither API gateways nor reverse proxies themselves) having incoming communication links " +
+			"directly from clients located in the public network, which are neither API gateways nor reverse proxies.",
+		RiskAssessment: "The risk rating depends on the sensitivity of the data processed by the web service.",
+		FalsePositives: "Web services intentionally published directly (like webhooks with message-level signatures) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1008,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*MissingApiGatewayRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *MissingApiGatewayRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		incomingLink := r.directExternalLink(parsedModel, techAsset)
+		if incomingLink == nil {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, incomingLink))
+	}
+	return risks, nil
+}
+
+func (r *MissingApiGatewayRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.IsWebService) || r.isApiGateway(techAsset)
+}
+
+func (r *MissingApiGatewayRule) isApiGateway(techAsset *types.TechnicalAsset) bool {
+	return techAsset.Technologies.GetAttribute(types.Gateway) || techAsset.Technologies.GetAttribute(types.ReverseProxy)
+}
+
+// directExternalLink returns the first incoming communication link (sorted by id) coming directly from an external client
+func (r *MissingApiGatewayRule) directExternalLink(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.CommunicationLink {
+	var result *types.CommunicationLink
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if !ok || r.isApiGateway(sourceAsset) || !isOnPublicNetwork(parsedModel, sourceAsset) {
+			continue
+		}
+		if result == nil || incomingLink.Id < result.Id {
+			result = incomingLink
+		}
+	}
+	return result
+}
+
+func (r *MissingApiGatewayRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *MissingApiGatewayRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, incomingLink *types.CommunicationLink) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>Missing API Gateway</b> risk at <b>" + techAsset.Title + "</b> " +
+		"(at least via communication link <b>" + incomingLink.Title + "</b>)"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: incomingLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MissingApiGatewayRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		incomingLink := r.directExternalLink(parsedModel, techAsset)
+		if incomingLink == nil {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (is web service, has neither [%q, %q])", techAsset.Technologies.String(), types.Gateway, types.ReverseProxy),
+			fmt.Sprintf("  - directly called by external client %q via communication link %q", incomingLink.SourceId, incomingLink.Id),
+		}...)
+
+		if impact := r.impact(parsedModel, techAsset); impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v or %v data is processed", impact, types.StrictlyConfidential, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingApiGatewayRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingApiGatewayRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingApiGatewayRuleTest struct {
+	isWebService     bool
+	targetTechnology string
+	sourceTechnology string
+	sourceInternet   bool
+	confidentiality  types.Confidentiality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestMissingApiGatewayRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingApiGatewayRuleTest{
+		"not a web service": {
+			targetTechnology: types.WebApplication,
+			sourceInternet:   true,
+			riskCreated:      false,
+		},
+		"web service is a gateway itself": {
+			isWebService:     true,
+			targetTechnology: types.Gateway,
+			sourceInternet:   true,
+			riskCreated:      false,
+		},
+		"internal client": {
+			isWebService:     true,
+			targetTechnology: types.WebServiceREST,
+			riskCreated:      false,
+		},
+		"called via reverse proxy": {
+			isWebService:     true,
+			targetTechnology: types.WebServiceREST,
+			sourceTechnology: types.ReverseProxy,
+			sourceInternet:   true,
+			riskCreated:      false,
+		},
+		"called directly from internet": {
+			isWebService:        true,
+			targetTechnology:    types.WebServiceREST,
+			sourceTechnology:    types.Browser,
+			sourceInternet:      true,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"called directly from internet processing strictly confidential data": {
+			isWebService:        true,
+			targetTechnology:    types.WebServiceSOAP,
+			sourceInternet:      true,
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because strictly-confidential or mission-critical data is processed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingApiGatewayRule()
+			incomingLink := &types.CommunicationLink{Id: "client>api", Title: "API Call", SourceId: "client", TargetId: "api"}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:                 "client",
+						Internet:           testCase.sourceInternet,
+						Technologies:       types.TechnologyList{{Name: testCase.sourceTechnology, Attributes: map[string]bool{testCase.sourceTechnology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"api": {
+						Id:              "api",
+						Title:           "API",
+						Confidentiality: testCase.confidentiality,
+						Technologies: types.TechnologyList{{Name: testCase.targetTechnology, Attributes: map[string]bool{
+							testCase.targetTechnology: true,
+							types.IsWebService:        testCase.isWebService,
+						}}},
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"api": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing API Gateway</b> risk at <b>API</b> (at least via communication link <b>API Call</b>)", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
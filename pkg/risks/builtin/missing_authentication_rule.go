@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -32,6 +33,7 @@ func (*MissingAuthenticationRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        306,
+		Labels:                     []string{"identity"},
 	}
 }
 
@@ -105,3 +107,38 @@ func (r *MissingAuthenticationRule) createRisk(input *types.Model, technicalAsse
 	risk.SyntheticId = risk.CategoryId + "@" + incomingAccess.Id + "@" + input.TechnicalAssets[incomingAccess.SourceId].Id + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingAuthenticationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[commLink.TargetId]
+		caller := parsedModel.TechnicalAssets[commLink.SourceId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", commLink.Id),
+			fmt.Sprintf("  - authentication: %v (=%v)", commLink.Authentication, types.NoneAuthentication),
+			fmt.Sprintf("  - protocol: %v (not process local)", commLink.Protocol),
+			fmt.Sprintf("  - caller: technical asset %q (type: %v, technology: %v, has not %q)",
+				caller.Id, caller.Type, caller.Technologies.String(), types.IsUnprotectedCommunicationsTolerated),
+			fmt.Sprintf("  - target: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("    - technology: %v (has not %q)", technicalAsset.Technologies.String(), types.NoAuthenticationRequired),
+			fmt.Sprintf("    - %v, multi-tenant: %v (at least %v or %v, or multi-tenant)", processedSensitivity(parsedModel, technicalAsset), technicalAsset.MultiTenant, types.Confidential, types.Critical),
+			fmt.Sprintf("  - highest confidentiality: %v, integrity: %v of the data assets transferred",
+				parsedModel.HighestCommunicationLinkConfidentiality(commLink), parsedModel.HighestCommunicationLinkIntegrity(commLink)))
+		switch generatedRisk.ExploitationImpact {
+		case types.HighImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v or %v data is transferred", types.HighImpact, types.StrictlyConfidential, types.MissionCritical))
+		case types.LowImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because at most %v confidentiality and %v integrity data is transferred", types.LowImpact, types.Internal, types.Operational))
+		default:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
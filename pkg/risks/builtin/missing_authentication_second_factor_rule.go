@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*MissingAuthenticationSecondFactorRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        308,
+		Labels:                     []string{"identity"},
 	}
 }
 
@@ -92,3 +94,49 @@ func (r *MissingAuthenticationSecondFactorRule) GenerateRisks(input *types.Model
 	}
 	return risks, nil
 }
+
+func (r *MissingAuthenticationSecondFactorRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[commLink.TargetId]
+		caller := parsedModel.TechnicalAssets[commLink.SourceId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", commLink.Id),
+			fmt.Sprintf("  - target: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("    - technology: %v (has neither %q nor %q)", technicalAsset.Technologies.String(), types.IsTrafficForwarding, types.IsUnprotectedCommunicationsTolerated),
+			fmt.Sprintf("    - %v, multi-tenant: %v (at least %v or %v, or multi-tenant)", processedSensitivity(parsedModel, technicalAsset), technicalAsset.MultiTenant, types.Confidential, types.Critical),
+			fmt.Sprintf("  - caller: technical asset %q (type: %v, technology: %v)", caller.Id, caller.Type, caller.Technologies.String()))
+
+		humanLinks := []*types.CommunicationLink{commLink}
+		if !caller.UsedAsClientByHuman {
+			explanation = append(explanation, fmt.Sprintf("    - forwards traffic (has %q) of human users", types.IsTrafficForwarding))
+			humanLinks = make([]*types.CommunicationLink, 0)
+			for _, callersCommLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[caller.Id] {
+				callersCaller := parsedModel.TechnicalAssets[callersCommLink.SourceId]
+				moreRisky := parsedModel.HighestCommunicationLinkConfidentiality(callersCommLink) >= types.Confidential ||
+					parsedModel.HighestCommunicationLinkIntegrity(callersCommLink) >= types.Critical
+				if callersCaller.UsedAsClientByHuman && callersCaller.Type != types.Datastore &&
+					!callersCaller.Technologies.GetAttribute(types.IsUnprotectedCommunicationsTolerated) && moreRisky && callersCommLink.Authentication != types.TwoFactor {
+					humanLinks = append(humanLinks, callersCommLink)
+				}
+			}
+		}
+
+		for _, humanLink := range humanLinks {
+			explanation = append(explanation,
+				fmt.Sprintf("  - communication link %q from technical asset %q used as client by human", humanLink.Id, humanLink.SourceId),
+				fmt.Sprintf("    - authentication: %v (not %v)", humanLink.Authentication, types.TwoFactor),
+				fmt.Sprintf("    - highest confidentiality: %v, integrity: %v (at least %v or %v)",
+					parsedModel.HighestCommunicationLinkConfidentiality(humanLink), parsedModel.HighestCommunicationLinkIntegrity(humanLink), types.Confidential, types.Critical))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+	}
+
+	return explanation
+}
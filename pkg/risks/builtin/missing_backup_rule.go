@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingBackupRule struct{}
+
+func NewMissingBackupRule() *MissingBackupRule {
+	return &MissingBackupRule{}
+}
+
+func (*MissingBackupRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-backup",
+		Title: "Missing Backup",
+		Description: "Data stores with a critical or mission-critical availability rating should be backed up regularly and " +
+			"be part of a disaster recovery plan. When no backup storage is modeled for such data stores, the data might be lost permanently.",
+		Impact: "If this risk is unmitigated, attackers (for example via ransomware) or operational failures might cause a " +
+			"permanent loss of the data stored.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Database_Security_Cheat_Sheet.html",
+		Action:     "Backup and Disaster Recovery",
+		Mitigation: "Regularly back up the data stores to a separate backup storage, test the restore procedures, " +
+			"and define recovery time and recovery point objectives as part of a disaster recovery plan.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.DenialOfService,
+		DetectionLogic: "In-scope data stores with an availability rating of 'critical' or higher having neither incoming nor outgoing communication links " +
+			"from or to technical assets with backup storage technology or tagged with 'backup'.",
+		RiskAssessment: "The risk rating depends on the availability rating of the data store.",
+		FalsePositives: "Data stores backed up by means not modeled as communication links (like storage snapshots of the cloud provider) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        693,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*MissingBackupRule) SupportedTags() []string {
+	return []string{"backup"}
+}
+
+func (r *MissingBackupRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) || isBackedUp(parsedModel, techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset))
+	}
+	return risks, nil
+}
+
+func (r *MissingBackupRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || techAsset.Type != types.Datastore || techAsset.Availability < types.Critical || isBackupAsset(techAsset)
+}
+
+func (r *MissingBackupRule) createRisk(techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if techAsset.Availability == types.MissionCritical {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Missing Backup</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MissingBackupRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) || isBackedUp(parsedModel, techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - type: %v (=%v)", techAsset.Type, types.Datastore),
+			fmt.Sprintf("  - availability: %v (>=%v)", techAsset.Availability, types.Critical),
+			fmt.Sprintf("  - no communication link from or to a technical asset with technology %q or tagged with %q", types.BackupStorage, "backup"),
+		}...)
+
+		if techAsset.Availability == types.MissionCritical {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the availability is %v", types.HighImpact, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
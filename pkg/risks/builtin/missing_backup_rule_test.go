@@ -0,0 +1,119 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingBackupRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingBackupRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingBackupRuleTest struct {
+	assetType              types.TechnicalAssetType
+	availability           types.Criticality
+	backupTechnology       bool
+	backupTag              bool
+	backupPullsFromDB      bool
+	databasePushesToBackup bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestMissingBackupRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingBackupRuleTest{
+		"not a data store": {
+			assetType:    types.Process,
+			availability: types.MissionCritical,
+			riskCreated:  false,
+		},
+		"availability not critical": {
+			assetType:    types.Datastore,
+			availability: types.Important,
+			riskCreated:  false,
+		},
+		"backed up to backup storage": {
+			assetType:              types.Datastore,
+			availability:           types.MissionCritical,
+			backupTechnology:       true,
+			databasePushesToBackup: true,
+			riskCreated:            false,
+		},
+		"backed up by tagged asset": {
+			assetType:         types.Datastore,
+			availability:      types.Critical,
+			backupTag:         true,
+			backupPullsFromDB: true,
+			riskCreated:       false,
+		},
+		"critical data store without backup": {
+			assetType:           types.Datastore,
+			availability:        types.Critical,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"mission-critical data store without backup": {
+			assetType:           types.Datastore,
+			availability:        types.MissionCritical,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the availability is mission-critical",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingBackupRule()
+			db := &types.TechnicalAsset{
+				Id:           "db",
+				Title:        "Database",
+				Type:         testCase.assetType,
+				Availability: testCase.availability,
+			}
+			backup := &types.TechnicalAsset{Id: "backup"}
+			if testCase.backupTechnology {
+				backup.Technologies = types.TechnologyList{{Name: types.BackupStorage, Attributes: map[string]bool{types.BackupStorage: true}}}
+			}
+			if testCase.backupTag {
+				backup.Tags = []string{"backup"}
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{"db": db, "backup": backup},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{},
+			}
+			if testCase.databasePushesToBackup {
+				commLink := &types.CommunicationLink{Id: "db>backup", SourceId: "db", TargetId: "backup"}
+				db.CommunicationLinks = []*types.CommunicationLink{commLink}
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["backup"] = []*types.CommunicationLink{commLink}
+			}
+			if testCase.backupPullsFromDB {
+				commLink := &types.CommunicationLink{Id: "backup>db", SourceId: "backup", TargetId: "db"}
+				backup.CommunicationLinks = []*types.CommunicationLink{commLink}
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["db"] = []*types.CommunicationLink{commLink}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Backup</b> risk at <b>Database</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
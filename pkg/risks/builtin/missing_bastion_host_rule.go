@@ -0,0 +1,156 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingBastionHostRule struct{}
+
+func NewMissingBastionHostRule() *MissingBastionHostRule {
+	return &MissingBastionHostRule{}
+}
+
+func (*MissingBastionHostRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-bastion-host",
+		Title: "Missing Bastion Host",
+		Description: "Administrative clients directly accessing data stores or servers across network trust boundaries bypass a bastion host (jump server), " +
+			"which would otherwise centralize, harden, and audit privileged access.",
+		Impact: "If this risk is unmitigated, malicious insiders or attackers having stolen administrative credentials or compromised an admin workstation " +
+			"might be able to directly access the data stores and servers without any central control or audit trail.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Network_Segmentation_Cheat_Sheet.html",
+		Action:     "Bastion Host",
+		Mitigation: "Route all administrative access to data stores and servers via a hardened bastion host (jump server) " +
+			"with strong authentication, session recording, and just-in-time access.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "Communication links from human clients tagged with 'admin' or with DevOps client technology to in-scope data stores or processes " +
+			"across a network trust boundary, where the target is not tagged with 'bastion' or 'jump-host'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity ratings of the data processed by the target.",
+		FalsePositives: "Administrative access protected by equivalent controls (like a privileged access management solution not modeled as technical asset) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        284,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*MissingBastionHostRule) SupportedTags() []string {
+	return []string{"admin", "bastion", "jump-host"}
+}
+
+func (r *MissingBastionHostRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isDirectAdminAccess(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *MissingBastionHostRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	if !techAsset.UsedAsClientByHuman {
+		return true
+	}
+	return !techAsset.IsTaggedWithAny("admin") && !techAsset.Technologies.GetAttribute(types.DevOpsClient)
+}
+
+func (r *MissingBastionHostRule) isDirectAdminAccess(parsedModel *types.Model, commLink *types.CommunicationLink, targetAsset *types.TechnicalAsset) bool {
+	if targetAsset.OutOfScope || targetAsset.IsTaggedWithAny("bastion", "jump-host") {
+		return false
+	}
+	if targetAsset.Type != types.Datastore && targetAsset.Type != types.Process {
+		return false
+	}
+	return isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink)
+}
+
+func (r *MissingBastionHostRule) impact(parsedModel *types.Model, targetAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(targetAsset) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(targetAsset) == types.MissionCritical {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *MissingBastionHostRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	impact := r.impact(parsedModel, targetAsset)
+	title := "<b>Missing Bastion Host</b> risk at <b>" + targetAsset.Title + "</b> directly accessed by <b>" + techAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Confidence:                      types.MediumConfidence,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *MissingBastionHostRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isDirectAdminAccess(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				"  - crosses a network trust boundary",
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - used as client by human: %v (=true)", techAsset.UsedAsClientByHuman),
+				fmt.Sprintf("    - technology: %v, tags: %v (has %q or tag %q)", techAsset.Technologies.String(), techAsset.Tags, types.DevOpsClient, "admin"),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=false)", targetAsset.OutOfScope),
+				fmt.Sprintf("    - type: %v (is either [%v, %v])", targetAsset.Type, types.Datastore, types.Process),
+				fmt.Sprintf("    - tags: %v (has neither [%q, %q])", targetAsset.Tags, "bastion", "jump-host"),
+			}...)
+
+			if impact := r.impact(parsedModel, targetAsset); impact == types.HighImpact {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because the target processes %v or %v data", impact, types.StrictlyConfidential, types.MissionCritical))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+			}
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,135 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingBastionHostRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingBastionHostRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingBastionHostRuleTest struct {
+	usedByHuman       bool
+	sourceTechnology  string
+	sourceTags        []string
+	targetType        types.TechnicalAssetType
+	targetTags        []string
+	sameTrustBoundary bool
+	confidentiality   types.Confidentiality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestMissingBastionHostRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingBastionHostRuleTest{
+		"not used by human": {
+			sourceTags:  []string{"admin"},
+			targetType:  types.Datastore,
+			riskCreated: false,
+		},
+		"not an admin client": {
+			usedByHuman: true,
+			targetType:  types.Datastore,
+			riskCreated: false,
+		},
+		"target is bastion host": {
+			usedByHuman: true,
+			sourceTags:  []string{"admin"},
+			targetType:  types.Process,
+			targetTags:  []string{"bastion"},
+			riskCreated: false,
+		},
+		"target is external entity": {
+			usedByHuman: true,
+			sourceTags:  []string{"admin"},
+			targetType:  types.ExternalEntity,
+			riskCreated: false,
+		},
+		"same trust boundary": {
+			usedByHuman:       true,
+			sourceTags:        []string{"admin"},
+			targetType:        types.Datastore,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"admin tag accessing server": {
+			usedByHuman:         true,
+			sourceTags:          []string{"admin"},
+			targetType:          types.Process,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"devops client accessing strictly confidential data store": {
+			usedByHuman:         true,
+			sourceTechnology:    types.DevOpsClient,
+			targetType:          types.Datastore,
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the target processes strictly-confidential or mission-critical data",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingBastionHostRule()
+			commLink := &types.CommunicationLink{Id: "admin>db", Title: "Maintenance", SourceId: "admin", TargetId: "db"}
+			adminBoundary := &types.TrustBoundary{Id: "office", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"admin"}}
+			dbBoundary := &types.TrustBoundary{Id: "cloud", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"db"}}
+			if testCase.sameTrustBoundary {
+				adminBoundary = dbBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"admin": {
+						Id:                  "admin",
+						Title:               "Admin Client",
+						UsedAsClientByHuman: testCase.usedByHuman,
+						Tags:                testCase.sourceTags,
+						Technologies:        types.TechnologyList{{Name: testCase.sourceTechnology, Attributes: map[string]bool{testCase.sourceTechnology: true}}},
+						CommunicationLinks:  []*types.CommunicationLink{commLink},
+					},
+					"db": {
+						Id:              "db",
+						Title:           "Database",
+						Type:            testCase.targetType,
+						Tags:            testCase.targetTags,
+						Confidentiality: testCase.confidentiality,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					adminBoundary.Id: adminBoundary,
+					dbBoundary.Id:    dbBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"admin": adminBoundary,
+					"db":    dbBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Bastion Host</b> risk at <b>Database</b> directly accessed by <b>Admin Client</b> via <b>Maintenance</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*MissingBuildInfrastructureRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1127,
+		Labels:                     []string{"supply-chain", "model"},
 	}
 }
 
@@ -93,6 +95,7 @@ func (r *MissingBuildInfrastructureRule) createRisk(technicalAsset *types.Techni
 		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
 		ExploitationLikelihood:       types.Unlikely,
 		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
 		Title:                        title,
 		MostRelevantTechnicalAssetId: technicalAsset.Id,
 		DataBreachProbability:        types.Improbable,
@@ -101,3 +104,26 @@ func (r *MissingBuildInfrastructureRule) createRisk(technicalAsset *types.Techni
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingBuildInfrastructureRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		found := make(map[string]bool)
+		for _, techAsset := range parsedModel.TechnicalAssets {
+			for _, attribute := range []string{types.BuildPipeline, types.SourcecodeRepository, types.DevOpsClient} {
+				found[attribute] = found[attribute] || techAsset.Technologies.GetAttribute(attribute)
+			}
+		}
+
+		explanation = appendExplanation(explanation,
+			"model",
+			"  - has in-scope technical assets with custom developed parts",
+			fmt.Sprintf("  - has technical assets with technology %q: %v, %q: %v, %q: %v (not all of them)",
+				types.BuildPipeline, found[types.BuildPipeline], types.SourcecodeRepository, found[types.SourcecodeRepository], types.DevOpsClient, found[types.DevOpsClient]),
+			fmt.Sprintf("  - technical asset %q is referenced as an example", generatedRisk.MostRelevantTechnicalAssetId),
+			explainedImpact(generatedRisk, types.LowImpact,
+				fmt.Sprintf("a technical asset with custom developed parts processes %v or higher confidentiality or %v or higher integrity or availability", types.Confidential, types.Critical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingCentralSecretsManagementRule struct{}
+
+func NewMissingCentralSecretsManagementRule() *MissingCentralSecretsManagementRule {
+	return &MissingCentralSecretsManagementRule{}
+}
+
+func (*MissingCentralSecretsManagementRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-central-secrets-management",
+		Title: "Missing Central Secrets Management",
+		Description: "Trust boundaries containing multiple technical assets processing credentials or other secrets without any secrets manager (vault) in the model " +
+			"most likely have their secrets spread over config files, environment variables, and deployment scripts.",
+		Impact: "If this risk is unmitigated, secrets might be leaked via any of the places they are spread over, " +
+			"and rotating compromised secrets consistently across all technical assets will be slow and error-prone.",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Secrets_Management_Cheat_Sheet.html",
+		Action:     "Secrets Management",
+		Mitigation: "Introduce a central secrets manager (vault) from which the technical assets retrieve their secrets at runtime, " +
+			"with audit logging and automated rotation.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "Models without any technical asset of technology '" + types.Vault + "': trust boundaries directly containing at least two in-scope " +
+			"technical assets processing data assets tagged with 'credentials' or 'secrets'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the secret data assets processed within the trust boundary.",
+		FalsePositives: "Models where the secrets management is provided by the platform without being modeled (like a cloud provider secrets service) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        522,
+		Labels:                     []string{"data", "operations"},
+	}
+}
+
+func (*MissingCentralSecretsManagementRule) SupportedTags() []string {
+	return []string{"credentials", "secrets"}
+}
+
+func (r *MissingCentralSecretsManagementRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	if r.hasVault(parsedModel) {
+		return risks, nil
+	}
+
+	for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+		trustBoundary := parsedModel.TrustBoundaries[id]
+		assets := r.assetsProcessingSecrets(parsedModel, trustBoundary)
+		if len(assets) < 2 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, trustBoundary, assets))
+	}
+	return risks, nil
+}
+
+func (r *MissingCentralSecretsManagementRule) hasVault(parsedModel *types.Model) bool {
+	for _, techAsset := range parsedModel.TechnicalAssets {
+		if techAsset.Technologies.GetAttribute(types.Vault) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MissingCentralSecretsManagementRule) secretDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.DataAsset {
+	result := make([]*types.DataAsset, 0)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		if dataAsset.IsTaggedWithAny(r.SupportedTags()...) {
+			result = append(result, dataAsset)
+		}
+	}
+	return result
+}
+
+// assetsProcessingSecrets returns the sorted ids of the in-scope technical assets directly inside the trust boundary
+// which process secret data assets
+func (r *MissingCentralSecretsManagementRule) assetsProcessingSecrets(parsedModel *types.Model, trustBoundary *types.TrustBoundary) []string {
+	result := make([]string, 0)
+	for _, id := range trustBoundary.TechnicalAssetsInside {
+		techAsset, ok := parsedModel.TechnicalAssets[id]
+		if !ok || techAsset.OutOfScope || contains(result, id) {
+			continue
+		}
+		if len(r.secretDataAssets(parsedModel, techAsset)) > 0 {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *MissingCentralSecretsManagementRule) impact(parsedModel *types.Model, assets []string) types.RiskExploitationImpact {
+	for _, id := range assets {
+		for _, dataAsset := range r.secretDataAssets(parsedModel, parsedModel.TechnicalAssets[id]) {
+			if dataAsset.Confidentiality == types.StrictlyConfidential {
+				return types.HighImpact
+			}
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *MissingCentralSecretsManagementRule) createRisk(parsedModel *types.Model, trustBoundary *types.TrustBoundary, assets []string) *types.Risk {
+	impact := r.impact(parsedModel, assets)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Missing Central Secrets Management</b> risk at trust boundary <b>" + trustBoundary.Title + "</b>",
+		MostRelevantTechnicalAssetId: assets[0],
+		MostRelevantTrustBoundaryId:  trustBoundary.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  assets,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + trustBoundary.Id
+	return risk
+}
+
+func (r *MissingCentralSecretsManagementRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	if r.hasVault(parsedModel) {
+		return explanation
+	}
+
+	for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+		trustBoundary := parsedModel.TrustBoundaries[id]
+		if !strings.EqualFold(risk, categoryId+"@"+trustBoundary.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		assets := r.assetsProcessingSecrets(parsedModel, trustBoundary)
+		if len(assets) < 2 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("trust boundary %q", trustBoundary.Id),
+			fmt.Sprintf("  - no technical asset with technology %q in the model", types.Vault),
+		}...)
+
+		for _, assetId := range assets {
+			secrets := make([]string, 0)
+			for _, dataAsset := range r.secretDataAssets(parsedModel, parsedModel.TechnicalAssets[assetId]) {
+				secrets = append(secrets, dataAsset.Id)
+			}
+			sort.Strings(secrets)
+			explanation = append(explanation, fmt.Sprintf("  - technical asset %q processes secret data assets %q", assetId, secrets))
+		}
+
+		if impact := r.impact(parsedModel, assets); impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v secrets are processed", impact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,101 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingCentralSecretsManagementRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingCentralSecretsManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingCentralSecretsManagementRuleTest struct {
+	secretTags          []string
+	confidentiality     types.Confidentiality
+	secondAssetOutScope bool
+	vaultInModel        bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestMissingCentralSecretsManagementRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingCentralSecretsManagementRuleTest{
+		"no secrets": {
+			secretTags:  []string{"pii"},
+			riskCreated: false,
+		},
+		"vault in model": {
+			secretTags:   []string{"credentials"},
+			vaultInModel: true,
+			riskCreated:  false,
+		},
+		"only one asset in scope": {
+			secretTags:          []string{"credentials"},
+			secondAssetOutScope: true,
+			riskCreated:         false,
+		},
+		"multiple assets processing credentials": {
+			secretTags:          []string{"credentials"},
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "  - technical asset \"app-2\" processes secret data assets [\"secret\"]",
+		},
+		"multiple assets processing strictly confidential secrets": {
+			secretTags:          []string{"secrets"},
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because strictly-confidential secrets are processed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingCentralSecretsManagementRule()
+			trustBoundary := &types.TrustBoundary{Id: "network", Title: "Network", TechnicalAssetsInside: []string{"app-1", "app-2"}}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app-1": {Id: "app-1", DataAssetsProcessed: []string{"secret"}},
+					"app-2": {Id: "app-2", DataAssetsProcessed: []string{"secret"}, OutOfScope: testCase.secondAssetOutScope},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"secret": {Id: "secret", Tags: testCase.secretTags, Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"network": trustBoundary,
+				},
+			}
+			if testCase.vaultInModel {
+				model.TechnicalAssets["vault"] = &types.TechnicalAsset{
+					Id:           "vault",
+					Technologies: types.TechnologyList{{Name: types.Vault, Attributes: map[string]bool{types.Vault: true}}},
+				}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Central Secrets Management</b> risk at trust boundary <b>Network</b>", risks[0].Title)
+				assert.Equal(t, "network", risks[0].MostRelevantTrustBoundaryId)
+				assert.Equal(t, []string{"app-1", "app-2"}, risks[0].DataBreachTechnicalAssetIDs)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingCertificateLifecycleManagementRule struct{}
+
+func NewMissingCertificateLifecycleManagementRule() *MissingCertificateLifecycleManagementRule {
+	return &MissingCertificateLifecycleManagementRule{}
+}
+
+func (*MissingCertificateLifecycleManagementRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-certificate-lifecycle-management",
+		Title: "Missing Certificate Lifecycle Management",
+		Description: "TLS-terminating technical assets (like load balancers, gateways, or web servers) with manually managed certificates " +
+			"risk outages due to expired certificates, as renewal depends on someone remembering to do it in time.",
+		Impact: "If this risk is unmitigated, expired certificates might render the services unavailable to their clients, " +
+			"or lead to clients being configured to ignore certificate errors as a workaround.",
+		ASVS:       "V9 - Communication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Transport_Layer_Security_Cheat_Sheet.html",
+		Action:     "Certificate Lifecycle Management",
+		Mitigation: "Automate the issuance and renewal of certificates (like via ACME or a certificate manager of the platform) " +
+			"and monitor the expiry dates of all certificates in use.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.DenialOfService,
+		DetectionLogic: "In-scope technical assets of technology '" + types.LoadBalancer + "', '" + types.Gateway + "', or '" + types.WebServer + "' " +
+			"tagged with 'manual-certs' with encrypted incoming communication links, which have no communication link from or to " +
+			"a technical asset tagged with 'certificate-management'.",
+		RiskAssessment: "The risk rating depends on the highest availability rating of the data processed.",
+		FalsePositives: "Technical assets with certificates of very long validity monitored by other means " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        324,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*MissingCertificateLifecycleManagementRule) SupportedTags() []string {
+	return []string{"manual-certs", "certificate-management"}
+}
+
+func (r *MissingCertificateLifecycleManagementRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *MissingCertificateLifecycleManagementRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || !techAsset.IsTaggedWithAny("manual-certs") {
+		return true
+	}
+	if !techAsset.Technologies.GetAttribute(types.LoadBalancer) && !techAsset.Technologies.GetAttribute(types.Gateway) && !techAsset.Technologies.GetAttribute(types.WebServer) {
+		return true
+	}
+	return !r.isTerminatingTLS(parsedModel, techAsset) || r.hasCertificateManagement(parsedModel, techAsset)
+}
+
+func (r *MissingCertificateLifecycleManagementRule) isTerminatingTLS(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Protocol.IsEncrypted() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCertificateManagement checks whether the technical asset communicates with a certificate management asset in either direction
+func (r *MissingCertificateLifecycleManagementRule) hasCertificateManagement(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, commLink := range techAsset.CommunicationLinks {
+		if targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && targetAsset.IsTaggedWithAny("certificate-management") {
+			return true
+		}
+	}
+	for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if sourceAsset, ok := parsedModel.TechnicalAssets[commLink.SourceId]; ok && sourceAsset.IsTaggedWithAny("certificate-management") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MissingCertificateLifecycleManagementRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	switch parsedModel.HighestProcessedAvailability(techAsset) {
+	case types.MissionCritical:
+		return types.HighImpact
+	case types.Critical:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *MissingCertificateLifecycleManagementRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Missing Certificate Lifecycle Management</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MissingCertificateLifecycleManagementRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has either [%q, %q, %q])", techAsset.Technologies.String(), types.LoadBalancer, types.Gateway, types.WebServer),
+			fmt.Sprintf("  - tags: %v (has %q)", techAsset.Tags, "manual-certs"),
+			"  - has encrypted incoming communication links (terminates TLS)",
+			fmt.Sprintf("  - no communication link from or to a technical asset tagged with %q", "certificate-management"),
+			fmt.Sprintf("    - impact is %v because of highest availability %v", r.impact(parsedModel, techAsset), parsedModel.HighestProcessedAvailability(techAsset)),
+		}...)
+	}
+
+	return explanation
+}
@@ -0,0 +1,125 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingCertificateLifecycleManagementRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingCertificateLifecycleManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingCertificateLifecycleManagementRuleTest struct {
+	technology             string
+	tags                   []string
+	protocol               types.Protocol
+	availability           types.Criticality
+	certificateManagement  bool
+	certificateManagerTags []string
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestMissingCertificateLifecycleManagementRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingCertificateLifecycleManagementRuleTest{
+		"not tls terminating technology": {
+			technology:  types.Database,
+			tags:        []string{"manual-certs"},
+			protocol:    types.HTTPS,
+			riskCreated: false,
+		},
+		"certificates not managed manually": {
+			technology:  types.LoadBalancer,
+			protocol:    types.HTTPS,
+			riskCreated: false,
+		},
+		"no tls terminated": {
+			technology:  types.WebServer,
+			tags:        []string{"manual-certs"},
+			protocol:    types.HTTP,
+			riskCreated: false,
+		},
+		"related to certificate management": {
+			technology:             types.Gateway,
+			tags:                   []string{"manual-certs"},
+			protocol:               types.HTTPS,
+			certificateManagement:  true,
+			certificateManagerTags: []string{"certificate-management"},
+			riskCreated:            false,
+		},
+		"related to other asset": {
+			technology:             types.Gateway,
+			tags:                   []string{"manual-certs"},
+			protocol:               types.HTTPS,
+			availability:           types.Critical,
+			certificateManagement:  true,
+			certificateManagerTags: []string{"monitoring"},
+			riskCreated:            true,
+			expectedImpact:         types.MediumImpact,
+		},
+		"low availability load balancer": {
+			technology:     types.LoadBalancer,
+			tags:           []string{"manual-certs"},
+			protocol:       types.HTTPS,
+			availability:   types.Operational,
+			riskCreated:    true,
+			expectedImpact: types.LowImpact,
+		},
+		"mission critical web server": {
+			technology:     types.WebServer,
+			tags:           []string{"manual-certs"},
+			protocol:       types.WSS,
+			availability:   types.MissionCritical,
+			riskCreated:    true,
+			expectedImpact: types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingCertificateLifecycleManagementRule()
+			incomingLink := &types.CommunicationLink{Id: "client>proxy", SourceId: "client", TargetId: "proxy", Protocol: testCase.protocol}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {Id: "client", CommunicationLinks: []*types.CommunicationLink{incomingLink}},
+					"proxy": {
+						Id:           "proxy",
+						Title:        "Proxy",
+						Tags:         testCase.tags,
+						Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						Availability: testCase.availability,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"proxy": {incomingLink},
+				},
+			}
+			if testCase.certificateManagement {
+				link := &types.CommunicationLink{Id: "proxy>cert-manager", SourceId: "proxy", TargetId: "cert-manager", Protocol: types.HTTPS}
+				model.TechnicalAssets["proxy"].CommunicationLinks = []*types.CommunicationLink{link}
+				model.TechnicalAssets["cert-manager"] = &types.TechnicalAsset{Id: "cert-manager", Tags: testCase.certificateManagerTags}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Certificate Lifecycle Management</b> risk at <b>Proxy</b>", risks[0].Title)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package builtin
 
 import (
+	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -41,6 +43,7 @@ func (*MissingCloudHardeningRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"cloud"},
 	}
 }
 
@@ -289,7 +292,7 @@ func isTechnicalAssetTaggedWithAnyTraversingUp(model *types.Model, ta *types.Tec
 	if containsCaseInsensitiveAny(ta.Tags, tags...) {
 		return true
 	}
-	tbID := model.GetTechnicalAssetTrustBoundaryId(ta)
+	tbID := model.AnalysisContext().TrustBoundaryId(ta.Id)
 	if len(tbID) > 0 {
 		if isTrustedBoundaryTaggedWithAnyTraversingUp(model, model.TrustBoundaries[tbID], tags...) {
 			return true
@@ -307,7 +310,7 @@ func isTrustedBoundaryTaggedWithAnyTraversingUp(model *types.Model, tb *types.Tr
 	if tb.IsTaggedWithAny(tags...) {
 		return true
 	}
-	parentTb := model.FindParentTrustBoundary(tb)
+	parentTb := model.AnalysisContext().ParentTrustBoundary(tb)
 	if parentTb != nil && isTrustedBoundaryTaggedWithAnyTraversingUp(model, parentTb, tags...) {
 		return true
 	}
@@ -518,3 +521,49 @@ func (r *MissingCloudHardeningRule) createRiskForTechnicalAsset(parsedModel *typ
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id + id
 	return risk
 }
+
+func (r *MissingCloudHardeningRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		var header, tags, sensitivity string
+		switch {
+		case len(generatedRisk.MostRelevantSharedRuntimeId) > 0:
+			sharedRuntime := parsedModel.SharedRuntimes[generatedRisk.MostRelevantSharedRuntimeId]
+			header = fmt.Sprintf("shared runtime %q", sharedRuntime.Id)
+			tags = fmt.Sprintf("  - tags: %v", sharedRuntime.Tags)
+			sensitivity = fmt.Sprintf("  - highest confidentiality %v, integrity %v, availability %v of the technical assets running",
+				parsedModel.FindSharedRuntimeHighestConfidentiality(sharedRuntime), parsedModel.FindSharedRuntimeHighestIntegrity(sharedRuntime), parsedModel.FindSharedRuntimeHighestAvailability(sharedRuntime))
+		case len(generatedRisk.MostRelevantTrustBoundaryId) > 0:
+			trustBoundary := parsedModel.TrustBoundaries[generatedRisk.MostRelevantTrustBoundaryId]
+			header = fmt.Sprintf("trust boundary %q", trustBoundary.Id)
+			tags = fmt.Sprintf("  - type: %v (within cloud: %v), tags: %v", trustBoundary.Type, trustBoundary.Type.IsWithinCloud(), trustBoundary.Tags)
+			sensitivity = fmt.Sprintf("  - highest confidentiality %v, integrity %v, availability %v of the technical assets inside",
+				parsedModel.FindTrustBoundaryHighestConfidentiality(trustBoundary), parsedModel.FindTrustBoundaryHighestIntegrity(trustBoundary), parsedModel.FindTrustBoundaryHighestAvailability(trustBoundary))
+		default:
+			technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+			header = fmt.Sprintf("technical asset %q", technicalAsset.Id)
+			tags = fmt.Sprintf("  - tags: %v (including the tags of the trust boundaries and shared runtimes containing it)", technicalAsset.Tags)
+			sensitivity = "  - " + processedSensitivity(parsedModel, technicalAsset)
+		}
+
+		explanation = appendExplanation(explanation, header, tags)
+		if index := strings.LastIndex(generatedRisk.SyntheticId, "@"); index > len(generatedRisk.CategoryId) &&
+			slices.Contains([]string{"aws", "azure", "gcp", "ocp", "ec2", "s3"}, generatedRisk.SyntheticId[index+1:]) {
+			explanation = append(explanation, fmt.Sprintf("  - cloud specific risk for %q (tagged with one of %v)", generatedRisk.SyntheticId[index+1:], r.SupportedTags()))
+		} else {
+			explanation = append(explanation, "  - generic cloud risk (within a cloud trust boundary, not tagged for a specific cloud provider)")
+		}
+
+		explanation = append(explanation, sensitivity)
+		switch generatedRisk.ExploitationImpact {
+		case types.VeryHighImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the rating is %v or %v", types.VeryHighImpact, types.StrictlyConfidential, types.MissionCritical))
+		case types.HighImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the rating is at least %v or %v", types.HighImpact, types.Confidential, types.Critical))
+		default:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,156 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingEgressFilteringRule struct{}
+
+func NewMissingEgressFilteringRule() *MissingEgressFilteringRule {
+	return &MissingEgressFilteringRule{}
+}
+
+func (*MissingEgressFilteringRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-egress-filtering",
+		Title: "Missing Egress Filtering",
+		Description: "Production environments allowing unrestricted outbound internet connectivity can be abused for data exfiltration " +
+			"or command-and-control (C2) communication once an asset inside them is compromised.",
+		Impact: "If this risk is unmitigated, attackers who compromised an asset within the production environment might " +
+			"exfiltrate sensitive data or remotely control the compromised asset via outbound connections.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Network_Segmentation_Cheat_Sheet.html",
+		Action:     "Egress Filtering",
+		Mitigation: "Restrict outbound connectivity of production environments with an egress firewall or a NAT gateway using an allowlist " +
+			"of permitted destinations.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.InformationDisclosure,
+		DetectionLogic: "Trust boundaries tagged with 'production' containing technical assets with outgoing communication links to technical assets on the public network (either internet-facing or within a trust boundary tagged with 'public-network') where the production trust boundary is not tagged with 'egress-firewall' or 'nat-with-allowlist'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data processed within the production trust boundary.",
+		FalsePositives: "Production environments with egress filtering applied on a different layer not reflected in the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        923,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*MissingEgressFilteringRule) SupportedTags() []string {
+	return []string{"production", "egress-firewall", "nat-with-allowlist", "public-network"}
+}
+
+func (r *MissingEgressFilteringRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+		trustBoundary := parsedModel.TrustBoundaries[id]
+		if r.skipTrustBoundary(trustBoundary) {
+			continue
+		}
+
+		outboundLinks := r.outboundPublicLinks(parsedModel, trustBoundary)
+		if len(outboundLinks) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, trustBoundary, outboundLinks))
+	}
+	return risks, nil
+}
+
+func (r *MissingEgressFilteringRule) skipTrustBoundary(trustBoundary *types.TrustBoundary) bool {
+	return !trustBoundary.IsTaggedWithAny("production") || trustBoundary.IsTaggedWithAny("egress-firewall", "nat-with-allowlist")
+}
+
+func (r *MissingEgressFilteringRule) outboundPublicLinks(parsedModel *types.Model, trustBoundary *types.TrustBoundary) []*types.CommunicationLink {
+	assetIDsInside := parsedModel.RecursivelyAllTechnicalAssetIDsInside(trustBoundary)
+	sort.Strings(assetIDsInside)
+
+	result := make([]*types.CommunicationLink, 0)
+	for _, assetId := range assetIDsInside {
+		techAsset, ok := parsedModel.TechnicalAssets[assetId]
+		if !ok {
+			continue
+		}
+		for _, outgoingLink := range techAsset.CommunicationLinksSorted() {
+			if contains(assetIDsInside, outgoingLink.TargetId) {
+				continue
+			}
+			if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[outgoingLink.TargetId]) {
+				result = append(result, outgoingLink)
+			}
+		}
+	}
+	return result
+}
+
+func (r *MissingEgressFilteringRule) createRisk(parsedModel *types.Model, trustBoundary *types.TrustBoundary, outboundLinks []*types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if parsedModel.FindTrustBoundaryHighestConfidentiality(trustBoundary) == types.StrictlyConfidential {
+		impact = types.HighImpact
+	}
+	title := "<b>Missing Egress Filtering</b> risk at production trust boundary <b>" + trustBoundary.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Confidence:                      types.MediumConfidence,
+		Title:                           title,
+		MostRelevantTrustBoundaryId:     trustBoundary.Id,
+		MostRelevantCommunicationLinkId: outboundLinks[0].Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     parsedModel.RecursivelyAllTechnicalAssetIDsInside(trustBoundary),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + trustBoundary.Id
+	return risk
+}
+
+func (r *MissingEgressFilteringRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+		trustBoundary := parsedModel.TrustBoundaries[id]
+		if !strings.EqualFold(risk, categoryId+"@"+trustBoundary.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipTrustBoundary(trustBoundary) {
+			continue
+		}
+
+		outboundLinks := r.outboundPublicLinks(parsedModel, trustBoundary)
+		if len(outboundLinks) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("trust boundary %q", trustBoundary.Id),
+			fmt.Sprintf("  - tags: %v (has %q, has neither [%q, %q])", trustBoundary.Tags, "production", "egress-firewall", "nat-with-allowlist"),
+		}...)
+
+		for _, outgoingLink := range outboundLinks {
+			destination := "internet"
+			if targetBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[outgoingLink.TargetId]; ok {
+				destination = fmt.Sprintf("trust boundary %q", targetBoundary.Id)
+			}
+			explanation = append(explanation, fmt.Sprintf("  - outbound communication link %q from %q to %q in %v", outgoingLink.Id, outgoingLink.SourceId, outgoingLink.TargetId, destination))
+		}
+
+		if parsedModel.FindTrustBoundaryHighestConfidentiality(trustBoundary) == types.StrictlyConfidential {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is processed within the trust boundary", types.HighImpact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,139 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingEgressFilteringRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingEgressFilteringRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingEgressFilteringRuleTest struct {
+	boundaryTags          []string
+	targetInternet        bool
+	targetBoundaryTags    []string
+	processedConfidential types.Confidentiality
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestMissingEgressFilteringRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingEgressFilteringRuleTest{
+		"not production": {
+			boundaryTags:          []string{"staging"},
+			targetInternet:        true,
+			processedConfidential: types.Internal,
+			riskCreated:           false,
+		},
+		"egress firewall": {
+			boundaryTags:          []string{"production", "egress-firewall"},
+			targetInternet:        true,
+			processedConfidential: types.Internal,
+			riskCreated:           false,
+		},
+		"nat with allowlist": {
+			boundaryTags:          []string{"production", "nat-with-allowlist"},
+			targetInternet:        true,
+			processedConfidential: types.Internal,
+			riskCreated:           false,
+		},
+		"target not on public network": {
+			boundaryTags:          []string{"production"},
+			processedConfidential: types.Internal,
+			riskCreated:           false,
+		},
+		"target on internet": {
+			boundaryTags:          []string{"production"},
+			targetInternet:        true,
+			processedConfidential: types.Internal,
+			riskCreated:           true,
+			expectedImpact:        types.MediumImpact,
+		},
+		"target in public network trust boundary": {
+			boundaryTags:          []string{"production"},
+			targetBoundaryTags:    []string{"public-network"},
+			processedConfidential: types.Confidential,
+			riskCreated:           true,
+			expectedImpact:        types.MediumImpact,
+		},
+		"strictly confidential data in production": {
+			boundaryTags:          []string{"production"},
+			targetInternet:        true,
+			processedConfidential: types.StrictlyConfidential,
+			riskCreated:           true,
+			expectedImpact:        types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingEgressFilteringRule()
+			productionBoundary := &types.TrustBoundary{
+				Id:                    "prod",
+				Title:                 "Production",
+				Type:                  types.NetworkCloudProvider,
+				Tags:                  testCase.boundaryTags,
+				TechnicalAssetsInside: []string{"app"},
+			}
+			externalBoundary := &types.TrustBoundary{
+				Id:                    "external",
+				Title:                 "External",
+				Type:                  types.NetworkOnPrem,
+				Tags:                  testCase.targetBoundaryTags,
+				TechnicalAssetsInside: []string{"target"},
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:                  "app",
+						Title:               "App",
+						DataAssetsProcessed: []string{"data"},
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "app>target", SourceId: "app", TargetId: "target"},
+						},
+					},
+					"target": {
+						Id:       "target",
+						Title:    "Target",
+						Internet: testCase.targetInternet,
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.processedConfidential},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"prod":     productionBoundary,
+					"external": externalBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"app":    productionBoundary,
+					"target": externalBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Egress Filtering</b> risk at production trust boundary <b>Production</b>", risks[0].Title)
+				assert.Equal(t, "missing-egress-filtering@prod", risks[0].SyntheticId)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId),
+					`  - outbound communication link "app>target" from "app" to "target" in trust boundary "external"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
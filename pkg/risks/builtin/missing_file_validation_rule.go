@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -33,6 +34,7 @@ func (*MissingFileValidationRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        434,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -77,3 +79,19 @@ func (r *MissingFileValidationRule) createRisk(input *types.Model, technicalAsse
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingFileValidationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - custom developed parts: %v (=true)", technicalAsset.CustomDevelopedParts),
+			fmt.Sprintf("  - data formats accepted: %v (contains %v)", technicalAsset.DataFormatsAccepted, types.File),
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("%v data or %v integrity or availability is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
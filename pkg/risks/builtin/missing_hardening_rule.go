@@ -1,6 +1,8 @@
 package builtin
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/threagile/threagile/pkg/types"
@@ -15,6 +17,13 @@ func NewMissingHardeningRule() *MissingHardeningRule {
 	return &MissingHardeningRule{raaLimit: 55, raaLimitReduced: 40}
 }
 
+func (r *MissingHardeningRule) Configure(parameters *types.RiskRuleParameters) error {
+	return errors.Join(
+		parameters.Int("raa-limit", &r.raaLimit),
+		parameters.Int("raa-limit-reduced", &r.raaLimitReduced),
+	)
+}
+
 func (r *MissingHardeningRule) Category() *types.RiskCategory {
 	return &types.RiskCategory{
 		ID:    "missing-hardening",
@@ -36,6 +45,7 @@ func (r *MissingHardeningRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives.",
 		ModelFailurePossibleReason: false,
 		CWE:                        16,
+		Labels:                     []string{"operations"},
 	}
 }
 
@@ -43,6 +53,10 @@ func (*MissingHardeningRule) SupportedTags() []string {
 	return []string{"tomcat"}
 }
 
+func (*MissingHardeningRule) IsAssetScoped() bool {
+	return true
+}
+
 func (r *MissingHardeningRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
 	risks := make([]*types.Risk, 0)
 	for _, id := range input.SortedTechnicalAssetIDs() {
@@ -62,7 +76,7 @@ func (r *MissingHardeningRule) GenerateRisks(input *types.Model) ([]*types.Risk,
 func (r *MissingHardeningRule) createRisk(input *types.Model, technicalAsset *types.TechnicalAsset) *types.Risk {
 	title := "<b>Missing Hardening</b> risk at <b>" + technicalAsset.Title + "</b>"
 	impact := types.LowImpact
-	if input.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || input.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical {
+	if input.AnalysisContext().IsHighlySensitive(technicalAsset) {
 		impact = types.MediumImpact
 	}
 	risk := &types.Risk{
@@ -78,3 +92,25 @@ func (r *MissingHardeningRule) createRisk(input *types.Model, technicalAsset *ty
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingHardeningRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope))
+		if technicalAsset.RAA >= float64(r.raaLimit) {
+			explanation = append(explanation, fmt.Sprintf("  - RAA: %.2f%% (>=%v%%)", technicalAsset.RAA, r.raaLimit))
+		} else {
+			explanation = append(explanation,
+				fmt.Sprintf("  - RAA: %.2f%% (>=%v%% for data stores and high value targets)", technicalAsset.RAA, r.raaLimitReduced),
+				fmt.Sprintf("  - type: %v, technology: %v (is %v or has %q)", technicalAsset.Type, technicalAsset.Technologies.String(), types.Datastore, types.IsHighValueTarget))
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("%v data or %v integrity is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -171,3 +171,38 @@ func TestMissingHardeningRuleRisksCreated(t *testing.T) {
 		})
 	}
 }
+
+func TestMissingHardeningRuleConfiguredRaaLimits(t *testing.T) {
+	rule := NewMissingHardeningRule()
+	err := rule.Configure(types.NewRiskRuleParameters(map[string]any{"raa-limit": 80, "raa-limit-reduced": 70}))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"ta1": {
+				Id:    "ta1",
+				Title: "Test Technical Asset",
+				Type:  types.Datastore,
+				RAA:   60,
+				Technologies: types.TechnologyList{
+					{
+						Name:       "some-technology",
+						Attributes: map[string]bool{},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+	assert.Contains(t, rule.Category().Description, "80 %")
+}
+
+func TestMissingHardeningRuleConfigureInvalidValue(t *testing.T) {
+	rule := NewMissingHardeningRule()
+
+	err := rule.Configure(types.NewRiskRuleParameters(map[string]any{"raa-limit": "high"}))
+
+	assert.NotNil(t, err)
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -38,6 +39,7 @@ func (*MissingIdentityPropagationRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        284,
+		Labels:                     []string{"identity"},
 	}
 }
 
@@ -105,3 +107,30 @@ func (r *MissingIdentityPropagationRule) createRisk(input *types.Model, technica
 	risk.SyntheticId = risk.CategoryId + "@" + incomingAccess.Id + "@" + input.TechnicalAssets[incomingAccess.SourceId].Id + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingIdentityPropagationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[commLink.TargetId]
+		caller := parsedModel.TechnicalAssets[commLink.SourceId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", commLink.Id),
+			fmt.Sprintf("  - authentication: %v (not %v)", commLink.Authentication, types.NoneAuthentication),
+			fmt.Sprintf("  - authorization: %v (not %v), usage: %v", commLink.Authorization, types.EndUserIdentityPropagation, commLink.Usage),
+			fmt.Sprintf("  - caller: technical asset %q (type: %v, technology: %v, has %q)",
+				caller.Id, caller.Type, caller.Technologies.String(), types.IsUsuallyAbleToPropagateIdentityToOutgoingTargets),
+			fmt.Sprintf("  - target: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("    - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsUsuallyProcessingEndUserRequests),
+			fmt.Sprintf("    - confidentiality: %v, integrity: %v, availability: %v, multi-tenant: %v",
+				technicalAsset.Confidentiality, technicalAsset.Integrity, technicalAsset.Availability, technicalAsset.MultiTenant),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the target is rated %v or %v", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*MissingIdentityProviderIsolationRule) Category() *types.RiskCategory {
 			"identity providers with data of highest sensitivity.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"identity", "network"},
 	}
 }
 
@@ -103,3 +105,32 @@ func (r *MissingIdentityProviderIsolationRule) createRisk(techAsset *types.Techn
 	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
 	return risk
 }
+
+func (r *MissingIdentityProviderIsolationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsIdentityRelated))
+		for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+			sparringAsset := parsedModel.TechnicalAssets[id]
+			if id == technicalAsset.Id || sparringAsset.Technologies.GetAttribute(types.IsIdentityRelated) ||
+				sparringAsset.Technologies.GetAttribute(types.IsCloseToHighValueTargetsTolerated) {
+				continue
+			}
+			if isSameExecutionEnvironment(parsedModel, technicalAsset, id) {
+				explanation = append(explanation, fmt.Sprintf("  - technical asset %q (not identity related) is in the same execution environment", id))
+			} else if isSameTrustBoundaryNetworkOnly(parsedModel, technicalAsset, id) {
+				explanation = append(explanation, fmt.Sprintf("  - technical asset %q (not identity related) is in the same network segment", id))
+			}
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - confidentiality: %v, integrity: %v, availability: %v", technicalAsset.Confidentiality, technicalAsset.Integrity, technicalAsset.Availability),
+			explainedLikelihood(generatedRisk, types.Unlikely, "unrelated technical assets share the same execution environment"),
+			explainedImpact(generatedRisk, types.HighImpact, fmt.Sprintf("the technical asset is rated %v or %v", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
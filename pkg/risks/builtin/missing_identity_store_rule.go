@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -32,6 +33,7 @@ func (*MissingIdentityStoreRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: true,
 		CWE:                        287,
+		Labels:                     []string{"identity", "model"},
 	}
 }
 
@@ -91,6 +93,7 @@ func (r *MissingIdentityStoreRule) createRisk(technicalAsset *types.TechnicalAss
 		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
 		ExploitationLikelihood:       types.Unlikely,
 		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
 		Title:                        title,
 		MostRelevantTechnicalAssetId: technicalAsset.Id,
 		DataBreachProbability:        types.Improbable,
@@ -99,3 +102,25 @@ func (r *MissingIdentityStoreRule) createRisk(technicalAsset *types.TechnicalAss
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingIdentityStoreRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		explanation = appendExplanation(explanation,
+			"model",
+			fmt.Sprintf("  - no in-scope technical asset has technology %q", types.IsIdentityStore))
+		for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+			for _, commLink := range parsedModel.TechnicalAssets[id].CommunicationLinksSorted() {
+				if commLink.Authorization == types.EndUserIdentityPropagation {
+					explanation = append(explanation, fmt.Sprintf("  - communication link %q (authorization: %v)", commLink.Id, commLink.Authorization))
+				}
+			}
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - technical asset %q is referenced as an example", generatedRisk.MostRelevantTechnicalAssetId),
+			explainedImpact(generatedRisk, types.LowImpact,
+				fmt.Sprintf("a target of these communication links processes %v or higher confidentiality or %v or higher integrity or availability", types.Confidential, types.Critical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingMtlsRule struct{}
+
+func NewMissingMtlsRule() *MissingMtlsRule {
+	return &MissingMtlsRule{}
+}
+
+func (*MissingMtlsRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-mtls",
+		Title: "Missing Mutual TLS",
+		Description: "While TLS provides server authentication, many high-value internal services still use one-way TLS where " +
+			"the client is not authenticated at the transport layer.",
+		Impact: "If this risk is unmitigated, attackers within the network might impersonate legitimate clients when calling " +
+			"sensitive services, as one-way TLS prevents passive eavesdropping but not active impersonation of the client.",
+		ASVS:       "V9 - Communication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Transport_Layer_Security_Cheat_Sheet.html",
+		Action:     "Mutual TLS",
+		Mitigation: "Configure mutual TLS (mTLS) between sensitive services, i.e. issue client certificates to the calling " +
+			"services (ideally via a service mesh or an internal CA with short-lived certificates) and require them on the server side. " +
+			"Consider certificate pinning for the most critical service-to-service connections.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.Spoofing,
+		DetectionLogic: "Encrypted communication links crossing a network trust boundary not using client certificate authentication (and not being tagged with 'mtls') where both the source and the target technical asset process data rated at least as " + types.Confidential.String() + ".",
+		RiskAssessment: "The risk rating is medium, as one-way TLS still prevents passive eavesdropping.",
+		FalsePositives: "Communication links where clients are strongly authenticated on the application layer " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        297,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*MissingMtlsRule) SupportedTags() []string {
+	return []string{"mtls"}
+}
+
+func (r *MissingMtlsRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || r.skipCommunicationLink(parsedModel, sourceAsset, targetAsset, commLink) {
+				continue
+			}
+			risks = append(risks, r.createRisk(sourceAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *MissingMtlsRule) skipCommunicationLink(parsedModel *types.Model, sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) bool {
+	return !commLink.Protocol.IsEncrypted() ||
+		commLink.Authentication == types.ClientCertificate ||
+		commLink.IsTaggedWithAny("mtls") ||
+		!isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink) ||
+		parsedModel.HighestProcessedConfidentiality(sourceAsset) < types.Confidential ||
+		parsedModel.HighestProcessedConfidentiality(targetAsset) < types.Confidential
+}
+
+func (r *MissingMtlsRule) createRisk(sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	title := "<b>Missing Mutual TLS</b> risk at <b>" + sourceAsset.Title + "</b> calling <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, types.MediumImpact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              types.MediumImpact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *MissingMtlsRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+sourceAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || r.skipCommunicationLink(parsedModel, sourceAsset, targetAsset, commLink) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - protocol: %v (encrypted)", commLink.Protocol),
+				fmt.Sprintf("  - authentication: %v (not %v)", commLink.Authentication, types.ClientCertificate),
+				fmt.Sprintf("  - tags: %v (has not %q)", commLink.Tags, "mtls"),
+				"  - crosses a network trust boundary",
+				fmt.Sprintf("  - source: technical asset %q (highest processed confidentiality %v)", sourceAsset.Id, parsedModel.HighestProcessedConfidentiality(sourceAsset)),
+				fmt.Sprintf("  - target: technical asset %q (highest processed confidentiality %v)", targetAsset.Id, parsedModel.HighestProcessedConfidentiality(targetAsset)),
+				fmt.Sprintf("    - impact is %v (default)", types.MediumImpact),
+			}...)
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,143 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingMtlsRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingMtlsRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingMtlsRuleTest struct {
+	protocol              types.Protocol
+	authentication        types.Authentication
+	tags                  []string
+	sameTrustBoundary     bool
+	sourceConfidentiality types.Confidentiality
+	targetConfidentiality types.Confidentiality
+
+	riskCreated bool
+}
+
+func TestMissingMtlsRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingMtlsRuleTest{
+		"unencrypted": {
+			protocol:              types.HTTP,
+			sourceConfidentiality: types.Confidential,
+			targetConfidentiality: types.Confidential,
+			riskCreated:           false,
+		},
+		"client certificate": {
+			protocol:              types.HTTPS,
+			authentication:        types.ClientCertificate,
+			sourceConfidentiality: types.Confidential,
+			targetConfidentiality: types.Confidential,
+			riskCreated:           false,
+		},
+		"tagged mtls": {
+			protocol:              types.HTTPS,
+			authentication:        types.Token,
+			tags:                  []string{"mtls"},
+			sourceConfidentiality: types.Confidential,
+			targetConfidentiality: types.Confidential,
+			riskCreated:           false,
+		},
+		"same trust boundary": {
+			protocol:              types.HTTPS,
+			sameTrustBoundary:     true,
+			sourceConfidentiality: types.Confidential,
+			targetConfidentiality: types.Confidential,
+			riskCreated:           false,
+		},
+		"source not sensitive": {
+			protocol:              types.HTTPS,
+			sourceConfidentiality: types.Internal,
+			targetConfidentiality: types.StrictlyConfidential,
+			riskCreated:           false,
+		},
+		"target not sensitive": {
+			protocol:              types.HTTPS,
+			sourceConfidentiality: types.StrictlyConfidential,
+			targetConfidentiality: types.Restricted,
+			riskCreated:           false,
+		},
+		"one-way tls between sensitive services": {
+			protocol:              types.HTTPS,
+			authentication:        types.Token,
+			sourceConfidentiality: types.Confidential,
+			targetConfidentiality: types.StrictlyConfidential,
+			riskCreated:           true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingMtlsRule()
+			sourceBoundary := &types.TrustBoundary{Id: "source-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"source"}}
+			targetBoundary := &types.TrustBoundary{Id: "target-network", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"target"}}
+			if testCase.sameTrustBoundary {
+				targetBoundary = sourceBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"source": {
+						Id:                  "source",
+						Title:               "Source",
+						DataAssetsProcessed: []string{"source-data"},
+						CommunicationLinks: []*types.CommunicationLink{
+							{
+								Id:             "source>target",
+								Title:          "Call",
+								SourceId:       "source",
+								TargetId:       "target",
+								Tags:           testCase.tags,
+								Protocol:       testCase.protocol,
+								Authentication: testCase.authentication,
+							},
+						},
+					},
+					"target": {
+						Id:                  "target",
+						Title:               "Target",
+						DataAssetsProcessed: []string{"target-data"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"source-data": {Id: "source-data", Confidentiality: testCase.sourceConfidentiality},
+					"target-data": {Id: "target-data", Confidentiality: testCase.targetConfidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					sourceBoundary.Id: sourceBoundary,
+					targetBoundary.Id: targetBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"source": sourceBoundary,
+					"target": targetBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Mutual TLS</b> risk at <b>Source</b> calling <b>Target</b> via <b>Call</b>", risks[0].Title)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, "  - protocol: https (encrypted)")
+				assert.Contains(t, explanation, `  - target: technical asset "target" (highest processed confidentiality strictly-confidential)`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/threagile/threagile/pkg/types"
@@ -14,6 +15,10 @@ func NewMissingNetworkSegmentationRule() *MissingNetworkSegmentationRule {
 	return &MissingNetworkSegmentationRule{raaLimit: 50}
 }
 
+func (r *MissingNetworkSegmentationRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Int("raa-limit", &r.raaLimit)
+}
+
 func (*MissingNetworkSegmentationRule) Category() *types.RiskCategory {
 	return &types.RiskCategory{
 		ID:    "missing-network-segmentation",
@@ -41,6 +46,7 @@ func (*MissingNetworkSegmentationRule) Category() *types.RiskCategory {
 			"containing/processing highly sensitive data.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"network"},
 	}
 }
 
@@ -87,7 +93,7 @@ func (r *MissingNetworkSegmentationRule) GenerateRisks(input *types.Model) ([]*t
 			sparringAssetCandidate := input.TechnicalAssets[sparringAssetCandidateId]
 			if sparringAssetCandidate.Technologies.GetAttribute(types.IsLessProtectedType) &&
 				isSameTrustBoundaryNetworkOnly(input, technicalAsset, sparringAssetCandidateId) &&
-				!input.HasDirectConnection(technicalAsset, sparringAssetCandidateId) &&
+				!input.AnalysisContext().HasDirectConnection(technicalAsset.Id, sparringAssetCandidateId) &&
 				!sparringAssetCandidate.Technologies.GetAttribute(types.IsCloseToHighValueTargetsTolerated) {
 				highRisk := technicalAsset.Confidentiality == types.StrictlyConfidential ||
 					technicalAsset.Integrity == types.MissionCritical || technicalAsset.Availability == types.MissionCritical
@@ -118,3 +124,30 @@ func (r *MissingNetworkSegmentationRule) createRisk(techAsset *types.TechnicalAs
 	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
 	return risk
 }
+
+func (r *MissingNetworkSegmentationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsNoNetworkSegmentationRequired),
+			fmt.Sprintf("  - RAA: %.2f%% (>=%v%%)", technicalAsset.RAA, r.raaLimit),
+			fmt.Sprintf("  - type: %v, confidentiality: %v, integrity: %v, availability: %v (%v, or at least %v or %v)",
+				technicalAsset.Type, technicalAsset.Confidentiality, technicalAsset.Integrity, technicalAsset.Availability, types.Datastore, types.Confidential, types.Critical))
+		for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+			sparringAsset := parsedModel.TechnicalAssets[id]
+			if id != technicalAsset.Id && sparringAsset.Technologies.GetAttribute(types.IsLessProtectedType) &&
+				isSameTrustBoundaryNetworkOnly(parsedModel, technicalAsset, id) &&
+				!parsedModel.AnalysisContext().HasDirectConnection(technicalAsset.Id, id) &&
+				!sparringAsset.Technologies.GetAttribute(types.IsCloseToHighValueTargetsTolerated) {
+				explanation = append(explanation, fmt.Sprintf("  - less protected technical asset %q is in the same network segment without direct connection", id))
+				break
+			}
+		}
+		explanation = append(explanation, explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the technical asset is rated %v or %v", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,130 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingPodSecurityAdmissionRule struct{}
+
+func NewMissingPodSecurityAdmissionRule() *MissingPodSecurityAdmissionRule {
+	return &MissingPodSecurityAdmissionRule{}
+}
+
+func (*MissingPodSecurityAdmissionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-pod-security-admission",
+		Title: "Missing Pod Security Admission",
+		Description: "Kubernetes namespaces without Pod Security Admission (PSA) or pod security policies allow pods to run " +
+			"privileged, with host path mounts, or with access to the host PID namespace.",
+		Impact: "If this risk is unmitigated, attackers able to deploy or compromise a pod within the namespace might " +
+			"escalate their privileges to the underlying node and from there to other workloads of the cluster.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Kubernetes_Security_Cheat_Sheet.html",
+		Action:     "Pod Security Admission",
+		Mitigation: "Label each namespace with a Pod Security Admission level ('restricted' preferred, at least 'baseline') " +
+			"or enforce equivalent policies via a policy engine.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.ElevationOfPrivilege,
+		DetectionLogic:             "Trust boundaries tagged with 'kubernetes-namespace' neither tagged with 'psa-restricted', 'psa-baseline', nor 'pod-security-policy'.",
+		RiskAssessment:             "The risk rating depends on whether the namespace contains technical assets processing " + types.StrictlyConfidential.String() + " data.",
+		FalsePositives:             "Namespaces protected by admission controls not reflected in the model can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        250,
+		Labels:                     []string{"container"},
+	}
+}
+
+func (*MissingPodSecurityAdmissionRule) SupportedTags() []string {
+	return []string{"kubernetes-namespace", "psa-restricted", "psa-baseline", "pod-security-policy"}
+}
+
+func (r *MissingPodSecurityAdmissionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+		trustBoundary := parsedModel.TrustBoundaries[id]
+		if r.skipTrustBoundary(trustBoundary) {
+			continue
+		}
+		risks = append(risks, r.createRisk(parsedModel, trustBoundary))
+	}
+	return risks, nil
+}
+
+func (r *MissingPodSecurityAdmissionRule) skipTrustBoundary(trustBoundary *types.TrustBoundary) bool {
+	return !trustBoundary.IsTaggedWithAny("kubernetes-namespace") ||
+		trustBoundary.IsTaggedWithAny("psa-restricted", "psa-baseline", "pod-security-policy")
+}
+
+func (r *MissingPodSecurityAdmissionRule) strictlyConfidentialAssets(parsedModel *types.Model, trustBoundary *types.TrustBoundary) []string {
+	result := make([]string, 0)
+	for _, id := range parsedModel.RecursivelyAllTechnicalAssetIDsInside(trustBoundary) {
+		techAsset, ok := parsedModel.TechnicalAssets[id]
+		if ok && parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *MissingPodSecurityAdmissionRule) createRisk(parsedModel *types.Model, trustBoundary *types.TrustBoundary) *types.Risk {
+	impact := types.MediumImpact
+	if len(r.strictlyConfidentialAssets(parsedModel, trustBoundary)) > 0 {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                  r.Category().ID,
+		Severity:                    types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:      types.Likely,
+		ExploitationImpact:          impact,
+		Title:                       "<b>Missing Pod Security Admission</b> risk at namespace <b>" + trustBoundary.Title + "</b>",
+		MostRelevantTrustBoundaryId: trustBoundary.Id,
+		DataBreachProbability:       types.Possible,
+		DataBreachTechnicalAssetIDs: parsedModel.RecursivelyAllTechnicalAssetIDsInside(trustBoundary),
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + trustBoundary.Id
+	return risk
+}
+
+func (r *MissingPodSecurityAdmissionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+		trustBoundary := parsedModel.TrustBoundaries[id]
+		if !strings.EqualFold(risk, categoryId+"@"+trustBoundary.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipTrustBoundary(trustBoundary) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("trust boundary %q", trustBoundary.Id),
+			fmt.Sprintf("  - tags: %v (has %q)", trustBoundary.Tags, "kubernetes-namespace"),
+			fmt.Sprintf("  - no pod security control tag present (neither [%q, %q, %q])", "psa-restricted", "psa-baseline", "pod-security-policy"),
+		}...)
+
+		strictlyConfidentialAssets := r.strictlyConfidentialAssets(parsedModel, trustBoundary)
+		for _, assetId := range strictlyConfidentialAssets {
+			explanation = append(explanation, fmt.Sprintf("    - technical asset %q processes %v data", assetId, types.StrictlyConfidential))
+		}
+
+		if len(strictlyConfidentialAssets) > 0 {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the namespace contains %v data", types.HighImpact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
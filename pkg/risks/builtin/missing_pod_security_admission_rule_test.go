@@ -0,0 +1,94 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingPodSecurityAdmissionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingPodSecurityAdmissionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingPodSecurityAdmissionRuleTest struct {
+	tags            []string
+	confidentiality types.Confidentiality
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestMissingPodSecurityAdmissionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingPodSecurityAdmissionRuleTest{
+		"not a namespace": {
+			tags:        []string{"production"},
+			riskCreated: false,
+		},
+		"psa restricted": {
+			tags:        []string{"kubernetes-namespace", "psa-restricted"},
+			riskCreated: false,
+		},
+		"psa baseline": {
+			tags:        []string{"kubernetes-namespace", "psa-baseline"},
+			riskCreated: false,
+		},
+		"pod security policy": {
+			tags:        []string{"kubernetes-namespace", "pod-security-policy"},
+			riskCreated: false,
+		},
+		"namespace without pod security": {
+			tags:            []string{"kubernetes-namespace"},
+			confidentiality: types.Confidential,
+			riskCreated:     true,
+			expectedImpact:  types.MediumImpact,
+		},
+		"namespace without pod security containing strictly confidential data": {
+			tags:            []string{"kubernetes-namespace"},
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     true,
+			expectedImpact:  types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingPodSecurityAdmissionRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {Id: "app", DataAssetsProcessed: []string{"data"}},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"payments": {
+						Id:                    "payments",
+						Title:                 "Payments",
+						Tags:                  testCase.tags,
+						TechnicalAssetsInside: []string{"app"},
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Pod Security Admission</b> risk at namespace <b>Payments</b>", risks[0].Title)
+				assert.Equal(t, "payments", risks[0].MostRelevantTrustBoundaryId)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MissingRateLimitingRule struct{}
+
+func NewMissingRateLimitingRule() *MissingRateLimitingRule {
+	return &MissingRateLimitingRule{}
+}
+
+func (*MissingRateLimitingRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "missing-rate-limiting",
+		Title: "Missing Rate Limiting",
+		Description: "Internet-facing web applications and web services without a gateway, web application firewall (WAF), " +
+			"load balancer, or reverse proxy in front of them usually lack rate limiting and are therefore prone to resource exhaustion.",
+		Impact: "If this risk is unmitigated, attackers might be able to exhaust the resources of the technical asset " +
+			"by flooding it with requests and thus make it unavailable for legitimate users.",
+		ASVS:       "V11 - Business Logic Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Denial_of_Service_Cheat_Sheet.html",
+		Action:     "Rate Limiting",
+		Mitigation: "Place a gateway, WAF, load balancer, or reverse proxy enforcing rate limits and request size limits " +
+			"in front of internet-facing web applications and web services.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.DenialOfService,
+		DetectionLogic: "In-scope web applications and web services (not being a gateway, WAF, load balancer, or reverse proxy themselves) " +
+			"having incoming communication links directly from the public network and not being tagged with 'rate-limiting'.",
+		RiskAssessment:             "The risk rating depends on the highest availability rating of the technical asset itself and of the data assets processed and stored.",
+		FalsePositives:             "Technical assets implementing rate limiting themselves can be tagged with 'rate-limiting'.",
+		ModelFailurePossibleReason: false,
+		CWE:                        770,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*MissingRateLimitingRule) SupportedTags() []string {
+	return []string{"rate-limiting"}
+}
+
+func (r *MissingRateLimitingRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		publicLink := r.incomingPublicLink(parsedModel, techAsset)
+		if publicLink == nil {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, publicLink))
+	}
+	return risks, nil
+}
+
+func (r *MissingRateLimitingRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.IsTaggedWithAny("rate-limiting") {
+		return true
+	}
+	if !techAsset.Technologies.GetAttribute(types.WebApplication) && !techAsset.Technologies.GetAttribute(types.IsWebService) {
+		return true
+	}
+	return r.isRateLimitingAsset(techAsset)
+}
+
+func (r *MissingRateLimitingRule) isRateLimitingAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.Technologies.GetAttribute(types.Gateway) ||
+		techAsset.Technologies.GetAttribute(types.WAF) ||
+		techAsset.Technologies.GetAttribute(types.LoadBalancer) ||
+		techAsset.Technologies.GetAttribute(types.ReverseProxy)
+}
+
+// incomingPublicLink returns the first incoming communication link (sorted by id) coming directly from the public network
+func (r *MissingRateLimitingRule) incomingPublicLink(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.CommunicationLink {
+	var result *types.CommunicationLink
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		sourceAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if !ok || r.isRateLimitingAsset(sourceAsset) || !isOnPublicNetwork(parsedModel, sourceAsset) {
+			continue
+		}
+		if result == nil || incomingLink.Id < result.Id {
+			result = incomingLink
+		}
+	}
+	return result
+}
+
+func (r *MissingRateLimitingRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	switch parsedModel.HighestProcessedAvailability(techAsset) {
+	case types.MissionCritical:
+		return types.HighImpact
+	case types.Critical:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *MissingRateLimitingRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, publicLink *types.CommunicationLink) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           "<b>Missing Rate Limiting</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: publicLink.Id,
+		DataBreachProbability:           types.Improbable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MissingRateLimitingRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		publicLink := r.incomingPublicLink(parsedModel, techAsset)
+		if publicLink == nil {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has either [%q, %q])", techAsset.Technologies.String(), types.WebApplication, types.IsWebService),
+			fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "rate-limiting"),
+			fmt.Sprintf("  - incoming communication link %q directly from public network technical asset %q", publicLink.Id, publicLink.SourceId),
+			fmt.Sprintf("    - impact is %v because of highest availability %v", r.impact(parsedModel, techAsset), parsedModel.HighestProcessedAvailability(techAsset)),
+		}...)
+	}
+
+	return explanation
+}
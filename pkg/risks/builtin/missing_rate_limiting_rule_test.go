@@ -0,0 +1,118 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMissingRateLimitingRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMissingRateLimitingRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MissingRateLimitingRuleTest struct {
+	technology       string
+	tags             []string
+	callerTechnology string
+	callerInternet   bool
+	availability     types.Criticality
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestMissingRateLimitingRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MissingRateLimitingRuleTest{
+		"not a web application or web service": {
+			technology:     types.Database,
+			callerInternet: true,
+			riskCreated:    false,
+		},
+		"not internet-facing": {
+			technology:  types.WebServiceREST,
+			riskCreated: false,
+		},
+		"behind gateway": {
+			technology:       types.WebServiceREST,
+			callerTechnology: types.Gateway,
+			callerInternet:   true,
+			riskCreated:      false,
+		},
+		"rate limiting implemented": {
+			technology:     types.WebApplication,
+			tags:           []string{"rate-limiting"},
+			callerInternet: true,
+			riskCreated:    false,
+		},
+		"directly exposed web application": {
+			technology:     types.WebApplication,
+			callerInternet: true,
+			riskCreated:    true,
+			expectedImpact: types.LowImpact,
+		},
+		"directly exposed critical web service": {
+			technology:     types.WebServiceREST,
+			callerInternet: true,
+			availability:   types.Critical,
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+		},
+		"directly exposed mission-critical web service": {
+			technology:     types.WebServiceSOAP,
+			callerInternet: true,
+			availability:   types.MissionCritical,
+			riskCreated:    true,
+			expectedImpact: types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMissingRateLimitingRule()
+			incomingLink := &types.CommunicationLink{Id: "caller>service", SourceId: "caller", TargetId: "service"}
+			technologyAttributes := map[string]bool{testCase.technology: true}
+			if testCase.technology == types.WebServiceREST || testCase.technology == types.WebServiceSOAP {
+				technologyAttributes[types.IsWebService] = true
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"caller": {
+						Id:                 "caller",
+						Internet:           testCase.callerInternet,
+						Technologies:       types.TechnologyList{{Name: testCase.callerTechnology, Attributes: map[string]bool{testCase.callerTechnology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"service": {
+						Id:           "service",
+						Title:        "Service",
+						Tags:         testCase.tags,
+						Availability: testCase.availability,
+						Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: technologyAttributes}},
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"service": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Missing Rate Limiting</b> risk at <b>Service</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), `  - incoming communication link "caller>service" directly from public network technical asset "caller"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*MissingVaultIsolationRule) Category() *types.RiskCategory {
 			"vaults with data of highest sensitivity.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"data", "network"},
 	}
 }
 
@@ -77,7 +79,7 @@ func (r *MissingVaultIsolationRule) GenerateRisks(input *types.Model) ([]*types.
 }
 
 func isVaultStorage(parsedModel *types.Model, vault *types.TechnicalAsset, storage *types.TechnicalAsset) bool {
-	return storage.Type == types.Datastore && parsedModel.HasDirectConnection(vault, storage.Id)
+	return storage.Type == types.Datastore && parsedModel.AnalysisContext().HasDirectConnection(vault.Id, storage.Id)
 }
 
 func (r *MissingVaultIsolationRule) createRisk(techAsset *types.TechnicalAsset, moreImpact bool, sameExecutionEnv bool) *types.Risk {
@@ -105,3 +107,31 @@ func (r *MissingVaultIsolationRule) createRisk(techAsset *types.TechnicalAsset,
 	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
 	return risk
 }
+
+func (r *MissingVaultIsolationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.Vault))
+		for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+			sparringAsset := parsedModel.TechnicalAssets[id]
+			if id == technicalAsset.Id || sparringAsset.Technologies.GetAttribute(types.Vault) || isVaultStorage(parsedModel, technicalAsset, sparringAsset) {
+				continue
+			}
+			if isSameExecutionEnvironment(parsedModel, technicalAsset, id) {
+				explanation = append(explanation, fmt.Sprintf("  - technical asset %q (neither vault nor vault storage) is in the same execution environment", id))
+			} else if isSameTrustBoundaryNetworkOnly(parsedModel, technicalAsset, id) {
+				explanation = append(explanation, fmt.Sprintf("  - technical asset %q (neither vault nor vault storage) is in the same network segment", id))
+			}
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - confidentiality: %v, integrity: %v, availability: %v", technicalAsset.Confidentiality, technicalAsset.Integrity, technicalAsset.Availability),
+			explainedLikelihood(generatedRisk, types.Unlikely, "unrelated technical assets share the same execution environment"),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the vault is rated %v or %v", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -33,6 +34,7 @@ func (*MissingVaultRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: true,
 		CWE:                        522,
+		Labels:                     []string{"data", "model"},
 	}
 }
 
@@ -84,6 +86,7 @@ func (r *MissingVaultRule) createRisk(technicalAsset *types.TechnicalAsset, impa
 		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
 		ExploitationLikelihood:       types.Unlikely,
 		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
 		Title:                        title,
 		MostRelevantTechnicalAssetId: id,
 		DataBreachProbability:        types.Improbable,
@@ -92,3 +95,19 @@ func (r *MissingVaultRule) createRisk(technicalAsset *types.TechnicalAsset, impa
 	risk.SyntheticId = risk.CategoryId + "@" + id
 	return risk
 }
+
+func (r *MissingVaultRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		explanation = appendExplanation(explanation,
+			"model",
+			fmt.Sprintf("  - no technical asset has technology %q", types.Vault))
+		if techAsset, ok := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]; ok {
+			explanation = append(explanation, fmt.Sprintf("  - technical asset %q has the highest sensitivity (%v)", techAsset.Id, processedSensitivity(parsedModel, techAsset)))
+		}
+		explanation = append(explanation, explainedImpact(generatedRisk, types.LowImpact,
+			fmt.Sprintf("a technical asset processes %v or higher confidentiality or %v or higher integrity or availability", types.Confidential, types.Critical)))
+	}
+
+	return explanation
+}
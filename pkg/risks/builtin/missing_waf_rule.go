@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -32,6 +33,7 @@ func (*MissingWafRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"network", "appsec"},
 	}
 }
 
@@ -83,3 +85,30 @@ func (r *MissingWafRule) createRisk(input *types.Model, technicalAsset *types.Te
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *MissingWafRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q or %q)", technicalAsset.Technologies.String(), types.WebApplication, types.IsWebService))
+		for _, incomingAccess := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			sourceAsset := parsedModel.TechnicalAssets[incomingAccess.SourceId]
+			if isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingAccess) && incomingAccess.Protocol.IsPotentialWebAccessProtocol() &&
+				!sourceAsset.Technologies.GetAttribute(types.WAF) {
+				explanation = append(explanation,
+					fmt.Sprintf("  - communication link %q crosses a network trust boundary", incomingAccess.Id),
+					fmt.Sprintf("    - protocol: %v (is potential web access protocol)", incomingAccess.Protocol),
+					fmt.Sprintf("    - source: technical asset %q (technology: %v, has not %q)", sourceAsset.Id, sourceAsset.Technologies.String(), types.WAF))
+				break
+			}
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("%v data or %v integrity or availability is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
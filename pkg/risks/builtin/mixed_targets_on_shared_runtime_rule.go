@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/threagile/threagile/pkg/types"
@@ -37,6 +38,7 @@ func (*MixedTargetsOnSharedRuntimeRule) Category() *types.RiskCategory {
 			"containing/processing highly sensitive data.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1008,
+		Labels:                     []string{"operations"},
 	}
 }
 
@@ -59,12 +61,12 @@ func (r *MixedTargetsOnSharedRuntimeRule) GenerateRisks(input *types.Model) ([]*
 		riskAdded := false
 		for _, technicalAssetId := range sharedRuntime.TechnicalAssetsRunning {
 			technicalAsset := input.TechnicalAssets[technicalAssetId]
-			if len(currentTrustBoundaryId) > 0 && currentTrustBoundaryId != input.GetTechnicalAssetTrustBoundaryId(technicalAsset) {
+			if len(currentTrustBoundaryId) > 0 && currentTrustBoundaryId != input.AnalysisContext().TrustBoundaryId(technicalAsset.Id) {
 				risks = append(risks, r.createRisk(input, sharedRuntime))
 				riskAdded = true
 				break
 			}
-			currentTrustBoundaryId = input.GetTechnicalAssetTrustBoundaryId(technicalAsset)
+			currentTrustBoundaryId = input.AnalysisContext().TrustBoundaryId(technicalAsset.Id)
 			if technicalAsset.Technologies.GetAttribute(types.IsExclusivelyFrontendRelated) {
 				hasFrontend = true
 			}
@@ -109,3 +111,22 @@ func isMoreRisky(input *types.Model, sharedRuntime *types.SharedRuntime) bool {
 	}
 	return false
 }
+
+func (r *MixedTargetsOnSharedRuntimeRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		sharedRuntime := parsedModel.SharedRuntimes[generatedRisk.MostRelevantSharedRuntimeId]
+		explanation = appendExplanation(explanation, fmt.Sprintf("shared runtime %q", sharedRuntime.Id))
+		for _, technicalAssetId := range sharedRuntime.TechnicalAssetsRunning {
+			technicalAsset := parsedModel.TechnicalAssets[technicalAssetId]
+			explanation = append(explanation, fmt.Sprintf("  - runs technical asset %q (trust boundary: %q, exclusively frontend related: %v, exclusively backend related: %v)",
+				technicalAsset.Id, parsedModel.AnalysisContext().TrustBoundaryId(technicalAsset.Id),
+				technicalAsset.Technologies.GetAttribute(types.IsExclusivelyFrontendRelated), technicalAsset.Technologies.GetAttribute(types.IsExclusivelyBackendRelated)))
+		}
+		explanation = append(explanation,
+			"    - the technical assets are in different trust boundaries, or mix frontend and backend related ones",
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("a technical asset running is rated %v or %v", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
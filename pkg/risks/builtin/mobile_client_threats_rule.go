@@ -0,0 +1,207 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MobileClientThreatsRule struct{}
+
+func NewMobileClientThreatsRule() *MobileClientThreatsRule {
+	return &MobileClientThreatsRule{}
+}
+
+// mobileThreat describes one of the mobile client specific threats checked by the rule
+type mobileThreat struct {
+	id         string
+	title      string
+	likelihood types.RiskExploitationLikelihood
+}
+
+var (
+	mobileInsecureLocalStorage = mobileThreat{id: "insecure-local-storage", title: "Mobile Insecure Local Storage", likelihood: types.Likely}
+	mobileMissingCertPinning   = mobileThreat{id: "missing-certificate-pinning", title: "Mobile Missing Certificate Pinning", likelihood: types.Unlikely}
+	mobileEmbeddedApiKeys      = mobileThreat{id: "embedded-api-keys", title: "Mobile Embedded API Keys", likelihood: types.VeryLikely}
+)
+
+var mobileThreats = []mobileThreat{mobileInsecureLocalStorage, mobileMissingCertPinning, mobileEmbeddedApiKeys}
+
+func (*MobileClientThreatsRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "mobile-client-threats",
+		Title: "Mobile Client Threats",
+		Description: "Mobile apps run on devices outside of the control of the system operator, which might be lost, stolen, rooted, or run alongside malware. " +
+			"Confidential data stored unencrypted on the device, missing certificate pinning, and API keys embedded into the app are the most common resulting weaknesses.",
+		Impact: "If this risk is unmitigated, attackers might be able to read confidential data from the device, intercept the traffic of the app, " +
+			"or extract API keys by reverse-engineering the app and abuse them to access the backend.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Mobile_Application_Security_Cheat_Sheet.html",
+		Action:     "Mobile Client Hardening",
+		Mitigation: "Store confidential data only encrypted using the platform keystore, pin the certificates of the backend " +
+			"(tag the mobile app with 'cert-pinning'), and never embed secrets into the app: use per-user tokens obtained at runtime instead.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets of technology '" + types.MobileApp + "'. An insecure local storage risk is raised when " +
+			types.Confidential.String() + " or higher data is stored without encryption, a missing certificate pinning risk when the app has outgoing " +
+			"communication links and is not tagged with 'cert-pinning', and an embedded API keys risk when data assets tagged with 'api-key', " +
+			"'credentials', or 'secrets' are stored by the app.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data affected. " +
+			"Embedded API keys are very likely to be extracted, unencrypted local storage is likely to be read.",
+		FalsePositives: "Mobile apps only storing data encrypted by the platform (without being modeled as encryption) or using public API keys only " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        922,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*MobileClientThreatsRule) SupportedTags() []string {
+	return []string{"cert-pinning", "api-key", "credentials", "secrets"}
+}
+
+func (r *MobileClientThreatsRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.MobileApp) {
+			continue
+		}
+
+		for _, threat := range r.threats(parsedModel, techAsset) {
+			risks = append(risks, r.createRisk(parsedModel, techAsset, threat))
+		}
+	}
+	return risks, nil
+}
+
+func (r *MobileClientThreatsRule) threats(parsedModel *types.Model, techAsset *types.TechnicalAsset) []mobileThreat {
+	result := make([]mobileThreat, 0)
+	for _, threat := range mobileThreats {
+		if len(r.affectedDataAssets(parsedModel, techAsset, threat)) > 0 {
+			result = append(result, threat)
+		}
+	}
+	return result
+}
+
+// affectedDataAssets returns the sorted ids of the data assets exposed by the threat, which is present if any are returned
+func (r *MobileClientThreatsRule) affectedDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset, threat mobileThreat) []string {
+	result := make([]string, 0)
+	switch threat {
+	case mobileInsecureLocalStorage:
+		if techAsset.Encryption != types.NoneEncryption {
+			return result
+		}
+		for _, dataId := range techAsset.DataAssetsStored {
+			if dataAsset, ok := parsedModel.DataAssets[dataId]; ok && dataAsset.Confidentiality >= types.Confidential && !contains(result, dataId) {
+				result = append(result, dataId)
+			}
+		}
+	case mobileMissingCertPinning:
+		if techAsset.IsTaggedWithAny("cert-pinning") {
+			return result
+		}
+		for _, commLink := range techAsset.CommunicationLinks {
+			for _, dataId := range append(commLink.DataAssetsSent, commLink.DataAssetsReceived...) {
+				if !contains(result, dataId) {
+					result = append(result, dataId)
+				}
+			}
+		}
+	case mobileEmbeddedApiKeys:
+		for _, dataId := range techAsset.DataAssetsStored {
+			if dataAsset, ok := parsedModel.DataAssets[dataId]; ok && dataAsset.IsTaggedWithAny("api-key", "credentials", "secrets") && !contains(result, dataId) {
+				result = append(result, dataId)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *MobileClientThreatsRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset, threat mobileThreat) types.RiskExploitationImpact {
+	highest := types.Public
+	for _, dataId := range r.affectedDataAssets(parsedModel, techAsset, threat) {
+		if dataAsset, ok := parsedModel.DataAssets[dataId]; ok && dataAsset.Confidentiality > highest {
+			highest = dataAsset.Confidentiality
+		}
+	}
+	switch highest {
+	case types.StrictlyConfidential:
+		return types.HighImpact
+	case types.Confidential:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *MobileClientThreatsRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, threat mobileThreat) *types.Risk {
+	impact := r.impact(parsedModel, techAsset, threat)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(threat.likelihood, impact),
+		ExploitationLikelihood:       threat.likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>" + threat.title + "</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + threat.id + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MobileClientThreatsRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.MobileApp) {
+			continue
+		}
+
+		for _, threat := range r.threats(parsedModel, techAsset) {
+			if !strings.EqualFold(risk, categoryId+"@"+threat.id+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("technical asset %q", techAsset.Id),
+				fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("  - technology: %v (has %q)", techAsset.Technologies.String(), types.MobileApp),
+			}...)
+
+			affected := r.affectedDataAssets(parsedModel, techAsset, threat)
+			switch threat {
+			case mobileInsecureLocalStorage:
+				explanation = append(explanation, []string{
+					fmt.Sprintf("  - encryption: %v (=%v)", techAsset.Encryption, types.NoneEncryption),
+					fmt.Sprintf("  - stores data assets %q (>=%v)", affected, types.Confidential),
+				}...)
+			case mobileMissingCertPinning:
+				explanation = append(explanation, []string{
+					fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "cert-pinning"),
+					fmt.Sprintf("  - transfers data assets %q via outgoing communication links", affected),
+				}...)
+			case mobileEmbeddedApiKeys:
+				explanation = append(explanation, fmt.Sprintf("  - stores data assets %q (tagged with either [%q, %q, %q])", affected, "api-key", "credentials", "secrets"))
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("    - likelihood is %v because of %v", threat.likelihood, threat.id),
+				fmt.Sprintf("    - impact is %v because of highest confidentiality of the data assets affected", r.impact(parsedModel, techAsset, threat)),
+			}...)
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,147 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMobileClientThreatsRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMobileClientThreatsRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MobileClientThreatsRuleTest struct {
+	technology      string
+	outOfScope      bool
+	encryption      types.EncryptionStyle
+	tags            []string
+	storesData      bool
+	dataTags        []string
+	confidentiality types.Confidentiality
+	hasLink         bool
+
+	expectedTitles     []string
+	expectedLikelihood []types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestMobileClientThreatsRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MobileClientThreatsRuleTest{
+		"not a mobile app": {
+			technology:      types.Browser,
+			storesData:      true,
+			confidentiality: types.StrictlyConfidential,
+			hasLink:         true,
+		},
+		"out of scope": {
+			technology:      types.MobileApp,
+			outOfScope:      true,
+			storesData:      true,
+			confidentiality: types.StrictlyConfidential,
+			hasLink:         true,
+		},
+		"encrypted storage and pinned certificates": {
+			technology:      types.MobileApp,
+			encryption:      types.DataWithSymmetricSharedKey,
+			tags:            []string{"cert-pinning"},
+			storesData:      true,
+			confidentiality: types.StrictlyConfidential,
+			hasLink:         true,
+		},
+		"unencrypted storage of internal data": {
+			technology:      types.MobileApp,
+			tags:            []string{"cert-pinning"},
+			storesData:      true,
+			confidentiality: types.Internal,
+			hasLink:         true,
+		},
+		"unencrypted storage of confidential data": {
+			technology:         types.MobileApp,
+			tags:               []string{"cert-pinning"},
+			storesData:         true,
+			confidentiality:    types.Confidential,
+			expectedTitles:     []string{"<b>Mobile Insecure Local Storage</b> risk at <b>App</b>"},
+			expectedLikelihood: []types.RiskExploitationLikelihood{types.Likely},
+			expectedImpact:     types.MediumImpact,
+		},
+		"missing certificate pinning": {
+			technology:         types.MobileApp,
+			confidentiality:    types.StrictlyConfidential,
+			hasLink:            true,
+			expectedTitles:     []string{"<b>Mobile Missing Certificate Pinning</b> risk at <b>App</b>"},
+			expectedLikelihood: []types.RiskExploitationLikelihood{types.Unlikely},
+			expectedImpact:     types.HighImpact,
+		},
+		"embedded api key": {
+			technology:         types.MobileApp,
+			encryption:         types.DataWithSymmetricSharedKey,
+			tags:               []string{"cert-pinning"},
+			storesData:         true,
+			dataTags:           []string{"api-key"},
+			confidentiality:    types.Internal,
+			expectedTitles:     []string{"<b>Mobile Embedded API Keys</b> risk at <b>App</b>"},
+			expectedLikelihood: []types.RiskExploitationLikelihood{types.VeryLikely},
+			expectedImpact:     types.LowImpact,
+		},
+		"all threats": {
+			technology:      types.MobileApp,
+			storesData:      true,
+			dataTags:        []string{"credentials"},
+			confidentiality: types.StrictlyConfidential,
+			hasLink:         true,
+			expectedTitles: []string{
+				"<b>Mobile Insecure Local Storage</b> risk at <b>App</b>",
+				"<b>Mobile Missing Certificate Pinning</b> risk at <b>App</b>",
+				"<b>Mobile Embedded API Keys</b> risk at <b>App</b>",
+			},
+			expectedLikelihood: []types.RiskExploitationLikelihood{types.Likely, types.Unlikely, types.VeryLikely},
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMobileClientThreatsRule()
+			app := &types.TechnicalAsset{
+				Id:           "app",
+				Title:        "App",
+				OutOfScope:   testCase.outOfScope,
+				Encryption:   testCase.encryption,
+				Tags:         testCase.tags,
+				Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+			}
+			if testCase.storesData {
+				app.DataAssetsStored = []string{"data"}
+			}
+			if testCase.hasLink {
+				app.CommunicationLinks = []*types.CommunicationLink{{Id: "app>backend", SourceId: "app", TargetId: "backend", DataAssetsSent: []string{"data"}}}
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app":     app,
+					"backend": {Id: "backend"},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Tags: testCase.dataTags, Confidentiality: testCase.confidentiality},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, len(testCase.expectedTitles))
+			for i, risk := range risks {
+				assert.Equal(t, testCase.expectedTitles[i], risk.Title)
+				assert.Equal(t, testCase.expectedLikelihood[i], risk.ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risk.ExploitationImpact)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risk.SyntheticId))
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type MultiTenantIsolationRule struct{}
+
+func NewMultiTenantIsolationRule() *MultiTenantIsolationRule {
+	return &MultiTenantIsolationRule{}
+}
+
+func (*MultiTenantIsolationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "multi-tenant-isolation",
+		Title: "Multi-Tenant Isolation",
+		Description: "Multi-tenant technical assets processing or storing strictly-confidential data assets of multiple owners " +
+			"depend on a strict tenant isolation, as any flaw in it exposes the data of one owner to the others.",
+		Impact: "If this risk is unmitigated, tenants (or attackers having compromised a tenant) might access the strictly-confidential " +
+			"data of other owners processed by the same technical asset.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authorization_Cheat_Sheet.html",
+		Action:     "Tenant Isolation",
+		Mitigation: "Enforce the tenant context on every data access (like via tenant-scoped credentials, encryption keys per tenant, " +
+			"or row-level security) and verify the tenant isolation with automated tests, or separate the data of different owners into dedicated technical assets.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope multi-tenant technical assets (not tagged with 'tenant-isolation-verified') processing or storing " +
+			"strictly-confidential data assets of at least two different owners.",
+		RiskAssessment:             "The risk rating depends on the number of distinct strictly-confidential data assets processed or stored.",
+		FalsePositives:             "Multi-tenant technical assets with a tenant isolation verified by tests can be tagged with 'tenant-isolation-verified'.",
+		ModelFailurePossibleReason: false,
+		CWE:                        668,
+		Labels:                     []string{"cloud"},
+	}
+}
+
+func (*MultiTenantIsolationRule) SupportedTags() []string {
+	return []string{"tenant-isolation-verified"}
+}
+
+func (r *MultiTenantIsolationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		dataAssets, owners := r.strictlyConfidentialDataAssets(parsedModel, techAsset)
+		if len(owners) < 2 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, dataAssets))
+	}
+	return risks, nil
+}
+
+func (r *MultiTenantIsolationRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.MultiTenant || techAsset.IsTaggedWithAny("tenant-isolation-verified")
+}
+
+// strictlyConfidentialDataAssets returns the sorted strictly-confidential data assets processed or stored by the
+// technical asset and their sorted distinct owners
+func (r *MultiTenantIsolationRule) strictlyConfidentialDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) ([]string, []string) {
+	dataAssets := make([]string, 0)
+	owners := make([]string, 0)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		if dataAsset.Confidentiality != types.StrictlyConfidential {
+			continue
+		}
+		dataAssets = append(dataAssets, dataAsset.Id)
+		if !contains(owners, dataAsset.Owner) {
+			owners = append(owners, dataAsset.Owner)
+		}
+	}
+	sort.Strings(dataAssets)
+	sort.Strings(owners)
+	return dataAssets, owners
+}
+
+func (r *MultiTenantIsolationRule) impact(dataAssets []string) types.RiskExploitationImpact {
+	switch {
+	case len(dataAssets) >= 5:
+		return types.VeryHighImpact
+	case len(dataAssets) >= 3:
+		return types.HighImpact
+	default:
+		return types.MediumImpact
+	}
+}
+
+func (r *MultiTenantIsolationRule) createRisk(techAsset *types.TechnicalAsset, dataAssets []string) *types.Risk {
+	impact := r.impact(dataAssets)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Multi-Tenant Isolation</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *MultiTenantIsolationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		dataAssets, owners := r.strictlyConfidentialDataAssets(parsedModel, techAsset)
+		if len(owners) < 2 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - multi-tenant: %v (=true)", techAsset.MultiTenant),
+			fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "tenant-isolation-verified"),
+			fmt.Sprintf("  - processes %v data assets %q", types.StrictlyConfidential, dataAssets),
+			fmt.Sprintf("  - of owners %q", owners),
+			fmt.Sprintf("    - impact is %v because of %d distinct data assets", r.impact(dataAssets), len(dataAssets)),
+		}...)
+	}
+
+	return explanation
+}
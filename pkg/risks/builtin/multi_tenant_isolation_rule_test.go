@@ -0,0 +1,119 @@
+package builtin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestMultiTenantIsolationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewMultiTenantIsolationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type MultiTenantIsolationRuleTest struct {
+	multiTenant     bool
+	tags            []string
+	confidentiality types.Confidentiality
+	owners          []string
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestMultiTenantIsolationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]MultiTenantIsolationRuleTest{
+		"not multi-tenant": {
+			confidentiality: types.StrictlyConfidential,
+			owners:          []string{"tenant-a", "tenant-b"},
+			riskCreated:     false,
+		},
+		"tenant isolation verified": {
+			multiTenant:     true,
+			tags:            []string{"tenant-isolation-verified"},
+			confidentiality: types.StrictlyConfidential,
+			owners:          []string{"tenant-a", "tenant-b"},
+			riskCreated:     false,
+		},
+		"data not strictly confidential": {
+			multiTenant:     true,
+			confidentiality: types.Confidential,
+			owners:          []string{"tenant-a", "tenant-b"},
+			riskCreated:     false,
+		},
+		"single owner": {
+			multiTenant:     true,
+			confidentiality: types.StrictlyConfidential,
+			owners:          []string{"tenant-a", "tenant-a", "tenant-a"},
+			riskCreated:     false,
+		},
+		"two data assets of different owners": {
+			multiTenant:         true,
+			confidentiality:     types.StrictlyConfidential,
+			owners:              []string{"tenant-a", "tenant-b"},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium because of 2 distinct data assets",
+		},
+		"three data assets": {
+			multiTenant:         true,
+			confidentiality:     types.StrictlyConfidential,
+			owners:              []string{"tenant-a", "tenant-b", "tenant-b"},
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because of 3 distinct data assets",
+		},
+		"five data assets": {
+			multiTenant:         true,
+			confidentiality:     types.StrictlyConfidential,
+			owners:              []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d", "tenant-e"},
+			riskCreated:         true,
+			expectedImpact:      types.VeryHighImpact,
+			expectedExplanation: "    - impact is very-high because of 5 distinct data assets",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewMultiTenantIsolationRule()
+			dataAssets := make(map[string]*types.DataAsset)
+			dataAssetIds := make([]string, 0)
+			for i, owner := range testCase.owners {
+				id := fmt.Sprintf("data-%d", i)
+				dataAssets[id] = &types.DataAsset{Id: id, Owner: owner, Confidentiality: testCase.confidentiality}
+				dataAssetIds = append(dataAssetIds, id)
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"saas": {
+						Id:                  "saas",
+						Title:               "SaaS",
+						MultiTenant:         testCase.multiTenant,
+						Tags:                testCase.tags,
+						DataAssetsProcessed: dataAssetIds,
+					},
+				},
+				DataAssets: dataAssets,
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Multi-Tenant Isolation</b> risk at <b>SaaS</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
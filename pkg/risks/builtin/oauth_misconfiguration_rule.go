@@ -0,0 +1,195 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type OAuthMisconfigurationRule struct{}
+
+func NewOAuthMisconfigurationRule() *OAuthMisconfigurationRule {
+	return &OAuthMisconfigurationRule{}
+}
+
+// oauthWeakness describes one of the OAuth / OIDC specific weaknesses checked by the rule
+type oauthWeakness struct {
+	id         string
+	title      string
+	likelihood types.RiskExploitationLikelihood
+}
+
+var (
+	oauthImplicitFlow = oauthWeakness{id: "implicit-flow", title: "OAuth Implicit Flow", likelihood: types.Likely}
+	oauthMissingPKCE  = oauthWeakness{id: "missing-pkce", title: "OAuth Missing PKCE", likelihood: types.Unlikely}
+	oauthUnencrypted  = oauthWeakness{id: "unencrypted-token-validation", title: "OAuth Unencrypted Token Validation", likelihood: types.VeryLikely}
+)
+
+var oauthWeaknesses = []oauthWeakness{oauthImplicitFlow, oauthMissingPKCE, oauthUnencrypted}
+
+func (*OAuthMisconfigurationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "oauth-misconfiguration",
+		Title: "OAuth/OIDC Misconfiguration",
+		Description: "Clients of identity providers using OAuth 2.0 or OpenID Connect are prone to token theft when public clients use the implicit flow " +
+			"or the authorization code flow without PKCE, and to token forgery when tokens are issued or validated via unencrypted communication links.",
+		Impact: "If this risk is unmitigated, attackers might be able to steal or forge access tokens and thereby " +
+			"impersonate users towards all the services trusting the identity provider.",
+		ASVS:       "V2 - Authentication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/OAuth2_Cheat_Sheet.html",
+		Action:     "OAuth/OIDC Hardening",
+		Mitigation: "Use the authorization code flow with PKCE for public clients (tag the communication link or client with 'pkce'), " +
+			"do not use the implicit flow, and communicate with the identity provider (including fetching its keys) via encrypted protocols only.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.Spoofing,
+		DetectionLogic: "Communication links to technical assets of technology '" + types.IdentityProvider + "' (with at least one of both ends in scope). " +
+			"For public clients (technology '" + types.Browser + "' or '" + types.MobileApp + "') a risk is raised when the communication link or the client is tagged with " +
+			"'oauth-implicit-flow', otherwise when neither is tagged with 'pkce'. For all clients a risk is raised when the communication link is not encrypted.",
+		RiskAssessment: "The risk rating depends on the sensitivity of the data processed by the identity provider. " +
+			"The likelihood depends on the weakness.",
+		FalsePositives: "Clients having the weaknesses mitigated in a way not modeled via tags " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        287,
+		Labels:                     []string{"identity"},
+	}
+}
+
+func (*OAuthMisconfigurationRule) SupportedTags() []string {
+	return []string{"oauth-implicit-flow", "pkce"}
+}
+
+func (r *OAuthMisconfigurationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || r.skipLink(techAsset, targetAsset) {
+				continue
+			}
+
+			for _, weakness := range r.weaknesses(techAsset, commLink) {
+				risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink, weakness))
+			}
+		}
+	}
+	return risks, nil
+}
+
+func (r *OAuthMisconfigurationRule) skipLink(techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset) bool {
+	return (techAsset.OutOfScope && targetAsset.OutOfScope) || !targetAsset.Technologies.GetAttribute(types.IdentityProvider)
+}
+
+func (r *OAuthMisconfigurationRule) isPublicClient(techAsset *types.TechnicalAsset) bool {
+	return techAsset.Technologies.GetAttribute(types.Browser) || techAsset.Technologies.GetAttribute(types.MobileApp)
+}
+
+// weaknesses returns the weaknesses of the communication link from the client to the identity provider
+func (r *OAuthMisconfigurationRule) weaknesses(techAsset *types.TechnicalAsset, commLink *types.CommunicationLink) []oauthWeakness {
+	result := make([]oauthWeakness, 0)
+	if r.isPublicClient(techAsset) {
+		if commLink.IsTaggedWithAny("oauth-implicit-flow") || techAsset.IsTaggedWithAny("oauth-implicit-flow") {
+			result = append(result, oauthImplicitFlow)
+		} else if !commLink.IsTaggedWithAny("pkce") && !techAsset.IsTaggedWithAny("pkce") {
+			result = append(result, oauthMissingPKCE)
+		}
+	}
+	if !commLink.Protocol.IsEncrypted() && !commLink.Protocol.IsProcessLocal() {
+		result = append(result, oauthUnencrypted)
+	}
+	return result
+}
+
+func (r *OAuthMisconfigurationRule) hasWeakness(techAsset *types.TechnicalAsset, commLink *types.CommunicationLink, weakness oauthWeakness) bool {
+	for _, candidate := range r.weaknesses(techAsset, commLink) {
+		if candidate.id == weakness.id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *OAuthMisconfigurationRule) impact(parsedModel *types.Model, identityProvider *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(identityProvider) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(identityProvider) == types.MissionCritical {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *OAuthMisconfigurationRule) syntheticId(weakness oauthWeakness, commLink *types.CommunicationLink) string {
+	return r.Category().ID + "@" + weakness.id + "@" + commLink.Id + "@" + commLink.SourceId + "@" + commLink.TargetId
+}
+
+func (r *OAuthMisconfigurationRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink, weakness oauthWeakness) *types.Risk {
+	impact := r.impact(parsedModel, targetAsset)
+	title := "<b>" + weakness.title + "</b> risk at <b>" + techAsset.Title + "</b> authenticating at <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(weakness.likelihood, impact),
+		ExploitationLikelihood:          weakness.likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = r.syntheticId(weakness, commLink)
+	return risk
+}
+
+func (r *OAuthMisconfigurationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			for _, weakness := range oauthWeaknesses {
+				if !strings.EqualFold(risk, r.syntheticId(weakness, commLink)) && !strings.EqualFold(risk, categoryId+"@*") {
+					continue
+				}
+
+				targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+				if !ok || r.skipLink(techAsset, targetAsset) || !r.hasWeakness(techAsset, commLink, weakness) {
+					continue
+				}
+
+				if len(explanation) > 0 {
+					explanation = append(explanation, "")
+				}
+
+				explanation = append(explanation, []string{
+					fmt.Sprintf("communication link %q", commLink.Id),
+					fmt.Sprintf("  - weakness: %v", weakness.id),
+					fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+					fmt.Sprintf("    - out of scope: %v", techAsset.OutOfScope),
+					fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+					fmt.Sprintf("    - technology: %v (has %q)", targetAsset.Technologies.String(), types.IdentityProvider),
+				}...)
+
+				switch weakness.id {
+				case oauthImplicitFlow.id:
+					explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because public client tags %v or link tags %v have %q",
+						weakness.likelihood, techAsset.Tags, commLink.Tags, "oauth-implicit-flow"))
+				case oauthMissingPKCE.id:
+					explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because neither public client tags %v nor link tags %v have %q",
+						weakness.likelihood, techAsset.Tags, commLink.Tags, "pkce"))
+				default:
+					explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because protocol %v is not encrypted", weakness.likelihood, commLink.Protocol))
+				}
+				if impact := r.impact(parsedModel, targetAsset); impact == types.HighImpact {
+					explanation = append(explanation, fmt.Sprintf("    - impact is %v because the identity provider processes %v or %v data", impact, types.StrictlyConfidential, types.MissionCritical))
+				} else {
+					explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+				}
+			}
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestOAuthMisconfigurationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewOAuthMisconfigurationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type OAuthMisconfigurationRuleTest struct {
+	clientTechnology string
+	clientTags       []string
+	linkTags         []string
+	protocol         types.Protocol
+	targetTechnology string
+	confidentiality  types.Confidentiality
+
+	expectedTitles      []string
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestOAuthMisconfigurationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]OAuthMisconfigurationRuleTest{
+		"target not an identity provider": {
+			clientTechnology: types.Browser,
+			protocol:         types.HTTP,
+			targetTechnology: types.WebApplication,
+		},
+		"public client with pkce": {
+			clientTechnology: types.Browser,
+			linkTags:         []string{"pkce"},
+			protocol:         types.HTTPS,
+			targetTechnology: types.IdentityProvider,
+		},
+		"confidential client via encrypted link": {
+			clientTechnology: types.WebApplication,
+			protocol:         types.HTTPS,
+			targetTechnology: types.IdentityProvider,
+		},
+		"public client without pkce": {
+			clientTechnology:    types.MobileApp,
+			protocol:            types.HTTPS,
+			targetTechnology:    types.IdentityProvider,
+			expectedTitles:      []string{"<b>OAuth Missing PKCE</b> risk at <b>Client</b> authenticating at <b>IdP</b> via <b>Login</b>"},
+			expectedLikelihood:  types.Unlikely,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "  - weakness: missing-pkce",
+		},
+		"public client using implicit flow": {
+			clientTechnology:    types.Browser,
+			clientTags:          []string{"oauth-implicit-flow", "pkce"},
+			protocol:            types.HTTPS,
+			targetTechnology:    types.IdentityProvider,
+			confidentiality:     types.StrictlyConfidential,
+			expectedTitles:      []string{"<b>OAuth Implicit Flow</b> risk at <b>Client</b> authenticating at <b>IdP</b> via <b>Login</b>"},
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the identity provider processes strictly-confidential or mission-critical data",
+		},
+		"confidential client validating tokens via unencrypted link": {
+			clientTechnology:    types.WebServiceREST,
+			protocol:            types.HTTP,
+			targetTechnology:    types.IdentityProvider,
+			expectedTitles:      []string{"<b>OAuth Unencrypted Token Validation</b> risk at <b>Client</b> authenticating at <b>IdP</b> via <b>Login</b>"},
+			expectedLikelihood:  types.VeryLikely,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - likelihood is very-likely because protocol http is not encrypted",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewOAuthMisconfigurationRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:           "client",
+						Title:        "Client",
+						Tags:         testCase.clientTags,
+						Technologies: types.TechnologyList{{Name: testCase.clientTechnology, Attributes: map[string]bool{testCase.clientTechnology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "client>idp", Title: "Login", SourceId: "client", TargetId: "idp", Tags: testCase.linkTags, Protocol: testCase.protocol},
+						},
+					},
+					"idp": {
+						Id:              "idp",
+						Title:           "IdP",
+						Confidentiality: testCase.confidentiality,
+						Technologies:    types.TechnologyList{{Name: testCase.targetTechnology, Attributes: map[string]bool{testCase.targetTechnology: true}}},
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			assert.Len(t, risks, len(testCase.expectedTitles))
+			for i, expectedTitle := range testCase.expectedTitles {
+				assert.Equal(t, expectedTitle, risks[i].Title)
+				assert.Equal(t, testCase.expectedLikelihood, risks[i].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[i].ExploitationImpact)
+				assert.Contains(t, rule.ExplainRisk(model, risks[i].SyntheticId), testCase.expectedExplanation)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type OverPermissionedServerlessRule struct {
+	datastoreLimit int
+}
+
+func NewOverPermissionedServerlessRule() *OverPermissionedServerlessRule {
+	return &OverPermissionedServerlessRule{datastoreLimit: 3}
+}
+
+func (r *OverPermissionedServerlessRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Int("datastore-limit", &r.datastoreLimit)
+}
+
+func (r *OverPermissionedServerlessRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "over-permissioned-serverless",
+		Title: "Over-Permissioned Serverless Function",
+		Description: "Serverless functions accessing many data stores of differing classification usually run with an IAM role " +
+			"granting broad permissions, instead of following the principle of least privilege.",
+		Impact: "If this risk is unmitigated, attackers compromising the function (like via injection or a vulnerable dependency) " +
+			"might use its permissions to access all the data stores reachable by it, including the most sensitive ones.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authorization_Cheat_Sheet.html",
+		Action:     "Least Privilege",
+		Mitigation: "Split the function into smaller functions each accessing only the data stores of one classification, " +
+			"and grant each function a dedicated role scoped to the resources and actions it requires.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope technical assets of machine type " + types.Serverless.String() + " or tagged with 'lambda', 'cloud-function', or 'serverless' " +
+			"having outgoing communication links to at least " + strconv.Itoa(r.datastoreLimit) + " data stores of at least two different confidentiality ratings.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data stores reachable by the function (i.e. its blast radius).",
+		FalsePositives: "Functions having dedicated narrowly scoped permissions per data store " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        250,
+		Labels:                     []string{"cloud", "identity"},
+	}
+}
+
+func (*OverPermissionedServerlessRule) SupportedTags() []string {
+	return []string{"lambda", "cloud-function", "serverless"}
+}
+
+func (r *OverPermissionedServerlessRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		datastores := r.reachedDatastores(parsedModel, techAsset)
+		if !r.isOverPermissioned(parsedModel, datastores) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, datastores))
+	}
+	return risks, nil
+}
+
+func (r *OverPermissionedServerlessRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || (techAsset.Machine != types.Serverless && !techAsset.IsTaggedWithAny(r.SupportedTags()...))
+}
+
+// reachedDatastores returns the sorted ids of the data stores targeted by outgoing communication links of the function
+func (r *OverPermissionedServerlessRule) reachedDatastores(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, commLink := range techAsset.CommunicationLinks {
+		targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+		if ok && targetAsset.Type == types.Datastore && !contains(result, targetAsset.Id) {
+			result = append(result, targetAsset.Id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *OverPermissionedServerlessRule) isOverPermissioned(parsedModel *types.Model, datastores []string) bool {
+	if len(datastores) < r.datastoreLimit {
+		return false
+	}
+	classifications := make(map[types.Confidentiality]bool)
+	for _, id := range datastores {
+		classifications[parsedModel.HighestProcessedConfidentiality(parsedModel.TechnicalAssets[id])] = true
+	}
+	return len(classifications) > 1
+}
+
+func (r *OverPermissionedServerlessRule) highestConfidentiality(parsedModel *types.Model, datastores []string) types.Confidentiality {
+	highest := types.Public
+	for _, id := range datastores {
+		if confidentiality := parsedModel.HighestProcessedConfidentiality(parsedModel.TechnicalAssets[id]); confidentiality > highest {
+			highest = confidentiality
+		}
+	}
+	return highest
+}
+
+func (r *OverPermissionedServerlessRule) impact(parsedModel *types.Model, datastores []string) types.RiskExploitationImpact {
+	switch r.highestConfidentiality(parsedModel, datastores) {
+	case types.StrictlyConfidential:
+		return types.HighImpact
+	case types.Confidential:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *OverPermissionedServerlessRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, datastores []string) *types.Risk {
+	impact := r.impact(parsedModel, datastores)
+	title := "<b>Over-Permissioned Serverless Function</b> risk at <b>" + techAsset.Title + "</b> accessing <b>" + strconv.Itoa(len(datastores)) + " data stores</b>"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  datastores,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *OverPermissionedServerlessRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		datastores := r.reachedDatastores(parsedModel, techAsset)
+		if !r.isOverPermissioned(parsedModel, datastores) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - machine: %v, tags: %v (is %v or has either [%q, %q, %q])", techAsset.Machine, techAsset.Tags, types.Serverless, "lambda", "cloud-function", "serverless"),
+			fmt.Sprintf("  - accessing %v data stores (>=%v) of differing confidentiality:", len(datastores), r.datastoreLimit),
+		}...)
+
+		for _, datastoreId := range datastores {
+			explanation = append(explanation, fmt.Sprintf("    - technical asset %q (%v)", datastoreId, parsedModel.HighestProcessedConfidentiality(parsedModel.TechnicalAssets[datastoreId])))
+		}
+
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest confidentiality %v", r.impact(parsedModel, datastores), r.highestConfidentiality(parsedModel, datastores)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,112 @@
+package builtin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestOverPermissionedServerlessRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewOverPermissionedServerlessRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type OverPermissionedServerlessRuleTest struct {
+	machine           types.TechnicalAssetMachine
+	tags              []string
+	datastoreRatings  []types.Confidentiality
+	targetsAreProcess bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedTitle       string
+	expectedExplanation string
+}
+
+func TestOverPermissionedServerlessRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]OverPermissionedServerlessRuleTest{
+		"not serverless": {
+			machine:          types.Virtual,
+			datastoreRatings: []types.Confidentiality{types.Public, types.Internal, types.StrictlyConfidential},
+			riskCreated:      false,
+		},
+		"too few data stores": {
+			machine:          types.Serverless,
+			datastoreRatings: []types.Confidentiality{types.Public, types.StrictlyConfidential},
+			riskCreated:      false,
+		},
+		"data stores of same classification": {
+			tags:             []string{"lambda"},
+			datastoreRatings: []types.Confidentiality{types.Confidential, types.Confidential, types.Confidential},
+			riskCreated:      false,
+		},
+		"targets not data stores": {
+			machine:           types.Serverless,
+			datastoreRatings:  []types.Confidentiality{types.Public, types.Internal, types.StrictlyConfidential},
+			targetsAreProcess: true,
+			riskCreated:       false,
+		},
+		"cloud function accessing data stores of differing classification": {
+			tags:                []string{"cloud-function"},
+			datastoreRatings:    []types.Confidentiality{types.Public, types.Internal, types.Confidential},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedTitle:       "<b>Over-Permissioned Serverless Function</b> risk at <b>Function</b> accessing <b>3 data stores</b>",
+			expectedExplanation: "    - technical asset \"datastore-2\" (confidential)",
+		},
+		"serverless function accessing strictly confidential data store": {
+			machine:             types.Serverless,
+			datastoreRatings:    []types.Confidentiality{types.Internal, types.Internal, types.Restricted, types.StrictlyConfidential},
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedTitle:       "<b>Over-Permissioned Serverless Function</b> risk at <b>Function</b> accessing <b>4 data stores</b>",
+			expectedExplanation: "    - impact is high because of highest confidentiality strictly-confidential",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewOverPermissionedServerlessRule()
+			function := &types.TechnicalAsset{
+				Id:      "function",
+				Title:   "Function",
+				Machine: testCase.machine,
+				Tags:    testCase.tags,
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"function": function,
+				},
+			}
+			for i, rating := range testCase.datastoreRatings {
+				datastoreId := fmt.Sprintf("datastore-%d", i)
+				datastoreType := types.Datastore
+				if testCase.targetsAreProcess {
+					datastoreType = types.Process
+				}
+				model.TechnicalAssets[datastoreId] = &types.TechnicalAsset{Id: datastoreId, Type: datastoreType, Confidentiality: rating}
+				function.CommunicationLinks = append(function.CommunicationLinks, &types.CommunicationLink{Id: "function>" + datastoreId, SourceId: "function", TargetId: datastoreId})
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+				assert.Len(t, risks[0].DataBreachTechnicalAssetIDs, len(testCase.datastoreRatings))
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*PathTraversalRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        22,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -41,6 +43,10 @@ func (*PathTraversalRule) SupportedTags() []string {
 	return []string{}
 }
 
+func (*PathTraversalRule) IsAssetScoped() bool {
+	return true
+}
+
 func (r *PathTraversalRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
 	risks := make([]*types.Risk, 0)
 	for _, id := range input.SortedTechnicalAssetIDs() {
@@ -68,7 +74,7 @@ func (r *PathTraversalRule) createRisk(input *types.Model, technicalAsset *types
 	title := "<b>Path-Traversal</b> risk at <b>" + caller.Title + "</b> against filesystem <b>" + technicalAsset.Title + "</b>" +
 		" via <b>" + incomingFlow.Title + "</b>"
 	impact := types.MediumImpact
-	if input.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || input.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical {
+	if input.AnalysisContext().IsHighlySensitive(technicalAsset) {
 		impact = types.HighImpact
 	}
 	risk := &types.Risk{
@@ -85,3 +91,25 @@ func (r *PathTraversalRule) createRisk(input *types.Model, technicalAsset *types
 	risk.SyntheticId = risk.CategoryId + "@" + caller.Id + "@" + technicalAsset.Id + "@" + incomingFlow.Id
 	return risk
 }
+
+func (r *PathTraversalRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[incomingFlow.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", incomingFlow.Id),
+			fmt.Sprintf("  - caller: technical asset %q (out of scope: %v (=false))", incomingFlow.SourceId, parsedModel.TechnicalAssets[incomingFlow.SourceId].OutOfScope),
+			fmt.Sprintf("  - filesystem: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsFileStorage),
+			fmt.Sprintf("    - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedLikelihood(generatedRisk, types.VeryLikely, fmt.Sprintf("the usage is %v", incomingFlow.Usage)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the filesystem processes %v data or %v integrity", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,148 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type PhysicalAccessRule struct{}
+
+func NewPhysicalAccessRule() *PhysicalAccessRule {
+	return &PhysicalAccessRule{}
+}
+
+func (*PhysicalAccessRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "physical-access",
+		Title: "Physical Access",
+		Description: "Physical machines outside of a cloud provider storing strictly confidential data without encryption at rest " +
+			"can be compromised by anyone gaining physical access to them (like by stealing or replacing disks).",
+		Impact: "If this risk is unmitigated, attackers with physical access to the machine might be able to read " +
+			"all the data stored on it, bypassing any logical access control.",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Cryptographic_Storage_Cheat_Sheet.html",
+		Action:     "Physical Protection",
+		Mitigation: "Encrypt the data at rest (like by full disk encryption with keys not stored on the machine) and operate the machine " +
+			"within a datacenter with physical access control. Tag the technical asset with 'encrypted-at-rest' when the encryption is in place.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets of machine type " + types.Physical.String() + " not located within a cloud provider trust boundary, " +
+			"which store " + types.StrictlyConfidential.String() + " data, are not encrypted, and are not tagged with 'encrypted-at-rest'.",
+		RiskAssessment: "The impact is high due to the " + types.StrictlyConfidential.String() + " data. The likelihood is lower when the machine " +
+			"is located within a " + types.NetworkOnPrem.String() + " or " + types.NetworkDedicatedHoster.String() + " trust boundary (i.e. a datacenter).",
+		FalsePositives: "Machines located in facilities with strict physical access control (like locked cages with surveillance) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        311,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*PhysicalAccessRule) SupportedTags() []string {
+	return []string{"encrypted-at-rest"}
+}
+
+func (r *PhysicalAccessRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *PhysicalAccessRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.Machine != types.Physical || techAsset.Encryption != types.NoneEncryption ||
+		techAsset.IsTaggedWithAny(r.SupportedTags()...) {
+		return true
+	}
+	if parsedModel.HighestStoredConfidentiality(techAsset) != types.StrictlyConfidential {
+		return true
+	}
+	hosting := r.hostingTrustBoundary(parsedModel, techAsset)
+	return hosting != nil && hosting.Type.IsWithinCloud()
+}
+
+// hostingTrustBoundary returns the innermost trust boundary containing the technical asset which reveals where
+// the machine is hosted (i.e. on prem, at a dedicated hoster, or at a cloud provider), if any
+func (r *PhysicalAccessRule) hostingTrustBoundary(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.TrustBoundary {
+	trustBoundary := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+	for trustBoundary != nil {
+		if trustBoundary.Type.IsWithinCloud() || r.isDatacenter(trustBoundary) {
+			return trustBoundary
+		}
+		trustBoundary = parsedModel.AnalysisContext().ParentTrustBoundary(trustBoundary)
+	}
+	return nil
+}
+
+func (r *PhysicalAccessRule) isDatacenter(trustBoundary *types.TrustBoundary) bool {
+	return trustBoundary.Type == types.NetworkOnPrem || trustBoundary.Type == types.NetworkDedicatedHoster
+}
+
+func (r *PhysicalAccessRule) likelihood(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationLikelihood {
+	if r.hostingTrustBoundary(parsedModel, techAsset) != nil {
+		return types.Unlikely
+	}
+	return types.Likely
+}
+
+func (r *PhysicalAccessRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	likelihood := r.likelihood(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, types.HighImpact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           types.HighImpact,
+		Title:                        "<b>Physical Access</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *PhysicalAccessRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - machine: %v (=%v)", techAsset.Machine, types.Physical),
+			fmt.Sprintf("  - encryption: %v (=%v)", techAsset.Encryption, types.NoneEncryption),
+			fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "encrypted-at-rest"),
+			fmt.Sprintf("  - highest stored confidentiality: %v (=%v)", parsedModel.HighestStoredConfidentiality(techAsset), types.StrictlyConfidential),
+		}...)
+
+		if hosting := r.hostingTrustBoundary(parsedModel, techAsset); hosting != nil {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the machine is located within datacenter trust boundary %q (type %v)", types.Unlikely, hosting.Id, hosting.Type))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the machine is not located within a datacenter trust boundary", types.Likely))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is stored", types.HighImpact, types.StrictlyConfidential))
+	}
+
+	return explanation
+}
@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestPhysicalAccessRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewPhysicalAccessRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type PhysicalAccessRuleTest struct {
+	machine         types.TechnicalAssetMachine
+	encryption      types.EncryptionStyle
+	tags            []string
+	confidentiality types.Confidentiality
+	noTrustBoundary bool
+	boundaryType    types.TrustBoundaryType
+	nestedInVLAN    bool
+
+	riskCreated         bool
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedExplanation string
+}
+
+func TestPhysicalAccessRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]PhysicalAccessRuleTest{
+		"virtual machine": {
+			machine:         types.Virtual,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.NetworkOnPrem,
+			riskCreated:     false,
+		},
+		"not strictly confidential": {
+			machine:         types.Physical,
+			confidentiality: types.Confidential,
+			boundaryType:    types.NetworkOnPrem,
+			riskCreated:     false,
+		},
+		"encrypted": {
+			machine:         types.Physical,
+			encryption:      types.Transparent,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.NetworkOnPrem,
+			riskCreated:     false,
+		},
+		"tagged encrypted at rest": {
+			machine:         types.Physical,
+			tags:            []string{"encrypted-at-rest"},
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.NetworkOnPrem,
+			riskCreated:     false,
+		},
+		"located at cloud provider": {
+			machine:         types.Physical,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.NetworkCloudProvider,
+			riskCreated:     false,
+		},
+		"located on prem": {
+			machine:             types.Physical,
+			confidentiality:     types.StrictlyConfidential,
+			boundaryType:        types.NetworkOnPrem,
+			riskCreated:         true,
+			expectedLikelihood:  types.Unlikely,
+			expectedExplanation: "    - likelihood is unlikely because the machine is located within datacenter trust boundary \"datacenter\" (type network-on-prem)",
+		},
+		"located within vlan at dedicated hoster": {
+			machine:             types.Physical,
+			confidentiality:     types.StrictlyConfidential,
+			boundaryType:        types.NetworkDedicatedHoster,
+			nestedInVLAN:        true,
+			riskCreated:         true,
+			expectedLikelihood:  types.Unlikely,
+			expectedExplanation: "    - likelihood is unlikely because the machine is located within datacenter trust boundary \"datacenter\" (type network-dedicated-hoster)",
+		},
+		"not located within datacenter": {
+			machine:             types.Physical,
+			confidentiality:     types.StrictlyConfidential,
+			noTrustBoundary:     true,
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedExplanation: "    - likelihood is likely because the machine is not located within a datacenter trust boundary",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewPhysicalAccessRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"server": {
+						Id:               "server",
+						Title:            "Server",
+						Machine:          testCase.machine,
+						Encryption:       testCase.encryption,
+						Tags:             testCase.tags,
+						DataAssetsStored: []string{"data"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{},
+			}
+			if !testCase.noTrustBoundary {
+				datacenter := &types.TrustBoundary{Id: "datacenter", Type: testCase.boundaryType, TechnicalAssetsInside: []string{"server"}}
+				model.TrustBoundaries[datacenter.Id] = datacenter
+				model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["server"] = datacenter
+				if testCase.nestedInVLAN {
+					vlan := &types.TrustBoundary{Id: "vlan", Type: types.NetworkVirtualLAN, TechnicalAssetsInside: []string{"server"}}
+					datacenter.TechnicalAssetsInside = []string{}
+					datacenter.TrustBoundariesNested = []string{vlan.Id}
+					model.TrustBoundaries[vlan.Id] = vlan
+					model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["server"] = vlan
+				}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Physical Access</b> risk at <b>Server</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type PipelinePoisoningRule struct{}
+
+func NewPipelinePoisoningRule() *PipelinePoisoningRule {
+	return &PipelinePoisoningRule{}
+}
+
+func (*PipelinePoisoningRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "pipeline-poisoning",
+		Title: "Pipeline Poisoning",
+		Description: "Build pipelines with write access to artifact registries or deployment targets are valuable targets: " +
+			"when attackers are able to manipulate the pipeline definition or its build steps (aka Poisoned Pipeline Execution), " +
+			"they can push malicious artifacts or deployments with the privileges of the pipeline.",
+		Impact: "If this risk is unmitigated, attackers might be able to inject malicious artifacts into the artifact registries " +
+			"or deploy malicious code to the target systems.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/CI_CD_Security_Cheat_Sheet.html",
+		Action:     "Build Pipeline Hardening",
+		Mitigation: "Protect the pipeline definitions with branch protection and mandatory reviews, do not run pipelines with write access " +
+			"for untrusted contributions, and restrict the credentials of the pipeline to the least privileges required.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.Tampering,
+		DetectionLogic: "In-scope build pipelines having non-readonly outgoing communication links to artifact registries or DevOps-usage communication links to deployment targets.",
+		RiskAssessment: "The risk rating depends on the highest integrity rating of the data processed by the build pipeline (mission-critical raises the impact) " +
+			"and on whether the build pipeline writes across a network trust boundary (which raises the likelihood).",
+		FalsePositives: "Build pipelines which only build from reviewed and protected branches using short-lived credentials " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        829,
+		Labels:                     []string{"supply-chain"},
+	}
+}
+
+func (*PipelinePoisoningRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *PipelinePoisoningRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		writeLinks := r.writeLinks(parsedModel, techAsset)
+		if len(writeLinks) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, writeLinks))
+	}
+	return risks, nil
+}
+
+func (r *PipelinePoisoningRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.BuildPipeline)
+}
+
+// writeLinks returns the outgoing communication links writing to artifact registries or deploying to targets
+func (r *PipelinePoisoningRule) writeLinks(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.CommunicationLink {
+	result := make([]*types.CommunicationLink, 0)
+	for _, commLink := range techAsset.CommunicationLinksSorted() {
+		if commLink.Readonly {
+			continue
+		}
+		targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+		if !ok {
+			continue
+		}
+		if targetAsset.Technologies.GetAttribute(types.ArtifactRegistry) || commLink.Usage == types.DevOps {
+			result = append(result, commLink)
+		}
+	}
+	return result
+}
+
+func (r *PipelinePoisoningRule) isWritingAcrossTrustBoundary(parsedModel *types.Model, writeLinks []*types.CommunicationLink) bool {
+	for _, commLink := range writeLinks {
+		if isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *PipelinePoisoningRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, writeLinks []*types.CommunicationLink) *types.Risk {
+	likelihood := types.Unlikely
+	if r.isWritingAcrossTrustBoundary(parsedModel, writeLinks) {
+		likelihood = types.Likely
+	}
+	impact := types.MediumImpact
+	if parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+		impact = types.HighImpact
+	}
+	dataBreachTechnicalAssetIDs := []string{techAsset.Id}
+	for _, commLink := range writeLinks {
+		if !contains(dataBreachTechnicalAssetIDs, commLink.TargetId) {
+			dataBreachTechnicalAssetIDs = append(dataBreachTechnicalAssetIDs, commLink.TargetId)
+		}
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Pipeline Poisoning</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  dataBreachTechnicalAssetIDs,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *PipelinePoisoningRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		writeLinks := r.writeLinks(parsedModel, techAsset)
+		if len(writeLinks) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", techAsset.Technologies.String(), types.BuildPipeline),
+			"  - writing communication links:",
+		}...)
+
+		for _, commLink := range writeLinks {
+			explanation = append(explanation, fmt.Sprintf("    - %q to technical asset %q", commLink.Id, commLink.TargetId))
+		}
+
+		if r.isWritingAcrossTrustBoundary(parsedModel, writeLinks) {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the build pipeline writes across a network trust boundary", types.Likely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v (default)", types.Unlikely))
+		}
+
+		if parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is processed", types.HighImpact, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
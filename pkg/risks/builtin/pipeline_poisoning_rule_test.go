@@ -0,0 +1,125 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestPipelinePoisoningRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewPipelinePoisoningRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type PipelinePoisoningRuleTest struct {
+	technology        string
+	targetTechnology  string
+	readonly          bool
+	usage             types.Usage
+	sameTrustBoundary bool
+	integrity         types.Criticality
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestPipelinePoisoningRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]PipelinePoisoningRuleTest{
+		"not a build pipeline": {
+			technology:       types.DevOpsClient,
+			targetTechnology: types.ArtifactRegistry,
+			riskCreated:      false,
+		},
+		"readonly access to artifact registry": {
+			technology:       types.BuildPipeline,
+			targetTechnology: types.ArtifactRegistry,
+			readonly:         true,
+			riskCreated:      false,
+		},
+		"business usage link to other asset": {
+			technology:       types.BuildPipeline,
+			targetTechnology: types.WebServer,
+			riskCreated:      false,
+		},
+		"write access to artifact registry within same trust boundary": {
+			technology:         types.BuildPipeline,
+			targetTechnology:   types.ArtifactRegistry,
+			sameTrustBoundary:  true,
+			riskCreated:        true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"deployment across trust boundary": {
+			technology:         types.BuildPipeline,
+			targetTechnology:   types.WebServer,
+			usage:              types.DevOps,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"pipeline processing mission-critical data": {
+			technology:         types.BuildPipeline,
+			targetTechnology:   types.ArtifactRegistry,
+			sameTrustBoundary:  true,
+			integrity:          types.MissionCritical,
+			riskCreated:        true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewPipelinePoisoningRule()
+			commLink := &types.CommunicationLink{Id: "pipeline>target", SourceId: "pipeline", TargetId: "target", Readonly: testCase.readonly, Usage: testCase.usage}
+			pipelineBoundary := &types.TrustBoundary{Id: "ci", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"pipeline"}}
+			targetBoundary := &types.TrustBoundary{Id: "prod", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"target"}}
+			if testCase.sameTrustBoundary {
+				targetBoundary = pipelineBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"pipeline": {
+						Id:                 "pipeline",
+						Title:              "Pipeline",
+						Integrity:          testCase.integrity,
+						Technologies:       types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{commLink},
+					},
+					"target": {
+						Id:           "target",
+						Technologies: types.TechnologyList{{Name: testCase.targetTechnology, Attributes: map[string]bool{testCase.targetTechnology: true}}},
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					pipelineBoundary.Id: pipelineBoundary,
+					targetBoundary.Id:   targetBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"pipeline": pipelineBoundary,
+					"target":   targetBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Pipeline Poisoning</b> risk at <b>Pipeline</b>", risks[0].Title)
+				assert.Equal(t, []string{"pipeline", "target"}, risks[0].DataBreachTechnicalAssetIDs)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), `    - "pipeline>target" to technical asset "target"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type PublicCloudStorageExposureRule struct{}
+
+func NewPublicCloudStorageExposureRule() *PublicCloudStorageExposureRule {
+	return &PublicCloudStorageExposureRule{}
+}
+
+func (*PublicCloudStorageExposureRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "public-cloud-storage-exposure",
+		Title: "Public Cloud Storage Exposure",
+		Description: "Cloud storage (like Amazon S3 buckets, Google Cloud Storage, or Azure Blob Storage containers) which is internet-facing " +
+			"or reachable from outside of its trust boundary is frequently misconfigured to allow public or overly broad access.",
+		Impact: "If this risk is unmitigated, attackers might be able to list, read, or even overwrite the objects stored " +
+			"due to misconfigured bucket policies or access control lists.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Secure_Cloud_Architecture_Cheat_Sheet.html",
+		Action:     "Cloud Storage Access Control",
+		Mitigation: "Block public access on account level, grant access to the storage only via private endpoints and narrowly scoped " +
+			"identities, and regularly audit the bucket policies and access control lists.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets with block storage technology or tagged with 's3', 'gcs', or 'azure-blob' which are internet-facing " +
+			"or have incoming communication links from the public network or across a network trust boundary.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data stored. " +
+			"The likelihood is higher when the storage is internet-facing.",
+		FalsePositives: "Cloud storage intentionally serving public content (like static websites) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        284,
+		Labels:                     []string{"cloud", "data"},
+	}
+}
+
+func (*PublicCloudStorageExposureRule) SupportedTags() []string {
+	return []string{"s3", "gcs", "azure-blob"}
+}
+
+func (r *PublicCloudStorageExposureRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		internetFacing := r.isInternetFacing(parsedModel, techAsset)
+		if !internetFacing && !r.isReachableFromOutside(parsedModel, techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, internetFacing))
+	}
+	return risks, nil
+}
+
+func (r *PublicCloudStorageExposureRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || (!techAsset.Technologies.GetAttribute(types.BlockStorage) && !techAsset.IsTaggedWithAny(r.SupportedTags()...))
+}
+
+func (r *PublicCloudStorageExposureRule) isInternetFacing(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.Internet {
+		return true
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *PublicCloudStorageExposureRule) isReachableFromOutside(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *PublicCloudStorageExposureRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	switch parsedModel.HighestProcessedConfidentiality(techAsset) {
+	case types.StrictlyConfidential:
+		return types.HighImpact
+	case types.Confidential:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *PublicCloudStorageExposureRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, internetFacing bool) *types.Risk {
+	likelihood := types.Likely
+	if internetFacing {
+		likelihood = types.VeryLikely
+	}
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Public Cloud Storage Exposure</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *PublicCloudStorageExposureRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		internetFacing := r.isInternetFacing(parsedModel, techAsset)
+		if !internetFacing && !r.isReachableFromOutside(parsedModel, techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v, tags: %v (has %q or either tag [%q, %q, %q])", techAsset.Technologies.String(), techAsset.Tags, types.BlockStorage, "s3", "gcs", "azure-blob"),
+		}...)
+
+		if internetFacing {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the storage is internet-facing", types.VeryLikely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the storage is reachable across a network trust boundary", types.Likely))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest confidentiality %v", r.impact(parsedModel, techAsset), parsedModel.HighestProcessedConfidentiality(techAsset)))
+	}
+
+	return explanation
+}
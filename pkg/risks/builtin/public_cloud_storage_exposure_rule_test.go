@@ -0,0 +1,123 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestPublicCloudStorageExposureRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewPublicCloudStorageExposureRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type PublicCloudStorageExposureRuleTest struct {
+	technology        string
+	tags              []string
+	internet          bool
+	callerInternet    bool
+	sameTrustBoundary bool
+	confidentiality   types.Confidentiality
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestPublicCloudStorageExposureRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]PublicCloudStorageExposureRuleTest{
+		"not a cloud storage": {
+			technology:     types.Database,
+			callerInternet: true,
+			riskCreated:    false,
+		},
+		"only reachable from same trust boundary": {
+			tags:              []string{"s3"},
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"reachable across trust boundary": {
+			tags:               []string{"gcs"},
+			confidentiality:    types.Confidential,
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.MediumImpact,
+		},
+		"internet-facing storage": {
+			technology:         types.BlockStorage,
+			internet:           true,
+			sameTrustBoundary:  true,
+			riskCreated:        true,
+			expectedLikelihood: types.VeryLikely,
+			expectedImpact:     types.LowImpact,
+		},
+		"accessed from internet": {
+			tags:               []string{"azure-blob"},
+			callerInternet:     true,
+			sameTrustBoundary:  true,
+			confidentiality:    types.StrictlyConfidential,
+			riskCreated:        true,
+			expectedLikelihood: types.VeryLikely,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewPublicCloudStorageExposureRule()
+			incomingLink := &types.CommunicationLink{Id: "caller>bucket", SourceId: "caller", TargetId: "bucket"}
+			callerBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"caller"}}
+			bucketBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"bucket"}}
+			if testCase.sameTrustBoundary {
+				callerBoundary = bucketBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"caller": {
+						Id:                 "caller",
+						Internet:           testCase.callerInternet,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"bucket": {
+						Id:              "bucket",
+						Title:           "Bucket",
+						Internet:        testCase.internet,
+						Tags:            testCase.tags,
+						Confidentiality: testCase.confidentiality,
+						Technologies:    types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					callerBoundary.Id: callerBoundary,
+					bucketBoundary.Id: bucketBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"caller": callerBoundary,
+					"bucket": bucketBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"bucket": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, types.Probable, risks[0].DataBreachProbability)
+				assert.Equal(t, "<b>Public Cloud Storage Exposure</b> risk at <b>Bucket</b>", risks[0].Title)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*PushInsteadPullDeploymentRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1127,
+		Labels:                     []string{"supply-chain"},
 	}
 }
 
@@ -77,6 +79,7 @@ func (r *PushInsteadPullDeploymentRule) createRisk(buildPipeline *types.Technica
 		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
 		ExploitationLikelihood:          types.Unlikely,
 		ExploitationImpact:              impact,
+		Confidence:                      types.MediumConfidence,
 		Title:                           title,
 		MostRelevantTechnicalAssetId:    deploymentTarget.Id,
 		MostRelevantCommunicationLinkId: deploymentCommLink.Id,
@@ -86,3 +89,29 @@ func (r *PushInsteadPullDeploymentRule) createRisk(buildPipeline *types.Technica
 	risk.SyntheticId = risk.CategoryId + "@" + buildPipeline.Id
 	return risk
 }
+
+func (r *PushInsteadPullDeploymentRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		deploymentLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if deploymentLink == nil {
+			continue
+		}
+
+		buildPipeline := parsedModel.TechnicalAssets[deploymentLink.SourceId]
+		targetAsset := parsedModel.TechnicalAssets[deploymentLink.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", deploymentLink.Id),
+			fmt.Sprintf("  - readonly: %v (=false)", deploymentLink.Readonly),
+			fmt.Sprintf("  - usage: %v (=%v)", deploymentLink.Usage, types.DevOps),
+			fmt.Sprintf("  - source: technical asset %q (technology: %v, has %q)", buildPipeline.Id, buildPipeline.Technologies.String(), types.BuildPipeline),
+			fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", targetAsset.OutOfScope),
+			fmt.Sprintf("    - technology: %v (has not %q)", targetAsset.Technologies.String(), types.IsDevelopmentRelevant),
+			fmt.Sprintf("    - usage: %v (not %v)", targetAsset.Usage, types.DevOps),
+			fmt.Sprintf("    - %v", processedSensitivity(parsedModel, targetAsset)),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("a deployment target of the build pipeline processes %v or higher confidentiality or %v or higher integrity or availability", types.Confidential, types.Critical)))
+	}
+
+	return explanation
+}
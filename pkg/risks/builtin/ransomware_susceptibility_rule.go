@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type RansomwareSusceptibilityRule struct {
+	writerLimit int
+}
+
+func NewRansomwareSusceptibilityRule() *RansomwareSusceptibilityRule {
+	return &RansomwareSusceptibilityRule{writerLimit: 3}
+}
+
+func (r *RansomwareSusceptibilityRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Int("writer-limit", &r.writerLimit)
+}
+
+func (r *RansomwareSusceptibilityRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "ransomware-susceptibility",
+		Title: "Ransomware Susceptibility",
+		Description: "Mission-critical file servers and data stores writable from many clients and without any backup are a prime target for ransomware: " +
+			"a single compromised client is sufficient to encrypt the data, and there is no way to recover it.",
+		Impact: "If this risk is unmitigated, a ransomware infection of any of the writing clients might encrypt (and exfiltrate) the mission-critical data, " +
+			"causing a long-lasting outage or forcing a ransom payment.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Attack_Surface_Analysis_Cheat_Sheet.html",
+		Action:     "Ransomware Resilience",
+		Mitigation: "Back up the data to immutable or offline storage, reduce the number of clients with write access, " +
+			"and apply versioning or snapshots to be able to restore unencrypted data.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.DenialOfService,
+		DetectionLogic: "In-scope technical assets of technology '" + types.FileServer + "' or of type " + types.Datastore.String() + " processing " +
+			types.MissionCritical.String() + " data, which are written by at least " + strconv.Itoa(r.writerLimit) + " different technical assets " +
+			"(i.e. via non-readonly communication links) and have no communication link from or to a backup asset.",
+		RiskAssessment: "The impact is high, and very high when also " + types.StrictlyConfidential.String() + " data is processed (due to the additional threat of extortion by exfiltration).",
+		FalsePositives: "Technical assets with backups not modeled or with immutable snapshots " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        693,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*RansomwareSusceptibilityRule) SupportedTags() []string {
+	return []string{"backup"}
+}
+
+func (r *RansomwareSusceptibilityRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		writers := writingAssetIDs(parsedModel, techAsset)
+		if len(writers) < r.writerLimit {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *RansomwareSusceptibilityRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || isBackupAsset(techAsset) {
+		return true
+	}
+	if techAsset.Type != types.Datastore && !techAsset.Technologies.GetAttribute(types.FileServer) {
+		return true
+	}
+	return parsedModel.HighestProcessedAvailability(techAsset) != types.MissionCritical || isBackedUp(parsedModel, techAsset)
+}
+
+func (r *RansomwareSusceptibilityRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential {
+		return types.VeryHighImpact
+	}
+	return types.HighImpact
+}
+
+func (r *RansomwareSusceptibilityRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Ransomware Susceptibility</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *RansomwareSusceptibilityRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		writers := writingAssetIDs(parsedModel, techAsset)
+		if len(writers) < r.writerLimit {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - type: %v, technology: %v (is %v or has %q)", techAsset.Type, techAsset.Technologies.String(), types.Datastore, types.FileServer),
+			fmt.Sprintf("  - highest availability: %v (=%v)", parsedModel.HighestProcessedAvailability(techAsset), types.MissionCritical),
+			fmt.Sprintf("  - written by %v technical assets %q (>=%v)", len(writers), writers, r.writerLimit),
+			fmt.Sprintf("  - no communication link from or to a technical asset with technology %q or tagged with %q", types.BackupStorage, "backup"),
+		}...)
+
+		if impact := r.impact(parsedModel, techAsset); impact == types.VeryHighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because also %v data is processed", impact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,130 @@
+package builtin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestRansomwareSusceptibilityRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewRansomwareSusceptibilityRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type RansomwareSusceptibilityRuleTest struct {
+	assetType       types.TechnicalAssetType
+	technology      string
+	availability    types.Criticality
+	confidentiality types.Confidentiality
+	writers         int
+	readonly        bool
+	backedUp        bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestRansomwareSusceptibilityRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]RansomwareSusceptibilityRuleTest{
+		"not a data store": {
+			assetType:    types.Process,
+			availability: types.MissionCritical,
+			writers:      3,
+			riskCreated:  false,
+		},
+		"not mission critical": {
+			assetType:    types.Datastore,
+			availability: types.Critical,
+			writers:      3,
+			riskCreated:  false,
+		},
+		"too few writers": {
+			assetType:    types.Datastore,
+			availability: types.MissionCritical,
+			writers:      2,
+			riskCreated:  false,
+		},
+		"readonly clients": {
+			assetType:    types.Datastore,
+			availability: types.MissionCritical,
+			writers:      3,
+			readonly:     true,
+			riskCreated:  false,
+		},
+		"backed up": {
+			assetType:    types.Datastore,
+			availability: types.MissionCritical,
+			writers:      3,
+			backedUp:     true,
+			riskCreated:  false,
+		},
+		"file server written by many clients": {
+			assetType:           types.Process,
+			technology:          types.FileServer,
+			availability:        types.MissionCritical,
+			writers:             3,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high (default)",
+		},
+		"strictly confidential data store written by many clients": {
+			assetType:           types.Datastore,
+			availability:        types.MissionCritical,
+			confidentiality:     types.StrictlyConfidential,
+			writers:             4,
+			riskCreated:         true,
+			expectedImpact:      types.VeryHighImpact,
+			expectedExplanation: "    - impact is very-high because also strictly-confidential data is processed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewRansomwareSusceptibilityRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"storage": {
+						Id:              "storage",
+						Title:           "Storage",
+						Type:            testCase.assetType,
+						Technologies:    types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						Availability:    testCase.availability,
+						Confidentiality: testCase.confidentiality,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{},
+			}
+			for i := 0; i < testCase.writers; i++ {
+				clientId := fmt.Sprintf("client-%d", i)
+				link := &types.CommunicationLink{Id: clientId + ">storage", SourceId: clientId, TargetId: "storage", Readonly: testCase.readonly}
+				model.TechnicalAssets[clientId] = &types.TechnicalAsset{Id: clientId, CommunicationLinks: []*types.CommunicationLink{link}}
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["storage"] = append(model.IncomingTechnicalCommunicationLinksMappedByTargetId["storage"], link)
+			}
+			if testCase.backedUp {
+				link := &types.CommunicationLink{Id: "storage>backup", SourceId: "storage", TargetId: "backup"}
+				model.TechnicalAssets["storage"].CommunicationLinks = []*types.CommunicationLink{link}
+				model.TechnicalAssets["backup"] = &types.TechnicalAsset{Id: "backup", Tags: []string{"backup"}}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Ransomware Susceptibility</b> risk at <b>Storage</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
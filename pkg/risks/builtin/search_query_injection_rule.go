@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -35,6 +36,7 @@ func (*SearchQueryInjectionRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        74,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -73,7 +75,7 @@ func (r *SearchQueryInjectionRule) createRisk(input *types.Model, technicalAsset
 	title := "<b>Search Query Injection</b> risk at <b>" + caller.Title + "</b> against search engine server <b>" + technicalAsset.Title + "</b>" +
 		" via <b>" + incomingFlow.Title + "</b>"
 	impact := types.MediumImpact
-	if input.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || input.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical {
+	if input.AnalysisContext().IsHighlySensitive(technicalAsset) {
 		impact = types.HighImpact
 	} else if input.HighestProcessedConfidentiality(technicalAsset) <= types.Internal && input.HighestProcessedIntegrity(technicalAsset) == types.Operational {
 		impact = types.LowImpact
@@ -92,3 +94,33 @@ func (r *SearchQueryInjectionRule) createRisk(input *types.Model, technicalAsset
 	risk.SyntheticId = risk.CategoryId + "@" + caller.Id + "@" + technicalAsset.Id + "@" + incomingFlow.Id
 	return risk
 }
+
+func (r *SearchQueryInjectionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[incomingFlow.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", incomingFlow.Id),
+			fmt.Sprintf("  - protocol: %v (is one of [%v, %v, %v, %v])", incomingFlow.Protocol, types.HTTP, types.HTTPS, types.BINARY, types.BinaryEncrypted),
+			fmt.Sprintf("  - caller: technical asset %q (out of scope: %v (=false))", incomingFlow.SourceId, parsedModel.TechnicalAssets[incomingFlow.SourceId].OutOfScope),
+			fmt.Sprintf("  - search engine server: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsSearchRelated),
+			fmt.Sprintf("    - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedLikelihood(generatedRisk, types.VeryLikely, fmt.Sprintf("the usage is %v", incomingFlow.Usage)))
+		switch generatedRisk.ExploitationImpact {
+		case types.HighImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the search engine server processes %v data or %v integrity", types.HighImpact, types.StrictlyConfidential, types.MissionCritical))
+		case types.LowImpact:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the search engine server processes at most %v confidentiality and %v integrity", types.LowImpact, types.Internal, types.Operational))
+		default:
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,161 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type SecretsInEnvironmentRule struct{}
+
+func NewSecretsInEnvironmentRule() *SecretsInEnvironmentRule {
+	return &SecretsInEnvironmentRule{}
+}
+
+func (*SecretsInEnvironmentRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "secrets-in-environment",
+		Title: "Secrets in Environment",
+		Description: "Technical assets processing secrets or credentials without communicating with a vault most likely get their secrets " +
+			"injected via environment variables or configuration files. Those are easily leaked via process listings, crash dumps, " +
+			"debug endpoints, container inspection, or accidentally committed configuration files.",
+		Impact: "If this risk is unmitigated, attackers might be able to obtain secrets and credentials from the environment " +
+			"or configuration of the technical asset and use them to access further systems.",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Secrets_Management_Cheat_Sheet.html",
+		Action:     "Secrets Management",
+		Mitigation: "Retrieve secrets at runtime from a vault (or mount them via a secrets management integration) " +
+			"instead of passing them via environment variables or configuration files.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets tagged with 'env-config', 'kubernetes', or 'docker' processing data assets tagged with 'credentials' or 'secrets' " +
+			"without any communication link from or to a vault.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the secret data assets processed.",
+		FalsePositives: "Technical assets receiving their secrets via other secure means (like mounted secret volumes managed by a secrets operator) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        526,
+		Labels:                     []string{"data", "container"},
+	}
+}
+
+func (*SecretsInEnvironmentRule) SupportedTags() []string {
+	return []string{"env-config", "kubernetes", "docker", "credentials", "secrets"}
+}
+
+func (r *SecretsInEnvironmentRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		secrets := r.secretDataAssets(parsedModel, techAsset)
+		if len(secrets) == 0 || r.isCommunicatingWithVault(parsedModel, techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, secrets))
+	}
+	return risks, nil
+}
+
+func (r *SecretsInEnvironmentRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("env-config", "kubernetes", "docker")
+}
+
+func (r *SecretsInEnvironmentRule) secretDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.DataAsset {
+	result := make([]*types.DataAsset, 0)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		if dataAsset.IsTaggedWithAny("credentials", "secrets") {
+			result = append(result, dataAsset)
+		}
+	}
+	return result
+}
+
+func (r *SecretsInEnvironmentRule) isCommunicatingWithVault(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, commLink := range techAsset.CommunicationLinks {
+		if targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]; ok && targetAsset.Technologies.GetAttribute(types.Vault) {
+			return true
+		}
+	}
+	for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if sourceAsset, ok := parsedModel.TechnicalAssets[commLink.SourceId]; ok && sourceAsset.Technologies.GetAttribute(types.Vault) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *SecretsInEnvironmentRule) impact(secrets []*types.DataAsset) types.RiskExploitationImpact {
+	for _, dataAsset := range secrets {
+		if dataAsset.Confidentiality == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *SecretsInEnvironmentRule) createRisk(techAsset *types.TechnicalAsset, secrets []*types.DataAsset) *types.Risk {
+	impact := r.impact(secrets)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Secrets in Environment</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *SecretsInEnvironmentRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		secrets := r.secretDataAssets(parsedModel, techAsset)
+		if len(secrets) == 0 || r.isCommunicatingWithVault(parsedModel, techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q])", techAsset.Tags, "env-config", "kubernetes", "docker"),
+			fmt.Sprintf("  - no communication link from or to a technical asset with technology %q", types.Vault),
+		}...)
+
+		for _, dataAsset := range secrets {
+			explanation = append(explanation, fmt.Sprintf("  - processes secret data asset %q (confidentiality %v)", dataAsset.Id, dataAsset.Confidentiality))
+		}
+
+		if r.impact(secrets) == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v secrets are processed", types.HighImpact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,112 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestSecretsInEnvironmentRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewSecretsInEnvironmentRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type SecretsInEnvironmentRuleTest struct {
+	tags                  []string
+	dataAssetTags         []string
+	dataConfidentiality   types.Confidentiality
+	outgoingLinkToVault   bool
+	incomingLinkFromVault bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestSecretsInEnvironmentRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]SecretsInEnvironmentRuleTest{
+		"not tagged": {
+			dataAssetTags: []string{"credentials"},
+			riskCreated:   false,
+		},
+		"no secrets processed": {
+			tags:          []string{"docker"},
+			dataAssetTags: []string{"customer"},
+			riskCreated:   false,
+		},
+		"secrets retrieved from vault": {
+			tags:                []string{"kubernetes"},
+			dataAssetTags:       []string{"secrets"},
+			outgoingLinkToVault: true,
+			riskCreated:         false,
+		},
+		"secrets pushed by vault": {
+			tags:                  []string{"kubernetes"},
+			dataAssetTags:         []string{"secrets"},
+			incomingLinkFromVault: true,
+			riskCreated:           false,
+		},
+		"credentials in environment": {
+			tags:                []string{"env-config"},
+			dataAssetTags:       []string{"credentials"},
+			dataConfidentiality: types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+		},
+		"strictly confidential secrets in environment": {
+			tags:                []string{"docker"},
+			dataAssetTags:       []string{"secrets"},
+			dataConfidentiality: types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewSecretsInEnvironmentRule()
+			app := &types.TechnicalAsset{
+				Id:                  "app",
+				Title:               "App",
+				Tags:                testCase.tags,
+				DataAssetsProcessed: []string{"secret"},
+			}
+			vault := &types.TechnicalAsset{
+				Id:           "vault",
+				Technologies: types.TechnologyList{{Name: types.Vault, Attributes: map[string]bool{types.Vault: true}}},
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{"app": app, "vault": vault},
+				DataAssets: map[string]*types.DataAsset{
+					"secret": {Id: "secret", Tags: testCase.dataAssetTags, Confidentiality: testCase.dataConfidentiality},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{},
+			}
+			if testCase.outgoingLinkToVault {
+				app.CommunicationLinks = []*types.CommunicationLink{{Id: "app>vault", SourceId: "app", TargetId: "vault"}}
+			}
+			if testCase.incomingLinkFromVault {
+				commLink := &types.CommunicationLink{Id: "vault>app", SourceId: "vault", TargetId: "app"}
+				vault.CommunicationLinks = []*types.CommunicationLink{commLink}
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["app"] = []*types.CommunicationLink{commLink}
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Secrets in Environment</b> risk at <b>App</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), `  - processes secret data asset "secret" (confidentiality `+testCase.dataConfidentiality.String()+`)`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
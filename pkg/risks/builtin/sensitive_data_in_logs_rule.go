@@ -0,0 +1,164 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type SensitiveDataInLogsRule struct{}
+
+func NewSensitiveDataInLogsRule() *SensitiveDataInLogsRule {
+	return &SensitiveDataInLogsRule{}
+}
+
+func (*SensitiveDataInLogsRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "sensitive-data-in-logs",
+		Title: "Sensitive Data in Logs",
+		Description: "Monitoring and logging sinks receiving data flows which carry strictly-confidential data assets frequently " +
+			"become unintentional secret stores, as centralized logs are usually accessible by far more people than the original data.",
+		Impact: "If this risk is unmitigated, attackers or insiders with access to the logs might be able to read " +
+			"strictly-confidential data (like credentials, tokens, or personal data) written to the logs.",
+		ASVS:       "V7 - Error Handling and Logging Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Logging_Cheat_Sheet.html",
+		Action:     "Log Data Minimization",
+		Mitigation: "Do not write strictly-confidential data to logs. Mask or redact sensitive fields before they are sent to the logging sink " +
+			"and restrict the access to the logs.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets with monitoring technology or tagged with 'logging', 'log-aggregation', or 'siem' (and not tagged with 'log-redaction'), " +
+			"which receive strictly-confidential data assets either sent to them via incoming communication links or received by them via outgoing communication links.",
+		RiskAssessment:             "The risk rating depends on the number of technical assets sending strictly-confidential data to the logging sink.",
+		FalsePositives:             "Logging sinks where sensitive fields are reliably redacted before being stored can be tagged with 'log-redaction'.",
+		ModelFailurePossibleReason: true,
+		CWE:                        532,
+		Labels:                     []string{"data", "compliance:gdpr"},
+	}
+}
+
+func (*SensitiveDataInLogsRule) SupportedTags() []string {
+	return []string{"logging", "log-aggregation", "siem", "log-redaction"}
+}
+
+func (r *SensitiveDataInLogsRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		dataAssets, sources := r.strictlyConfidentialDataFlows(parsedModel, techAsset)
+		if len(dataAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, sources))
+	}
+	return risks, nil
+}
+
+func (r *SensitiveDataInLogsRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.IsTaggedWithAny("log-redaction") {
+		return true
+	}
+	return !techAsset.Technologies.GetAttribute(types.Monitoring) && !techAsset.IsTaggedWithAny("logging", "log-aggregation", "siem")
+}
+
+// strictlyConfidentialDataFlows returns the sorted strictly-confidential data assets flowing into the logging sink
+// (sent to it by other technical assets or received by it from other technical assets) and the sorted technical
+// assets they originate from
+func (r *SensitiveDataInLogsRule) strictlyConfidentialDataFlows(parsedModel *types.Model, techAsset *types.TechnicalAsset) ([]string, []string) {
+	dataAssets := make([]string, 0)
+	sources := make([]string, 0)
+	addDataFlow := func(dataAssetIDs []string, sourceId string) {
+		for _, dataAssetId := range dataAssetIDs {
+			dataAsset, ok := parsedModel.DataAssets[dataAssetId]
+			if !ok || dataAsset.Confidentiality != types.StrictlyConfidential {
+				continue
+			}
+			if !contains(dataAssets, dataAsset.Id) {
+				dataAssets = append(dataAssets, dataAsset.Id)
+			}
+			if !contains(sources, sourceId) {
+				sources = append(sources, sourceId)
+			}
+		}
+	}
+
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		addDataFlow(incomingLink.DataAssetsSent, incomingLink.SourceId)
+	}
+	for _, outgoingLink := range techAsset.CommunicationLinks {
+		addDataFlow(outgoingLink.DataAssetsReceived, outgoingLink.TargetId)
+	}
+
+	sort.Strings(dataAssets)
+	sort.Strings(sources)
+	return dataAssets, sources
+}
+
+func (r *SensitiveDataInLogsRule) createRisk(techAsset *types.TechnicalAsset, sources []string) *types.Risk {
+	impact := types.MediumImpact
+	if len(sources) > 1 {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Sensitive Data in Logs</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *SensitiveDataInLogsRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		dataAssets, sources := r.strictlyConfidentialDataFlows(parsedModel, techAsset)
+		if len(dataAssets) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v, tags: %v (has %q or either tag [%q, %q, %q], has not %q)", techAsset.Technologies.String(), techAsset.Tags,
+				types.Monitoring, "logging", "log-aggregation", "siem", "log-redaction"),
+			fmt.Sprintf("  - receives %v data assets %q", types.StrictlyConfidential, dataAssets),
+			fmt.Sprintf("  - from technical assets %q", sources),
+		}...)
+
+		if len(sources) > 1 {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because sensitive data from multiple technical assets is collected", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,120 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestSensitiveDataInLogsRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewSensitiveDataInLogsRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type SensitiveDataInLogsRuleTest struct {
+	technology          string
+	tags                []string
+	dataConfidentiality types.Confidentiality
+	pushedBy            []string
+	pulledFrom          []string
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestSensitiveDataInLogsRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]SensitiveDataInLogsRuleTest{
+		"not a logging sink": {
+			technology:          types.Database,
+			dataConfidentiality: types.StrictlyConfidential,
+			pushedBy:            []string{"app1"},
+			riskCreated:         false,
+		},
+		"no strictly confidential data": {
+			technology:          types.Monitoring,
+			dataConfidentiality: types.Confidential,
+			pushedBy:            []string{"app1"},
+			riskCreated:         false,
+		},
+		"redacted logs": {
+			technology:          types.Monitoring,
+			tags:                []string{"log-redaction"},
+			dataConfidentiality: types.StrictlyConfidential,
+			pushedBy:            []string{"app1"},
+			riskCreated:         false,
+		},
+		"sensitive data pushed by one asset": {
+			technology:          types.Monitoring,
+			dataConfidentiality: types.StrictlyConfidential,
+			pushedBy:            []string{"app1"},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+		},
+		"sensitive data pulled from one asset by siem": {
+			tags:                []string{"siem"},
+			dataConfidentiality: types.StrictlyConfidential,
+			pulledFrom:          []string{"app1"},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+		},
+		"sensitive data from multiple assets": {
+			tags:                []string{"logging"},
+			dataConfidentiality: types.StrictlyConfidential,
+			pushedBy:            []string{"app1"},
+			pulledFrom:          []string{"app2"},
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewSensitiveDataInLogsRule()
+			sink := &types.TechnicalAsset{
+				Id:           "sink",
+				Title:        "Sink",
+				Tags:         testCase.tags,
+				Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"sink": sink,
+					"app1": {Id: "app1"},
+					"app2": {Id: "app2"},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.dataConfidentiality},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{},
+			}
+			for _, sourceId := range testCase.pushedBy {
+				commLink := &types.CommunicationLink{Id: sourceId + ">sink", SourceId: sourceId, TargetId: "sink", DataAssetsSent: []string{"data"}}
+				model.TechnicalAssets[sourceId].CommunicationLinks = append(model.TechnicalAssets[sourceId].CommunicationLinks, commLink)
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["sink"] = append(model.IncomingTechnicalCommunicationLinksMappedByTargetId["sink"], commLink)
+			}
+			for _, targetId := range testCase.pulledFrom {
+				commLink := &types.CommunicationLink{Id: "sink>" + targetId, SourceId: "sink", TargetId: targetId, DataAssetsReceived: []string{"data"}}
+				sink.CommunicationLinks = append(sink.CommunicationLinks, commLink)
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId[targetId] = append(model.IncomingTechnicalCommunicationLinksMappedByTargetId[targetId], commLink)
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Sensitive Data in Logs</b> risk at <b>Sink</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), `  - receives strictly-confidential data assets ["data"]`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
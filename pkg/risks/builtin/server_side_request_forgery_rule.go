@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*ServerSideRequestForgeryRule) Category() *types.RiskCategory {
 			"as false positives after review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        918,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -84,8 +86,8 @@ func (r *ServerSideRequestForgeryRule) createRisk(input *types.Model, technicalA
 		}
 	}
 	// adjust for cloud-based special risks
-	trustBoundaryId := input.GetTechnicalAssetTrustBoundaryId(technicalAsset)
-	if impact == types.LowImpact && len(trustBoundaryId) > 0 && input.TrustBoundaries[input.GetTechnicalAssetTrustBoundaryId(technicalAsset)].Type.IsWithinCloud() {
+	trustBoundaryId := input.AnalysisContext().TrustBoundaryId(technicalAsset.Id)
+	if impact == types.LowImpact && len(trustBoundaryId) > 0 && input.TrustBoundaries[input.AnalysisContext().TrustBoundaryId(technicalAsset.Id)].Type.IsWithinCloud() {
 		impact = types.MediumImpact
 	}
 	dataBreachTechnicalAssetIDs := make([]string, 0)
@@ -110,3 +112,42 @@ func (r *ServerSideRequestForgeryRule) createRisk(input *types.Model, technicalA
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id + "@" + target.Id + "@" + outgoingFlow.Id
 	return risk
 }
+
+func (r *ServerSideRequestForgeryRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		outgoingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if outgoingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[outgoingFlow.SourceId]
+		target := parsedModel.TechnicalAssets[outgoingFlow.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", outgoingFlow.Id),
+			fmt.Sprintf("  - protocol: %v (is potential web access protocol)", outgoingFlow.Protocol),
+			fmt.Sprintf("  - source: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("    - technology: %v (has neither %q nor %q)", technicalAsset.Technologies.String(), types.IsClient, types.LoadBalancer),
+			fmt.Sprintf("  - target: technical asset %q (highest processed confidentiality %v)", target.Id, parsedModel.HighestProcessedConfidentiality(target)))
+		for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+			potentialTargetAsset := parsedModel.TechnicalAssets[id]
+			if !isSameTrustBoundaryNetworkOnly(parsedModel, technicalAsset, id) {
+				continue
+			}
+			for _, commLinkIncoming := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[id] {
+				if commLinkIncoming.Protocol.IsPotentialWebAccessProtocol() {
+					explanation = append(explanation, fmt.Sprintf("  - technical asset %q in the same network segment is web accessible (highest processed confidentiality %v)",
+						id, parsedModel.HighestProcessedConfidentiality(potentialTargetAsset)))
+					break
+				}
+			}
+		}
+		explanation = append(explanation,
+			explainedLikelihood(generatedRisk, types.Likely, fmt.Sprintf("the usage is %v", outgoingFlow.Usage)),
+			explainedImpact(generatedRisk, types.LowImpact,
+				fmt.Sprintf("the target or a web accessible technical asset in the same network segment processes %v data, or the source is within a cloud trust boundary", types.StrictlyConfidential)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,173 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ServerSideTemplateInjectionRule struct{}
+
+func NewServerSideTemplateInjectionRule() *ServerSideTemplateInjectionRule {
+	return &ServerSideTemplateInjectionRule{}
+}
+
+func (*ServerSideTemplateInjectionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "server-side-template-injection",
+		Title: "Server-Side Template Injection",
+		Description: "Web applications rendering user input with server-side template engines (like Jinja, FreeMarker, Thymeleaf, or Handlebars) " +
+			"are prone to Server-Side Template Injection (SSTI) when the input is embedded into the template itself instead of being passed as data.",
+		Impact: "If this risk is unmitigated, attackers might be able to execute arbitrary code on the server " +
+			"and thereby access all data processed by the web application.",
+		ASVS:       "V5 - Validation, Sanitization and Encoding Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Injection_Prevention_Cheat_Sheet.html",
+		Action:     "Template Injection Prevention",
+		Mitigation: "Never build templates from user input, always pass user input as template data. " +
+			"Use logic-less templates or sandboxed template environments where available.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope web applications tagged with 'jinja', 'freemarker', 'thymeleaf', or 'handlebars' having incoming communication links " +
+			"from the public network or across a network trust boundary, which either send data assets or originate from human clients.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity ratings of the data processed by the web application. " +
+			"The likelihood is higher when the input stems from the public network.",
+		FalsePositives: "Web applications only rendering static templates with user input passed as template data " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1336,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*ServerSideTemplateInjectionRule) SupportedTags() []string {
+	return []string{"jinja", "freemarker", "thymeleaf", "handlebars"}
+}
+
+func (r *ServerSideTemplateInjectionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrustedLinks := r.untrustedInputLinks(parsedModel, techAsset)
+		if len(untrustedLinks) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, untrustedLinks))
+	}
+	return risks, nil
+}
+
+func (r *ServerSideTemplateInjectionRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.Technologies.GetAttribute(types.WebApplication) || !techAsset.IsTaggedWithAny(r.SupportedTags()...)
+}
+
+// untrustedInputLinks returns the sorted incoming communication links carrying user input
+// from the public network or across a network trust boundary
+func (r *ServerSideTemplateInjectionRule) untrustedInputLinks(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.CommunicationLink {
+	result := make([]*types.CommunicationLink, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		sourceAsset := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		if len(incomingLink.DataAssetsSent) == 0 && !sourceAsset.UsedAsClientByHuman {
+			continue
+		}
+		if isOnPublicNetwork(parsedModel, sourceAsset) || isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			result = append(result, incomingLink)
+		}
+	}
+	sort.Sort(types.ByTechnicalCommunicationLinkIdSort(result))
+	return result
+}
+
+func (r *ServerSideTemplateInjectionRule) likelihood(parsedModel *types.Model, untrustedLinks []*types.CommunicationLink) types.RiskExploitationLikelihood {
+	for _, incomingLink := range untrustedLinks {
+		if isOnPublicNetwork(parsedModel, parsedModel.TechnicalAssets[incomingLink.SourceId]) {
+			return types.VeryLikely
+		}
+	}
+	return types.Likely
+}
+
+func (r *ServerSideTemplateInjectionRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+		return types.VeryHighImpact
+	}
+	return types.HighImpact
+}
+
+func (r *ServerSideTemplateInjectionRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, untrustedLinks []*types.CommunicationLink) *types.Risk {
+	likelihood := r.likelihood(parsedModel, untrustedLinks)
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>Server-Side Template Injection</b> risk at <b>" + techAsset.Title + "</b> " +
+		"(at least via communication link <b>" + untrustedLinks[0].Title + "</b>)"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: untrustedLinks[0].Id,
+		DataBreachProbability:           types.Probable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *ServerSideTemplateInjectionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		untrustedLinks := r.untrustedInputLinks(parsedModel, techAsset)
+		if len(untrustedLinks) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		linkIds := make([]string, 0)
+		for _, incomingLink := range untrustedLinks {
+			linkIds = append(linkIds, incomingLink.Id)
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", techAsset.Technologies.String(), types.WebApplication),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q, %q])", techAsset.Tags, "jinja", "freemarker", "thymeleaf", "handlebars"),
+			fmt.Sprintf("  - user input via communication links %q", linkIds),
+		}...)
+
+		if likelihood := r.likelihood(parsedModel, untrustedLinks); likelihood == types.VeryLikely {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the input stems from the public network", likelihood))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the input crosses a network trust boundary", likelihood))
+		}
+		if impact := r.impact(parsedModel, techAsset); impact == types.VeryHighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v or %v data is processed", impact, types.StrictlyConfidential, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
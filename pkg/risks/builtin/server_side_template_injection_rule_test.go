@@ -0,0 +1,137 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestServerSideTemplateInjectionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewServerSideTemplateInjectionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ServerSideTemplateInjectionRuleTest struct {
+	technology        string
+	tags              []string
+	confidentiality   types.Confidentiality
+	clientInternet    bool
+	clientIsHuman     bool
+	sameTrustBoundary bool
+
+	riskCreated         bool
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestServerSideTemplateInjectionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ServerSideTemplateInjectionRuleTest{
+		"not a web application": {
+			technology:     types.WebServiceREST,
+			tags:           []string{"jinja"},
+			clientInternet: true,
+			clientIsHuman:  true,
+			riskCreated:    false,
+		},
+		"no template engine": {
+			technology:     types.WebApplication,
+			tags:           []string{"react"},
+			clientInternet: true,
+			clientIsHuman:  true,
+			riskCreated:    false,
+		},
+		"no user input": {
+			technology:     types.WebApplication,
+			tags:           []string{"thymeleaf"},
+			clientInternet: true,
+			riskCreated:    false,
+		},
+		"input from same trust boundary": {
+			technology:        types.WebApplication,
+			tags:              []string{"freemarker"},
+			clientIsHuman:     true,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"input across trust boundary": {
+			technology:          types.WebApplication,
+			tags:                []string{"handlebars"},
+			clientIsHuman:       true,
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high (default)",
+		},
+		"input from internet with strictly confidential data": {
+			technology:          types.WebApplication,
+			tags:                []string{"jinja"},
+			confidentiality:     types.StrictlyConfidential,
+			clientInternet:      true,
+			clientIsHuman:       true,
+			sameTrustBoundary:   true,
+			riskCreated:         true,
+			expectedLikelihood:  types.VeryLikely,
+			expectedImpact:      types.VeryHighImpact,
+			expectedExplanation: "    - impact is very-high because strictly-confidential or mission-critical data is processed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewServerSideTemplateInjectionRule()
+			incomingLink := &types.CommunicationLink{Id: "browser>app", Title: "Web Access", SourceId: "browser", TargetId: "app"}
+			browserBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"browser"}}
+			appBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"app"}}
+			if testCase.sameTrustBoundary {
+				browserBoundary = appBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"browser": {
+						Id:                  "browser",
+						Internet:            testCase.clientInternet,
+						UsedAsClientByHuman: testCase.clientIsHuman,
+						CommunicationLinks:  []*types.CommunicationLink{incomingLink},
+					},
+					"app": {
+						Id:              "app",
+						Title:           "App",
+						Tags:            testCase.tags,
+						Confidentiality: testCase.confidentiality,
+						Technologies:    types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					browserBoundary.Id: browserBoundary,
+					appBoundary.Id:     appBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"browser": browserBoundary,
+					"app":     appBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"app": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Server-Side Template Injection</b> risk at <b>App</b> (at least via communication link <b>Web Access</b>)", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -31,6 +32,7 @@ func (*ServiceRegistryPoisoningRule) Category() *types.RiskCategory {
 			"can be considered as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        693,
+		Labels:                     []string{"network"},
 	}
 }
 
@@ -56,8 +58,8 @@ func (r *ServiceRegistryPoisoningRule) createRisk(input *types.Model, technicalA
 
 	for _, incomingFlow := range incomingFlows {
 		caller := input.TechnicalAssets[incomingFlow.SourceId]
-		if input.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || input.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical || input.HighestProcessedAvailability(technicalAsset) == types.MissionCritical ||
-			input.HighestProcessedConfidentiality(caller) == types.StrictlyConfidential || input.HighestProcessedIntegrity(caller) == types.MissionCritical || input.HighestProcessedAvailability(caller) == types.MissionCritical ||
+		if input.AnalysisContext().IsHighlySensitive(technicalAsset) || input.HighestProcessedAvailability(technicalAsset) == types.MissionCritical ||
+			input.AnalysisContext().IsHighlySensitive(caller) || input.HighestProcessedAvailability(caller) == types.MissionCritical ||
 			input.HighestCommunicationLinkConfidentiality(incomingFlow) == types.StrictlyConfidential || input.HighestCommunicationLinkIntegrity(incomingFlow) == types.MissionCritical || input.HighestCommunicationLinkAvailability(incomingFlow) == types.MissionCritical {
 			impact = types.MediumImpact
 			break
@@ -77,3 +79,23 @@ func (r *ServiceRegistryPoisoningRule) createRisk(input *types.Model, technicalA
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *ServiceRegistryPoisoningRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technologies: %v (has attribute %q)", technicalAsset.Technologies.String(), types.ServiceRegistry),
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)))
+		for _, incomingFlow := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			explanation = append(explanation, fmt.Sprintf("  - called by technical asset %q via communication link %q (%v)",
+				incomingFlow.SourceId, incomingFlow.Id, processedSensitivity(parsedModel, parsedModel.TechnicalAssets[incomingFlow.SourceId])))
+		}
+		explanation = append(explanation, explainedImpact(generatedRisk, types.LowImpact,
+			fmt.Sprintf("the service registry, a caller or a communication link handles %v data or %v integrity or availability", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
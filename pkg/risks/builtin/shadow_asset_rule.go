@@ -0,0 +1,118 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ShadowAssetRule struct{}
+
+func NewShadowAssetRule() *ShadowAssetRule {
+	return &ShadowAssetRule{}
+}
+
+func (*ShadowAssetRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "shadow-asset",
+		Title: "Shadow Asset",
+		Description: "Technical assets without an owner, without any tags, and with unknown technology are an indicator for shadow assets, " +
+			"which are frequently overlooked in threat models as well as in operations (patching, monitoring, decommissioning).",
+		Impact:     "If this risk is unmitigated, shadow assets might remain unpatched and unmonitored, and other risks affecting them might not be noticed.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Threat_Modeling_Cheat_Sheet.html",
+		Action:     "Asset Inventory",
+		Mitigation: "Identify the owner and the technology of the technical asset, document them in the model, " +
+			"and tag the asset accordingly. Decommission the asset when no owner can be found.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Architecture,
+		STRIDE:                     types.InformationDisclosure,
+		DetectionLogic:             "In-scope technical assets without owner, without tags, and with technology specified as unknown.",
+		RiskAssessment:             "The risk rating depends on the highest confidentiality rating of the data processed by the technical asset.",
+		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
+		ModelFailurePossibleReason: true,
+		CWE:                        1059,
+		Labels:                     []string{"model"},
+	}
+}
+
+func (*ShadowAssetRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *ShadowAssetRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *ShadowAssetRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || len(techAsset.Owner) > 0 || len(techAsset.Tags) > 0 || !techAsset.Technologies.IsUnknown()
+}
+
+func (r *ShadowAssetRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) >= types.Confidential {
+		return types.MediumImpact
+	}
+	return types.LowImpact
+}
+
+func (r *ShadowAssetRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Confidence:                   types.LowConfidence,
+		Title:                        "<b>Shadow Asset</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *ShadowAssetRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - owner: %q (is empty)", techAsset.Owner),
+			fmt.Sprintf("  - tags: %v (are empty)", techAsset.Tags),
+			fmt.Sprintf("  - technology: %v (is unknown)", techAsset.Technologies.String()),
+		}...)
+
+		if impact := r.impact(parsedModel, techAsset); impact == types.MediumImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is processed", impact, parsedModel.HighestProcessedConfidentiality(techAsset)))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,99 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestShadowAssetRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewShadowAssetRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ShadowAssetRuleTest struct {
+	outOfScope      bool
+	owner           string
+	tags            []string
+	technology      string
+	confidentiality types.Confidentiality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestShadowAssetRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ShadowAssetRuleTest{
+		"out of scope": {
+			outOfScope:  true,
+			technology:  types.UnknownTechnology,
+			riskCreated: false,
+		},
+		"has owner": {
+			owner:       "Team A",
+			technology:  types.UnknownTechnology,
+			riskCreated: false,
+		},
+		"has tags": {
+			tags:        []string{"legacy"},
+			technology:  types.UnknownTechnology,
+			riskCreated: false,
+		},
+		"known technology": {
+			technology:  types.WebServer,
+			riskCreated: false,
+		},
+		"shadow asset": {
+			technology:          types.UnknownTechnology,
+			confidentiality:     types.Internal,
+			riskCreated:         true,
+			expectedImpact:      types.LowImpact,
+			expectedExplanation: "    - impact is low (default)",
+		},
+		"shadow asset processing confidential data": {
+			technology:          types.UnknownTechnology,
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium because confidential data is processed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewShadowAssetRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"server": {
+						Id:              "server",
+						Title:           "Server",
+						OutOfScope:      testCase.outOfScope,
+						Owner:           testCase.owner,
+						Tags:            testCase.tags,
+						Confidentiality: testCase.confidentiality,
+						Technologies:    types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, types.LowConfidence, risks[0].Confidence)
+				assert.Equal(t, "<b>Shadow Asset</b> risk at <b>Server</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
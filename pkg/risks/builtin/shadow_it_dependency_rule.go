@@ -0,0 +1,127 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ShadowItDependencyRule struct{}
+
+func NewShadowItDependencyRule() *ShadowItDependencyRule {
+	return &ShadowItDependencyRule{}
+}
+
+func (*ShadowItDependencyRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "shadow-it-dependency",
+		Title: "Shadow IT Dependency",
+		Description: "Communication links targeting unmanaged or not security-assessed third-party services represent undocumented " +
+			"external dependencies bypassing the regular security review.",
+		Impact: "If this risk is unmitigated, a compromised or malicious third-party service might tamper with or leak the data " +
+			"exchanged with it without anybody being aware of the dependency.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Third_Party_Javascript_Management_Cheat_Sheet.html",
+		Action:     "Third-Party Security Assessment",
+		Mitigation: "Document all external dependencies and subject the third-party vendors to a security assessment (like reviewing their " +
+			"SOC 2 reports) before integrating their services.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.BusinessSide,
+		STRIDE:         types.Tampering,
+		DetectionLogic: "Communication links targeting technical assets tagged with 'external-unmanaged' or tagged with 'third-party' without being tagged with 'vendor-security-assessed' or 'soc2-certified'.",
+		RiskAssessment: types.MediumImpact.String(),
+		FalsePositives: "Third-party services having been security-assessed outside of the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        1357,
+		Labels:                     []string{"model", "supply-chain"},
+	}
+}
+
+func (*ShadowItDependencyRule) SupportedTags() []string {
+	return []string{"external-unmanaged", "third-party", "vendor-security-assessed", "soc2-certified"}
+}
+
+func (r *ShadowItDependencyRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, outgoingLink := range sourceAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]
+			if !ok || !r.isUnassessedExternalAsset(targetAsset) {
+				continue
+			}
+			risks = append(risks, r.createRisk(sourceAsset, targetAsset, outgoingLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *ShadowItDependencyRule) isUnassessedExternalAsset(techAsset *types.TechnicalAsset) bool {
+	if techAsset.IsTaggedWithAny("external-unmanaged") {
+		return true
+	}
+	return techAsset.IsTaggedWithAny("third-party") && !techAsset.IsTaggedWithAny("vendor-security-assessed", "soc2-certified")
+}
+
+func (r *ShadowItDependencyRule) createRisk(sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, outgoingLink *types.CommunicationLink) *types.Risk {
+	title := "<b>Shadow IT Dependency</b> risk at <b>" + sourceAsset.Title + "</b> depending on unassessed external " +
+		"<b>" + targetAsset.Title + "</b> via <b>" + outgoingLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, types.MediumImpact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              types.MediumImpact,
+		Confidence:                      types.LowConfidence,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: outgoingLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{sourceAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + outgoingLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *ShadowItDependencyRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, outgoingLink := range sourceAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]
+			if !ok {
+				continue
+			}
+
+			if !strings.EqualFold(risk, categoryId+"@"+outgoingLink.Id+"@"+sourceAsset.Id+"@"+targetAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if !r.isUnassessedExternalAsset(targetAsset) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q from %q", outgoingLink.Id, sourceAsset.Id),
+				fmt.Sprintf("  - target: external technical asset %q", targetAsset.Id),
+			}...)
+
+			if targetAsset.IsTaggedWithAny("external-unmanaged") {
+				explanation = append(explanation, fmt.Sprintf("    - tags: %v (has %q)", targetAsset.Tags, "external-unmanaged"))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - tags: %v (has %q)", targetAsset.Tags, "third-party"))
+			}
+			if !targetAsset.IsTaggedWithAny("vendor-security-assessed", "soc2-certified") {
+				explanation = append(explanation, fmt.Sprintf("    - no security assessment tag (neither [%q, %q])", "vendor-security-assessed", "soc2-certified"))
+			}
+		}
+	}
+
+	return explanation
+}
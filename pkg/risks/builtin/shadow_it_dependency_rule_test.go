@@ -0,0 +1,86 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestShadowItDependencyRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewShadowItDependencyRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ShadowItDependencyRuleTest struct {
+	targetTags []string
+
+	riskCreated bool
+}
+
+func TestShadowItDependencyRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ShadowItDependencyRuleTest{
+		"internal target": {
+			targetTags:  []string{},
+			riskCreated: false,
+		},
+		"unmanaged external target": {
+			targetTags:  []string{"external-unmanaged"},
+			riskCreated: true,
+		},
+		"third party without assessment": {
+			targetTags:  []string{"third-party"},
+			riskCreated: true,
+		},
+		"third party vendor security assessed": {
+			targetTags:  []string{"third-party", "vendor-security-assessed"},
+			riskCreated: false,
+		},
+		"third party soc2 certified": {
+			targetTags:  []string{"third-party", "soc2-certified"},
+			riskCreated: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewShadowItDependencyRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:    "app",
+						Title: "App",
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "app>saas", Title: "Call", SourceId: "app", TargetId: "saas"},
+						},
+					},
+					"saas": {
+						Id:    "saas",
+						Title: "SaaS",
+						Tags:  testCase.targetTags,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Shadow IT Dependency</b> risk at <b>App</b> depending on unassessed external <b>SaaS</b> via <b>Call</b>", risks[0].Title)
+				assert.Equal(t, "shadow-it-dependency@app>saas@app@saas", risks[0].SyntheticId)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `  - target: external technical asset "saas"`)
+				assert.Contains(t, explanation, `    - no security assessment tag (neither ["vendor-security-assessed", "soc2-certified"])`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
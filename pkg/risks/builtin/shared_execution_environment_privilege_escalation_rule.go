@@ -0,0 +1,161 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type SharedExecutionEnvironmentPrivilegeEscalationRule struct {
+	raaLimit int
+}
+
+func NewSharedExecutionEnvironmentPrivilegeEscalationRule() *SharedExecutionEnvironmentPrivilegeEscalationRule {
+	return &SharedExecutionEnvironmentPrivilegeEscalationRule{raaLimit: 40}
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Int("raa-limit", &r.raaLimit)
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "shared-execution-environment-privilege-escalation",
+		Title: "Shared Execution Environment Privilege Escalation",
+		Description: "Data stores holding confidential data which share an execution environment (like the same host or hypervisor) " +
+			"with technical assets of low attacker attractiveness are exposed to host-level privilege escalation paths.",
+		Impact: "If this risk is unmitigated, attackers which have compromised a less protected technical asset within the same execution environment " +
+			"might escalate their privileges on the host and directly access the files or memory of the data store.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Attack_Surface_Analysis_Cheat_Sheet.html",
+		Action:     "Execution Environment Separation",
+		Mitigation: "Run data stores holding confidential data on dedicated execution environments, or harden the shared host " +
+			"(like least privilege, mandatory access control, and regular patching) to the level required by the most sensitive data store.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.ElevationOfPrivilege,
+		DetectionLogic: "In-scope data stores of confidential or strictly confidential data placed inside an execution environment trust boundary " +
+			"together with other in-scope technical assets having RAA values below " + strconv.Itoa(r.raaLimit) + " %.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data store.",
+		FalsePositives: "Execution environments with strong isolation between the technical assets (like separate micro VMs) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        269,
+		Labels:                     []string{"operations"},
+	}
+}
+
+func (*SharedExecutionEnvironmentPrivilegeEscalationRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		lowRAAAssets := r.lowRAAColocatedAssets(parsedModel, techAsset)
+		if len(lowRAAAssets) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, lowRAAAssets))
+	}
+	return risks, nil
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.Type != types.Datastore || parsedModel.HighestProcessedConfidentiality(techAsset) < types.Confidential {
+		return true
+	}
+	trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+	return !ok || trustBoundary.Type != types.ExecutionEnvironment
+}
+
+// lowRAAColocatedAssets returns the sorted in-scope technical assets sharing the execution environment
+// of the data store which have an RAA value below the limit
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) lowRAAColocatedAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	assets := make([]string, 0)
+	for _, otherId := range parsedModel.SortedTechnicalAssetIDs() {
+		otherAsset := parsedModel.TechnicalAssets[otherId]
+		if otherId == techAsset.Id || otherAsset.OutOfScope || !isSameExecutionEnvironment(parsedModel, techAsset, otherId) {
+			continue
+		}
+		if otherAsset.RAA < float64(r.raaLimit) {
+			assets = append(assets, otherId)
+		}
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(techAsset) == types.StrictlyConfidential {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, lowRAAAssets []string) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Shared Execution Environment Privilege Escalation</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *SharedExecutionEnvironmentPrivilegeEscalationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		lowRAAAssets := r.lowRAAColocatedAssets(parsedModel, techAsset)
+		if len(lowRAAAssets) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - type: %v (=%v)", techAsset.Type, types.Datastore),
+			fmt.Sprintf("  - highest confidentiality: %v (>=%v)", parsedModel.HighestProcessedConfidentiality(techAsset), types.Confidential),
+			fmt.Sprintf("  - trust boundary: %q (type %v)", parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id].Id, types.ExecutionEnvironment),
+			fmt.Sprintf("  - shared with technical assets with RAA below %v %%: %q", r.raaLimit, lowRAAAssets),
+		}...)
+
+		impact := r.impact(parsedModel, techAsset)
+		if impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the data store is %v", impact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
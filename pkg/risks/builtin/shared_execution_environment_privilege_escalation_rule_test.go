@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestSharedExecutionEnvironmentPrivilegeEscalationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewSharedExecutionEnvironmentPrivilegeEscalationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type SharedExecutionEnvironmentPrivilegeEscalationRuleTest struct {
+	assetType       types.TechnicalAssetType
+	confidentiality types.Confidentiality
+	boundaryType    types.TrustBoundaryType
+	otherRAA        float64
+	otherOutOfScope bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestSharedExecutionEnvironmentPrivilegeEscalationRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]SharedExecutionEnvironmentPrivilegeEscalationRuleTest{
+		"not a data store": {
+			assetType:       types.Process,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.ExecutionEnvironment,
+			otherRAA:        10,
+			riskCreated:     false,
+		},
+		"data store not confidential": {
+			assetType:       types.Datastore,
+			confidentiality: types.Internal,
+			boundaryType:    types.ExecutionEnvironment,
+			otherRAA:        10,
+			riskCreated:     false,
+		},
+		"not an execution environment": {
+			assetType:       types.Datastore,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.NetworkCloudProvider,
+			otherRAA:        10,
+			riskCreated:     false,
+		},
+		"colocated asset with high RAA": {
+			assetType:       types.Datastore,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.ExecutionEnvironment,
+			otherRAA:        40,
+			riskCreated:     false,
+		},
+		"colocated asset out of scope": {
+			assetType:       types.Datastore,
+			confidentiality: types.StrictlyConfidential,
+			boundaryType:    types.ExecutionEnvironment,
+			otherRAA:        10,
+			otherOutOfScope: true,
+			riskCreated:     false,
+		},
+		"confidential data store shared with low RAA asset": {
+			assetType:           types.Datastore,
+			confidentiality:     types.Confidential,
+			boundaryType:        types.ExecutionEnvironment,
+			otherRAA:            10,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"strictly confidential data store shared with low RAA asset": {
+			assetType:           types.Datastore,
+			confidentiality:     types.StrictlyConfidential,
+			boundaryType:        types.ExecutionEnvironment,
+			otherRAA:            39,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because the data store is strictly-confidential",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewSharedExecutionEnvironmentPrivilegeEscalationRule()
+			trustBoundary := &types.TrustBoundary{Id: "host", Type: testCase.boundaryType, TechnicalAssetsInside: []string{"db", "other"}}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"db": {
+						Id:              "db",
+						Title:           "Database",
+						Type:            testCase.assetType,
+						Confidentiality: testCase.confidentiality,
+						RAA:             80,
+					},
+					"other": {
+						Id:         "other",
+						RAA:        testCase.otherRAA,
+						OutOfScope: testCase.otherOutOfScope,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"host": trustBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"db":    trustBoundary,
+					"other": trustBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Shared Execution Environment Privilege Escalation</b> risk at <b>Database</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
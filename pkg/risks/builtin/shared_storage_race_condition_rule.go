@@ -0,0 +1,144 @@
+package builtin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type SharedStorageRaceConditionRule struct {
+	writerLimit int
+}
+
+func NewSharedStorageRaceConditionRule() *SharedStorageRaceConditionRule {
+	return &SharedStorageRaceConditionRule{writerLimit: 2}
+}
+
+func (r *SharedStorageRaceConditionRule) Configure(parameters *types.RiskRuleParameters) error {
+	return parameters.Int("writer-limit", &r.writerLimit)
+}
+
+func (r *SharedStorageRaceConditionRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "shared-storage-race-condition",
+		Title: "Shared Storage Race Condition",
+		Description: "File servers and shared volumes updated concurrently by multiple technical assets are prone to time-of-check to time-of-use (TOCTOU) " +
+			"race conditions and lost updates, as file system operations are usually neither atomic nor coordinated between the writers.",
+		Impact: "If this risk is unmitigated, concurrent modifications might corrupt or silently overwrite data with critical integrity requirements, " +
+			"and attackers controlling one of the writers might exploit the race window to tamper with files checked by another writer.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/File_Upload_Cheat_Sheet.html",
+		Action:     "Concurrent Modification Handling",
+		Mitigation: "Reduce the number of writers to a single owning service, use file locking or atomic operations (like write to temporary file and rename), " +
+			"or switch to a storage offering transactional or versioned updates.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Architecture,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "In-scope technical assets of technology '" + types.FileServer + "' or of type " + types.Datastore.String() + " tagged with 'shared-volume' " +
+			"processing data of at least " + types.Critical.String() + " integrity, which are written by at least " + strconv.Itoa(r.writerLimit) +
+			" different technical assets (i.e. via non-readonly communication links).",
+		RiskAssessment: "The risk rating depends on the highest integrity rating of the data processed.",
+		FalsePositives: "Shared storage where the writers operate on disjoint files or coordinate via locking " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        367,
+		Labels:                     []string{"data"},
+	}
+}
+
+func (*SharedStorageRaceConditionRule) SupportedTags() []string {
+	return []string{"shared-volume"}
+}
+
+func (r *SharedStorageRaceConditionRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		writers := writingAssetIDs(parsedModel, techAsset)
+		if len(writers) < r.writerLimit {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, writers))
+	}
+	return risks, nil
+}
+
+func (r *SharedStorageRaceConditionRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope {
+		return true
+	}
+	if !techAsset.Technologies.GetAttribute(types.FileServer) && (techAsset.Type != types.Datastore || !techAsset.IsTaggedWithAny(r.SupportedTags()...)) {
+		return true
+	}
+	return parsedModel.HighestProcessedIntegrity(techAsset) < types.Critical
+}
+
+func (r *SharedStorageRaceConditionRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedIntegrity(techAsset) == types.MissionCritical {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *SharedStorageRaceConditionRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, writers []string) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Shared Storage Race Condition</b> risk at <b>" + techAsset.Title + "</b> written by <b>" + strconv.Itoa(len(writers)) + " technical assets</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *SharedStorageRaceConditionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		writers := writingAssetIDs(parsedModel, techAsset)
+		if len(writers) < r.writerLimit {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v, type: %v, tags: %v (has %q or is %v tagged with %q)", techAsset.Technologies.String(), techAsset.Type, techAsset.Tags, types.FileServer, types.Datastore, "shared-volume"),
+			fmt.Sprintf("  - highest integrity: %v (>=%v)", parsedModel.HighestProcessedIntegrity(techAsset), types.Critical),
+			fmt.Sprintf("  - written by %v technical assets %q (>=%v)", len(writers), writers, r.writerLimit),
+		}...)
+
+		if impact := r.impact(parsedModel, techAsset); impact == types.HighImpact {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because of %v integrity", impact, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+		}
+	}
+
+	return explanation
+}
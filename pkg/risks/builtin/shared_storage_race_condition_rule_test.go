@@ -0,0 +1,121 @@
+package builtin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestSharedStorageRaceConditionRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewSharedStorageRaceConditionRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type SharedStorageRaceConditionRuleTest struct {
+	assetType  types.TechnicalAssetType
+	technology string
+	tags       []string
+	integrity  types.Criticality
+	writers    int
+	readonly   bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedTitle       string
+	expectedExplanation string
+}
+
+func TestSharedStorageRaceConditionRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]SharedStorageRaceConditionRuleTest{
+		"data store not tagged as shared volume": {
+			assetType:   types.Datastore,
+			integrity:   types.Critical,
+			writers:     2,
+			riskCreated: false,
+		},
+		"not critical integrity": {
+			assetType:   types.Datastore,
+			tags:        []string{"shared-volume"},
+			integrity:   types.Important,
+			writers:     2,
+			riskCreated: false,
+		},
+		"single writer": {
+			technology:  types.FileServer,
+			integrity:   types.Critical,
+			writers:     1,
+			riskCreated: false,
+		},
+		"readonly clients": {
+			technology:  types.FileServer,
+			integrity:   types.Critical,
+			writers:     2,
+			readonly:    true,
+			riskCreated: false,
+		},
+		"file server written by multiple clients": {
+			assetType:           types.Process,
+			technology:          types.FileServer,
+			integrity:           types.Critical,
+			writers:             2,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedTitle:       "<b>Shared Storage Race Condition</b> risk at <b>Storage</b> written by <b>2 technical assets</b>",
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"mission critical shared volume": {
+			assetType:           types.Datastore,
+			tags:                []string{"shared-volume"},
+			integrity:           types.MissionCritical,
+			writers:             3,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedTitle:       "<b>Shared Storage Race Condition</b> risk at <b>Storage</b> written by <b>3 technical assets</b>",
+			expectedExplanation: "    - impact is high because of mission-critical integrity",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewSharedStorageRaceConditionRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"storage": {
+						Id:           "storage",
+						Title:        "Storage",
+						Type:         testCase.assetType,
+						Tags:         testCase.tags,
+						Technologies: types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						Integrity:    testCase.integrity,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{},
+			}
+			for i := 0; i < testCase.writers; i++ {
+				clientId := fmt.Sprintf("client-%d", i)
+				link := &types.CommunicationLink{Id: clientId + ">storage", SourceId: clientId, TargetId: "storage", Readonly: testCase.readonly}
+				model.TechnicalAssets[clientId] = &types.TechnicalAsset{Id: clientId, CommunicationLinks: []*types.CommunicationLink{link}}
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["storage"] = append(model.IncomingTechnicalCommunicationLinksMappedByTargetId["storage"], link)
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
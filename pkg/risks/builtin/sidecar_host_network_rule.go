@@ -0,0 +1,153 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type SidecarHostNetworkRule struct{}
+
+func NewSidecarHostNetworkRule() *SidecarHostNetworkRule {
+	return &SidecarHostNetworkRule{}
+}
+
+func (*SidecarHostNetworkRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "sidecar-host-network",
+		Title: "Sidecar Host Network",
+		Description: "Envoy or other sidecar proxies configured with 'hostNetwork: true' in Kubernetes have full access to the " +
+			"host's network stack, bypassing namespace isolation.",
+		Impact: "If this risk is unmitigated, attackers compromising the sidecar might sniff or intercept the network traffic " +
+			"of all workloads on the same host, including those of other tenants.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Kubernetes_Security_Cheat_Sheet.html",
+		Action:     "Sidecar Network Isolation",
+		Mitigation: "Run sidecar proxies within the pod network namespace (without 'hostNetwork: true') and enforce this via " +
+			"pod security admission or a policy engine.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.ElevationOfPrivilege,
+		DetectionLogic:             "In-scope technical assets tagged with 'sidecar', 'envoy', or 'linkerd-proxy' which are also tagged with 'host-network'.",
+		RiskAssessment:             "The risk rating depends on whether other technical assets within the same host trust boundary process data tagged with 'tenant-data'.",
+		FalsePositives:             "Sidecars on dedicated single-tenant nodes can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        250,
+		Labels:                     []string{"container", "network"},
+	}
+}
+
+func (*SidecarHostNetworkRule) SupportedTags() []string {
+	return []string{"sidecar", "envoy", "linkerd-proxy", "host-network", "tenant-data"}
+}
+
+func (r *SidecarHostNetworkRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *SidecarHostNetworkRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.IsTaggedWithAny("sidecar", "envoy", "linkerd-proxy") ||
+		!techAsset.IsTaggedWithAny("host-network")
+}
+
+// otherTenantAssets returns the sorted IDs of other technical assets within the host trust boundary of the sidecar
+// which process tenant data
+func (r *SidecarHostNetworkRule) otherTenantAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	hostBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+	if !ok {
+		return result
+	}
+	for _, otherId := range hostBoundary.TechnicalAssetsInside {
+		otherAsset, found := parsedModel.TechnicalAssets[otherId]
+		if !found || otherId == techAsset.Id {
+			continue
+		}
+		for _, dataAsset := range parsedModel.DataAssetsProcessedBy(otherAsset) {
+			if dataAsset.IsTaggedWithAny("tenant-data") {
+				result = append(result, otherId)
+				break
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *SidecarHostNetworkRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if len(r.otherTenantAssets(parsedModel, techAsset)) > 0 {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Sidecar Host Network</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	if hostBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]; ok {
+		risk.MostRelevantTrustBoundaryId = hostBoundary.Id
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *SidecarHostNetworkRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q] and %q)", techAsset.Tags, "sidecar", "envoy", "linkerd-proxy", "host-network"),
+		}...)
+
+		hostBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+		if !ok {
+			explanation = append(explanation, "  - host trust boundary: (none)")
+		} else {
+			explanation = append(explanation, fmt.Sprintf("  - host trust boundary: %q", hostBoundary.Id))
+		}
+
+		otherTenantAssets := r.otherTenantAssets(parsedModel, techAsset)
+		for _, otherId := range otherTenantAssets {
+			explanation = append(explanation, fmt.Sprintf("    - shared with technical asset %q processing tenant data", otherId))
+		}
+
+		if len(otherTenantAssets) > 0 {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because other tenant data is reachable on the same host", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
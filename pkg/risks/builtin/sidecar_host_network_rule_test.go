@@ -0,0 +1,94 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestSidecarHostNetworkRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewSidecarHostNetworkRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type SidecarHostNetworkRuleTest struct {
+	tags              []string
+	neighbourDataTags []string
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestSidecarHostNetworkRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]SidecarHostNetworkRuleTest{
+		"not a sidecar": {
+			tags:        []string{"host-network"},
+			riskCreated: false,
+		},
+		"sidecar without host network": {
+			tags:        []string{"envoy"},
+			riskCreated: false,
+		},
+		"sidecar with host network": {
+			tags:              []string{"sidecar", "host-network"},
+			neighbourDataTags: []string{"metrics"},
+			riskCreated:       true,
+			expectedImpact:    types.MediumImpact,
+		},
+		"sidecar with host network next to tenant data": {
+			tags:              []string{"linkerd-proxy", "host-network"},
+			neighbourDataTags: []string{"tenant-data"},
+			riskCreated:       true,
+			expectedImpact:    types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewSidecarHostNetworkRule()
+			node := &types.TrustBoundary{Id: "node", Type: types.ExecutionEnvironment, TechnicalAssetsInside: []string{"proxy", "app"}}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"proxy": {
+						Id:    "proxy",
+						Title: "Proxy",
+						Tags:  testCase.tags,
+					},
+					"app": {
+						Id:                  "app",
+						DataAssetsProcessed: []string{"data"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Tags: testCase.neighbourDataTags},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"node": node,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"proxy": node,
+					"app":   node,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Sidecar Host Network</b> risk at <b>Proxy</b>", risks[0].Title)
+				assert.Equal(t, "node", risks[0].MostRelevantTrustBoundaryId)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), `  - host trust boundary: "node"`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
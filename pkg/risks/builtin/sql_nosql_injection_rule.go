@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -31,6 +32,7 @@ func (*SqlNoSqlInjectionRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        89,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -38,6 +40,10 @@ func (*SqlNoSqlInjectionRule) SupportedTags() []string {
 	return []string{}
 }
 
+func (*SqlNoSqlInjectionRule) IsAssetScoped() bool {
+	return true
+}
+
 func (r *SqlNoSqlInjectionRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
 	risks := make([]*types.Risk, 0)
 	for _, id := range input.SortedTechnicalAssetIDs() {
@@ -65,7 +71,7 @@ func (r *SqlNoSqlInjectionRule) createRisk(input *types.Model, technicalAsset *t
 	title := "<b>SQL/NoSQL-Injection</b> risk at <b>" + caller.Title + "</b> against database <b>" + technicalAsset.Title + "</b>" +
 		" via <b>" + incomingFlow.Title + "</b>"
 	impact := types.MediumImpact
-	if input.HighestProcessedConfidentiality(technicalAsset) == types.StrictlyConfidential || input.HighestProcessedIntegrity(technicalAsset) == types.MissionCritical {
+	if input.AnalysisContext().IsHighlySensitive(technicalAsset) {
 		impact = types.HighImpact
 	}
 	likelihood := types.VeryLikely
@@ -86,3 +92,35 @@ func (r *SqlNoSqlInjectionRule) createRisk(input *types.Model, technicalAsset *t
 	risk.SyntheticId = risk.CategoryId + "@" + caller.Id + "@" + technicalAsset.Id + "@" + incomingFlow.Id
 	return risk
 }
+
+func (r *SqlNoSqlInjectionRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingFlow == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[incomingFlow.TargetId]
+		explanation = appendExplanation(explanation, fmt.Sprintf("communication link %q", incomingFlow.Id))
+		if incomingFlow.Protocol.IsPotentialLaxDatabaseAccessProtocol() {
+			explanation = append(explanation, fmt.Sprintf("  - protocol: %v (is potential lax database access protocol)", incomingFlow.Protocol))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("  - protocol: %v (is potential database access protocol)", incomingFlow.Protocol))
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - caller: technical asset %q", incomingFlow.SourceId),
+			fmt.Sprintf("  - database: technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("    - type: %v (=%v)", technicalAsset.Type, types.Datastore))
+		if !incomingFlow.Protocol.IsPotentialLaxDatabaseAccessProtocol() {
+			explanation = append(explanation, fmt.Sprintf("    - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsVulnerableToQueryInjection))
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("    - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedLikelihood(generatedRisk, types.VeryLikely, fmt.Sprintf("the usage is %v", incomingFlow.Usage)),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the database processes %v data or %v integrity", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
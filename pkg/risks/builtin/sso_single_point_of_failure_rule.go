@@ -0,0 +1,140 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const defaultSsoMaxDependentAssets = 5
+
+type SsoSinglePointOfFailureRule struct {
+	maxDependentAssets int
+}
+
+func NewSsoSinglePointOfFailureRule() *SsoSinglePointOfFailureRule {
+	return &SsoSinglePointOfFailureRule{maxDependentAssets: defaultSsoMaxDependentAssets}
+}
+
+// SetMaxDependentAssets sets the number of dependent technical assets an identity provider may have before being
+// considered a single point of failure.
+func (r *SsoSinglePointOfFailureRule) SetMaxDependentAssets(maxDependentAssets int) {
+	r.maxDependentAssets = maxDependentAssets
+}
+
+func (r *SsoSinglePointOfFailureRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "sso-single-point-of-failure",
+		Title: "SSO Single Point of Failure",
+		Description: "When all applications rely on a single identity provider without any fallback, an outage of the identity " +
+			"provider causes a complete authentication denial-of-service.",
+		Impact: "If this risk is unmitigated, an outage or overload of the identity provider might render all dependent " +
+			"(including mission-critical) systems unusable.",
+		ASVS:       "V1 - Architecture, Design and Threat Modeling Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Denial_of_Service_Cheat_Sheet.html",
+		Action:     "Identity Provider Redundancy",
+		Mitigation: "Deploy the identity provider in a highly available (ideally active-active) setup and consider fallback " +
+			"authentication mechanisms for mission-critical systems.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.DenialOfService,
+		DetectionLogic: "Identity providers or technical assets tagged with 'sso' having incoming communication links from more than " + strconv.Itoa(r.maxDependentAssets) + " other technical assets, at least one of them processing data rated as " + types.MissionCritical.String() + " in terms of availability, while not being tagged with 'ha-deployment' or 'active-active'.",
+		RiskAssessment: types.HighImpact.String(),
+		FalsePositives: "Identity providers deployed highly available without this being reflected in the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        654,
+		Labels:                     []string{"identity", "operations"},
+	}
+}
+
+func (*SsoSinglePointOfFailureRule) SupportedTags() []string {
+	return []string{"sso", "ha-deployment", "active-active"}
+}
+
+func (r *SsoSinglePointOfFailureRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		dependentAssetIDs := r.dependentAssetIDs(parsedModel, techAsset)
+		if len(dependentAssetIDs) <= r.maxDependentAssets || !r.guardsMissionCriticalAsset(parsedModel, dependentAssetIDs) {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, dependentAssetIDs))
+	}
+	return risks, nil
+}
+
+func (r *SsoSinglePointOfFailureRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope || techAsset.IsTaggedWithAny("ha-deployment", "active-active") {
+		return true
+	}
+	return !techAsset.Technologies.GetAttribute(types.IdentityProvider) && !techAsset.IsTaggedWithAny("sso")
+}
+
+func (r *SsoSinglePointOfFailureRule) dependentAssetIDs(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.SourceId != techAsset.Id && !contains(result, incomingLink.SourceId) {
+			result = append(result, incomingLink.SourceId)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *SsoSinglePointOfFailureRule) guardsMissionCriticalAsset(parsedModel *types.Model, dependentAssetIDs []string) bool {
+	for _, id := range dependentAssetIDs {
+		if dependentAsset, ok := parsedModel.TechnicalAssets[id]; ok && parsedModel.HighestProcessedAvailability(dependentAsset) == types.MissionCritical {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *SsoSinglePointOfFailureRule) createRisk(techAsset *types.TechnicalAsset, dependentAssetIDs []string) *types.Risk {
+	title := "<b>SSO Single Point of Failure</b> risk at <b>" + techAsset.Title + "</b> with " +
+		strconv.Itoa(len(dependentAssetIDs)) + " dependent technical assets"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, types.HighImpact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           types.HighImpact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Improbable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *SsoSinglePointOfFailureRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v, tags: %v (has %q or tag %q)", techAsset.Technologies.String(), techAsset.Tags, types.IdentityProvider, "sso"),
+			fmt.Sprintf("  - tags: %v (has neither %q nor %q)", techAsset.Tags, "ha-deployment", "active-active"))
+		dependentAssetIDs := r.dependentAssetIDs(parsedModel, techAsset)
+		explanation = append(explanation, fmt.Sprintf("  - dependent technical assets: %v (more than %v)", len(dependentAssetIDs), r.maxDependentAssets))
+		for _, id := range dependentAssetIDs {
+			if dependentAsset, ok := parsedModel.TechnicalAssets[id]; ok && parsedModel.HighestProcessedAvailability(dependentAsset) == types.MissionCritical {
+				explanation = append(explanation, fmt.Sprintf("    - technical asset %q (highest processed availability %v)", id, types.MissionCritical))
+			}
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.HighImpact))
+	}
+
+	return explanation
+}
@@ -0,0 +1,135 @@
+package builtin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestSsoSinglePointOfFailureRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewSsoSinglePointOfFailureRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type SsoSinglePointOfFailureRuleTest struct {
+	technology          string
+	tags                []string
+	dependentAssets     int
+	maxDependentAssets  int
+	missionCriticalData bool
+
+	riskCreated bool
+}
+
+func TestSsoSinglePointOfFailureRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]SsoSinglePointOfFailureRuleTest{
+		"not an identity provider": {
+			technology:          types.WebServiceREST,
+			dependentAssets:     6,
+			missionCriticalData: true,
+			riskCreated:         false,
+		},
+		"not enough dependent assets": {
+			technology:          types.IdentityProvider,
+			dependentAssets:     5,
+			missionCriticalData: true,
+			riskCreated:         false,
+		},
+		"no mission critical dependent asset": {
+			technology:      types.IdentityProvider,
+			dependentAssets: 6,
+			riskCreated:     false,
+		},
+		"highly available": {
+			technology:          types.IdentityProvider,
+			tags:                []string{"ha-deployment"},
+			dependentAssets:     6,
+			missionCriticalData: true,
+			riskCreated:         false,
+		},
+		"active-active": {
+			tags:                []string{"sso", "active-active"},
+			dependentAssets:     6,
+			missionCriticalData: true,
+			riskCreated:         false,
+		},
+		"identity provider single point of failure": {
+			technology:          types.IdentityProvider,
+			dependentAssets:     6,
+			missionCriticalData: true,
+			riskCreated:         true,
+		},
+		"sso tagged single point of failure": {
+			tags:                []string{"sso"},
+			dependentAssets:     6,
+			missionCriticalData: true,
+			riskCreated:         true,
+		},
+		"lowered threshold": {
+			technology:          types.IdentityProvider,
+			dependentAssets:     2,
+			maxDependentAssets:  1,
+			missionCriticalData: true,
+			riskCreated:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewSsoSinglePointOfFailureRule()
+			if testCase.maxDependentAssets > 0 {
+				rule.SetMaxDependentAssets(testCase.maxDependentAssets)
+			}
+
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"idp": {
+						Id:    "idp",
+						Title: "IdP",
+						Tags:  testCase.tags,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{},
+			}
+			for n := 0; n < testCase.dependentAssets; n++ {
+				id := fmt.Sprintf("app%d", n)
+				availability := types.Important
+				if testCase.missionCriticalData && n == 0 {
+					availability = types.MissionCritical
+				}
+				link := &types.CommunicationLink{Id: id + ">idp", SourceId: id, TargetId: "idp"}
+				model.TechnicalAssets[id] = &types.TechnicalAsset{
+					Id:                 id,
+					Availability:       availability,
+					CommunicationLinks: []*types.CommunicationLink{link},
+				}
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId["idp"] = append(model.IncomingTechnicalCommunicationLinksMappedByTargetId["idp"], link)
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, fmt.Sprintf("<b>SSO Single Point of Failure</b> risk at <b>IdP</b> with %d dependent technical assets", testCase.dependentAssets), risks[0].Title)
+				assert.Equal(t, "sso-single-point-of-failure@idp", risks[0].SyntheticId)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type StaticIpAllowlistOnlyRule struct{}
+
+func NewStaticIpAllowlistOnlyRule() *StaticIpAllowlistOnlyRule {
+	return &StaticIpAllowlistOnlyRule{}
+}
+
+func (*StaticIpAllowlistOnlyRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "static-ip-allowlist-only",
+		Title: "Static IP Allowlist Only",
+		Description: "IP allowlisting is frequently bypassed by server-side request forgery (SSRF), cloud metadata attacks, or " +
+			"compromised internal hosts. When it is the only control protecting a sensitive API, the risk is high.",
+		Impact: "If this risk is unmitigated, attackers able to send requests from an allowlisted IP address might access " +
+			"the sensitive API without any further authentication.",
+		ASVS:       "V2 - Authentication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/REST_Security_Cheat_Sheet.html",
+		Action:     "Layered Authentication",
+		Mitigation: "Layer mutual TLS (mTLS) or API key authentication on top of IP restrictions, so that an allowlisted IP address " +
+			"alone does not grant access to the sensitive API.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Architecture,
+		STRIDE:         types.Spoofing,
+		DetectionLogic: "Communication links tagged with 'ip-allowlist-only' targeting technical assets processing data rated at least as " + types.Confidential.String() + ".",
+		RiskAssessment: "The risk rating depends on whether the communication link originates from a cloud environment, where IP addresses " +
+			"can be spoofed or shared with other cloud customers.",
+		FalsePositives:             "Communication links with additional authentication not reflected in the model can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        290,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*StaticIpAllowlistOnlyRule) SupportedTags() []string {
+	return []string{"ip-allowlist-only"}
+}
+
+func (r *StaticIpAllowlistOnlyRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			if !commLink.IsTaggedWithAny("ip-allowlist-only") {
+				continue
+			}
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || parsedModel.HighestProcessedConfidentiality(targetAsset) < types.Confidential {
+				continue
+			}
+			risks = append(risks, r.createRisk(parsedModel, sourceAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *StaticIpAllowlistOnlyRule) isWithinCloud(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]
+	if !ok {
+		return false
+	}
+	for _, id := range parsedModel.AnalysisContext().AllParentTrustBoundaryIDs(trustBoundary) {
+		if parent, found := parsedModel.TrustBoundaries[id]; found && parent.Type.IsWithinCloud() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *StaticIpAllowlistOnlyRule) createRisk(parsedModel *types.Model, sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if r.isWithinCloud(parsedModel, sourceAsset) {
+		impact = types.HighImpact
+	}
+	title := "<b>Static IP Allowlist Only</b> risk at <b>" + sourceAsset.Title + "</b> calling <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Probable,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *StaticIpAllowlistOnlyRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		sourceAsset := parsedModel.TechnicalAssets[commLink.SourceId]
+		targetAsset := parsedModel.TechnicalAssets[commLink.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", commLink.Id),
+			fmt.Sprintf("  - tags: %v (has %q)", commLink.Tags, "ip-allowlist-only"),
+			fmt.Sprintf("  - source: technical asset %q (within cloud: %v)", sourceAsset.Id, r.isWithinCloud(parsedModel, sourceAsset)),
+			fmt.Sprintf("  - target: technical asset %q (highest processed confidentiality: %v (>=%v))", targetAsset.Id, parsedModel.HighestProcessedConfidentiality(targetAsset), types.Confidential),
+			explainedImpact(generatedRisk, types.MediumImpact, "the source is within a cloud trust boundary, where IP addresses are shared and reassigned"))
+	}
+
+	return explanation
+}
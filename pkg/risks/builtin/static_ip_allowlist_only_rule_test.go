@@ -0,0 +1,98 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestStaticIpAllowlistOnlyRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewStaticIpAllowlistOnlyRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type StaticIpAllowlistOnlyRuleTest struct {
+	tags               []string
+	confidentiality    types.Confidentiality
+	sourceBoundaryType types.TrustBoundaryType
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestStaticIpAllowlistOnlyRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]StaticIpAllowlistOnlyRuleTest{
+		"not ip allowlist only": {
+			tags:            []string{"mtls"},
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     false,
+		},
+		"target not sensitive": {
+			tags:            []string{"ip-allowlist-only"},
+			confidentiality: types.Internal,
+			riskCreated:     false,
+		},
+		"internal caller": {
+			tags:               []string{"ip-allowlist-only"},
+			confidentiality:    types.Confidential,
+			sourceBoundaryType: types.NetworkOnPrem,
+			riskCreated:        true,
+			expectedImpact:     types.MediumImpact,
+		},
+		"cloud caller": {
+			tags:               []string{"ip-allowlist-only"},
+			confidentiality:    types.StrictlyConfidential,
+			sourceBoundaryType: types.NetworkCloudProvider,
+			riskCreated:        true,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewStaticIpAllowlistOnlyRule()
+			sourceBoundary := &types.TrustBoundary{Id: "source-network", Type: testCase.sourceBoundaryType, TechnicalAssetsInside: []string{"client"}}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:    "client",
+						Title: "Client",
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "client>api", Title: "Call", SourceId: "client", TargetId: "api", Tags: testCase.tags},
+						},
+					},
+					"api": {
+						Id:                  "api",
+						Title:               "API",
+						DataAssetsProcessed: []string{"data"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					"source-network": sourceBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"client": sourceBoundary,
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Static IP Allowlist Only</b> risk at <b>Client</b> calling <b>API</b> via <b>Call</b>", risks[0].Title)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
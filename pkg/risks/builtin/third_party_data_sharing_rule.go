@@ -0,0 +1,171 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ThirdPartyDataSharingRule struct{}
+
+func NewThirdPartyDataSharingRule() *ThirdPartyDataSharingRule {
+	return &ThirdPartyDataSharingRule{}
+}
+
+func (*ThirdPartyDataSharingRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "third-party-data-sharing",
+		Title: "Third-Party Data Sharing",
+		Description: "Confidential data sent to externally hosted third-party systems (like SaaS providers) leaves the control of the organization " +
+			"and is only as well protected as the vendor protects it.",
+		Impact: "If this risk is unmitigated, a breach or misuse at the vendor might disclose the shared confidential data, " +
+			"possibly also violating privacy regulations.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/User_Privacy_Protection_Cheat_Sheet.html",
+		Action:     "Vendor Risk Management",
+		Mitigation: "Share only the data required by the vendor (like pseudonymized data), assess the security of the vendor, " +
+			"and put data processing agreements in place. Tag the communication link or the target with 'vendor-assessed' when done.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.BusinessSide,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "Communication links of in-scope technical assets sending data assets rated at least " + types.Confidential.String() +
+			" to out-of-scope technical assets, external entities, or technical assets tagged with 'saas' or 'third-party', " +
+			"unless the communication link or the target is tagged with 'vendor-assessed'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data assets sent.",
+		FalsePositives: "Third-party systems processing the data on behalf of and under full control of the organization " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        359,
+		Labels:                     []string{"data", "compliance:gdpr"},
+	}
+}
+
+func (*ThirdPartyDataSharingRule) SupportedTags() []string {
+	return []string{"saas", "third-party", "vendor-assessed"}
+}
+
+func (r *ThirdPartyDataSharingRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if techAsset.OutOfScope {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isThirdPartyLink(commLink, targetAsset) {
+				continue
+			}
+
+			sharedDataAssets := r.sharedConfidentialDataAssets(parsedModel, commLink)
+			if len(sharedDataAssets) == 0 {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink, sharedDataAssets))
+		}
+	}
+	return risks, nil
+}
+
+func (r *ThirdPartyDataSharingRule) isThirdParty(targetAsset *types.TechnicalAsset) bool {
+	return targetAsset.OutOfScope || targetAsset.Type == types.ExternalEntity || targetAsset.IsTaggedWithAny("saas", "third-party")
+}
+
+func (r *ThirdPartyDataSharingRule) isThirdPartyLink(commLink *types.CommunicationLink, targetAsset *types.TechnicalAsset) bool {
+	return r.isThirdParty(targetAsset) && !commLink.IsTaggedWithAny("vendor-assessed") && !targetAsset.IsTaggedWithAny("vendor-assessed")
+}
+
+// sharedConfidentialDataAssets returns the sorted ids of the data assets rated at least confidential sent via the communication link
+func (r *ThirdPartyDataSharingRule) sharedConfidentialDataAssets(parsedModel *types.Model, commLink *types.CommunicationLink) []string {
+	result := make([]string, 0)
+	for _, dataAssetId := range commLink.DataAssetsSent {
+		dataAsset, ok := parsedModel.DataAssets[dataAssetId]
+		if ok && dataAsset.Confidentiality >= types.Confidential && !contains(result, dataAssetId) {
+			result = append(result, dataAssetId)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *ThirdPartyDataSharingRule) impact(parsedModel *types.Model, sharedDataAssets []string) types.RiskExploitationImpact {
+	for _, id := range sharedDataAssets {
+		if parsedModel.DataAssets[id].Confidentiality == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *ThirdPartyDataSharingRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink, sharedDataAssets []string) *types.Risk {
+	impact := r.impact(parsedModel, sharedDataAssets)
+	title := "<b>Third-Party Data Sharing</b> risk at <b>" + techAsset.Title + "</b> sharing data with <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *ThirdPartyDataSharingRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if techAsset.OutOfScope {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isThirdPartyLink(commLink, targetAsset) {
+				continue
+			}
+
+			sharedDataAssets := r.sharedConfidentialDataAssets(parsedModel, commLink)
+			if len(sharedDataAssets) == 0 {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - tags: %v (has not %q)", commLink.Tags, "vendor-assessed"),
+				fmt.Sprintf("  - confidential data assets sent: %q", sharedDataAssets),
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=false)", techAsset.OutOfScope),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - out of scope: %v, type: %v, tags: %v (is out of scope, %v, or has either [%q, %q])", targetAsset.OutOfScope, targetAsset.Type,
+					targetAsset.Tags, types.ExternalEntity, "saas", "third-party"),
+			}...)
+
+			if impact := r.impact(parsedModel, sharedDataAssets); impact == types.HighImpact {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is sent", impact, types.StrictlyConfidential))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+			}
+		}
+	}
+
+	return explanation
+}
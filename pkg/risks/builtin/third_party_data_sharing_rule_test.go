@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestThirdPartyDataSharingRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewThirdPartyDataSharingRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ThirdPartyDataSharingRuleTest struct {
+	sourceOutOfScope bool
+	targetOutOfScope bool
+	targetType       types.TechnicalAssetType
+	targetTags       []string
+	linkTags         []string
+	confidentiality  types.Confidentiality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestThirdPartyDataSharingRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ThirdPartyDataSharingRuleTest{
+		"internal target": {
+			targetType:      types.Process,
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     false,
+		},
+		"source out of scope": {
+			sourceOutOfScope: true,
+			targetOutOfScope: true,
+			confidentiality:  types.StrictlyConfidential,
+			riskCreated:      false,
+		},
+		"non-confidential data sent": {
+			targetType:      types.ExternalEntity,
+			confidentiality: types.Restricted,
+			riskCreated:     false,
+		},
+		"vendor assessed link": {
+			targetType:      types.ExternalEntity,
+			linkTags:        []string{"vendor-assessed"},
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     false,
+		},
+		"vendor assessed target": {
+			targetTags:      []string{"saas", "vendor-assessed"},
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     false,
+		},
+		"confidential data sent to external entity": {
+			targetType:          types.ExternalEntity,
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"strictly confidential data sent to out of scope asset": {
+			targetOutOfScope:    true,
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because strictly-confidential data is sent",
+		},
+		"confidential data sent to saas": {
+			targetTags:          []string{"saas"},
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "  - confidential data assets sent: [\"data\"]",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewThirdPartyDataSharingRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:         "app",
+						Title:      "App",
+						OutOfScope: testCase.sourceOutOfScope,
+						CommunicationLinks: []*types.CommunicationLink{
+							{
+								Id:             "app>vendor",
+								Title:          "Export",
+								SourceId:       "app",
+								TargetId:       "vendor",
+								Tags:           testCase.linkTags,
+								DataAssetsSent: []string{"data"},
+							},
+						},
+					},
+					"vendor": {
+						Id:         "vendor",
+						Title:      "Vendor",
+						Type:       testCase.targetType,
+						Tags:       testCase.targetTags,
+						OutOfScope: testCase.targetOutOfScope,
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.confidentiality},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Third-Party Data Sharing</b> risk at <b>App</b> sharing data with <b>Vendor</b> via <b>Export</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type ThirdPartyJavascriptRule struct{}
+
+func NewThirdPartyJavascriptRule() *ThirdPartyJavascriptRule {
+	return &ThirdPartyJavascriptRule{}
+}
+
+func (*ThirdPartyJavascriptRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "third-party-javascript",
+		Title: "Third-Party JavaScript",
+		Description: "Browsers loading scripts from third-party content delivery networks (CDNs) execute whatever code the CDN delivers " +
+			"within the origin of the web application, which makes the CDN part of the software supply chain.",
+		Impact: "If this risk is unmitigated, attackers compromising the CDN might inject malicious scripts (like Magecart-style skimmers) " +
+			"and steal the data entered into the forms of the web application.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Third_Party_Javascript_Management_Cheat_Sheet.html",
+		Action:     "Subresource Integrity",
+		Mitigation: "Host the scripts on own infrastructure or apply Subresource Integrity (SRI) and a restrictive Content Security Policy (CSP). " +
+			"Tag the communication link with 'sri' when in place.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.Tampering,
+		DetectionLogic: "Communication links from technical assets of technology '" + types.Browser + "' to out-of-scope technical assets tagged with 'cdn', " +
+			"which are not tagged with 'sri'.",
+		RiskAssessment:             "The risk rating depends on the highest confidentiality rating of the data assets sent by the browser (i.e. the form data).",
+		FalsePositives:             "CDNs only delivering non-executable content (like images) can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        829,
+		Labels:                     []string{"appsec", "supply-chain"},
+	}
+}
+
+func (*ThirdPartyJavascriptRule) SupportedTags() []string {
+	return []string{"cdn", "sri"}
+}
+
+func (r *ThirdPartyJavascriptRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isThirdPartyScriptLink(commLink, targetAsset) {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *ThirdPartyJavascriptRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return !techAsset.Technologies.GetAttribute(types.Browser)
+}
+
+func (r *ThirdPartyJavascriptRule) isThirdPartyScriptLink(commLink *types.CommunicationLink, targetAsset *types.TechnicalAsset) bool {
+	return targetAsset.OutOfScope && targetAsset.IsTaggedWithAny("cdn") && !commLink.IsTaggedWithAny("sri")
+}
+
+// formDataAssets returns the sorted ids of the data assets sent by the browser to in-scope technical assets
+func (r *ThirdPartyJavascriptRule) formDataAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	for _, commLink := range techAsset.CommunicationLinks {
+		if targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]; !ok || targetAsset.OutOfScope {
+			continue
+		}
+		for _, dataAssetId := range commLink.DataAssetsSent {
+			if !contains(result, dataAssetId) {
+				result = append(result, dataAssetId)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *ThirdPartyJavascriptRule) highestFormDataConfidentiality(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.Confidentiality {
+	highest := types.Public
+	for _, dataAssetId := range r.formDataAssets(parsedModel, techAsset) {
+		if dataAsset, ok := parsedModel.DataAssets[dataAssetId]; ok && dataAsset.Confidentiality > highest {
+			highest = dataAsset.Confidentiality
+		}
+	}
+	return highest
+}
+
+func (r *ThirdPartyJavascriptRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	switch r.highestFormDataConfidentiality(parsedModel, techAsset) {
+	case types.StrictlyConfidential:
+		return types.HighImpact
+	case types.Confidential:
+		return types.MediumImpact
+	default:
+		return types.LowImpact
+	}
+}
+
+func (r *ThirdPartyJavascriptRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>Third-Party JavaScript</b> risk at <b>" + techAsset.Title + "</b> loading scripts from <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *ThirdPartyJavascriptRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isThirdPartyScriptLink(commLink, targetAsset) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - tags: %v (has not %q)", commLink.Tags, "sri"),
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - technology: %v (has %q)", techAsset.Technologies.String(), types.Browser),
+				fmt.Sprintf("    - form data assets sent: %q", r.formDataAssets(parsedModel, techAsset)),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=true)", targetAsset.OutOfScope),
+				fmt.Sprintf("    - tags: %v (has %q)", targetAsset.Tags, "cdn"),
+				fmt.Sprintf("    - impact is %v because of highest form data confidentiality %v", r.impact(parsedModel, techAsset), r.highestFormDataConfidentiality(parsedModel, techAsset)),
+			}...)
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,120 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestThirdPartyJavascriptRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewThirdPartyJavascriptRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type ThirdPartyJavascriptRuleTest struct {
+	sourceTechnology string
+	cdnOutOfScope    bool
+	cdnTags          []string
+	linkTags         []string
+	confidentiality  types.Confidentiality
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestThirdPartyJavascriptRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]ThirdPartyJavascriptRuleTest{
+		"not a browser": {
+			sourceTechnology: types.WebApplication,
+			cdnOutOfScope:    true,
+			cdnTags:          []string{"cdn"},
+			riskCreated:      false,
+		},
+		"cdn in scope": {
+			sourceTechnology: types.Browser,
+			cdnTags:          []string{"cdn"},
+			riskCreated:      false,
+		},
+		"out of scope asset not a cdn": {
+			sourceTechnology: types.Browser,
+			cdnOutOfScope:    true,
+			riskCreated:      false,
+		},
+		"subresource integrity applied": {
+			sourceTechnology: types.Browser,
+			cdnOutOfScope:    true,
+			cdnTags:          []string{"cdn"},
+			linkTags:         []string{"sri"},
+			riskCreated:      false,
+		},
+		"scripts loaded from cdn": {
+			sourceTechnology:    types.Browser,
+			cdnOutOfScope:       true,
+			cdnTags:             []string{"cdn"},
+			confidentiality:     types.Internal,
+			riskCreated:         true,
+			expectedImpact:      types.LowImpact,
+			expectedExplanation: "    - impact is low because of highest form data confidentiality internal",
+		},
+		"scripts loaded from cdn with strictly confidential form data": {
+			sourceTechnology:    types.Browser,
+			cdnOutOfScope:       true,
+			cdnTags:             []string{"cdn"},
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because of highest form data confidentiality strictly-confidential",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewThirdPartyJavascriptRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"browser": {
+						Id:           "browser",
+						Title:        "Browser",
+						Technologies: types.TechnologyList{{Name: testCase.sourceTechnology, Attributes: map[string]bool{testCase.sourceTechnology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "browser>cdn", Title: "Load Scripts", SourceId: "browser", TargetId: "cdn", Tags: testCase.linkTags},
+							{Id: "browser>shop", Title: "Checkout", SourceId: "browser", TargetId: "shop", DataAssetsSent: []string{"payment-data"}},
+						},
+					},
+					"cdn": {
+						Id:         "cdn",
+						Title:      "CDN",
+						OutOfScope: testCase.cdnOutOfScope,
+						Tags:       testCase.cdnTags,
+					},
+					"shop": {
+						Id:    "shop",
+						Title: "Shop",
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"payment-data": {Id: "payment-data", Confidentiality: testCase.confidentiality},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Third-Party JavaScript</b> risk at <b>Browser</b> loading scripts from <b>CDN</b> via <b>Load Scripts</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
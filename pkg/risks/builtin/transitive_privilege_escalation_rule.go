@@ -0,0 +1,115 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/analysis"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type TransitivePrivilegeEscalationRule struct {
+	maxDepth int
+}
+
+func NewTransitivePrivilegeEscalationRule() *TransitivePrivilegeEscalationRule {
+	return &TransitivePrivilegeEscalationRule{maxDepth: 6}
+}
+
+func (*TransitivePrivilegeEscalationRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "transitive-privilege-escalation",
+		Title: "Transitive Privilege Escalation",
+		Description: "An attacker who compromises an internet-facing asset might be able to reach a high-privilege asset through a chain " +
+			"of communication links, each step being individually authenticated and authorized, but the trust level increasing with each hop.",
+		Impact: "If this risk is unmitigated, attackers compromising an internet-facing asset might be able to escalate their privileges " +
+			"hop by hop until they reach highly sensitive systems.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authorization_Cheat_Sheet.html",
+		Action:     "Access Control Review",
+		Mitigation: "Review the access control matrix along the path: apply least privilege to each hop, propagate the end-user identity " +
+			"instead of relying on technical service accounts, and introduce additional authorization checks before reaching high-privilege assets.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Architecture,
+		STRIDE:         types.ElevationOfPrivilege,
+		DetectionLogic: "Chains of authenticated communication links starting at internet-facing technical assets and ending at in-scope technical assets processing strictly-confidential data or tagged with 'admin', where the trust level increases at each hop.",
+		RiskAssessment: "The risk rating depends on the sensitivity of the high-privilege target asset.",
+		FalsePositives: "Paths where each hop properly propagates and verifies the end-user identity can be considered " +
+			"as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        269,
+		Labels:                     []string{"identity"},
+	}
+}
+
+func (*TransitivePrivilegeEscalationRule) SupportedTags() []string {
+	return []string{"admin"}
+}
+
+func (r *TransitivePrivilegeEscalationRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, path := range analysis.FindPrivilegeEscalationPaths(parsedModel, r.maxDepth) {
+		risks = append(risks, r.createRisk(parsedModel, path))
+	}
+	return risks, nil
+}
+
+func (r *TransitivePrivilegeEscalationRule) createRisk(parsedModel *types.Model, path *analysis.PrivilegeEscalationPath) *types.Risk {
+	titles := make([]string, 0)
+	for _, step := range path.Steps {
+		titles = append(titles, "<b>"+step.TechnicalAsset.Title+"</b>")
+	}
+	target := path.Target()
+	title := "<b>Transitive Privilege Escalation</b> from internet-facing <b>" + path.Source().Title + "</b> to <b>" + target.Title + "</b> " +
+		"via " + strings.Join(titles, " -> ")
+	impact := types.MediumImpact
+	if parsedModel.HighestProcessedConfidentiality(target) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(target) == types.MissionCritical {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    target.Id,
+		MostRelevantCommunicationLinkId: path.Steps[len(path.Steps)-2].CommunicationLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     path.TechnicalAssetIDs()[1:],
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + strings.Join(path.TechnicalAssetIDs(), "@")
+	return risk
+}
+
+func (r *TransitivePrivilegeEscalationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, path := range analysis.FindPrivilegeEscalationPaths(parsedModel, r.maxDepth) {
+		if !strings.EqualFold(risk, categoryId+"@"+strings.Join(path.TechnicalAssetIDs(), "@")) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		target := path.Target()
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("privilege escalation path %v (at most %v communication links)", path.TechnicalAssetIDs(), r.maxDepth),
+			fmt.Sprintf("  - source: technical asset %q (internet: %v)", path.Source().Id, path.Source().Internet))
+		for _, step := range path.Steps {
+			explanation = append(explanation, fmt.Sprintf("  - technical asset %q (trust level %v)", step.TechnicalAsset.Id, analysis.TrustLevel(parsedModel, step.TechnicalAsset)))
+			if step.CommunicationLink != nil {
+				explanation = append(explanation, fmt.Sprintf("    - calls %q via communication link %q (authentication: %v)", step.CommunicationLink.TargetId, step.CommunicationLink.Id, step.CommunicationLink.Authentication))
+			}
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - target: technical asset %q (out of scope: %v, highest processed confidentiality %v, tags: %v)",
+				target.Id, target.OutOfScope, parsedModel.HighestProcessedConfidentiality(target), target.Tags),
+			"    - the trust level increases with each hop, and the target processes strictly-confidential data or is tagged with \"admin\"")
+		if parsedModel.HighestProcessedConfidentiality(target) == types.StrictlyConfidential || parsedModel.HighestProcessedIntegrity(target) == types.MissionCritical {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the target processes %v data or %v integrity", types.HighImpact, types.StrictlyConfidential, types.MissionCritical))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
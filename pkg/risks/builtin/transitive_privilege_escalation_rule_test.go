@@ -0,0 +1,73 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestTransitivePrivilegeEscalationRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewTransitivePrivilegeEscalationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+func TestTransitivePrivilegeEscalationRuleGenerateRisksFourHopPathRiskCreated(t *testing.T) {
+	rule := NewTransitivePrivilegeEscalationRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"web": {
+				Id:       "web",
+				Title:    "Web",
+				Internet: true,
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "web>app", SourceId: "web", TargetId: "app", Authentication: types.Token},
+				},
+			},
+			"app": {
+				Id:              "app",
+				Title:           "App",
+				Confidentiality: types.Internal,
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "app>service", SourceId: "app", TargetId: "service", Authentication: types.Credentials},
+				},
+			},
+			"service": {
+				Id:              "service",
+				Title:           "Service",
+				Confidentiality: types.Restricted,
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "service>backend", SourceId: "service", TargetId: "backend", Authentication: types.Token},
+				},
+			},
+			"backend": {
+				Id:              "backend",
+				Title:           "Backend",
+				Confidentiality: types.Confidential,
+				CommunicationLinks: []*types.CommunicationLink{
+					{Id: "backend>vault", SourceId: "backend", TargetId: "vault", Authentication: types.ClientCertificate},
+				},
+			},
+			"vault": {
+				Id:              "vault",
+				Title:           "Vault",
+				Confidentiality: types.StrictlyConfidential,
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, "transitive-privilege-escalation@web@app@service@backend@vault", risks[0].SyntheticId)
+	assert.Equal(t, "vault", risks[0].MostRelevantTechnicalAssetId)
+	assert.Equal(t, "backend>vault", risks[0].MostRelevantCommunicationLinkId)
+	assert.Equal(t, []string{"app", "service", "backend", "vault"}, risks[0].DataBreachTechnicalAssetIDs)
+	assert.Equal(t, "<b>Transitive Privilege Escalation</b> from internet-facing <b>Web</b> to <b>Vault</b> via "+
+		"<b>Web</b> -> <b>App</b> -> <b>Service</b> -> <b>Backend</b> -> <b>Vault</b>", risks[0].Title)
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -34,6 +35,7 @@ func (*UncheckedDeploymentRule) Category() *types.RiskCategory {
 			"after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        1127,
+		Labels:                     []string{"supply-chain"},
 	}
 }
 
@@ -95,3 +97,32 @@ func (r *UncheckedDeploymentRule) createRisk(input *types.Model, technicalAsset
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *UncheckedDeploymentRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - technology: %v (has %q)", technicalAsset.Technologies.String(), types.IsDevelopmentRelevant))
+		for _, codeDeploymentTargetCommLink := range technicalAsset.CommunicationLinksSorted() {
+			if codeDeploymentTargetCommLink.Usage != types.DevOps {
+				continue
+			}
+			for _, dataAssetID := range codeDeploymentTargetCommLink.DataAssetsSent {
+				if dataAsset, ok := parsedModel.DataAssets[dataAssetID]; ok && dataAsset.Integrity >= types.Important {
+					targetTechAsset := parsedModel.TechnicalAssets[codeDeploymentTargetCommLink.TargetId]
+					explanation = append(explanation,
+						fmt.Sprintf("  - deploys data asset %q (integrity: %v (>=%v)) to technical asset %q via communication link %q",
+							dataAsset.Id, dataAsset.Integrity, types.Important, codeDeploymentTargetCommLink.TargetId, codeDeploymentTargetCommLink.Id),
+						fmt.Sprintf("    - %v", processedSensitivity(parsedModel, targetTechAsset)))
+					break
+				}
+			}
+		}
+		explanation = append(explanation, explainedImpact(generatedRisk, types.LowImpact,
+			fmt.Sprintf("a deployment target processes %v or higher confidentiality or %v or higher integrity or availability", types.Confidential, types.Critical)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,109 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnencryptedArtifactStorageRule struct{}
+
+func NewUnencryptedArtifactStorageRule() *UnencryptedArtifactStorageRule {
+	return &UnencryptedArtifactStorageRule{}
+}
+
+func (*UnencryptedArtifactStorageRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unencrypted-artifact-storage",
+		Title: "Unencrypted Artifact Storage",
+		Description: "CI/CD artifact registries storing container images, JAR files, or deployment packages unencrypted are an " +
+			"attractive target because they contain application code and potentially embedded secrets.",
+		Impact: "If this risk is unmitigated, attackers gaining access to the underlying storage might read or tamper with " +
+			"build artifacts which are deployed to production.",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Cryptographic_Storage_Cheat_Sheet.html",
+		Action:     "Encryption of Artifact Storage",
+		Mitigation: "Enable encryption at rest for the artifact registry storage and sign artifacts to detect tampering. " +
+			"Never embed credentials or keys into build artifacts.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.InformationDisclosure,
+		DetectionLogic:             "In-scope unencrypted technical assets of technology '" + types.ArtifactRegistry + "' not being tagged with 'encryption-at-rest' having outgoing communication links to production technical assets.",
+		RiskAssessment:             "The risk rating depends on whether the artifact registry contains credentials or keys (tagged with 'contains-credentials' or 'contains-keys').",
+		FalsePositives:             "Artifact registries whose storage is encrypted on infrastructure level not reflected in the model can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        311,
+		Labels:                     []string{"supply-chain", "data"},
+	}
+}
+
+func (*UnencryptedArtifactStorageRule) SupportedTags() []string {
+	return []string{"encryption-at-rest", "contains-credentials", "contains-keys", "production"}
+}
+
+func (r *UnencryptedArtifactStorageRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) || !r.deploysToProduction(parsedModel, techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(techAsset))
+	}
+	return risks, nil
+}
+
+func (r *UnencryptedArtifactStorageRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.Technologies.GetAttribute(types.ArtifactRegistry) ||
+		techAsset.Encryption != types.NoneEncryption ||
+		techAsset.IsTaggedWithAny("encryption-at-rest")
+}
+
+func (r *UnencryptedArtifactStorageRule) deploysToProduction(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, outgoingLink := range techAsset.CommunicationLinksSorted() {
+		if targetAsset, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]; ok && isProductionAsset(parsedModel, targetAsset) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UnencryptedArtifactStorageRule) createRisk(techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if techAsset.IsTaggedWithAny("contains-credentials", "contains-keys") {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Unencrypted Artifact Storage</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *UnencryptedArtifactStorageRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has %q)", techAsset.Technologies.String(), types.ArtifactRegistry),
+			fmt.Sprintf("  - encryption: %v (=%v)", techAsset.Encryption, types.NoneEncryption),
+			fmt.Sprintf("  - tags: %v (has not %q)", techAsset.Tags, "encryption-at-rest"))
+		for _, outgoingLink := range techAsset.CommunicationLinksSorted() {
+			if targetAsset, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]; ok && isProductionAsset(parsedModel, targetAsset) {
+				explanation = append(explanation, fmt.Sprintf("  - deploys to production technical asset %q via communication link %q", targetAsset.Id, outgoingLink.Id))
+			}
+		}
+		explanation = append(explanation, explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the technical asset is tagged with %q or %q", "contains-credentials", "contains-keys")))
+	}
+
+	return explanation
+}
@@ -0,0 +1,107 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnencryptedArtifactStorageRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnencryptedArtifactStorageRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnencryptedArtifactStorageRuleTest struct {
+	technology         string
+	tags               []string
+	encryption         types.EncryptionStyle
+	targetInProduction bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestUnencryptedArtifactStorageRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnencryptedArtifactStorageRuleTest{
+		"not an artifact registry": {
+			technology:         types.FileServer,
+			targetInProduction: true,
+			riskCreated:        false,
+		},
+		"encrypted": {
+			technology:         types.ArtifactRegistry,
+			encryption:         types.Transparent,
+			targetInProduction: true,
+			riskCreated:        false,
+		},
+		"tagged encryption at rest": {
+			technology:         types.ArtifactRegistry,
+			tags:               []string{"encryption-at-rest"},
+			targetInProduction: true,
+			riskCreated:        false,
+		},
+		"not deploying to production": {
+			technology:  types.ArtifactRegistry,
+			riskCreated: false,
+		},
+		"unencrypted registry deploying to production": {
+			technology:         types.ArtifactRegistry,
+			targetInProduction: true,
+			riskCreated:        true,
+			expectedImpact:     types.MediumImpact,
+		},
+		"unencrypted registry containing keys": {
+			technology:         types.ArtifactRegistry,
+			tags:               []string{"contains-keys"},
+			targetInProduction: true,
+			riskCreated:        true,
+			expectedImpact:     types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnencryptedArtifactStorageRule()
+			targetTags := []string{}
+			if testCase.targetInProduction {
+				targetTags = []string{"production"}
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"registry": {
+						Id:         "registry",
+						Title:      "Registry",
+						Tags:       testCase.tags,
+						Encryption: testCase.encryption,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "registry>cluster", SourceId: "registry", TargetId: "cluster"},
+						},
+					},
+					"cluster": {Id: "cluster", Tags: targetTags},
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Unencrypted Artifact Storage</b> risk at <b>Registry</b>", risks[0].Title)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -35,6 +36,7 @@ func (*UnencryptedAssetRule) Category() *types.RiskCategory {
 		FalsePositives:             "When all sensitive data stored within the asset is already fully encrypted on document or data level.",
 		ModelFailurePossibleReason: false,
 		CWE:                        311,
+		Labels:                     []string{"data", "compliance:pci"},
 	}
 }
 
@@ -42,6 +44,10 @@ func (*UnencryptedAssetRule) SupportedTags() []string {
 	return []string{}
 }
 
+func (*UnencryptedAssetRule) IsAssetScoped() bool {
+	return true
+}
+
 // check for technical assets that should be encrypted due to their confidentiality
 
 func (r *UnencryptedAssetRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
@@ -103,3 +109,30 @@ func (r *UnencryptedAssetRule) createRisk(technicalAsset *types.TechnicalAsset,
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *UnencryptedAssetRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		highestStoredConfidentiality := parsedModel.HighestStoredConfidentiality(technicalAsset)
+		highestStoredIntegrity := parsedModel.HighestStoredIntegrity(technicalAsset)
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (has neither %q nor %q)", technicalAsset.Technologies.String(), types.IsNoStorageAtRest, types.IsEmbeddedComponent),
+			fmt.Sprintf("  - data assets stored: %v", technicalAsset.DataAssetsStored),
+			fmt.Sprintf("  - highest stored confidentiality: %v (>=%v), integrity: %v (>=%v)", highestStoredConfidentiality, types.Confidential, highestStoredIntegrity, types.Critical))
+		if technicalAsset.Encryption == types.NoneEncryption {
+			explanation = append(explanation,
+				fmt.Sprintf("  - encryption: %v (=%v)", technicalAsset.Encryption, types.NoneEncryption),
+				explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("%v or %v data is stored", types.StrictlyConfidential, types.MissionCritical)))
+		} else {
+			explanation = append(explanation,
+				fmt.Sprintf("  - encryption: %v (not %v)", technicalAsset.Encryption, types.DataWithEndUserIndividualKey),
+				fmt.Sprintf("    - %v or %v data is stored by a technical asset usually storing end user data (%q)", types.StrictlyConfidential, types.MissionCritical, types.IsUsuallyStoringEndUserData),
+				fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"slices"
 
 	"github.com/threagile/threagile/pkg/types"
@@ -33,6 +34,7 @@ func (*UnencryptedCommunicationRule) Category() *types.RiskCategory {
 			"Also intra-container/pod communication can be considered false positive when container orchestration platform handles encryption.",
 		ModelFailurePossibleReason: false,
 		CWE:                        319,
+		Labels:                     []string{"network", "compliance:pci"},
 	}
 }
 
@@ -40,6 +42,10 @@ func (*UnencryptedCommunicationRule) SupportedTags() []string {
 	return []string{}
 }
 
+func (*UnencryptedCommunicationRule) IsAssetScoped() bool {
+	return true
+}
+
 // check for communication links that should be encrypted due to their confidentiality and/or integrity
 
 func (r *UnencryptedCommunicationRule) GenerateRisks(input *types.Model) ([]*types.Risk, error) {
@@ -118,3 +124,29 @@ func isHighSensitivity(dataAsset *types.DataAsset) bool {
 func isMediumSensitivity(dataAsset *types.DataAsset) bool {
 	return dataAsset.Confidentiality == types.Confidential || dataAsset.Integrity == types.Critical
 }
+
+func (r *UnencryptedCommunicationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		dataFlow := explainedCommunicationLink(parsedModel, generatedRisk)
+		if dataFlow == nil {
+			continue
+		}
+
+		sourceAsset := parsedModel.TechnicalAssets[dataFlow.SourceId]
+		targetAsset := parsedModel.TechnicalAssets[dataFlow.TargetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", dataFlow.Id),
+			fmt.Sprintf("  - protocol: %v (neither encrypted nor process local)", dataFlow.Protocol),
+			fmt.Sprintf("  - source: technical asset %q (out of scope: %v), target: technical asset %q (out of scope: %v) (not both out of scope)",
+				sourceAsset.Id, sourceAsset.OutOfScope, targetAsset.Id, targetAsset.OutOfScope),
+			fmt.Sprintf("  - neither source nor target has %q", types.IsUnprotectedCommunicationsTolerated),
+			fmt.Sprintf("  - authentication: %v, VPN: %v", dataFlow.Authentication, dataFlow.VPN),
+			fmt.Sprintf("  - highest confidentiality: %v, integrity: %v of the data assets transferred",
+				parsedModel.HighestCommunicationLinkConfidentiality(dataFlow), parsedModel.HighestCommunicationLinkIntegrity(dataFlow)),
+			explainedLikelihood(generatedRisk, types.Unlikely, "the communication link crosses a network trust boundary"),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("authentication data or %v or %v data is transferred", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
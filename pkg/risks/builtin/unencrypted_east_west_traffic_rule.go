@@ -0,0 +1,173 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnencryptedEastWestTrafficRule struct{}
+
+func NewUnencryptedEastWestTrafficRule() *UnencryptedEastWestTrafficRule {
+	return &UnencryptedEastWestTrafficRule{}
+}
+
+func (*UnencryptedEastWestTrafficRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unencrypted-east-west-traffic",
+		Title: "Unencrypted East-West Traffic",
+		Description: "Unencrypted communication between services inside the same network trust boundary (east-west traffic) relies solely on the network perimeter. " +
+			"Following zero-trust principles, confidential data should also be encrypted within the trust boundary.",
+		Impact: "If this risk is unmitigated, attackers who gained a foothold inside the network trust boundary might be able to " +
+			"eavesdrop on or tamper with confidential data sent between the services.",
+		ASVS:       "V9 - Communication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Microservices_Security_Cheat_Sheet.html",
+		Action:     "Encryption of East-West Traffic",
+		Mitigation: "Apply mutual TLS between the services, for example transparently via a service mesh (like Istio or Linkerd).",
+		Check:      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:   types.Operations,
+		STRIDE:     types.InformationDisclosure,
+		DetectionLogic: "Unencrypted communication links (excluding process-local ones) between in-scope processes or data stores located in the same network trust boundary " +
+			"transferring confidential or strictly-confidential data assets, where neither the source nor the target is tagged with 'istio-mtls' or 'linkerd'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data assets transferred.",
+		FalsePositives: "Communication links encrypted transparently by means not modeled as tags (like encrypted overlay networks) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        319,
+		Labels:                     []string{"network"},
+	}
+}
+
+func (*UnencryptedEastWestTrafficRule) SupportedTags() []string {
+	return []string{"istio-mtls", "linkerd"}
+}
+
+func (r *UnencryptedEastWestTrafficRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || r.skipAsset(targetAsset) || !r.isUnencryptedEastWest(parsedModel, commLink) {
+				continue
+			}
+
+			dataAssets := r.confidentialDataAssets(parsedModel, commLink)
+			if len(dataAssets) == 0 {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink, dataAssets))
+		}
+	}
+	return risks, nil
+}
+
+func (r *UnencryptedEastWestTrafficRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || techAsset.Type == types.ExternalEntity || techAsset.IsTaggedWithAny(r.SupportedTags()...)
+}
+
+func (r *UnencryptedEastWestTrafficRule) isUnencryptedEastWest(parsedModel *types.Model, commLink *types.CommunicationLink) bool {
+	if commLink.Protocol.IsEncrypted() || commLink.Protocol.IsProcessLocal() {
+		return false
+	}
+	sourceTrustBoundaryId := networkTrustBoundaryId(parsedModel, commLink.SourceId)
+	return len(sourceTrustBoundaryId) > 0 && sourceTrustBoundaryId == networkTrustBoundaryId(parsedModel, commLink.TargetId)
+}
+
+// confidentialDataAssets returns the sorted confidential or strictly-confidential data assets sent or received via the communication link
+func (r *UnencryptedEastWestTrafficRule) confidentialDataAssets(parsedModel *types.Model, commLink *types.CommunicationLink) []string {
+	result := make([]string, 0)
+	for _, dataAssetId := range append(append([]string{}, commLink.DataAssetsSent...), commLink.DataAssetsReceived...) {
+		dataAsset, ok := parsedModel.DataAssets[dataAssetId]
+		if ok && dataAsset.Confidentiality >= types.Confidential && !contains(result, dataAsset.Id) {
+			result = append(result, dataAsset.Id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *UnencryptedEastWestTrafficRule) impact(parsedModel *types.Model, dataAssets []string) types.RiskExploitationImpact {
+	for _, id := range dataAssets {
+		if parsedModel.DataAssets[id].Confidentiality == types.StrictlyConfidential {
+			return types.HighImpact
+		}
+	}
+	return types.MediumImpact
+}
+
+func (r *UnencryptedEastWestTrafficRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink, dataAssets []string) *types.Risk {
+	impact := r.impact(parsedModel, dataAssets)
+	title := "<b>Unencrypted East-West Traffic</b> between <b>" + techAsset.Title + "</b> and <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:          types.Unlikely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *UnencryptedEastWestTrafficRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || r.skipAsset(targetAsset) || !r.isUnencryptedEastWest(parsedModel, commLink) {
+				continue
+			}
+
+			dataAssets := r.confidentialDataAssets(parsedModel, commLink)
+			if len(dataAssets) == 0 {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - protocol: %v (is neither encrypted nor process-local)", commLink.Protocol),
+				fmt.Sprintf("  - transfers data assets %q (>=%v)", dataAssets, types.Confidential),
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - tags: %v (has neither [%q, %q])", techAsset.Tags, "istio-mtls", "linkerd"),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - tags: %v (has neither [%q, %q])", targetAsset.Tags, "istio-mtls", "linkerd"),
+				fmt.Sprintf("  - both located in network trust boundary %q", networkTrustBoundaryId(parsedModel, techAsset.Id)),
+			}...)
+
+			if impact := r.impact(parsedModel, dataAssets); impact == types.HighImpact {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v data is transferred", impact, types.StrictlyConfidential))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+			}
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,132 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnencryptedEastWestTrafficRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnencryptedEastWestTrafficRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnencryptedEastWestTrafficRuleTest struct {
+	protocol          types.Protocol
+	confidentiality   types.Confidentiality
+	targetTags        []string
+	sameTrustBoundary bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestUnencryptedEastWestTrafficRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnencryptedEastWestTrafficRuleTest{
+		"encrypted": {
+			protocol:          types.HTTPS,
+			confidentiality:   types.StrictlyConfidential,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"across trust boundary": {
+			protocol:        types.HTTP,
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     false,
+		},
+		"no confidential data": {
+			protocol:          types.HTTP,
+			confidentiality:   types.Internal,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"service mesh": {
+			protocol:          types.HTTP,
+			confidentiality:   types.StrictlyConfidential,
+			targetTags:        []string{"istio-mtls"},
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"confidential data": {
+			protocol:            types.HTTP,
+			confidentiality:     types.Confidential,
+			sameTrustBoundary:   true,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - impact is medium (default)",
+		},
+		"strictly confidential data": {
+			protocol:            types.JDBC,
+			confidentiality:     types.StrictlyConfidential,
+			sameTrustBoundary:   true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - impact is high because strictly-confidential data is transferred",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnencryptedEastWestTrafficRule()
+			commLink := &types.CommunicationLink{
+				Id:             "service>backend",
+				Title:          "Call",
+				SourceId:       "service",
+				TargetId:       "backend",
+				Protocol:       testCase.protocol,
+				DataAssetsSent: []string{"data"},
+			}
+			serviceBoundary := &types.TrustBoundary{Id: "frontend-network", Type: types.NetworkCloudSecurityGroup, TechnicalAssetsInside: []string{"service"}}
+			backendBoundary := &types.TrustBoundary{Id: "backend-network", Type: types.NetworkCloudSecurityGroup, TechnicalAssetsInside: []string{"backend"}}
+			if testCase.sameTrustBoundary {
+				serviceBoundary = backendBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"service": {
+						Id:                 "service",
+						Title:              "Service",
+						Type:               types.Process,
+						CommunicationLinks: []*types.CommunicationLink{commLink},
+					},
+					"backend": {
+						Id:    "backend",
+						Title: "Backend",
+						Type:  types.Process,
+						Tags:  testCase.targetTags,
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					serviceBoundary.Id: serviceBoundary,
+					backendBoundary.Id: backendBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"service": serviceBoundary,
+					"backend": backendBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Unencrypted East-West Traffic</b> between <b>Service</b> and <b>Backend</b> via <b>Call</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnencryptedOrExposedBackupRule struct{}
+
+func NewUnencryptedOrExposedBackupRule() *UnencryptedOrExposedBackupRule {
+	return &UnencryptedOrExposedBackupRule{}
+}
+
+func (*UnencryptedOrExposedBackupRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unencrypted-or-exposed-backup",
+		Title: "Unencrypted or Exposed Backup",
+		Description: "Backups hold a complete copy of the sensitive data, but are frequently protected less rigorously than the production systems. " +
+			"Backup storage holding confidential data should therefore be encrypted and not be reachable from outside of its trust boundary.",
+		Impact: "If this risk is unmitigated, attackers gaining access to the backup storage (or its media) might be able to read " +
+			"all the sensitive data at once, bypassing the protection of the production systems.",
+		ASVS:       "V8 - Data Protection Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Cryptographic_Storage_Cheat_Sheet.html",
+		Action:     "Backup Protection",
+		Mitigation: "Encrypt the backups with keys managed separately from the backed-up systems and restrict the access to the backup storage " +
+			"to the backup infrastructure within its own trust boundary.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets of technology '" + types.BackupStorage + "' (or tagged with 'backup') storing data rated at least " +
+			types.Confidential.String() + ", which are either not encrypted or internet-facing or have incoming communication links across a network trust boundary.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data stored. " +
+			"The likelihood is higher when the backup storage is reachable from outside of its trust boundary.",
+		FalsePositives: "Backups encrypted by the backup software itself (without this being modeled) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        530,
+		Labels:                     []string{"data", "operations"},
+	}
+}
+
+func (*UnencryptedOrExposedBackupRule) SupportedTags() []string {
+	return []string{"backup"}
+}
+
+func (r *UnencryptedOrExposedBackupRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		unencrypted := r.isUnencrypted(techAsset)
+		exposed := r.isExposed(parsedModel, techAsset)
+		if !unencrypted && !exposed {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, exposed))
+	}
+	return risks, nil
+}
+
+func (r *UnencryptedOrExposedBackupRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !isBackupAsset(techAsset) || parsedModel.HighestStoredConfidentiality(techAsset) < types.Confidential
+}
+
+func (r *UnencryptedOrExposedBackupRule) isUnencrypted(techAsset *types.TechnicalAsset) bool {
+	return techAsset.Encryption == types.NoneEncryption
+}
+
+func (r *UnencryptedOrExposedBackupRule) isExposed(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.Internet {
+		return true
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if isAcrossTrustBoundaryNetworkOnly(parsedModel, incomingLink) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UnencryptedOrExposedBackupRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestStoredConfidentiality(techAsset) == types.StrictlyConfidential {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *UnencryptedOrExposedBackupRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, exposed bool) *types.Risk {
+	likelihood := types.Unlikely
+	if exposed {
+		likelihood = types.Likely
+	}
+	impact := r.impact(parsedModel, techAsset)
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Unencrypted or Exposed Backup</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *UnencryptedOrExposedBackupRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(parsedModel, techAsset) {
+			continue
+		}
+
+		unencrypted := r.isUnencrypted(techAsset)
+		exposed := r.isExposed(parsedModel, techAsset)
+		if !unencrypted && !exposed {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v, tags: %v (has %q or tag %q)", techAsset.Technologies.String(), techAsset.Tags, types.BackupStorage, "backup"),
+			fmt.Sprintf("  - highest stored confidentiality: %v (>=%v)", parsedModel.HighestStoredConfidentiality(techAsset), types.Confidential),
+			fmt.Sprintf("  - encryption: %v", techAsset.Encryption),
+		}...)
+
+		if exposed {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the backup storage is reachable from outside of its trust boundary", types.Likely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the backup storage is not encrypted", types.Unlikely))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v because of highest confidentiality %v", r.impact(parsedModel, techAsset), parsedModel.HighestStoredConfidentiality(techAsset)))
+	}
+
+	return explanation
+}
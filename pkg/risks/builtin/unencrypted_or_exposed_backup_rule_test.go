@@ -0,0 +1,125 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnencryptedOrExposedBackupRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnencryptedOrExposedBackupRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnencryptedOrExposedBackupRuleTest struct {
+	technology        string
+	tags              []string
+	encryption        types.EncryptionStyle
+	sameTrustBoundary bool
+	confidentiality   types.Confidentiality
+
+	riskCreated         bool
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestUnencryptedOrExposedBackupRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnencryptedOrExposedBackupRuleTest{
+		"not a backup": {
+			technology:      types.Database,
+			confidentiality: types.StrictlyConfidential,
+			riskCreated:     false,
+		},
+		"backup of non-confidential data": {
+			tags:            []string{"backup"},
+			confidentiality: types.Restricted,
+			riskCreated:     false,
+		},
+		"encrypted backup within trust boundary": {
+			technology:        types.BackupStorage,
+			encryption:        types.Transparent,
+			sameTrustBoundary: true,
+			confidentiality:   types.StrictlyConfidential,
+			riskCreated:       false,
+		},
+		"unencrypted backup within trust boundary": {
+			technology:          types.BackupStorage,
+			sameTrustBoundary:   true,
+			confidentiality:     types.Confidential,
+			riskCreated:         true,
+			expectedLikelihood:  types.Unlikely,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - likelihood is unlikely because the backup storage is not encrypted",
+		},
+		"encrypted backup reachable across trust boundary": {
+			tags:                []string{"backup"},
+			encryption:          types.DataWithSymmetricSharedKey,
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - likelihood is likely because the backup storage is reachable from outside of its trust boundary",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnencryptedOrExposedBackupRule()
+			incomingLink := &types.CommunicationLink{Id: "app>backup", SourceId: "app", TargetId: "backup"}
+			appBoundary := &types.TrustBoundary{Id: "app-network", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"app"}}
+			backupBoundary := &types.TrustBoundary{Id: "backup-network", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"backup"}}
+			if testCase.sameTrustBoundary {
+				appBoundary = backupBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:                 "app",
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"backup": {
+						Id:               "backup",
+						Title:            "Backup",
+						Tags:             testCase.tags,
+						Encryption:       testCase.encryption,
+						Technologies:     types.TechnologyList{{Name: testCase.technology, Attributes: map[string]bool{testCase.technology: true}}},
+						DataAssetsStored: []string{"data"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.confidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					appBoundary.Id:    appBoundary,
+					backupBoundary.Id: backupBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"app":    appBoundary,
+					"backup": backupBoundary,
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"backup": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Unencrypted or Exposed Backup</b> risk at <b>Backup</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,18 +1,38 @@
 package builtin
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 
 	"github.com/threagile/threagile/pkg/types"
 )
 
-type UnguardedAccessFromInternetRule struct{}
+type UnguardedAccessFromInternetRule struct {
+	raaLimit      int
+	httpProtocols []types.Protocol
+	ftpProtocols  []types.Protocol
+}
 
 func NewUnguardedAccessFromInternetRule() *UnguardedAccessFromInternetRule {
-	return &UnguardedAccessFromInternetRule{}
+	return &UnguardedAccessFromInternetRule{
+		raaLimit:      40,
+		httpProtocols: []types.Protocol{types.HTTP, types.HTTPS},
+		ftpProtocols:  []types.Protocol{types.FTP, types.FTPS, types.SFTP},
+	}
+}
+
+func (r *UnguardedAccessFromInternetRule) Configure(parameters *types.RiskRuleParameters) error {
+	return errors.Join(
+		parameters.Int("raa-limit", &r.raaLimit),
+		parameters.Protocols("http-protocols", &r.httpProtocols),
+		parameters.Protocols("ftp-protocols", &r.ftpProtocols),
+	)
 }
 
-func (*UnguardedAccessFromInternetRule) Category() *types.RiskCategory {
+func (r *UnguardedAccessFromInternetRule) Category() *types.RiskCategory {
 	return &types.RiskCategory{
 		ID:    "unguarded-access-from-internet",
 		Title: "Unguarded Access From Internet",
@@ -39,10 +59,11 @@ func (*UnguardedAccessFromInternetRule) Category() *types.RiskCategory {
 		RiskAssessment: "The matching technical assets are at " + types.LowSeverity.String() + " risk. When either the " +
 			"confidentiality rating is " + types.StrictlyConfidential.String() + " or the integrity rating " +
 			"is " + types.MissionCritical.String() + ", the risk-rating is considered " + types.MediumSeverity.String() + ". " +
-			"For assets with RAA values higher than 40 % the risk-rating increases.",
+			"For assets with RAA values higher than " + strconv.Itoa(r.raaLimit) + " % the risk-rating increases.",
 		FalsePositives:             "When other means of filtering client requests are applied equivalent of " + types.ReverseProxy + ", " + types.WAF + ", or " + types.Gateway + " components.",
 		ModelFailurePossibleReason: false,
 		CWE:                        501,
+		Labels:                     []string{"network"},
 	}
 }
 
@@ -65,8 +86,8 @@ func (r *UnguardedAccessFromInternetRule) GenerateRisks(input *types.Model) ([]*
 				continue
 			}
 			if !technicalAsset.CustomDevelopedParts &&
-				((technicalAsset.Technologies.GetAttribute(types.IsHTTPInternetAccessOK) && (incomingAccess.Protocol == types.HTTP || incomingAccess.Protocol == types.HTTPS)) ||
-					(technicalAsset.Technologies.GetAttribute(types.IsFTPInternetAccessOK) && (incomingAccess.Protocol == types.FTP || incomingAccess.Protocol == types.FTPS || incomingAccess.Protocol == types.SFTP))) {
+				((technicalAsset.Technologies.GetAttribute(types.IsHTTPInternetAccessOK) && slices.Contains(r.httpProtocols, incomingAccess.Protocol)) ||
+					(technicalAsset.Technologies.GetAttribute(types.IsFTPInternetAccessOK) && slices.Contains(r.ftpProtocols, incomingAccess.Protocol))) {
 				continue
 			}
 			if input.TechnicalAssets[incomingAccess.SourceId].Technologies.GetAttribute(types.Monitoring) ||
@@ -90,7 +111,7 @@ func (r *UnguardedAccessFromInternetRule) GenerateRisks(input *types.Model) ([]*
 func (r *UnguardedAccessFromInternetRule) createRisk(dataStore *types.TechnicalAsset, dataFlow *types.CommunicationLink,
 	clientFromInternet *types.TechnicalAsset, moreRisky bool) *types.Risk {
 	impact := types.LowImpact
-	if moreRisky || dataStore.RAA > 40 {
+	if moreRisky || dataStore.RAA > float64(r.raaLimit) {
 		impact = types.MediumImpact
 	}
 	risk := &types.Risk{
@@ -108,3 +129,29 @@ func (r *UnguardedAccessFromInternetRule) createRisk(dataStore *types.TechnicalA
 	risk.SyntheticId = risk.CategoryId + "@" + dataStore.Id + "@" + clientFromInternet.Id + "@" + dataFlow.Id
 	return risk
 }
+
+func (r *UnguardedAccessFromInternetRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingAccess := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingAccess == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		sourceAsset := parsedModel.TechnicalAssets[incomingAccess.SourceId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - technology: %v (not %q)", technicalAsset.Technologies.String(), types.LoadBalancer),
+			fmt.Sprintf("  - confidentiality: %v, integrity: %v (at least %v or %v)", technicalAsset.Confidentiality, technicalAsset.Integrity, types.Confidential, types.Critical),
+			fmt.Sprintf("  - custom developed parts: %v, protocol: %v (not exempted as HTTP or FTP access of %q or %q without custom developed parts)",
+				technicalAsset.CustomDevelopedParts, incomingAccess.Protocol, types.IsHTTPInternetAccessOK, types.IsFTPInternetAccessOK),
+			fmt.Sprintf("  - communication link %q from technical asset %q", incomingAccess.Id, sourceAsset.Id),
+			fmt.Sprintf("    - source internet: %v (=true), technology: %v (not %q)", sourceAsset.Internet, sourceAsset.Technologies.String(), types.Monitoring),
+			fmt.Sprintf("    - VPN: %v (=false)", incomingAccess.VPN),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the rating is %v or %v, or the RAA of %.2f%% is above %v%%", types.StrictlyConfidential, types.MissionCritical, technicalAsset.RAA, r.raaLimit)))
+	}
+
+	return explanation
+}
@@ -246,3 +246,59 @@ func TestUnguardedAccessFromInternetRuleGenerateRisks(t *testing.T) {
 		})
 	}
 }
+
+func TestUnguardedAccessFromInternetRuleConfiguredParameters(t *testing.T) {
+	rule := NewUnguardedAccessFromInternetRule()
+	err := rule.Configure(types.NewRiskRuleParameters(map[string]any{
+		"raa-limit":      60,
+		"http-protocols": []any{"https"},
+	}))
+	assert.Nil(t, err)
+
+	commLink := &types.CommunicationLink{
+		Title:    "Test Communication Link",
+		SourceId: "source",
+		TargetId: "target",
+		Protocol: types.HTTP,
+	}
+	risks, err := rule.GenerateRisks(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"source": {
+				Id:       "source",
+				Title:    "Source Technical Asset",
+				Internet: true,
+			},
+			"target": {
+				Id:                 "target",
+				Title:              "Target Technical Asset",
+				RAA:                50,
+				CommunicationLinks: []*types.CommunicationLink{commLink},
+				Technologies: types.TechnologyList{
+					{
+						Attributes: map[string]bool{
+							types.IsHTTPInternetAccessOK: true,
+						},
+					},
+				},
+				Confidentiality: types.Confidential,
+				Integrity:       types.Critical,
+			},
+		},
+		IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+			"target": {commLink},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.LowImpact, risks[0].ExploitationImpact)
+	assert.Contains(t, rule.Category().RiskAssessment, "higher than 60 %")
+}
+
+func TestUnguardedAccessFromInternetRuleConfigureInvalidProtocol(t *testing.T) {
+	rule := NewUnguardedAccessFromInternetRule()
+
+	err := rule.Configure(types.NewRiskRuleParameters(map[string]any{"ftp-protocols": []any{"carrier-pigeon"}}))
+
+	assert.NotNil(t, err)
+}
@@ -1,16 +1,34 @@
 package builtin
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
 	"github.com/threagile/threagile/pkg/types"
 )
 
-type UnguardedDirectDatastoreAccessRule struct{}
+type UnguardedDirectDatastoreAccessRule struct {
+	raaLimit     int
+	ftpProtocols []types.Protocol
+}
 
 func NewUnguardedDirectDatastoreAccessRule() *UnguardedDirectDatastoreAccessRule {
-	return &UnguardedDirectDatastoreAccessRule{}
+	return &UnguardedDirectDatastoreAccessRule{
+		raaLimit:     40,
+		ftpProtocols: []types.Protocol{types.FTP, types.FTPS, types.SFTP},
+	}
 }
 
-func (*UnguardedDirectDatastoreAccessRule) Category() *types.RiskCategory {
+func (r *UnguardedDirectDatastoreAccessRule) Configure(parameters *types.RiskRuleParameters) error {
+	return errors.Join(
+		parameters.Int("raa-limit", &r.raaLimit),
+		parameters.Protocols("ftp-protocols", &r.ftpProtocols),
+	)
+}
+
+func (r *UnguardedDirectDatastoreAccessRule) Category() *types.RiskCategory {
 	return &types.RiskCategory{
 		ID:          "unguarded-direct-datastore-access",
 		Title:       "Unguarded Direct Datastore Access",
@@ -29,10 +47,11 @@ func (*UnguardedDirectDatastoreAccessRule) Category() *types.RiskCategory {
 		RiskAssessment: "The matching technical assets are at " + types.LowSeverity.String() + " risk. When either the " +
 			"confidentiality rating is " + types.StrictlyConfidential.String() + " or the integrity rating " +
 			"is " + types.MissionCritical.String() + ", the risk-rating is considered " + types.MediumSeverity.String() + ". " +
-			"For assets with RAA values higher than 40 % the risk-rating increases.",
+			"For assets with RAA values higher than " + strconv.Itoa(r.raaLimit) + " % the risk-rating increases.",
 		FalsePositives:             "When the caller is considered fully trusted as if it was part of the datastore itself.",
 		ModelFailurePossibleReason: false,
 		CWE:                        501,
+		Labels:                     []string{"network", "data"},
 	}
 }
 
@@ -60,7 +79,7 @@ func (r *UnguardedDirectDatastoreAccessRule) GenerateRisks(input *types.Model) (
 			if incomingAccess.Usage == types.DevOps {
 				continue
 			}
-			if !isAcrossTrustBoundaryNetworkOnly(input, incomingAccess) || fileServerAccessViaFTP(technicalAsset, incomingAccess) ||
+			if !isAcrossTrustBoundaryNetworkOnly(input, incomingAccess) || r.fileServerAccessViaFTP(technicalAsset, incomingAccess) ||
 				isSharingSameParentTrustBoundary(input, technicalAsset, sourceAsset) {
 				continue
 			}
@@ -75,7 +94,7 @@ func (r *UnguardedDirectDatastoreAccessRule) GenerateRisks(input *types.Model) (
 }
 
 func isSharingSameParentTrustBoundary(input *types.Model, left, right *types.TechnicalAsset) bool {
-	tbIDLeft, tbIDRight := input.GetTechnicalAssetTrustBoundaryId(left), input.GetTechnicalAssetTrustBoundaryId(right)
+	tbIDLeft, tbIDRight := input.AnalysisContext().TrustBoundaryId(left.Id), input.AnalysisContext().TrustBoundaryId(right.Id)
 	if len(tbIDLeft) == 0 && len(tbIDRight) > 0 {
 		return false
 	}
@@ -89,7 +108,7 @@ func isSharingSameParentTrustBoundary(input *types.Model, left, right *types.Tec
 		return true
 	}
 	tbLeft, tbRight := input.TrustBoundaries[tbIDLeft], input.TrustBoundaries[tbIDRight]
-	tbParentsLeft, tbParentsRight := input.AllParentTrustBoundaryIDs(tbLeft), input.AllParentTrustBoundaryIDs(tbRight)
+	tbParentsLeft, tbParentsRight := input.AnalysisContext().AllParentTrustBoundaryIDs(tbLeft), input.AnalysisContext().AllParentTrustBoundaryIDs(tbRight)
 	for _, parentLeft := range tbParentsLeft {
 		for _, parentRight := range tbParentsRight {
 			if parentLeft == parentRight {
@@ -100,14 +119,13 @@ func isSharingSameParentTrustBoundary(input *types.Model, left, right *types.Tec
 	return false
 }
 
-func fileServerAccessViaFTP(technicalAsset *types.TechnicalAsset, incomingAccess *types.CommunicationLink) bool {
-	return technicalAsset.Technologies.GetAttribute(types.FileServer) &&
-		(incomingAccess.Protocol == types.FTP || incomingAccess.Protocol == types.FTPS || incomingAccess.Protocol == types.SFTP)
+func (r *UnguardedDirectDatastoreAccessRule) fileServerAccessViaFTP(technicalAsset *types.TechnicalAsset, incomingAccess *types.CommunicationLink) bool {
+	return technicalAsset.Technologies.GetAttribute(types.FileServer) && slices.Contains(r.ftpProtocols, incomingAccess.Protocol)
 }
 
 func (r *UnguardedDirectDatastoreAccessRule) createRisk(dataStore *types.TechnicalAsset, dataFlow *types.CommunicationLink, clientOutsideTrustBoundary *types.TechnicalAsset, moreRisky bool) *types.Risk {
 	impact := types.LowImpact
-	if moreRisky || dataStore.RAA > 40 {
+	if moreRisky || dataStore.RAA > float64(r.raaLimit) {
 		impact = types.MediumImpact
 	}
 	risk := &types.Risk{
@@ -125,3 +143,28 @@ func (r *UnguardedDirectDatastoreAccessRule) createRisk(dataStore *types.Technic
 	risk.SyntheticId = risk.CategoryId + "@" + dataFlow.Id + "@" + clientOutsideTrustBoundary.Id + "@" + dataStore.Id
 	return risk
 }
+
+func (r *UnguardedDirectDatastoreAccessRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingAccess := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingAccess == nil {
+			continue
+		}
+
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		sourceAsset := parsedModel.TechnicalAssets[incomingAccess.SourceId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - type: %v (=%v)", technicalAsset.Type, types.Datastore),
+			fmt.Sprintf("  - confidentiality: %v, integrity: %v (at least %v or %v)", technicalAsset.Confidentiality, technicalAsset.Integrity, types.Confidential, types.Critical),
+			fmt.Sprintf("  - communication link %q from technical asset %q", incomingAccess.Id, sourceAsset.Id),
+			fmt.Sprintf("    - usage: %v (not %v), protocol: %v", incomingAccess.Usage, types.DevOps, incomingAccess.Protocol),
+			fmt.Sprintf("    - source technology: %v (no %q accessing an identity store)", sourceAsset.Technologies.String(), types.IdentityProvider),
+			"    - crosses a network trust boundary without sharing a parent trust boundary",
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the rating is %v or %v, or the RAA of %.2f%% is above %v%%", types.StrictlyConfidential, types.MissionCritical, technicalAsset.RAA, r.raaLimit)))
+	}
+
+	return explanation
+}
@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"fmt"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnmonitoredPrivilegedDbAccessRule struct{}
+
+func NewUnmonitoredPrivilegedDbAccessRule() *UnmonitoredPrivilegedDbAccessRule {
+	return &UnmonitoredPrivilegedDbAccessRule{}
+}
+
+func (*UnmonitoredPrivilegedDbAccessRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unmonitored-privileged-db-access",
+		Title: "Unmonitored Privileged Database Access",
+		Description: "Database administrators or admin tools with broad privileges on production databases not controlled by a " +
+			"privileged access management (PAM) solution pose both an insider and an external threat.",
+		Impact: "If this risk is unmitigated, malicious insiders or attackers who compromised an admin tool might read or modify " +
+			"sensitive data without leaving any traceable audit records.",
+		ASVS:       "V7 - Error Handling and Logging Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Database_Security_Cheat_Sheet.html",
+		Action:     "Privileged Access Management",
+		Mitigation: "Route privileged database access through a privileged access management (PAM) solution with session recording " +
+			"and just-in-time access approval.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.InformationDisclosure,
+		DetectionLogic: "In-scope databases processing data rated at least as " + types.Confidential.String() + " having incoming communication links from technical assets tagged with 'dba-tool' or 'database-admin', where neither the link nor the source is tagged with 'pam-controlled' or 'session-recording'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data processed by the database.",
+		FalsePositives: "Privileged database access monitored by means not reflected in the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        778,
+		Labels:                     []string{"data", "operations"},
+	}
+}
+
+func (*UnmonitoredPrivilegedDbAccessRule) SupportedTags() []string {
+	return []string{"dba-tool", "database-admin", "pam-controlled", "session-recording"}
+}
+
+func (r *UnmonitoredPrivilegedDbAccessRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		database := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(parsedModel, database) {
+			continue
+		}
+
+		for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[database.Id] {
+			adminAsset, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]
+			if !ok || !adminAsset.IsTaggedWithAny("dba-tool", "database-admin") {
+				continue
+			}
+			if adminAsset.IsTaggedWithAny("pam-controlled", "session-recording") ||
+				incomingLink.IsTaggedWithAny("pam-controlled", "session-recording") {
+				continue
+			}
+			risks = append(risks, r.createRisk(parsedModel, database, adminAsset, incomingLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *UnmonitoredPrivilegedDbAccessRule) skipAsset(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.Technologies.GetAttribute(types.Database) ||
+		parsedModel.HighestProcessedConfidentiality(techAsset) < types.Confidential
+}
+
+func (r *UnmonitoredPrivilegedDbAccessRule) createRisk(parsedModel *types.Model, database *types.TechnicalAsset, adminAsset *types.TechnicalAsset, incomingLink *types.CommunicationLink) *types.Risk {
+	impact := types.MediumImpact
+	if parsedModel.HighestProcessedConfidentiality(database) == types.StrictlyConfidential {
+		impact = types.HighImpact
+	}
+	title := "<b>Unmonitored Privileged Database Access</b> risk at <b>" + database.Title + "</b> from <b>" + adminAsset.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    database.Id,
+		MostRelevantCommunicationLinkId: incomingLink.Id,
+		DataBreachProbability:           types.Probable,
+		DataBreachTechnicalAssetIDs:     []string{database.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + incomingLink.Id + "@" + adminAsset.Id + "@" + database.Id
+	return risk
+}
+
+func (r *UnmonitoredPrivilegedDbAccessRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		incomingLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if incomingLink == nil {
+			continue
+		}
+
+		database := parsedModel.TechnicalAssets[incomingLink.TargetId]
+		adminAsset := parsedModel.TechnicalAssets[incomingLink.SourceId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", incomingLink.Id),
+			fmt.Sprintf("  - tags: %v (has neither %q nor %q)", incomingLink.Tags, "pam-controlled", "session-recording"),
+			fmt.Sprintf("  - source: technical asset %q", adminAsset.Id),
+			fmt.Sprintf("    - tags: %v (has %q or %q, has neither %q nor %q)", adminAsset.Tags, "dba-tool", "database-admin", "pam-controlled", "session-recording"),
+			fmt.Sprintf("  - target: technical asset %q", database.Id),
+			fmt.Sprintf("    - out of scope: %v (=false)", database.OutOfScope),
+			fmt.Sprintf("    - technology: %v (has %q)", database.Technologies.String(), types.Database),
+			fmt.Sprintf("    - highest processed confidentiality: %v (>=%v)", parsedModel.HighestProcessedConfidentiality(database), types.Confidential),
+			explainedImpact(generatedRisk, types.MediumImpact, fmt.Sprintf("the database processes %v data", types.StrictlyConfidential)))
+	}
+
+	return explanation
+}
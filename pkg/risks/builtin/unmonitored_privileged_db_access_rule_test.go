@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnmonitoredPrivilegedDbAccessRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnmonitoredPrivilegedDbAccessRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnmonitoredPrivilegedDbAccessRuleTest struct {
+	technology      string
+	confidentiality types.Confidentiality
+	adminTags       []string
+	linkTags        []string
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestUnmonitoredPrivilegedDbAccessRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnmonitoredPrivilegedDbAccessRuleTest{
+		"not a database": {
+			technology:      types.WebServiceREST,
+			confidentiality: types.Confidential,
+			adminTags:       []string{"dba-tool"},
+			riskCreated:     false,
+		},
+		"not confidential": {
+			technology:      types.Database,
+			confidentiality: types.Restricted,
+			adminTags:       []string{"dba-tool"},
+			riskCreated:     false,
+		},
+		"not an admin tool": {
+			technology:      types.Database,
+			confidentiality: types.Confidential,
+			riskCreated:     false,
+		},
+		"admin tool pam controlled": {
+			technology:      types.Database,
+			confidentiality: types.Confidential,
+			adminTags:       []string{"dba-tool", "pam-controlled"},
+			riskCreated:     false,
+		},
+		"link with session recording": {
+			technology:      types.Database,
+			confidentiality: types.Confidential,
+			adminTags:       []string{"database-admin"},
+			linkTags:        []string{"session-recording"},
+			riskCreated:     false,
+		},
+		"unmonitored confidential database": {
+			technology:      types.Database,
+			confidentiality: types.Confidential,
+			adminTags:       []string{"database-admin"},
+			riskCreated:     true,
+			expectedImpact:  types.MediumImpact,
+		},
+		"unmonitored strictly confidential database": {
+			technology:      types.Database,
+			confidentiality: types.StrictlyConfidential,
+			adminTags:       []string{"dba-tool"},
+			riskCreated:     true,
+			expectedImpact:  types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnmonitoredPrivilegedDbAccessRule()
+			incomingLink := &types.CommunicationLink{
+				Id:       "admin>db",
+				SourceId: "admin",
+				TargetId: "db",
+				Tags:     testCase.linkTags,
+			}
+
+			risks, err := rule.GenerateRisks(&types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"admin": {
+						Id:                 "admin",
+						Title:              "Admin Tool",
+						Tags:               testCase.adminTags,
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"db": {
+						Id:              "db",
+						Title:           "Database",
+						Confidentiality: testCase.confidentiality,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"db": {incomingLink},
+				},
+			})
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Unmonitored Privileged Database Access</b> risk at <b>Database</b> from <b>Admin Tool</b>", risks[0].Title)
+				assert.Equal(t, "unmonitored-privileged-db-access@admin>db@admin@db", risks[0].SyntheticId)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -29,6 +30,7 @@ func (*UnnecessaryCommunicationLinkRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model"},
 	}
 }
 
@@ -60,6 +62,7 @@ func (r *UnnecessaryCommunicationLinkRule) createRisk(technicalAsset *types.Tech
 		Severity:                        types.CalculateSeverity(types.Unlikely, types.LowImpact),
 		ExploitationLikelihood:          types.Unlikely,
 		ExploitationImpact:              types.LowImpact,
+		Confidence:                      types.LowConfidence,
 		Title:                           title,
 		MostRelevantTechnicalAssetId:    technicalAsset.Id,
 		MostRelevantCommunicationLinkId: commLink.Id,
@@ -69,3 +72,23 @@ func (r *UnnecessaryCommunicationLinkRule) createRisk(technicalAsset *types.Tech
 	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *UnnecessaryCommunicationLinkRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("communication link %q", commLink.Id),
+			fmt.Sprintf("  - data assets sent: %v, received: %v (none)", commLink.DataAssetsSent, commLink.DataAssetsReceived),
+			fmt.Sprintf("  - source: technical asset %q (out of scope: %v)", commLink.SourceId, parsedModel.TechnicalAssets[commLink.SourceId].OutOfScope),
+			fmt.Sprintf("  - target: technical asset %q (out of scope: %v)", commLink.TargetId, parsedModel.TechnicalAssets[commLink.TargetId].OutOfScope),
+			"    - not both source and target are out of scope",
+			fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+	}
+
+	return explanation
+}
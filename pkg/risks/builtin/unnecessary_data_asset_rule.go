@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/threagile/threagile/pkg/types"
@@ -33,6 +34,7 @@ func (*UnnecessaryDataAssetRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model"},
 	}
 }
 
@@ -49,11 +51,8 @@ func (r *UnnecessaryDataAssetRule) GenerateRisks(input *types.Model) ([]*types.R
 		unusedDataAssetIDs[k] = true
 	}
 	for _, technicalAsset := range input.TechnicalAssets {
-		for _, processedDataAssetID := range technicalAsset.DataAssetsProcessed {
-			delete(unusedDataAssetIDs, processedDataAssetID)
-		}
-		for _, storedDataAssetID := range technicalAsset.DataAssetsStored {
-			delete(unusedDataAssetIDs, storedDataAssetID)
+		for _, dataAsset := range input.DataAssetsProcessedBy(technicalAsset) {
+			delete(unusedDataAssetIDs, dataAsset.Id)
 		}
 		for _, commLink := range technicalAsset.CommunicationLinks {
 			for _, sentDataAssetID := range commLink.DataAssetsSent {
@@ -83,6 +82,7 @@ func (r *UnnecessaryDataAssetRule) createRisk(input *types.Model, unusedDataAsse
 		Severity:                    types.CalculateSeverity(types.Unlikely, types.LowImpact),
 		ExploitationLikelihood:      types.Unlikely,
 		ExploitationImpact:          types.LowImpact,
+		Confidence:                  types.LowConfidence,
 		Title:                       title,
 		MostRelevantDataAssetId:     unusedDataAsset.Id,
 		DataBreachProbability:       types.Improbable,
@@ -91,3 +91,16 @@ func (r *UnnecessaryDataAssetRule) createRisk(input *types.Model, unusedDataAsse
 	risk.SyntheticId = risk.CategoryId + "@" + unusedDataAsset.Id
 	return risk
 }
+
+func (r *UnnecessaryDataAssetRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("data asset %q", generatedRisk.MostRelevantDataAssetId),
+			"  - neither processed nor stored by any technical asset",
+			"  - neither sent nor received via any communication link",
+			fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+	}
+
+	return explanation
+}
@@ -1,7 +1,9 @@
 package builtin
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/threagile/threagile/pkg/types"
 )
@@ -38,6 +40,7 @@ func (*UnnecessaryDataTransferRule) Category() *types.RiskCategory {
 			"completing the model so that all necessary data assets are processed by the technical asset involved.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model", "data"},
 	}
 }
 
@@ -76,7 +79,7 @@ func (r *UnnecessaryDataTransferRule) GenerateRisks(input *types.Model) ([]*type
 
 func (r *UnnecessaryDataTransferRule) checkRisksAgainstTechnicalAsset(input *types.Model, risks []*types.Risk, technicalAsset *types.TechnicalAsset, dataFlow *types.CommunicationLink, inverseDirection bool) []*types.Risk {
 	for _, transferredDataAssetId := range dataFlow.DataAssetsSent {
-		if !processesOrStoresDataAsset(technicalAsset, transferredDataAssetId) {
+		if !processesOrStoresDataAsset(input, technicalAsset, transferredDataAssetId) {
 			transferredDataAsset := input.DataAssets[transferredDataAssetId]
 			//fmt.Print("--->>> Checking "+technicalAsset.ID+": "+transferredDataAsset.ID+" sent via "+dataFlow.ID+"\n")
 			if transferredDataAsset.Confidentiality >= types.Confidential || transferredDataAsset.Integrity >= types.Critical {
@@ -93,7 +96,7 @@ func (r *UnnecessaryDataTransferRule) checkRisksAgainstTechnicalAsset(input *typ
 		}
 	}
 	for _, transferredDataAssetId := range dataFlow.DataAssetsReceived {
-		if !processesOrStoresDataAsset(technicalAsset, transferredDataAssetId) {
+		if !processesOrStoresDataAsset(input, technicalAsset, transferredDataAssetId) {
 			transferredDataAsset := input.DataAssets[transferredDataAssetId]
 			//fmt.Print("--->>> Checking "+technicalAsset.ID+": "+transferredDataAsset.ID+" received via "+dataFlow.ID+"\n")
 			if transferredDataAsset.Confidentiality >= types.Confidential || transferredDataAsset.Integrity >= types.Critical {
@@ -112,8 +115,13 @@ func (r *UnnecessaryDataTransferRule) checkRisksAgainstTechnicalAsset(input *typ
 	return risks
 }
 
-func processesOrStoresDataAsset(ta *types.TechnicalAsset, dataAssetId string) bool {
-	return contains(ta.DataAssetsProcessed, dataAssetId) || contains(ta.DataAssetsStored, dataAssetId)
+func processesOrStoresDataAsset(input *types.Model, ta *types.TechnicalAsset, dataAssetId string) bool {
+	for _, dataAsset := range input.DataAssetsProcessedBy(ta) {
+		if dataAsset.Id == dataAssetId {
+			return true
+		}
+	}
+	return false
 }
 
 func isNewRisk(risks []*types.Risk, risk *types.Risk) bool {
@@ -140,6 +148,7 @@ func (r *UnnecessaryDataTransferRule) createRisk(technicalAsset *types.Technical
 		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
 		ExploitationLikelihood:       types.Unlikely,
 		ExploitationImpact:           impact,
+		Confidence:                   types.LowConfidence,
 		Title:                        title,
 		MostRelevantTechnicalAssetId: technicalAsset.Id,
 		MostRelevantDataAssetId:      dataAssetTransferred.Id,
@@ -149,3 +158,22 @@ func (r *UnnecessaryDataTransferRule) createRisk(technicalAsset *types.Technical
 	risk.SyntheticId = risk.CategoryId + "@" + dataAssetTransferred.Id + "@" + technicalAsset.Id + "@" + commPartnerAsset.Id
 	return risk
 }
+
+func (r *UnnecessaryDataTransferRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		dataAsset := parsedModel.DataAssets[generatedRisk.MostRelevantDataAssetId]
+		commPartnerId := strings.TrimPrefix(generatedRisk.SyntheticId, generatedRisk.CategoryId+"@"+dataAsset.Id+"@"+technicalAsset.Id+"@")
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - data asset %q is transferred from/to technical asset %q", dataAsset.Id, commPartnerId),
+			fmt.Sprintf("    - neither processed nor stored by technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("    - confidentiality: %v, integrity: %v (at least %v or %v)", dataAsset.Confidentiality, dataAsset.Integrity, types.Confidential, types.Critical),
+			fmt.Sprintf("  - communication partner technology: %v (not %q)", parsedModel.TechnicalAssets[commPartnerId].Technologies.String(), types.IsUnnecessaryDataTolerated),
+			explainedImpact(generatedRisk, types.LowImpact, fmt.Sprintf("the data asset is rated %v or %v", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -30,6 +31,7 @@ func (*UnnecessaryTechnicalAssetRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model"},
 	}
 }
 
@@ -56,6 +58,7 @@ func (r *UnnecessaryTechnicalAssetRule) createRisk(technicalAsset *types.Technic
 		Severity:                     types.CalculateSeverity(types.Unlikely, types.LowImpact),
 		ExploitationLikelihood:       types.Unlikely,
 		ExploitationImpact:           types.LowImpact,
+		Confidence:                   types.LowConfidence,
 		Title:                        title,
 		MostRelevantTechnicalAssetId: technicalAsset.Id,
 		DataBreachProbability:        types.Improbable,
@@ -64,3 +67,20 @@ func (r *UnnecessaryTechnicalAssetRule) createRisk(technicalAsset *types.Technic
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *UnnecessaryTechnicalAssetRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation, fmt.Sprintf("technical asset %q", technicalAsset.Id))
+		if len(technicalAsset.DataAssetsProcessed) == 0 && len(technicalAsset.DataAssetsStored) == 0 {
+			explanation = append(explanation, fmt.Sprintf("  - data assets processed: %v, stored: %v (none)", technicalAsset.DataAssetsProcessed, technicalAsset.DataAssetsStored))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("  - outgoing communication links: %v, incoming communication links: %v (none)",
+				len(technicalAsset.CommunicationLinks), len(parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id])))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+	}
+
+	return explanation
+}
@@ -0,0 +1,118 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnpatchedBaseImageRule struct{}
+
+func NewUnpatchedBaseImageRule() *UnpatchedBaseImageRule {
+	return &UnpatchedBaseImageRule{}
+}
+
+func (*UnpatchedBaseImageRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unpatched-base-image",
+		Title: "Unpatched Base Image",
+		Description: "Container images built from a base image with known unpatched vulnerabilities or an end-of-life operating system " +
+			"inherit all vulnerabilities of that base image without the application team's awareness.",
+		Impact: "If this risk is unmitigated, attackers might exploit well-documented vulnerabilities of the base image to " +
+			"tamper with the container or escalate their privileges.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Docker_Security_Cheat_Sheet.html",
+		Action:     "Base Image Patching",
+		Mitigation: "Rebuild container images regularly from patched and supported base images, scan images for known " +
+			"vulnerabilities within the build pipeline, and prefer minimal (distroless) base images.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.Tampering,
+		DetectionLogic:             "In-scope technical assets tagged with 'container' which are also tagged with 'unpatched-base' or 'eol-os'.",
+		RiskAssessment:             "The risk rating depends on whether the container is internet-facing.",
+		FalsePositives:             "Containers whose vulnerable base image components are not reachable can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        1395,
+		Labels:                     []string{"container", "supply-chain"},
+	}
+}
+
+func (*UnpatchedBaseImageRule) SupportedTags() []string {
+	return []string{"container", "unpatched-base", "eol-os"}
+}
+
+func (r *UnpatchedBaseImageRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(techAsset))
+	}
+	return risks, nil
+}
+
+func (r *UnpatchedBaseImageRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("container") || !techAsset.IsTaggedWithAny("unpatched-base", "eol-os")
+}
+
+func (r *UnpatchedBaseImageRule) createRisk(techAsset *types.TechnicalAsset) *types.Risk {
+	impact := types.MediumImpact
+	if techAsset.Internet {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        "<b>Unpatched Base Image</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *UnpatchedBaseImageRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has %q)", techAsset.Tags, "container"),
+		}...)
+
+		for _, tag := range []string{"unpatched-base", "eol-os"} {
+			if techAsset.IsTaggedWithAny(tag) {
+				explanation = append(explanation, fmt.Sprintf("    - base image tagged with %q", tag))
+			}
+		}
+
+		explanation = append(explanation, fmt.Sprintf("  - internet-facing: %v", techAsset.Internet))
+		if techAsset.Internet {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the container is internet-facing", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the container is internal-only", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
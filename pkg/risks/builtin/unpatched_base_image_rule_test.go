@@ -0,0 +1,81 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnpatchedBaseImageRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnpatchedBaseImageRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnpatchedBaseImageRuleTest struct {
+	tags     []string
+	internet bool
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestUnpatchedBaseImageRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnpatchedBaseImageRuleTest{
+		"not a container": {
+			tags:        []string{"eol-os"},
+			riskCreated: false,
+		},
+		"patched container": {
+			tags:        []string{"container"},
+			riskCreated: false,
+		},
+		"internal container with unpatched base": {
+			tags:                []string{"container", "unpatched-base"},
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: `    - base image tagged with "unpatched-base"`,
+		},
+		"internet-facing container with eol os": {
+			tags:                []string{"container", "eol-os"},
+			internet:            true,
+			riskCreated:         true,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: `    - base image tagged with "eol-os"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnpatchedBaseImageRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"app": {
+						Id:       "app",
+						Title:    "App",
+						Tags:     testCase.tags,
+						Internet: testCase.internet,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Unpatched Base Image</b> risk at <b>App</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
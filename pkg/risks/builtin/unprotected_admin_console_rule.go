@@ -0,0 +1,166 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnprotectedAdminConsoleRule struct{}
+
+func NewUnprotectedAdminConsoleRule() *UnprotectedAdminConsoleRule {
+	return &UnprotectedAdminConsoleRule{}
+}
+
+func (*UnprotectedAdminConsoleRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unprotected-admin-console",
+		Title: "Unprotected Admin Console",
+		Description: "Internal admin consoles (like Kubernetes dashboards, Grafana, or other management UIs) without authentication or with " +
+			"default credentials are trivially exploitable once an attacker gains any foothold in the internal network.",
+		Impact: "If this risk is unmitigated, attackers with internal network access might take over the admin console and " +
+			"thereby control the infrastructure managed by it.",
+		ASVS:       "V2 - Authentication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
+		Action:     "Admin Console Authentication",
+		Mitigation: "Require strong authentication (ideally via a central identity provider with two-factor authentication) for all " +
+			"admin consoles and change all default credentials before putting them into operation.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.Spoofing,
+		DetectionLogic: "In-scope technical assets tagged with 'admin-console', 'management-ui', 'grafana', or 'k8s-dashboard' having incoming communication links without authentication or being tagged with 'default-credentials'.",
+		RiskAssessment: "The risk rating depends on whether the admin console controls production infrastructure.",
+		FalsePositives: "Admin consoles protected by an authenticating reverse proxy not reflected in the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        306,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*UnprotectedAdminConsoleRule) SupportedTags() []string {
+	return []string{"admin-console", "management-ui", "grafana", "k8s-dashboard", "default-credentials", "production"}
+}
+
+func (r *UnprotectedAdminConsoleRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		unauthenticatedLinks := r.unauthenticatedIncomingLinks(parsedModel, techAsset)
+		defaultCredentials := r.hasDefaultCredentials(parsedModel, techAsset)
+		if len(unauthenticatedLinks) == 0 && !defaultCredentials {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, unauthenticatedLinks, defaultCredentials))
+	}
+	return risks, nil
+}
+
+func (r *UnprotectedAdminConsoleRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("admin-console", "management-ui", "grafana", "k8s-dashboard")
+}
+
+func (r *UnprotectedAdminConsoleRule) unauthenticatedIncomingLinks(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.CommunicationLink {
+	result := make([]*types.CommunicationLink, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Authentication == types.NoneAuthentication && !incomingLink.Protocol.IsProcessLocal() {
+			result = append(result, incomingLink)
+		}
+	}
+	return result
+}
+
+func (r *UnprotectedAdminConsoleRule) hasDefaultCredentials(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.IsTaggedWithAny("default-credentials") {
+		return true
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.IsTaggedWithAny("default-credentials") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UnprotectedAdminConsoleRule) createRisk(parsedModel *types.Model, technicalAsset *types.TechnicalAsset, unauthenticatedLinks []*types.CommunicationLink, defaultCredentials bool) *types.Risk {
+	title := "<b>Unprotected Admin Console</b> risk at <b>" + technicalAsset.Title + "</b>"
+	if defaultCredentials {
+		title += ": <u>default credentials</u>"
+	} else {
+		title += ": <u>missing authentication</u>"
+	}
+	impact := types.MediumImpact
+	if isProductionAsset(parsedModel, technicalAsset) {
+		impact = types.HighImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:       types.Likely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: technicalAsset.Id,
+		DataBreachProbability:        types.Probable,
+		DataBreachTechnicalAssetIDs:  []string{technicalAsset.Id},
+	}
+	if len(unauthenticatedLinks) > 0 {
+		risk.MostRelevantCommunicationLinkId = unauthenticatedLinks[0].Id
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
+	return risk
+}
+
+func (r *UnprotectedAdminConsoleRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		unauthenticatedLinks := r.unauthenticatedIncomingLinks(parsedModel, techAsset)
+		defaultCredentials := r.hasDefaultCredentials(parsedModel, techAsset)
+		if len(unauthenticatedLinks) == 0 && !defaultCredentials {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q, %q])", techAsset.Tags, "admin-console", "management-ui", "grafana", "k8s-dashboard"),
+		}...)
+
+		for _, incomingLink := range unauthenticatedLinks {
+			explanation = append(explanation, fmt.Sprintf("  - incoming communication link %q from %q: authentication %v", incomingLink.Id, incomingLink.SourceId, incomingLink.Authentication))
+		}
+
+		if defaultCredentials {
+			explanation = append(explanation, fmt.Sprintf("  - default credentials specifically flagged (tagged with %q)", "default-credentials"))
+		} else {
+			explanation = append(explanation, "  - default credentials not flagged")
+		}
+
+		if isProductionAsset(parsedModel, techAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the console controls production infrastructure", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,149 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnprotectedAdminConsoleRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnprotectedAdminConsoleRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnprotectedAdminConsoleRuleTest struct {
+	tags              []string
+	outOfScope        bool
+	authentication    types.Authentication
+	linkTags          []string
+	inProductionBound bool
+
+	riskCreated    bool
+	expectedImpact types.RiskExploitationImpact
+	expectedTitle  string
+}
+
+func TestUnprotectedAdminConsoleRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnprotectedAdminConsoleRuleTest{
+		"not an admin console": {
+			tags:           []string{"other"},
+			authentication: types.NoneAuthentication,
+			riskCreated:    false,
+		},
+		"out of scope": {
+			tags:           []string{"grafana"},
+			outOfScope:     true,
+			authentication: types.NoneAuthentication,
+			riskCreated:    false,
+		},
+		"authenticated": {
+			tags:           []string{"grafana"},
+			authentication: types.Credentials,
+			riskCreated:    false,
+		},
+		"unauthenticated": {
+			tags:           []string{"k8s-dashboard"},
+			authentication: types.NoneAuthentication,
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+			expectedTitle:  "<b>Unprotected Admin Console</b> risk at <b>Console</b>: <u>missing authentication</u>",
+		},
+		"default credentials": {
+			tags:           []string{"admin-console"},
+			authentication: types.Credentials,
+			linkTags:       []string{"default-credentials"},
+			riskCreated:    true,
+			expectedImpact: types.MediumImpact,
+			expectedTitle:  "<b>Unprotected Admin Console</b> risk at <b>Console</b>: <u>default credentials</u>",
+		},
+		"unauthenticated in production": {
+			tags:              []string{"management-ui"},
+			authentication:    types.NoneAuthentication,
+			inProductionBound: true,
+			riskCreated:       true,
+			expectedImpact:    types.HighImpact,
+			expectedTitle:     "<b>Unprotected Admin Console</b> risk at <b>Console</b>: <u>missing authentication</u>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnprotectedAdminConsoleRule()
+			incomingLink := &types.CommunicationLink{
+				Id:             "admin>console",
+				SourceId:       "admin",
+				TargetId:       "console",
+				Protocol:       types.HTTPS,
+				Authentication: testCase.authentication,
+				Tags:           testCase.linkTags,
+			}
+			trustBoundary := &types.TrustBoundary{Id: "tb", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"console"}}
+			if testCase.inProductionBound {
+				trustBoundary.Tags = []string{"production"}
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"admin": {
+						Id:                 "admin",
+						Title:              "Admin",
+						CommunicationLinks: []*types.CommunicationLink{incomingLink},
+					},
+					"console": {
+						Id:         "console",
+						Title:      "Console",
+						Tags:       testCase.tags,
+						OutOfScope: testCase.outOfScope,
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{"tb": trustBoundary},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"console": {incomingLink},
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"console": trustBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
+
+func TestUnprotectedAdminConsoleRuleExplainRiskShowsUnauthenticatedLink(t *testing.T) {
+	rule := NewUnprotectedAdminConsoleRule()
+	incomingLink := &types.CommunicationLink{
+		Id:             "admin>console",
+		SourceId:       "admin",
+		TargetId:       "console",
+		Protocol:       types.HTTP,
+		Authentication: types.NoneAuthentication,
+	}
+
+	explanation := rule.ExplainRisk(&types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"console": {Id: "console", Tags: []string{"grafana"}},
+		},
+		IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+			"console": {incomingLink},
+		},
+	}, "unprotected-admin-console@console")
+
+	assert.Contains(t, explanation, `  - incoming communication link "admin>console" from "admin": authentication none`)
+	assert.Contains(t, explanation, "  - default credentials not flagged")
+}
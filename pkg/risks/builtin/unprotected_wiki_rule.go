@@ -0,0 +1,137 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type UnprotectedWikiRule struct{}
+
+func NewUnprotectedWikiRule() *UnprotectedWikiRule {
+	return &UnprotectedWikiRule{}
+}
+
+func (*UnprotectedWikiRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "unprotected-wiki",
+		Title: "Unprotected Wiki",
+		Description: "Internal wikis (like Confluence, Notion, or an internal GitBook) storing architecture diagrams, runbooks, " +
+			"or incident response procedures which are accessible without authentication are a reconnaissance goldmine.",
+		Impact:     "If this risk is unmitigated, attackers within the network might learn about the architecture, weak spots, and operational procedures of the system.",
+		ASVS:       "V4 - Access Control Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
+		Action:     "Wiki Authentication",
+		Mitigation: "Require authentication (ideally via single sign-on) for all access to internal wikis and documentation systems " +
+			"and restrict sensitive spaces to the teams that need them.",
+		Check:                      "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:                   types.Operations,
+		STRIDE:                     types.InformationDisclosure,
+		DetectionLogic:             "In-scope technical assets tagged with 'wiki', 'confluence', or 'internal-docs' having incoming unauthenticated communication links.",
+		RiskAssessment:             "The risk rating depends on whether the wiki contains sensitive content (tagged with 'architecture-docs' or 'runbooks').",
+		FalsePositives:             "Wikis containing only public information can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        284,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*UnprotectedWikiRule) SupportedTags() []string {
+	return []string{"wiki", "confluence", "internal-docs", "architecture-docs", "runbooks"}
+}
+
+func (r *UnprotectedWikiRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		unauthenticatedLinks := r.unauthenticatedIncomingLinks(parsedModel, techAsset)
+		if len(unauthenticatedLinks) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(techAsset, unauthenticatedLinks))
+	}
+	return risks, nil
+}
+
+func (r *UnprotectedWikiRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope || !techAsset.IsTaggedWithAny("wiki", "confluence", "internal-docs")
+}
+
+func (r *UnprotectedWikiRule) unauthenticatedIncomingLinks(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.CommunicationLink {
+	result := make([]*types.CommunicationLink, 0)
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if incomingLink.Authentication == types.NoneAuthentication {
+			result = append(result, incomingLink)
+		}
+	}
+	return result
+}
+
+func (r *UnprotectedWikiRule) createRisk(techAsset *types.TechnicalAsset, unauthenticatedLinks []*types.CommunicationLink) *types.Risk {
+	impact := types.LowImpact
+	if techAsset.IsTaggedWithAny("architecture-docs", "runbooks") {
+		impact = types.MediumImpact
+	}
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(types.Likely, impact),
+		ExploitationLikelihood:          types.Likely,
+		ExploitationImpact:              impact,
+		Title:                           "<b>Unprotected Wiki</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId:    techAsset.Id,
+		MostRelevantCommunicationLinkId: unauthenticatedLinks[0].Id,
+		DataBreachProbability:           types.Probable,
+		DataBreachTechnicalAssetIDs:     []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *UnprotectedWikiRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		unauthenticatedLinks := r.unauthenticatedIncomingLinks(parsedModel, techAsset)
+		if len(unauthenticatedLinks) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has either [%q, %q, %q])", techAsset.Tags, "wiki", "confluence", "internal-docs"),
+			"  - unauthenticated incoming communication links:",
+		}...)
+
+		for _, incomingLink := range unauthenticatedLinks {
+			explanation = append(explanation, fmt.Sprintf("    - %q from %q", incomingLink.Id, incomingLink.SourceId))
+		}
+
+		if techAsset.IsTaggedWithAny("architecture-docs", "runbooks") {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because the content is tagged with either [%q, %q]", types.MediumImpact, "architecture-docs", "runbooks"))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+		}
+	}
+
+	return explanation
+}
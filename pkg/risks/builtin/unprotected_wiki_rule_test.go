@@ -0,0 +1,90 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestUnprotectedWikiRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewUnprotectedWikiRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type UnprotectedWikiRuleTest struct {
+	tags           []string
+	authentication types.Authentication
+
+	riskCreated         bool
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestUnprotectedWikiRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]UnprotectedWikiRuleTest{
+		"not a wiki": {
+			tags:           []string{"cms"},
+			authentication: types.NoneAuthentication,
+			riskCreated:    false,
+		},
+		"authenticated access": {
+			tags:           []string{"confluence"},
+			authentication: types.Externalized,
+			riskCreated:    false,
+		},
+		"unauthenticated wiki": {
+			tags:                []string{"wiki"},
+			authentication:      types.NoneAuthentication,
+			riskCreated:         true,
+			expectedImpact:      types.LowImpact,
+			expectedExplanation: "    - impact is low (default)",
+		},
+		"unauthenticated wiki with runbooks": {
+			tags:                []string{"internal-docs", "runbooks"},
+			authentication:      types.NoneAuthentication,
+			riskCreated:         true,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: `    - impact is medium because the content is tagged with either ["architecture-docs", "runbooks"]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewUnprotectedWikiRule()
+			incomingLink := &types.CommunicationLink{Id: "employee>wiki", SourceId: "employee", TargetId: "wiki", Authentication: testCase.authentication}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"employee": {Id: "employee", CommunicationLinks: []*types.CommunicationLink{incomingLink}},
+					"wiki": {
+						Id:    "wiki",
+						Title: "Wiki",
+						Tags:  testCase.tags,
+					},
+				},
+				IncomingTechnicalCommunicationLinksMappedByTargetId: map[string][]*types.CommunicationLink{
+					"wiki": {incomingLink},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Unprotected Wiki</b> risk at <b>Wiki</b>", risks[0].Title)
+				explanation := rule.ExplainRisk(model, risks[0].SyntheticId)
+				assert.Contains(t, explanation, `    - "employee>wiki" from "employee"`)
+				assert.Contains(t, explanation, testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -35,6 +36,7 @@ func (*UntrustedDeserializationRule) Category() *types.RiskCategory {
 			"as false positives after individual review.",
 		ModelFailurePossibleReason: false,
 		CWE:                        502,
+		Labels:                     []string{"appsec"},
 	}
 }
 
@@ -103,3 +105,28 @@ func (r *UntrustedDeserializationRule) createRisk(parsedModel *types.Model, tech
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *UntrustedDeserializationRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		technicalAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", technicalAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", technicalAsset.OutOfScope),
+			fmt.Sprintf("  - data formats accepted: %v (contains %v), technology: %v (has %q)",
+				technicalAsset.DataFormatsAccepted, types.Serialization, technicalAsset.Technologies.String(), types.EJB))
+		for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[technicalAsset.Id] {
+			if commLink.Protocol == types.IIOP || commLink.Protocol == types.IiopEncrypted ||
+				commLink.Protocol == types.JRMP || commLink.Protocol == types.JrmpEncrypted {
+				explanation = append(explanation, fmt.Sprintf("  - communication link %q (protocol: %v, crosses a network trust boundary: %v)",
+					commLink.Id, commLink.Protocol, isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink)))
+			}
+		}
+		explanation = append(explanation,
+			fmt.Sprintf("  - %v", processedSensitivity(parsedModel, technicalAsset)),
+			explainedLikelihood(generatedRisk, types.Likely, "serialized data is received across a network trust boundary"),
+			explainedImpact(generatedRisk, types.HighImpact, fmt.Sprintf("%v data or %v integrity or availability is processed", types.StrictlyConfidential, types.MissionCritical)))
+	}
+
+	return explanation
+}
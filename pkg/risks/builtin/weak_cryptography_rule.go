@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type WeakCryptographyRule struct{}
+
+func NewWeakCryptographyRule() *WeakCryptographyRule {
+	return &WeakCryptographyRule{}
+}
+
+func (*WeakCryptographyRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "weak-cryptography",
+		Title: "Weak Cryptography",
+		Description: "Technical assets using broken or legacy cryptographic algorithms (like MD5, SHA-1, DES, RC4, or RSA with 1024 bit keys), " +
+			"either themselves or for the data assets they process, lack cryptographic agility and offer no adequate protection anymore.",
+		Impact: "If this risk is unmitigated, attackers might be able to decrypt or forge the data protected by the weak algorithms " +
+			"(like by finding hash collisions or brute-forcing short keys).",
+		ASVS:       "V6 - Stored Cryptography Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Cryptographic_Storage_Cheat_Sheet.html",
+		Action:     "Cryptographic Agility",
+		Mitigation: "Replace the weak algorithms by current ones (like SHA-256, AES-GCM, or RSA with at least 3072 bit keys), " +
+			"and keep the algorithms configurable so that they can be replaced without major changes.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "In-scope technical assets tagged with 'md5', 'sha1', 'des', '3des', 'rc4', or 'rsa-1024', " +
+			"or processing or storing data assets tagged with one of these.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity ratings of the data processed by the technical asset.",
+		FalsePositives: "Weak algorithms used for non-security purposes only (like MD5 checksums for deduplication) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        327,
+		Labels:                     []string{"data"},
+	}
+}
+
+func (*WeakCryptographyRule) SupportedTags() []string {
+	return []string{"md5", "sha1", "des", "3des", "rc4", "rsa-1024"}
+}
+
+func (r *WeakCryptographyRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if techAsset.OutOfScope {
+			continue
+		}
+
+		algorithms := r.weakAlgorithms(parsedModel, techAsset)
+		if len(algorithms) == 0 {
+			continue
+		}
+
+		risks = append(risks, r.createRisk(parsedModel, techAsset, algorithms))
+	}
+	return risks, nil
+}
+
+// weakAlgorithms returns the sorted weak algorithms the technical asset or the data assets it processes are tagged with
+func (r *WeakCryptographyRule) weakAlgorithms(parsedModel *types.Model, techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	addAlgorithms := func(tags []string) {
+		for _, algorithm := range r.SupportedTags() {
+			if containsCaseInsensitiveAny(tags, algorithm) && !contains(result, algorithm) {
+				result = append(result, algorithm)
+			}
+		}
+	}
+
+	addAlgorithms(techAsset.Tags)
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		addAlgorithms(dataAsset.Tags)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (r *WeakCryptographyRule) impact(parsedModel *types.Model, techAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	confidentiality := parsedModel.HighestProcessedConfidentiality(techAsset)
+	integrity := parsedModel.HighestProcessedIntegrity(techAsset)
+	if confidentiality == types.StrictlyConfidential || integrity == types.MissionCritical {
+		return types.HighImpact
+	}
+	if confidentiality == types.Confidential || integrity == types.Critical {
+		return types.MediumImpact
+	}
+	return types.LowImpact
+}
+
+func (r *WeakCryptographyRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, algorithms []string) *types.Risk {
+	impact := r.impact(parsedModel, techAsset)
+	title := "<b>Weak Cryptography</b> risk at <b>" + techAsset.Title + "</b> using <b>" + strings.Join(algorithms, ", ") + "</b>"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(types.Unlikely, impact),
+		ExploitationLikelihood:       types.Unlikely,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *WeakCryptographyRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if techAsset.OutOfScope {
+			continue
+		}
+
+		algorithms := r.weakAlgorithms(parsedModel, techAsset)
+		if len(algorithms) == 0 {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - weak algorithms %q used by the technical asset or its data assets", algorithms),
+			fmt.Sprintf("    - impact is %v because of highest confidentiality %v and highest integrity %v", r.impact(parsedModel, techAsset),
+				parsedModel.HighestProcessedConfidentiality(techAsset), parsedModel.HighestProcessedIntegrity(techAsset)),
+		}...)
+	}
+
+	return explanation
+}
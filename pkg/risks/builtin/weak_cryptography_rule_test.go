@@ -0,0 +1,100 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestWeakCryptographyRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewWeakCryptographyRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type WeakCryptographyRuleTest struct {
+	outOfScope      bool
+	tags            []string
+	dataTags        []string
+	confidentiality types.Confidentiality
+	integrity       types.Criticality
+
+	riskCreated    bool
+	expectedTitle  string
+	expectedImpact types.RiskExploitationImpact
+}
+
+func TestWeakCryptographyRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]WeakCryptographyRuleTest{
+		"no weak algorithms": {
+			tags:        []string{"aes"},
+			dataTags:    []string{"sha256"},
+			riskCreated: false,
+		},
+		"out of scope": {
+			outOfScope:  true,
+			tags:        []string{"md5"},
+			riskCreated: false,
+		},
+		"technical asset tagged": {
+			tags:           []string{"sha1", "rsa-1024"},
+			riskCreated:    true,
+			expectedTitle:  "<b>Weak Cryptography</b> risk at <b>Service</b> using <b>rsa-1024, sha1</b>",
+			expectedImpact: types.LowImpact,
+		},
+		"data asset tagged": {
+			dataTags:        []string{"des"},
+			confidentiality: types.Confidential,
+			riskCreated:     true,
+			expectedTitle:   "<b>Weak Cryptography</b> risk at <b>Service</b> using <b>des</b>",
+			expectedImpact:  types.MediumImpact,
+		},
+		"mission-critical integrity": {
+			tags:           []string{"md5"},
+			dataTags:       []string{"md5", "rc4"},
+			integrity:      types.MissionCritical,
+			riskCreated:    true,
+			expectedTitle:  "<b>Weak Cryptography</b> risk at <b>Service</b> using <b>md5, rc4</b>",
+			expectedImpact: types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewWeakCryptographyRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"service": {
+						Id:                  "service",
+						Title:               "Service",
+						OutOfScope:          testCase.outOfScope,
+						Tags:                testCase.tags,
+						Confidentiality:     testCase.confidentiality,
+						Integrity:           testCase.integrity,
+						DataAssetsProcessed: []string{"data"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Tags: testCase.dataTags},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type WeakCspRule struct{}
+
+func NewWeakCspRule() *WeakCspRule {
+	return &WeakCspRule{}
+}
+
+// data asset tags indicating financial or medical data, raising the impact of a weak content security policy
+var weakCspSensitiveDataTags = []string{"financial", "medical", "pci", "phi"}
+
+func (*WeakCspRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "weak-csp",
+		Title: "Weak Content Security Policy",
+		Description: "A content security policy (CSP) allowing inline scripts without nonces or hashes falls back to 'unsafe-inline', " +
+			"and allowing 'unsafe-eval' permits dynamic code execution, both making cross-site scripting (XSS) significantly easier.",
+		Impact:     "If this risk is unmitigated, attackers might exploit XSS vulnerabilities which a strict content security policy would have blocked.",
+		ASVS:       "V14 - Configuration Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Content_Security_Policy_Cheat_Sheet.html",
+		Action:     "Strict Content Security Policy",
+		Mitigation: "Use a nonce- or hash-based content security policy instead of 'unsafe-inline' and remove 'unsafe-eval' " +
+			"by refactoring code relying on dynamic code evaluation.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Development,
+		STRIDE:         types.Tampering,
+		DetectionLogic: "In-scope technical assets of technology '" + types.WebApplication + "' tagged with 'csp-unsafe-inline' or tagged with both 'csp-enabled' and 'unsafe-eval', not being tagged with 'csp-nonce' or 'csp-hash'.",
+		RiskAssessment: "The risk rating depends on whether the web application is internet-facing and whether it processes " +
+			"financial or medical data (data assets tagged with '" + strings.Join(weakCspSensitiveDataTags, "', '") + "').",
+		FalsePositives:             "Web applications without any user-controlled content rendered can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        116,
+		Labels:                     []string{"appsec"},
+	}
+}
+
+func (*WeakCspRule) SupportedTags() []string {
+	return []string{"csp-unsafe-inline", "csp-enabled", "unsafe-eval", "csp-nonce", "csp-hash"}
+}
+
+func (r *WeakCspRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *WeakCspRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return techAsset.OutOfScope ||
+		!techAsset.Technologies.GetAttribute(types.WebApplication) ||
+		techAsset.IsTaggedWithAny("csp-nonce", "csp-hash") ||
+		len(r.unsafeDirectives(techAsset)) == 0
+}
+
+func (r *WeakCspRule) unsafeDirectives(techAsset *types.TechnicalAsset) []string {
+	result := make([]string, 0)
+	if techAsset.IsTaggedWithAny("csp-unsafe-inline") {
+		result = append(result, "unsafe-inline")
+	}
+	if techAsset.IsTaggedWithAny("csp-enabled") && techAsset.IsTaggedWithAny("unsafe-eval") {
+		result = append(result, "unsafe-eval")
+	}
+	return result
+}
+
+func (r *WeakCspRule) processesSensitiveData(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, dataAsset := range parsedModel.DataAssetsProcessedBy(techAsset) {
+		if dataAsset.IsTaggedWithAny(weakCspSensitiveDataTags...) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *WeakCspRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	likelihood := types.Unlikely
+	if techAsset.Internet {
+		likelihood = types.Likely
+	}
+	impact := types.MediumImpact
+	if r.processesSensitiveData(parsedModel, techAsset) {
+		impact = types.HighImpact
+	}
+	title := "<b>Weak Content Security Policy</b> risk at <b>" + techAsset.Title + "</b>: <u>" + strings.Join(r.unsafeDirectives(techAsset), ", ") + "</u>"
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Title:                        title,
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *WeakCspRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - technologies: %v (has %q)", techAsset.Technologies, types.WebApplication),
+			fmt.Sprintf("  - tags: %v (has neither [%q, %q])", techAsset.Tags, "csp-nonce", "csp-hash"),
+		}...)
+
+		for _, directive := range r.unsafeDirectives(techAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - content security policy allows '%v'", directive))
+		}
+
+		if techAsset.Internet {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the web application is internet-facing", types.Likely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v (default)", types.Unlikely))
+		}
+
+		if r.processesSensitiveData(parsedModel, techAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because financial or medical data is processed", types.HighImpact))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,116 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestWeakCspRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewWeakCspRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type WeakCspRuleTest struct {
+	technology string
+	tags       []string
+	internet   bool
+	dataTags   []string
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+	expectedTitle      string
+}
+
+func TestWeakCspRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]WeakCspRuleTest{
+		"not a web application": {
+			technology:  types.WebServiceREST,
+			tags:        []string{"csp-unsafe-inline"},
+			riskCreated: false,
+		},
+		"strict csp": {
+			technology:  types.WebApplication,
+			tags:        []string{"csp-enabled"},
+			riskCreated: false,
+		},
+		"unsafe eval without csp": {
+			technology:  types.WebApplication,
+			tags:        []string{"unsafe-eval"},
+			riskCreated: false,
+		},
+		"unsafe inline with nonce": {
+			technology:  types.WebApplication,
+			tags:        []string{"csp-unsafe-inline", "csp-nonce"},
+			riskCreated: false,
+		},
+		"unsafe inline with hash": {
+			technology:  types.WebApplication,
+			tags:        []string{"csp-unsafe-inline", "csp-hash"},
+			riskCreated: false,
+		},
+		"internal unsafe inline": {
+			technology:         types.WebApplication,
+			tags:               []string{"csp-unsafe-inline"},
+			riskCreated:        true,
+			expectedLikelihood: types.Unlikely,
+			expectedImpact:     types.MediumImpact,
+			expectedTitle:      "<b>Weak Content Security Policy</b> risk at <b>Shop</b>: <u>unsafe-inline</u>",
+		},
+		"internet-facing unsafe eval with financial data": {
+			technology:         types.WebApplication,
+			tags:               []string{"csp-enabled", "unsafe-eval"},
+			internet:           true,
+			dataTags:           []string{"financial"},
+			riskCreated:        true,
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.HighImpact,
+			expectedTitle:      "<b>Weak Content Security Policy</b> risk at <b>Shop</b>: <u>unsafe-eval</u>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewWeakCspRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"shop": {
+						Id:       "shop",
+						Title:    "Shop",
+						Tags:     testCase.tags,
+						Internet: testCase.internet,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+						DataAssetsProcessed: []string{"orders"},
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"orders": {Id: "orders", Tags: testCase.dataTags},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, testCase.expectedTitle, risks[0].Title)
+				assert.NotEmpty(t, rule.ExplainRisk(model, risks[0].SyntheticId))
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
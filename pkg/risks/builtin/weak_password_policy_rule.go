@@ -0,0 +1,178 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type WeakPasswordPolicyRule struct{}
+
+func NewWeakPasswordPolicyRule() *WeakPasswordPolicyRule {
+	return &WeakPasswordPolicyRule{}
+}
+
+func (*WeakPasswordPolicyRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "weak-password-policy",
+		Title: "Weak Password Policy",
+		Description: "Identity providers and user directories without enforced password complexity, length, or breached-password checks " +
+			"are a foundational authentication weakness.",
+		Impact: "If this risk is unmitigated, attackers might guess or credential-stuff user passwords and thereby access all " +
+			"systems relying on the identity provider for authentication.",
+		ASVS:       "V2 - Authentication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Authentication_Cheat_Sheet.html",
+		Action:     "Password Policy",
+		Mitigation: "Enforce a password policy requiring a minimum length and checking passwords against lists of breached passwords " +
+			"(as recommended by NIST SP 800-63B) and enforce multi-factor authentication.",
+		Check:          "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function:       types.Operations,
+		STRIDE:         types.Spoofing,
+		DetectionLogic: "In-scope identity providers or technical assets tagged with 'idp', 'user-directory', or 'auth-server' not being tagged with 'password-policy' or 'mfa-enforced'.",
+		RiskAssessment: "The risk rating depends on whether the identity provider is consumer-facing and on the confidentiality " +
+			"rating of the downstream systems protected by it.",
+		FalsePositives: "Identity providers with password policies enforced but not reflected in the model " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: true,
+		CWE:                        521,
+		Labels:                     []string{"identity", "compliance:pci"},
+	}
+}
+
+func (*WeakPasswordPolicyRule) SupportedTags() []string {
+	return []string{"idp", "user-directory", "auth-server", "password-policy", "mfa-enforced"}
+}
+
+func (r *WeakPasswordPolicyRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+		risks = append(risks, r.createRisk(parsedModel, techAsset))
+	}
+	return risks, nil
+}
+
+func (r *WeakPasswordPolicyRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	if techAsset.OutOfScope {
+		return true
+	}
+	if !techAsset.Technologies.GetAttribute(types.IdentityProvider) && !techAsset.IsTaggedWithAny("idp", "user-directory", "auth-server") {
+		return true
+	}
+	return techAsset.IsTaggedWithAny("password-policy", "mfa-enforced")
+}
+
+func (r *WeakPasswordPolicyRule) isConsumerFacing(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	if techAsset.Internet {
+		return true
+	}
+	for _, incomingLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[techAsset.Id] {
+		if caller, ok := parsedModel.TechnicalAssets[incomingLink.SourceId]; ok && caller.Internet {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *WeakPasswordPolicyRule) protectedAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) []*types.TechnicalAsset {
+	result := make([]*types.TechnicalAsset, 0)
+	for _, outgoingLink := range techAsset.CommunicationLinksSorted() {
+		if outgoingLink.Authentication == types.NoneAuthentication {
+			continue
+		}
+		if target, ok := parsedModel.TechnicalAssets[outgoingLink.TargetId]; ok {
+			result = append(result, target)
+		}
+	}
+	return result
+}
+
+func (r *WeakPasswordPolicyRule) protectsStrictlyConfidentialAssets(parsedModel *types.Model, techAsset *types.TechnicalAsset) bool {
+	for _, protectedAsset := range r.protectedAssets(parsedModel, techAsset) {
+		if parsedModel.HighestTechnicalAssetConfidentiality(protectedAsset) == types.StrictlyConfidential {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *WeakPasswordPolicyRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset) *types.Risk {
+	likelihood := types.Unlikely
+	if r.isConsumerFacing(parsedModel, techAsset) {
+		likelihood = types.Likely
+	}
+	impact := types.MediumImpact
+	if r.protectsStrictlyConfidentialAssets(parsedModel, techAsset) {
+		impact = types.HighImpact
+	}
+	dataBreachTechnicalAssetIDs := []string{techAsset.Id}
+	for _, protectedAsset := range r.protectedAssets(parsedModel, techAsset) {
+		if !contains(dataBreachTechnicalAssetIDs, protectedAsset.Id) {
+			dataBreachTechnicalAssetIDs = append(dataBreachTechnicalAssetIDs, protectedAsset.Id)
+		}
+	}
+	risk := &types.Risk{
+		CategoryId:                   r.Category().ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Confidence:                   types.MediumConfidence,
+		Title:                        "<b>Weak Password Policy</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  dataBreachTechnicalAssetIDs,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk
+}
+
+func (r *WeakPasswordPolicyRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if !strings.EqualFold(risk, categoryId+"@"+techAsset.Id) && !strings.EqualFold(risk, categoryId+"@*") {
+			continue
+		}
+
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, []string{
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - out of scope: %v (=false)", techAsset.OutOfScope),
+			fmt.Sprintf("  - tags: %v (has neither [%q, %q])", techAsset.Tags, "password-policy", "mfa-enforced"),
+		}...)
+
+		if r.isConsumerFacing(parsedModel, techAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the identity provider is consumer-facing", types.Likely))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the identity provider is internal", types.Unlikely))
+		}
+
+		protectedAssets := r.protectedAssets(parsedModel, techAsset)
+		if len(protectedAssets) == 0 {
+			explanation = append(explanation, "  - no downstream technical assets protected via authenticated outgoing communication links")
+		}
+		for _, protectedAsset := range protectedAssets {
+			explanation = append(explanation, fmt.Sprintf("  - protects downstream technical asset %q (confidentiality %v)", protectedAsset.Id, parsedModel.HighestTechnicalAssetConfidentiality(protectedAsset)))
+		}
+
+		if r.protectsStrictlyConfidentialAssets(parsedModel, techAsset) {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v because a protected technical asset is rated %v", types.HighImpact, types.StrictlyConfidential))
+		} else {
+			explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.MediumImpact))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,120 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestWeakPasswordPolicyRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewWeakPasswordPolicyRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type WeakPasswordPolicyRuleTest struct {
+	technology                string
+	tags                      []string
+	outOfScope                bool
+	internet                  bool
+	downstreamConfidentiality types.Confidentiality
+
+	riskCreated        bool
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestWeakPasswordPolicyRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]WeakPasswordPolicyRuleTest{
+		"not an identity provider": {
+			technology:  types.WebServiceREST,
+			riskCreated: false,
+		},
+		"out of scope": {
+			technology:  types.IdentityProvider,
+			outOfScope:  true,
+			riskCreated: false,
+		},
+		"password policy enforced": {
+			technology:  types.IdentityProvider,
+			tags:        []string{"password-policy"},
+			riskCreated: false,
+		},
+		"mfa enforced": {
+			tags:        []string{"auth-server", "mfa-enforced"},
+			riskCreated: false,
+		},
+		"internal identity provider": {
+			technology:                types.IdentityProvider,
+			downstreamConfidentiality: types.Confidential,
+			riskCreated:               true,
+			expectedLikelihood:        types.Unlikely,
+			expectedImpact:            types.MediumImpact,
+		},
+		"consumer-facing user directory": {
+			tags:                      []string{"user-directory"},
+			internet:                  true,
+			downstreamConfidentiality: types.Confidential,
+			riskCreated:               true,
+			expectedLikelihood:        types.Likely,
+			expectedImpact:            types.MediumImpact,
+		},
+		"protects strictly confidential system": {
+			tags:                      []string{"idp"},
+			downstreamConfidentiality: types.StrictlyConfidential,
+			riskCreated:               true,
+			expectedLikelihood:        types.Unlikely,
+			expectedImpact:            types.HighImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewWeakPasswordPolicyRule()
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"idp": {
+						Id:         "idp",
+						Title:      "IdP",
+						Tags:       testCase.tags,
+						OutOfScope: testCase.outOfScope,
+						Internet:   testCase.internet,
+						Technologies: types.TechnologyList{
+							{
+								Name:       testCase.technology,
+								Attributes: map[string]bool{testCase.technology: true},
+							},
+						},
+						CommunicationLinks: []*types.CommunicationLink{
+							{Id: "idp>app", SourceId: "idp", TargetId: "app", Authentication: types.Token},
+						},
+					},
+					"app": {
+						Id:              "app",
+						Title:           "App",
+						Confidentiality: testCase.downstreamConfidentiality,
+					},
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Weak Password Policy</b> risk at <b>IdP</b>", risks[0].Title)
+				assert.Equal(t, []string{"idp", "app"}, risks[0].DataBreachTechnicalAssetIDs)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId),
+					`  - protects downstream technical asset "app" (confidentiality `+testCase.downstreamConfidentiality.String()+`)`)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
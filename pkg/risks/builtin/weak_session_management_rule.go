@@ -0,0 +1,162 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type WeakSessionManagementRule struct{}
+
+func NewWeakSessionManagementRule() *WeakSessionManagementRule {
+	return &WeakSessionManagementRule{}
+}
+
+func (*WeakSessionManagementRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "weak-session-management",
+		Title: "Weak Session Management",
+		Description: "Web applications and web services authenticating browser clients via session identifiers are prone to session fixation and session hijacking " +
+			"when the session identifier is transferred unencrypted or across network trust boundaries.",
+		Impact: "If this risk is unmitigated, attackers might be able to steal or fixate session identifiers " +
+			"and thereby act on behalf of the authenticated users.",
+		ASVS:       "V3 - Session Management Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Session_Management_Cheat_Sheet.html",
+		Action:     "Session Management Hardening",
+		Mitigation: "Only transfer session identifiers via encrypted connections, set the 'Secure', 'HttpOnly', and 'SameSite' attributes on session cookies, " +
+			"renew the session identifier after login, and apply idle and absolute session timeouts.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Development,
+		STRIDE:   types.Spoofing,
+		DetectionLogic: "In-scope web applications and web services having incoming communication links from browsers authenticated via session identifier, " +
+			"which are either unencrypted or cross a network trust boundary.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality and integrity ratings of the data processed by the web application or web service. " +
+			"The likelihood is higher when the session identifier is transferred unencrypted.",
+		FalsePositives: "Session identifiers protected by additional means (like binding to client certificates) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        384,
+		Labels:                     []string{"appsec", "identity"},
+	}
+}
+
+func (*WeakSessionManagementRule) SupportedTags() []string {
+	return []string{}
+}
+
+func (r *WeakSessionManagementRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		if r.skipAsset(techAsset) {
+			continue
+		}
+
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isWeakSession(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, techAsset, targetAsset, commLink))
+		}
+	}
+	return risks, nil
+}
+
+func (r *WeakSessionManagementRule) skipAsset(techAsset *types.TechnicalAsset) bool {
+	return !techAsset.Technologies.GetAttribute(types.Browser)
+}
+
+// isWeakSession checks whether the browser sends a session identifier to an in-scope web application or web service
+// either unencrypted or across a network trust boundary
+func (r *WeakSessionManagementRule) isWeakSession(parsedModel *types.Model, commLink *types.CommunicationLink, targetAsset *types.TechnicalAsset) bool {
+	if targetAsset.OutOfScope || commLink.Authentication != types.SessionId {
+		return false
+	}
+	if !targetAsset.Technologies.GetAttribute(types.WebApplication) && !targetAsset.Technologies.GetAttribute(types.IsWebService) {
+		return false
+	}
+	return !commLink.Protocol.IsEncrypted() || isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink)
+}
+
+func (r *WeakSessionManagementRule) impact(parsedModel *types.Model, targetAsset *types.TechnicalAsset) types.RiskExploitationImpact {
+	if parsedModel.HighestProcessedConfidentiality(targetAsset) == types.StrictlyConfidential ||
+		parsedModel.HighestProcessedIntegrity(targetAsset) == types.MissionCritical {
+		return types.HighImpact
+	}
+	return types.MediumImpact
+}
+
+func (r *WeakSessionManagementRule) createRisk(parsedModel *types.Model, techAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) *types.Risk {
+	likelihood := types.Likely
+	if !commLink.Protocol.IsEncrypted() {
+		likelihood = types.VeryLikely
+	}
+	impact := r.impact(parsedModel, targetAsset)
+	title := "<b>Weak Session Management</b> risk at <b>" + targetAsset.Title + "</b> for browser <b>" + techAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + techAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *WeakSessionManagementRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range techAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+techAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			if r.skipAsset(techAsset) {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || !r.isWeakSession(parsedModel, commLink, targetAsset) {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - authentication: %v (=%v)", commLink.Authentication, types.SessionId),
+				fmt.Sprintf("  - source: technical asset %q", techAsset.Id),
+				fmt.Sprintf("    - technology: %v (has %q)", techAsset.Technologies.String(), types.Browser),
+				fmt.Sprintf("  - target: technical asset %q", targetAsset.Id),
+				fmt.Sprintf("    - out of scope: %v (=false)", targetAsset.OutOfScope),
+				fmt.Sprintf("    - technology: %v (has either [%q, %q])", targetAsset.Technologies.String(), types.WebApplication, types.IsWebService),
+			}...)
+
+			if !commLink.Protocol.IsEncrypted() {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because protocol %v is not encrypted", types.VeryLikely, commLink.Protocol))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the link crosses a network trust boundary", types.Likely))
+			}
+			if impact := r.impact(parsedModel, targetAsset); impact == types.HighImpact {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v because %v or %v data is processed", impact, types.StrictlyConfidential, types.MissionCritical))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", impact))
+			}
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestWeakSessionManagementRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewWeakSessionManagementRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type WeakSessionManagementRuleTest struct {
+	sourceTechnology  string
+	targetTechnology  string
+	authentication    types.Authentication
+	protocol          types.Protocol
+	sameTrustBoundary bool
+	confidentiality   types.Confidentiality
+
+	riskCreated         bool
+	expectedLikelihood  types.RiskExploitationLikelihood
+	expectedImpact      types.RiskExploitationImpact
+	expectedExplanation string
+}
+
+func TestWeakSessionManagementRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]WeakSessionManagementRuleTest{
+		"source not a browser": {
+			sourceTechnology: types.WebServer,
+			targetTechnology: types.WebApplication,
+			authentication:   types.SessionId,
+			protocol:         types.HTTP,
+			riskCreated:      false,
+		},
+		"target not a web application": {
+			sourceTechnology: types.Browser,
+			targetTechnology: types.Database,
+			authentication:   types.SessionId,
+			protocol:         types.HTTP,
+			riskCreated:      false,
+		},
+		"not authenticated via session id": {
+			sourceTechnology: types.Browser,
+			targetTechnology: types.WebApplication,
+			authentication:   types.Token,
+			protocol:         types.HTTP,
+			riskCreated:      false,
+		},
+		"encrypted within same trust boundary": {
+			sourceTechnology:  types.Browser,
+			targetTechnology:  types.WebApplication,
+			authentication:    types.SessionId,
+			protocol:          types.HTTPS,
+			sameTrustBoundary: true,
+			riskCreated:       false,
+		},
+		"encrypted across trust boundary": {
+			sourceTechnology:    types.Browser,
+			targetTechnology:    types.WebServiceREST,
+			authentication:      types.SessionId,
+			protocol:            types.HTTPS,
+			riskCreated:         true,
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.MediumImpact,
+			expectedExplanation: "    - likelihood is likely because the link crosses a network trust boundary",
+		},
+		"unencrypted within same trust boundary": {
+			sourceTechnology:    types.Browser,
+			targetTechnology:    types.WebApplication,
+			authentication:      types.SessionId,
+			protocol:            types.HTTP,
+			sameTrustBoundary:   true,
+			confidentiality:     types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedLikelihood:  types.VeryLikely,
+			expectedImpact:      types.HighImpact,
+			expectedExplanation: "    - likelihood is very-likely because protocol http is not encrypted",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewWeakSessionManagementRule()
+			commLink := &types.CommunicationLink{
+				Id:             "browser>app",
+				Title:          "Web Access",
+				SourceId:       "browser",
+				TargetId:       "app",
+				Authentication: testCase.authentication,
+				Protocol:       testCase.protocol,
+			}
+			browserBoundary := &types.TrustBoundary{Id: "office", Type: types.NetworkOnPrem, TechnicalAssetsInside: []string{"browser"}}
+			appBoundary := &types.TrustBoundary{Id: "cloud", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"app"}}
+			if testCase.sameTrustBoundary {
+				browserBoundary = appBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"browser": {
+						Id:                 "browser",
+						Title:              "Browser",
+						Technologies:       types.TechnologyList{{Name: testCase.sourceTechnology, Attributes: map[string]bool{testCase.sourceTechnology: true}}},
+						CommunicationLinks: []*types.CommunicationLink{commLink},
+					},
+					"app": {
+						Id:              "app",
+						Title:           "App",
+						Confidentiality: testCase.confidentiality,
+						Technologies: types.TechnologyList{{Name: testCase.targetTechnology, Attributes: map[string]bool{
+							testCase.targetTechnology: true,
+							types.IsWebService:        testCase.targetTechnology == types.WebServiceREST,
+						}}},
+					},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					browserBoundary.Id: browserBoundary,
+					appBoundary.Id:     appBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"browser": browserBoundary,
+					"app":     appBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Weak Session Management</b> risk at <b>App</b> for browser <b>Browser</b> via <b>Web Access</b>", risks[0].Title)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), testCase.expectedExplanation)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
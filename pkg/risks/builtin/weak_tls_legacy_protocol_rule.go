@@ -0,0 +1,154 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type WeakTlsLegacyProtocolRule struct{}
+
+func NewWeakTlsLegacyProtocolRule() *WeakTlsLegacyProtocolRule {
+	return &WeakTlsLegacyProtocolRule{}
+}
+
+func (*WeakTlsLegacyProtocolRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{
+		ID:    "weak-tls-legacy-protocol",
+		Title: "Weak TLS or Legacy Protocol",
+		Description: "Communication links using deprecated transport protocols (like plain LDAP, FTP, Telnet, or HTTP) or " +
+			"terminating at technical assets which still accept the outdated TLS versions 1.0 or 1.1 do not adequately protect the data transferred.",
+		Impact: "If this risk is unmitigated, attackers in a man-in-the-middle position might be able to eavesdrop on " +
+			"or tamper with the data transferred, including credentials.",
+		ASVS:       "V9 - Communication Verification Requirements",
+		CheatSheet: "https://cheatsheetseries.owasp.org/cheatsheets/Transport_Layer_Security_Cheat_Sheet.html",
+		Action:     "Modern Transport Protocols",
+		Mitigation: "Replace legacy protocols by their encrypted successors (like LDAPS, SFTP, SSH, or HTTPS) and " +
+			"disable TLS versions below 1.2 on all technical assets.",
+		Check:    "Are recommendations from the linked cheat sheet and referenced ASVS chapter applied?",
+		Function: types.Operations,
+		STRIDE:   types.InformationDisclosure,
+		DetectionLogic: "Communication links to in-scope technical assets using the protocols 'http', 'ftp', or 'ldap', being tagged with 'telnet', " +
+			"or using an encrypted protocol while the source or target technical asset is tagged with 'tls1.0' or 'tls1.1'.",
+		RiskAssessment: "The risk rating depends on the highest confidentiality rating of the data assets transferred via the communication link. " +
+			"The likelihood is higher when the communication link crosses a network trust boundary.",
+		FalsePositives: "Legacy protocols tunneled through an otherwise encrypted channel (like a VPN) " +
+			"can be considered as false positives after individual review.",
+		ModelFailurePossibleReason: false,
+		CWE:                        327,
+		Labels:                     []string{"network", "compliance:pci"},
+	}
+}
+
+func (*WeakTlsLegacyProtocolRule) SupportedTags() []string {
+	return []string{"telnet", "tls1.0", "tls1.1"}
+}
+
+func (r *WeakTlsLegacyProtocolRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || targetAsset.OutOfScope {
+				continue
+			}
+
+			weakness := r.weakness(sourceAsset, targetAsset, commLink)
+			if weakness == "" {
+				continue
+			}
+
+			risks = append(risks, r.createRisk(parsedModel, sourceAsset, targetAsset, commLink, weakness))
+		}
+	}
+	return risks, nil
+}
+
+// weakness returns a short description of the weak transport configuration of the communication link or an empty
+// string if there is none
+func (r *WeakTlsLegacyProtocolRule) weakness(sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink) string {
+	switch {
+	case commLink.IsTaggedWithAny("telnet"):
+		return "legacy protocol telnet"
+	case commLink.Protocol == types.HTTP || commLink.Protocol == types.FTP || commLink.Protocol == types.LDAP:
+		return "legacy protocol " + commLink.Protocol.String()
+	case commLink.Protocol.IsEncrypted() && (sourceAsset.IsTaggedWithAny("tls1.0", "tls1.1") || targetAsset.IsTaggedWithAny("tls1.0", "tls1.1")):
+		return "weak TLS version"
+	}
+	return ""
+}
+
+func (r *WeakTlsLegacyProtocolRule) createRisk(parsedModel *types.Model, sourceAsset *types.TechnicalAsset, targetAsset *types.TechnicalAsset, commLink *types.CommunicationLink, weakness string) *types.Risk {
+	likelihood := types.Unlikely
+	if isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink) {
+		likelihood = types.Likely
+	}
+	impact := types.LowImpact
+	switch parsedModel.HighestCommunicationLinkConfidentiality(commLink) {
+	case types.StrictlyConfidential:
+		impact = types.HighImpact
+	case types.Confidential:
+		impact = types.MediumImpact
+	}
+	title := "<b>Weak TLS or Legacy Protocol</b> (<u>" + weakness + "</u>) risk at <b>" + sourceAsset.Title + "</b> calling <b>" + targetAsset.Title + "</b> " +
+		"via <b>" + commLink.Title + "</b>"
+	risk := &types.Risk{
+		CategoryId:                      r.Category().ID,
+		Severity:                        types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:          likelihood,
+		ExploitationImpact:              impact,
+		Title:                           title,
+		MostRelevantTechnicalAssetId:    targetAsset.Id,
+		MostRelevantCommunicationLinkId: commLink.Id,
+		DataBreachProbability:           types.Possible,
+		DataBreachTechnicalAssetIDs:     []string{sourceAsset.Id, targetAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + commLink.Id + "@" + sourceAsset.Id + "@" + targetAsset.Id
+	return risk
+}
+
+func (r *WeakTlsLegacyProtocolRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	categoryId := r.Category().ID
+	explanation := make([]string, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		sourceAsset := parsedModel.TechnicalAssets[id]
+		for _, commLink := range sourceAsset.CommunicationLinksSorted() {
+			if !strings.EqualFold(risk, categoryId+"@"+commLink.Id+"@"+sourceAsset.Id+"@"+commLink.TargetId) && !strings.EqualFold(risk, categoryId+"@*") {
+				continue
+			}
+
+			targetAsset, ok := parsedModel.TechnicalAssets[commLink.TargetId]
+			if !ok || targetAsset.OutOfScope {
+				continue
+			}
+
+			weakness := r.weakness(sourceAsset, targetAsset, commLink)
+			if weakness == "" {
+				continue
+			}
+
+			if len(explanation) > 0 {
+				explanation = append(explanation, "")
+			}
+
+			explanation = append(explanation, []string{
+				fmt.Sprintf("communication link %q", commLink.Id),
+				fmt.Sprintf("  - protocol: %v, tags: %v", commLink.Protocol, commLink.Tags),
+				fmt.Sprintf("  - source: technical asset %q (tags: %v)", sourceAsset.Id, sourceAsset.Tags),
+				fmt.Sprintf("  - target: technical asset %q (tags: %v)", targetAsset.Id, targetAsset.Tags),
+				fmt.Sprintf("  - uses %v", weakness),
+			}...)
+
+			if isAcrossTrustBoundaryNetworkOnly(parsedModel, commLink) {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v because the communication link crosses a network trust boundary", types.Likely))
+			} else {
+				explanation = append(explanation, fmt.Sprintf("    - likelihood is %v (default)", types.Unlikely))
+			}
+			explanation = append(explanation, fmt.Sprintf("    - impact depends on the highest confidentiality %v of the data transferred", parsedModel.HighestCommunicationLinkConfidentiality(commLink)))
+		}
+	}
+
+	return explanation
+}
@@ -0,0 +1,138 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestWeakTlsLegacyProtocolRuleGenerateRisksEmptyModelNotRisksCreated(t *testing.T) {
+	rule := NewWeakTlsLegacyProtocolRule()
+
+	risks, err := rule.GenerateRisks(&types.Model{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, risks)
+}
+
+type WeakTlsLegacyProtocolRuleTest struct {
+	protocol            types.Protocol
+	linkTags            []string
+	targetTags          []string
+	targetOutOfScope    bool
+	sameTrustBoundary   bool
+	dataConfidentiality types.Confidentiality
+
+	riskCreated        bool
+	expectedWeakness   string
+	expectedLikelihood types.RiskExploitationLikelihood
+	expectedImpact     types.RiskExploitationImpact
+}
+
+func TestWeakTlsLegacyProtocolRuleGenerateRisks(t *testing.T) {
+	testCases := map[string]WeakTlsLegacyProtocolRuleTest{
+		"modern encrypted protocol": {
+			protocol:    types.HTTPS,
+			riskCreated: false,
+		},
+		"target out of scope": {
+			protocol:         types.FTP,
+			targetOutOfScope: true,
+			riskCreated:      false,
+		},
+		"plain ldap across trust boundary": {
+			protocol:            types.LDAP,
+			dataConfidentiality: types.StrictlyConfidential,
+			riskCreated:         true,
+			expectedWeakness:    "legacy protocol ldap",
+			expectedLikelihood:  types.Likely,
+			expectedImpact:      types.HighImpact,
+		},
+		"http within same trust boundary": {
+			protocol:            types.HTTP,
+			sameTrustBoundary:   true,
+			dataConfidentiality: types.Confidential,
+			riskCreated:         true,
+			expectedWeakness:    "legacy protocol http",
+			expectedLikelihood:  types.Unlikely,
+			expectedImpact:      types.MediumImpact,
+		},
+		"telnet tagged link": {
+			protocol:           types.TEXT,
+			linkTags:           []string{"telnet"},
+			riskCreated:        true,
+			expectedWeakness:   "legacy protocol telnet",
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.LowImpact,
+		},
+		"outdated tls version at target": {
+			protocol:           types.HTTPS,
+			targetTags:         []string{"tls1.0"},
+			riskCreated:        true,
+			expectedWeakness:   "weak TLS version",
+			expectedLikelihood: types.Likely,
+			expectedImpact:     types.LowImpact,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := NewWeakTlsLegacyProtocolRule()
+			commLink := &types.CommunicationLink{
+				Id:             "client>server",
+				Title:          "Access",
+				SourceId:       "client",
+				TargetId:       "server",
+				Protocol:       testCase.protocol,
+				Tags:           testCase.linkTags,
+				DataAssetsSent: []string{"data"},
+			}
+			clientBoundary := &types.TrustBoundary{Id: "outside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"client"}}
+			serverBoundary := &types.TrustBoundary{Id: "inside", Type: types.NetworkCloudProvider, TechnicalAssetsInside: []string{"server"}}
+			if testCase.sameTrustBoundary {
+				clientBoundary = serverBoundary
+			}
+			model := &types.Model{
+				TechnicalAssets: map[string]*types.TechnicalAsset{
+					"client": {
+						Id:                 "client",
+						Title:              "Client",
+						CommunicationLinks: []*types.CommunicationLink{commLink},
+					},
+					"server": {
+						Id:         "server",
+						Title:      "Server",
+						OutOfScope: testCase.targetOutOfScope,
+						Tags:       testCase.targetTags,
+					},
+				},
+				DataAssets: map[string]*types.DataAsset{
+					"data": {Id: "data", Confidentiality: testCase.dataConfidentiality},
+				},
+				TrustBoundaries: map[string]*types.TrustBoundary{
+					clientBoundary.Id: clientBoundary,
+					serverBoundary.Id: serverBoundary,
+				},
+				DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+					"client": clientBoundary,
+					"server": serverBoundary,
+				},
+			}
+
+			risks, err := rule.GenerateRisks(model)
+
+			assert.Nil(t, err)
+			if testCase.riskCreated {
+				assert.Len(t, risks, 1)
+				assert.Equal(t, testCase.expectedLikelihood, risks[0].ExploitationLikelihood)
+				assert.Equal(t, testCase.expectedImpact, risks[0].ExploitationImpact)
+				assert.Equal(t, "<b>Weak TLS or Legacy Protocol</b> (<u>"+testCase.expectedWeakness+"</u>) risk at <b>Client</b> calling <b>Server</b> via <b>Access</b>", risks[0].Title)
+				assert.Equal(t, "weak-tls-legacy-protocol@client>server@client@server", risks[0].SyntheticId)
+				assert.Contains(t, rule.ExplainRisk(model, risks[0].SyntheticId), "  - uses "+testCase.expectedWeakness)
+			} else {
+				assert.Empty(t, risks)
+			}
+		})
+	}
+}
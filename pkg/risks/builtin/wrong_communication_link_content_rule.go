@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -30,6 +31,7 @@ func (*WrongCommunicationLinkContentRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model"},
 	}
 }
 
@@ -93,3 +95,37 @@ func (r *WrongCommunicationLinkContentRule) createRisk(technicalAsset *types.Tec
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id + "@" + commLink.Id
 	return risk
 }
+
+func (r *WrongCommunicationLinkContentRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		commLink := explainedCommunicationLink(parsedModel, generatedRisk)
+		if commLink == nil {
+			continue
+		}
+
+		targetAsset := parsedModel.TechnicalAssets[commLink.TargetId]
+		explanation = appendExplanation(explanation, fmt.Sprintf("communication link %q", commLink.Id))
+		if commLink.Readonly && len(commLink.DataAssetsReceived) == 0 && len(commLink.DataAssetsSent) > 0 {
+			explanation = append(explanation, fmt.Sprintf("  - readonly: %v, but data assets sent: %v, received: %v (none)", commLink.Readonly, commLink.DataAssetsSent, commLink.DataAssetsReceived))
+		}
+		if !commLink.Readonly && len(commLink.DataAssetsSent) == 0 && len(commLink.DataAssetsReceived) > 0 {
+			explanation = append(explanation, fmt.Sprintf("  - readonly: %v, but data assets sent: %v (none), received: %v", commLink.Readonly, commLink.DataAssetsSent, commLink.DataAssetsReceived))
+		}
+		if commLink.Protocol == types.InterProcessCommunication && targetAsset.Type != types.Process {
+			explanation = append(explanation, fmt.Sprintf("  - protocol: %v, but target type: %v (expected %v)", commLink.Protocol, targetAsset.Type, types.Process))
+		}
+		if commLink.Protocol == types.InProcessLibraryCall && !targetAsset.Technologies.GetAttribute(types.Library) {
+			explanation = append(explanation, fmt.Sprintf("  - protocol: %v, but target technology: %v (expected %q)", commLink.Protocol, targetAsset.Technologies.String(), types.Library))
+		}
+		if commLink.Protocol == types.LocalFileAccess && !targetAsset.Technologies.GetAttribute(types.LocalFileSystem) {
+			explanation = append(explanation, fmt.Sprintf("  - protocol: %v, but target technology: %v (expected %q)", commLink.Protocol, targetAsset.Technologies.String(), types.LocalFileSystem))
+		}
+		if commLink.Protocol == types.ContainerSpawning && targetAsset.Machine != types.Container {
+			explanation = append(explanation, fmt.Sprintf("  - protocol: %v, but target machine: %v (expected %v)", commLink.Protocol, targetAsset.Machine, types.Container))
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+	}
+
+	return explanation
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"github.com/threagile/threagile/pkg/types"
 )
 
@@ -29,6 +30,7 @@ func (*WrongTrustBoundaryContentRule) Category() *types.RiskCategory {
 		FalsePositives:             "Usually no false positives as this looks like an incomplete model.",
 		ModelFailurePossibleReason: true,
 		CWE:                        1008,
+		Labels:                     []string{"model"},
 	}
 }
 
@@ -66,3 +68,22 @@ func (r *WrongTrustBoundaryContentRule) createRisk(technicalAsset *types.Technic
 	risk.SyntheticId = risk.CategoryId + "@" + technicalAsset.Id
 	return risk
 }
+
+func (r *WrongTrustBoundaryContentRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	explanation := make([]string, 0)
+	for _, generatedRisk := range explainedRisks(r, parsedModel, risk) {
+		techAsset := parsedModel.TechnicalAssets[generatedRisk.MostRelevantTechnicalAssetId]
+		explanation = appendExplanation(explanation,
+			fmt.Sprintf("technical asset %q", techAsset.Id),
+			fmt.Sprintf("  - machine: %v (neither %v nor %v)", techAsset.Machine, types.Container, types.Serverless))
+		for _, id := range sortedTrustBoundaryIDs(parsedModel) {
+			trustBoundary := parsedModel.TrustBoundaries[id]
+			if trustBoundary.Type == types.NetworkPolicyNamespaceIsolation && contains(trustBoundary.TechnicalAssetsInside, techAsset.Id) {
+				explanation = append(explanation, fmt.Sprintf("  - inside trust boundary %q of type %v", trustBoundary.Id, trustBoundary.Type))
+			}
+		}
+		explanation = append(explanation, fmt.Sprintf("    - impact is %v (default)", types.LowImpact))
+	}
+
+	return explanation
+}
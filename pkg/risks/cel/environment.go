@@ -0,0 +1,123 @@
+package cel
+
+import (
+	"fmt"
+
+	celgo "github.com/google/cel-go/cel"
+	"gopkg.in/yaml.v3"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func newEnvironment() (*celgo.Env, error) {
+	return celgo.NewEnv(
+		celgo.Variable("asset", celgo.MapType(celgo.StringType, celgo.DynType)),
+		celgo.Variable("model", celgo.MapType(celgo.StringType, celgo.DynType)),
+	)
+}
+
+func compile(env *celgo.Env, name string, expression string, outputType *celgo.Type) (celgo.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid %v expression: %w", name, issues.Err())
+	}
+
+	if !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(celgo.DynType) {
+		return nil, fmt.Errorf("%v expression must evaluate to %v, not %v", name, outputType, ast.OutputType())
+	}
+
+	program, programError := env.Program(ast)
+	if programError != nil {
+		return nil, fmt.Errorf("invalid %v expression: %w", name, programError)
+	}
+
+	return program, nil
+}
+
+func evalBool(program celgo.Program, variables map[string]any) (bool, error) {
+	value, _, evalError := program.Eval(variables)
+	if evalError != nil {
+		return false, evalError
+	}
+
+	result, ok := value.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expected bool result, got %v", value.Type())
+	}
+
+	return result, nil
+}
+
+func evalString(program celgo.Program, variables map[string]any) (string, error) {
+	value, _, evalError := program.Eval(variables)
+	if evalError != nil {
+		return "", evalError
+	}
+
+	result, ok := value.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("expected string result, got %v", value.Type())
+	}
+
+	return result, nil
+}
+
+// modelVariable converts the parsed model into generic maps the same way the script risk rules do
+func modelVariable(parsedModel *types.Model) (map[string]any, error) {
+	result := make(map[string]any)
+	data, marshalError := yaml.Marshal(parsedModel)
+	if marshalError != nil {
+		return nil, marshalError
+	}
+
+	unmarshalError := yaml.Unmarshal(data, &result)
+	if unmarshalError != nil {
+		return nil, unmarshalError
+	}
+
+	return result, nil
+}
+
+// assetVariable provides all values of the technical asset (even empty ones, so expressions need no presence checks)
+// along with the values derived from the model
+func assetVariable(parsedModel *types.Model, techAsset *types.TechnicalAsset) map[string]any {
+	technologies := make([]string, 0)
+	for _, technology := range techAsset.Technologies {
+		technologies = append(technologies, technology.Name)
+	}
+
+	trustBoundary := ""
+	if boundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAsset.Id]; ok && boundary != nil {
+		trustBoundary = boundary.Id
+	}
+
+	return map[string]any{
+		"id":                      techAsset.Id,
+		"title":                   techAsset.Title,
+		"usage":                   techAsset.Usage.String(),
+		"type":                    techAsset.Type.String(),
+		"size":                    techAsset.Size.String(),
+		"technologies":            technologies,
+		"machine":                 techAsset.Machine.String(),
+		"internet":                techAsset.Internet,
+		"multi_tenant":            techAsset.MultiTenant,
+		"redundant":               techAsset.Redundant,
+		"custom_developed_parts":  techAsset.CustomDevelopedParts,
+		"out_of_scope":            techAsset.OutOfScope,
+		"used_as_client_by_human": techAsset.UsedAsClientByHuman,
+		"encryption":              techAsset.Encryption.String(),
+		"owner":                   techAsset.Owner,
+		"location":                techAsset.Location,
+		"confidentiality":         techAsset.Confidentiality.String(),
+		"integrity":               techAsset.Integrity.String(),
+		"availability":            techAsset.Availability.String(),
+		"tags":                    append(make([]string, 0), techAsset.Tags...),
+		"data_assets_processed":   append(make([]string, 0), techAsset.DataAssetsProcessed...),
+		"data_assets_stored":      append(make([]string, 0), techAsset.DataAssetsStored...),
+		"raa":                     techAsset.RAA,
+		"highest_confidentiality": parsedModel.HighestProcessedConfidentiality(techAsset).String(),
+		"highest_integrity":       parsedModel.HighestProcessedIntegrity(techAsset).String(),
+		"highest_availability":    parsedModel.HighestProcessedAvailability(techAsset).String(),
+		"trust_boundary":          trustBoundary,
+	}
+}
@@ -0,0 +1,49 @@
+package cel
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// LoadRiskRules loads all CEL risk rules (*.yaml, *.yml) found in the folder and its sub-folders
+func LoadRiskRules(folder string) (types.RiskRules, error) {
+	rules := make(types.RiskRules)
+	if len(folder) == 0 {
+		return rules, nil
+	}
+
+	fileSystem := os.DirFS(folder)
+	walkError := fs.WalkDir(fileSystem, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		extension := strings.ToLower(filepath.Ext(path))
+		if entry.IsDir() || (extension != ".yaml" && extension != ".yml") {
+			return nil
+		}
+
+		newRule := new(RiskRule).Init()
+		loadError := newRule.Load(fileSystem, path, entry)
+		if loadError != nil {
+			return loadError
+		}
+
+		if newRule.Category().ID == "" {
+			return nil
+		}
+
+		rules[newRule.Category().ID] = newRule
+		return nil
+	})
+
+	if walkError != nil {
+		return nil, walkError
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,223 @@
+package cel
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	celgo "github.com/google/cel-go/cel"
+	"gopkg.in/yaml.v3"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const (
+	defaultLikelihood = "likely"
+	defaultImpact     = "medium"
+	defaultConfidence = "high"
+)
+
+// RiskRule is a user-defined risk rule whose detection logic and rating are given as CEL expressions.
+// The expressions are evaluated once per technical asset, with the variables 'asset' (the technical asset
+// including some derived values) and 'model' (the complete parsed model) available.
+type RiskRule struct {
+	category      types.RiskCategory
+	supportedTags []string
+	detection     celgo.Program
+	likelihood    celgo.Program
+	impact        celgo.Program
+	confidence    celgo.Program
+}
+
+func (what *RiskRule) Init() *RiskRule {
+	return what
+}
+
+func (what *RiskRule) ParseFromData(text []byte) (*RiskRule, error) {
+	categoryError := yaml.Unmarshal(text, &what.category)
+	if categoryError != nil {
+		return nil, categoryError
+	}
+
+	var rule struct {
+		SupportedTags []string `yaml:"supported-tags"`
+		Risk          struct {
+			Detection  string `yaml:"detection"`
+			Likelihood string `yaml:"likelihood"`
+			Impact     string `yaml:"impact"`
+			Confidence string `yaml:"confidence"`
+		} `yaml:"risk"`
+	}
+
+	ruleError := yaml.Unmarshal(text, &rule)
+	if ruleError != nil {
+		return nil, ruleError
+	}
+
+	if len(strings.TrimSpace(rule.Risk.Detection)) == 0 {
+		return nil, fmt.Errorf("missing detection expression")
+	}
+
+	if len(strings.TrimSpace(rule.Risk.Likelihood)) == 0 {
+		rule.Risk.Likelihood = fmt.Sprintf("%q", defaultLikelihood)
+	}
+
+	if len(strings.TrimSpace(rule.Risk.Impact)) == 0 {
+		rule.Risk.Impact = fmt.Sprintf("%q", defaultImpact)
+	}
+
+	if len(strings.TrimSpace(rule.Risk.Confidence)) == 0 {
+		rule.Risk.Confidence = fmt.Sprintf("%q", defaultConfidence)
+	}
+
+	env, envError := newEnvironment()
+	if envError != nil {
+		return nil, envError
+	}
+
+	var compileError error
+	what.supportedTags = rule.SupportedTags
+	what.detection, compileError = compile(env, "detection", rule.Risk.Detection, celgo.BoolType)
+	if compileError != nil {
+		return nil, compileError
+	}
+
+	what.likelihood, compileError = compile(env, "likelihood", rule.Risk.Likelihood, celgo.StringType)
+	if compileError != nil {
+		return nil, compileError
+	}
+
+	what.impact, compileError = compile(env, "impact", rule.Risk.Impact, celgo.StringType)
+	if compileError != nil {
+		return nil, compileError
+	}
+
+	what.confidence, compileError = compile(env, "confidence", rule.Risk.Confidence, celgo.StringType)
+	if compileError != nil {
+		return nil, compileError
+	}
+
+	return what, nil
+}
+
+func (what *RiskRule) Category() *types.RiskCategory {
+	return &what.category
+}
+
+func (what *RiskRule) SupportedTags() []string {
+	return what.supportedTags
+}
+
+func (what *RiskRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	if what.detection == nil {
+		return nil, fmt.Errorf("no detection expression found in risk rule")
+	}
+
+	modelValue, modelError := modelVariable(parsedModel)
+	if modelError != nil {
+		return nil, modelError
+	}
+
+	risks := make([]*types.Risk, 0)
+	for _, id := range parsedModel.SortedTechnicalAssetIDs() {
+		techAsset := parsedModel.TechnicalAssets[id]
+		variables := map[string]any{
+			"asset": assetVariable(parsedModel, techAsset),
+			"model": modelValue,
+		}
+
+		detected, detectionError := evalBool(what.detection, variables)
+		if detectionError != nil {
+			return nil, fmt.Errorf("error evaluating detection for technical asset %q: %w", techAsset.Id, detectionError)
+		}
+
+		if !detected {
+			continue
+		}
+
+		risk, riskError := what.createRisk(techAsset, variables)
+		if riskError != nil {
+			return nil, fmt.Errorf("error rating risk for technical asset %q: %w", techAsset.Id, riskError)
+		}
+
+		risks = append(risks, risk)
+	}
+
+	return risks, nil
+}
+
+func (what *RiskRule) createRisk(techAsset *types.TechnicalAsset, variables map[string]any) (*types.Risk, error) {
+	likelihoodText, likelihoodError := evalString(what.likelihood, variables)
+	if likelihoodError != nil {
+		return nil, likelihoodError
+	}
+
+	likelihood, parseLikelihoodError := types.ParseRiskExploitationLikelihood(likelihoodText)
+	if parseLikelihoodError != nil {
+		return nil, parseLikelihoodError
+	}
+
+	impactText, impactError := evalString(what.impact, variables)
+	if impactError != nil {
+		return nil, impactError
+	}
+
+	impact, parseImpactError := types.ParseRiskExploitationImpact(impactText)
+	if parseImpactError != nil {
+		return nil, parseImpactError
+	}
+
+	confidenceText, confidenceError := evalString(what.confidence, variables)
+	if confidenceError != nil {
+		return nil, confidenceError
+	}
+
+	confidence, parseConfidenceError := types.ParseRiskConfidence(confidenceText)
+	if parseConfidenceError != nil {
+		return nil, parseConfidenceError
+	}
+
+	risk := &types.Risk{
+		CategoryId:                   what.category.ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Confidence:                   confidence,
+		Title:                        "<b>" + what.category.Title + "</b> risk at <b>" + techAsset.Title + "</b>",
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        types.Possible,
+		DataBreachTechnicalAssetIDs:  []string{techAsset.Id},
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + techAsset.Id
+	return risk, nil
+}
+
+func (what *RiskRule) Load(fileSystem fs.FS, path string, entry fs.DirEntry) error {
+	if entry.IsDir() {
+		return nil
+	}
+
+	loadError := what.loadRiskRule(fileSystem, path)
+	if loadError != nil {
+		return loadError
+	}
+
+	return nil
+}
+
+func (what *RiskRule) loadRiskRule(fileSystem fs.FS, filename string) error {
+	ruleFilename := filepath.Clean(filename)
+
+	ruleData, ruleReadError := fs.ReadFile(fileSystem, ruleFilename)
+	if ruleReadError != nil {
+		return fmt.Errorf("error reading risk rule: %w", ruleReadError)
+	}
+
+	_, parseError := what.ParseFromData(ruleData)
+	if parseError != nil {
+		return fmt.Errorf("error parsing CEL risk rule from %q: %w", ruleFilename, parseError)
+	}
+
+	return nil
+}
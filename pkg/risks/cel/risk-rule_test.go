@@ -0,0 +1,131 @@
+package cel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testRule = `
+id: unencrypted-customer-database
+title: Unencrypted Customer Database
+supported-tags:
+  - customer
+risk:
+  detection: '!asset.out_of_scope && asset.type == "datastore" && "customer" in asset.tags && asset.encryption == "none"'
+  likelihood: '"unlikely"'
+  impact: 'asset.highest_confidentiality == "strictly-confidential" ? "high" : "medium"'
+  confidence: '"medium"'
+`
+
+func testModel() *types.Model {
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"customer-db": {Id: "customer-db", Title: "Customer DB", Type: types.Datastore, Tags: []string{"customer"}, DataAssetsProcessed: []string{"customers"}, DataAssetsStored: []string{"customers"}},
+			"order-db":    {Id: "order-db", Title: "Order DB", Type: types.Datastore, Tags: []string{"customer"}, Encryption: types.Transparent},
+			"frontend":    {Id: "frontend", Title: "Frontend", Type: types.Process, Tags: []string{"customer"}},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"customers": {Id: "customers", Confidentiality: types.StrictlyConfidential},
+		},
+	}
+}
+
+func TestRiskRuleParseFromData(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData([]byte(testRule))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "unencrypted-customer-database", rule.Category().ID)
+	assert.Equal(t, "Unencrypted Customer Database", rule.Category().Title)
+	assert.Equal(t, []string{"customer"}, rule.SupportedTags())
+}
+
+func TestRiskRuleParseFromDataInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"missing detection":     "id: test\nrisk:\n  impact: '\"high\"'\n",
+		"invalid syntax":        "id: test\nrisk:\n  detection: 'asset.type =='\n",
+		"non-bool detection":    "id: test\nrisk:\n  detection: 'asset.id + \"x\"'\n",
+		"non-string rating":     "id: test\nrisk:\n  detection: 'true'\n  impact: '1 + 2'\n",
+		"unknown variable":      "id: test\nrisk:\n  detection: 'unknown.type == \"datastore\"'\n",
+		"invalid category yaml": "id: [test\n",
+	}
+
+	for name, data := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := new(RiskRule).Init().ParseFromData([]byte(data))
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestRiskRuleGenerateRisks(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData([]byte(testRule))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(testModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "unencrypted-customer-database@customer-db", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Unencrypted Customer Database</b> risk at <b>Customer DB</b>", risks[0].Title)
+	assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, types.CalculateSeverity(types.Unlikely, types.HighImpact), risks[0].Severity)
+	assert.Equal(t, types.MediumConfidence, risks[0].Confidence)
+}
+
+func TestRiskRuleGenerateRisksDefaultRating(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData([]byte("id: test\ntitle: Test\nrisk:\n  detection: 'asset.id == \"frontend\"'\n"))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(testModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, types.HighConfidence, risks[0].Confidence)
+}
+
+func TestRiskRuleGenerateRisksInvalidRating(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData([]byte("id: test\nrisk:\n  detection: 'true'\n  impact: '\"enormous\"'\n"))
+	assert.Nil(t, err)
+
+	_, err = rule.GenerateRisks(testModel())
+
+	assert.NotNil(t, err)
+}
+
+func TestRiskRuleGenerateRisksModelVariable(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData([]byte("id: test\nrisk:\n  detection: 'asset.data_assets_stored.exists(id, model.data_assets[id].confidentiality == \"strictly-confidential\")'\n"))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(testModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "customer-db", risks[0].MostRelevantTechnicalAssetId)
+}
+
+func TestLoadRiskRules(t *testing.T) {
+	folder := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "rule.yaml"), []byte(testRule), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "readme.md"), []byte("not a rule"), 0600))
+
+	rules, err := LoadRiskRules(folder)
+
+	assert.Nil(t, err)
+	assert.Len(t, rules, 1)
+	assert.Contains(t, rules, "unencrypted-customer-database")
+}
+
+func TestLoadRiskRulesNoFolder(t *testing.T) {
+	rules, err := LoadRiskRules("")
+
+	assert.Nil(t, err)
+	assert.Empty(t, rules)
+}
@@ -0,0 +1,125 @@
+package risks
+
+// GetBuiltInRiskRuleProfiles returns the built-in risk rule profiles, each naming a curated subset of the built-in risk rules (by their ID)
+func GetBuiltInRiskRuleProfiles() map[string][]string {
+	return map[string][]string{
+		"minimal": {
+			"accidental-secret-leak",
+			"cross-site-scripting",
+			"missing-authentication",
+			"missing-hardening",
+			"missing-vault",
+			"server-side-request-forgery",
+			"sql-nosql-injection",
+			"unencrypted-asset",
+			"unencrypted-communication",
+			"unguarded-access-from-internet",
+			"unguarded-direct-datastore-access",
+			"untrusted-deserialization",
+		},
+
+		// OWASP Top 10 (2021)
+		"owasp-top10": {
+			// A01 broken access control
+			"broken-object-level-authorization",
+			"cors-misconfiguration",
+			"cross-site-request-forgery",
+			"path-traversal",
+			"unprotected-admin-console",
+			// A02 cryptographic failures
+			"insufficient-key-management",
+			"unencrypted-asset",
+			"unencrypted-communication",
+			"weak-cryptography",
+			"weak-tls-legacy-protocol",
+			// A03 injection
+			"cross-site-scripting",
+			"ldap-injection",
+			"log-injection",
+			"search-query-injection",
+			"server-side-template-injection",
+			"sql-nosql-injection",
+			// A04 insecure design
+			"missing-network-segmentation",
+			"missing-rate-limiting",
+			// A05 security misconfiguration
+			"default-credentials",
+			"exposed-dev-server",
+			"missing-hardening",
+			"weak-csp",
+			"xml-external-entity",
+			// A06 vulnerable and outdated components
+			"dependency-confusion",
+			"third-party-javascript",
+			"unpatched-base-image",
+			// A07 identification and authentication failures
+			"missing-authentication",
+			"missing-authentication-second-factor",
+			"oauth-misconfiguration",
+			"weak-password-policy",
+			"weak-session-management",
+			// A08 software and data integrity failures
+			"code-backdooring",
+			"container-baseimage-backdooring",
+			"insecure-deserialization",
+			"pipeline-poisoning",
+			"unchecked-deployment",
+			"untrusted-deserialization",
+			// A09 security logging and monitoring failures
+			"insufficient-security-monitoring",
+			"sensitive-data-in-logs",
+			// A10 server-side request forgery
+			"server-side-request-forgery",
+		},
+
+		"api-security": {
+			"aggregate-data-exposure",
+			"api-versioning-regression",
+			"broken-object-level-authorization",
+			"cors-misconfiguration",
+			"excessive-data-flow",
+			"graphql-abuse",
+			"insecure-websocket",
+			"missing-api-gateway",
+			"missing-authentication",
+			"missing-identity-propagation",
+			"missing-mtls",
+			"missing-rate-limiting",
+			"oauth-misconfiguration",
+			"server-side-request-forgery",
+			"shadow-asset",
+			"sql-nosql-injection",
+			"third-party-data-sharing",
+			"unencrypted-communication",
+			"weak-session-management",
+		},
+
+		"cloud": {
+			"backup-network-isolation",
+			"container-breakout-lateral-movement",
+			"container-platform-escape",
+			"cross-tenant-data-leak",
+			"data-residency-violation",
+			"iam-role-sharing",
+			"insecure-imds",
+			"kubernetes-cluster-security",
+			"kubernetes-service-account-token",
+			"missing-central-secrets-management",
+			"missing-cloud-hardening",
+			"missing-egress-filtering",
+			"missing-network-segmentation",
+			"missing-pod-security-admission",
+			"mixed-targets-on-shared-runtime",
+			"multi-tenant-isolation",
+			"over-permissioned-serverless",
+			"public-cloud-storage-exposure",
+			"secrets-in-environment",
+			"service-registry-poisoning",
+			"shared-execution-environment-privilege-escalation",
+			"sidecar-host-network",
+			"unencrypted-artifact-storage",
+			"unencrypted-east-west-traffic",
+			"unencrypted-or-exposed-backup",
+		},
+	}
+}
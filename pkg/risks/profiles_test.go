@@ -0,0 +1,19 @@
+package risks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltInRiskRuleProfilesContainKnownRules(t *testing.T) {
+	rules := GetBuiltInRiskRules()
+
+	for name, profile := range GetBuiltInRiskRuleProfiles() {
+		assert.NotEmpty(t, profile, name)
+
+		for _, id := range profile {
+			assert.Contains(t, rules, id, "profile %q", name)
+		}
+	}
+}
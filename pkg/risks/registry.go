@@ -0,0 +1,148 @@
+package risks
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// RuleSource names where a registered risk rule comes from
+type RuleSource string
+
+const (
+	BuiltInRule RuleSource = "built-in"
+	ScriptRule  RuleSource = "script"
+	PluginRule  RuleSource = "plugin"
+)
+
+// RegisteredRule is a risk rule along with its registration metadata
+type RegisteredRule struct {
+	Rule    types.RiskRule
+	Source  RuleSource
+	Enabled bool
+}
+
+func (what *RegisteredRule) Category() *types.RiskCategory {
+	return what.Rule.Category()
+}
+
+func (what *RegisteredRule) SupportedTags() []string {
+	return what.Rule.SupportedTags()
+}
+
+// Registry holds the built-in and plugin risk rules by their ID; registered rules are enabled until disabled
+type Registry struct {
+	rules map[string]*RegisteredRule
+}
+
+func NewRegistry() *Registry {
+	return &Registry{rules: make(map[string]*RegisteredRule)}
+}
+
+// NewBuiltInRegistry returns a registry with all built-in risk rules registered, script risk rules shadow Go risk rules of the same ID
+func NewBuiltInRegistry() *Registry {
+	registry := NewRegistry()
+	for _, rule := range builtInRiskRules() {
+		registry.Register(rule, BuiltInRule)
+	}
+
+	scriptRules, scriptError := GetScriptRiskRules()
+	if scriptError != nil {
+		fmt.Printf("error loading script risk rules: %v\n", scriptError)
+		return registry
+	}
+
+	for _, id := range types.RiskRules(scriptRules).SortedIds() {
+		if shadowed := registry.Register(scriptRules[id], ScriptRule); shadowed != nil {
+			fmt.Printf("WARNING: script risk rule %q shadows built-in risk rule\n", id)
+		}
+	}
+
+	return registry
+}
+
+// Register adds the risk rule as enabled, it returns the registration of the same ID it replaces, if any
+func (what *Registry) Register(rule types.RiskRule, source RuleSource) *RegisteredRule {
+	id := rule.Category().ID
+	shadowed := what.rules[id]
+	what.rules[id] = &RegisteredRule{Rule: rule, Source: source, Enabled: true}
+
+	return shadowed
+}
+
+// RegisterAll adds all risk rules of the same source as enabled
+func (what *Registry) RegisterAll(rules types.RiskRules, source RuleSource) {
+	for _, id := range rules.SortedIds() {
+		what.Register(rules[id], source)
+	}
+}
+
+func (what *Registry) Get(id string) (*RegisteredRule, bool) {
+	rule, ok := what.rules[id]
+	return rule, ok
+}
+
+// List returns all registered risk rules, sorted by ID
+func (what *Registry) List() []*RegisteredRule {
+	result := make([]*RegisteredRule, 0, len(what.rules))
+	for _, id := range what.SortedIds() {
+		result = append(result, what.rules[id])
+	}
+
+	return result
+}
+
+func (what *Registry) SortedIds() []string {
+	ids := make([]string, 0, len(what.rules))
+	for id := range what.rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (what *Registry) Enable(ids ...string) error {
+	return what.setEnabled(true, ids)
+}
+
+func (what *Registry) Disable(ids ...string) error {
+	return what.setEnabled(false, ids)
+}
+
+// RiskRules returns all registered risk rules, enabled or not
+func (what *Registry) RiskRules() types.RiskRules {
+	rules := make(types.RiskRules)
+	for id, registered := range what.rules {
+		rules[id] = registered.Rule
+	}
+
+	return rules
+}
+
+// EnabledRiskRules returns the enabled risk rules only
+func (what *Registry) EnabledRiskRules() types.RiskRules {
+	rules := make(types.RiskRules)
+	for id, registered := range what.rules {
+		if registered.Enabled {
+			rules[id] = registered.Rule
+		}
+	}
+
+	return rules
+}
+
+func (what *Registry) setEnabled(enabled bool, ids []string) error {
+	for _, id := range ids {
+		if _, ok := what.rules[id]; !ok {
+			return fmt.Errorf("unknown risk rule %q", id)
+		}
+	}
+
+	for _, id := range ids {
+		what.rules[id].Enabled = enabled
+	}
+
+	return nil
+}
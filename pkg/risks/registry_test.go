@@ -0,0 +1,91 @@
+package risks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+type registryTestRule struct {
+	id    string
+	title string
+}
+
+func (r *registryTestRule) Category() *types.RiskCategory {
+	return &types.RiskCategory{ID: r.id, Title: r.title}
+}
+
+func (r *registryTestRule) SupportedTags() []string {
+	return []string{r.id + "-tag"}
+}
+
+func (r *registryTestRule) GenerateRisks(*types.Model) ([]*types.Risk, error) {
+	return []*types.Risk{}, nil
+}
+
+func TestRegistryRegisterShadowsSameId(t *testing.T) {
+	registry := NewRegistry()
+	original := &registryTestRule{id: "b-rule", title: "original"}
+	replacement := &registryTestRule{id: "b-rule", title: "replacement"}
+
+	assert.Nil(t, registry.Register(original, BuiltInRule))
+	registry.Register(&registryTestRule{id: "a-rule"}, BuiltInRule)
+
+	shadowed := registry.Register(replacement, PluginRule)
+	assert.NotNil(t, shadowed)
+	assert.Same(t, original, shadowed.Rule)
+	assert.Equal(t, BuiltInRule, shadowed.Source)
+
+	registered, ok := registry.Get("b-rule")
+	assert.True(t, ok)
+	assert.Same(t, replacement, registered.Rule)
+	assert.Equal(t, PluginRule, registered.Source)
+	assert.True(t, registered.Enabled)
+	assert.Equal(t, []string{"b-rule-tag"}, registered.SupportedTags())
+
+	_, ok = registry.Get("unknown")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"a-rule", "b-rule"}, registry.SortedIds())
+	listed := registry.List()
+	assert.Len(t, listed, 2)
+	assert.Equal(t, "a-rule", listed[0].Category().ID)
+	assert.Equal(t, "replacement", listed[1].Category().Title)
+}
+
+func TestRegistryEnableDisable(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterAll(types.RiskRules{
+		"a-rule": &registryTestRule{id: "a-rule"},
+		"b-rule": &registryTestRule{id: "b-rule"},
+		"c-rule": &registryTestRule{id: "c-rule"},
+	}, PluginRule)
+
+	assert.NoError(t, registry.Disable("a-rule", "c-rule"))
+	assert.Len(t, registry.RiskRules(), 3)
+	assert.Equal(t, []string{"b-rule"}, registry.EnabledRiskRules().SortedIds())
+
+	assert.NoError(t, registry.Enable("c-rule"))
+	assert.Equal(t, []string{"b-rule", "c-rule"}, registry.EnabledRiskRules().SortedIds())
+
+	// unknown rules leave the registry unchanged
+	assert.Error(t, registry.Disable("b-rule", "unknown"))
+	assert.Equal(t, []string{"b-rule", "c-rule"}, registry.EnabledRiskRules().SortedIds())
+	assert.Error(t, registry.Enable("unknown"))
+}
+
+func TestBuiltInRegistrySources(t *testing.T) {
+	registry := NewBuiltInRegistry()
+
+	assert.Equal(t, GetBuiltInRiskRules().SortedIds(), registry.SortedIds())
+	assert.Equal(t, registry.RiskRules().SortedIds(), registry.EnabledRiskRules().SortedIds())
+
+	scriptRule, ok := registry.Get("accidental-secret-leak")
+	assert.True(t, ok)
+	assert.Equal(t, ScriptRule, scriptRule.Source)
+
+	builtInRule, ok := registry.Get("sql-nosql-injection")
+	assert.True(t, ok)
+	assert.Equal(t, BuiltInRule, builtInRule.Source)
+}
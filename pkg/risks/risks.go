@@ -2,7 +2,6 @@ package risks
 
 import (
 	"embed"
-	"fmt"
 	"github.com/threagile/threagile/pkg/risks/script"
 	"io/fs"
 
@@ -10,71 +9,127 @@ import (
 	"github.com/threagile/threagile/pkg/types"
 )
 
+// GetBuiltInRiskRules returns all built-in risk rules by their ID, see NewBuiltInRegistry
 func GetBuiltInRiskRules() types.RiskRules {
-	rules := make(types.RiskRules)
-	for _, rule := range []types.RiskRule{
+	return NewBuiltInRegistry().RiskRules()
+}
+
+func builtInRiskRules() []types.RiskRule {
+	return []types.RiskRule{
 		builtin.NewAccidentalSecretLeakRule(),
+		builtin.NewAggregateDataExposureRule(),
+		builtin.NewApiVersioningRegressionRule(),
+		builtin.NewBackupNetworkIsolationRule(),
+		builtin.NewBrokenObjectLevelAuthorizationRule(),
+		builtin.NewCertificateValidationSkipRule(),
 		builtin.NewCodeBackdooringRule(),
+		builtin.NewConfigMgmtWriteAccessRule(),
 		builtin.NewContainerBaseImageBackdooringRule(),
+		builtin.NewContainerBreakoutLateralMovementRule(),
 		builtin.NewContainerPlatformEscapeRule(),
+		builtin.NewCorsMisconfigurationRule(),
 		builtin.NewCrossSiteRequestForgeryRule(),
 		builtin.NewCrossSiteScriptingRule(),
+		builtin.NewCrossTenantDataLeakRule(),
+		builtin.NewDataResidencyViolationRule(),
+		builtin.NewDefaultCredentialsRule(),
+		builtin.NewDependencyConfusionRule(),
+		builtin.NewDNSSpoofingRule(),
 		builtin.NewDosRiskyAccessAcrossTrustBoundaryRule(),
+		builtin.NewExcessiveDataFlowRule(),
+		builtin.NewExposedDevServerRule(),
+		builtin.NewGraphQLAbuseRule(),
+		builtin.NewIamRoleSharingRule(),
 		builtin.NewIncompleteModelRule(),
+		builtin.NewInsecureDeserializationRule(),
+		builtin.NewInsecureFileUploadRule(),
+		builtin.NewInsecureImdsRule(),
+		builtin.NewInsecureWebsocketRule(),
+		builtin.NewInsufficientKeyManagementRule(),
+		builtin.NewInsufficientSecurityMonitoringRule(),
+		builtin.NewIoTDeviceHardeningRule(),
+		builtin.NewKubernetesClusterSecurityRule(),
+		builtin.NewKubernetesServiceAccountTokenRule(),
 		builtin.NewLdapInjectionRule(),
+		builtin.NewLLMPromptInjectionRule(),
+		builtin.NewLogInjectionRule(),
+		builtin.NewMessageQueuePoisoningRule(),
+		builtin.NewMissingApiGatewayRule(),
 		builtin.NewMissingAuthenticationRule(),
 		builtin.NewMissingAuthenticationSecondFactorRule(builtin.NewMissingAuthenticationRule()),
+		builtin.NewMissingBackupRule(),
+		builtin.NewMissingBastionHostRule(),
 		builtin.NewMissingBuildInfrastructureRule(),
+		builtin.NewMissingCentralSecretsManagementRule(),
+		builtin.NewMissingCertificateLifecycleManagementRule(),
 		builtin.NewMissingCloudHardeningRule(),
+		builtin.NewMissingEgressFilteringRule(),
 		builtin.NewMissingFileValidationRule(),
 		builtin.NewMissingHardeningRule(),
 		builtin.NewMissingIdentityPropagationRule(),
 		builtin.NewMissingIdentityProviderIsolationRule(),
 		builtin.NewMissingIdentityStoreRule(),
+		builtin.NewMissingMtlsRule(),
 		builtin.NewMissingNetworkSegmentationRule(),
+		builtin.NewMissingPodSecurityAdmissionRule(),
+		builtin.NewMissingRateLimitingRule(),
 		builtin.NewMissingVaultRule(),
 		builtin.NewMissingVaultIsolationRule(),
 		builtin.NewMissingWafRule(),
 		builtin.NewMixedTargetsOnSharedRuntimeRule(),
+		builtin.NewMobileClientThreatsRule(),
+		builtin.NewMultiTenantIsolationRule(),
+		builtin.NewOAuthMisconfigurationRule(),
+		builtin.NewOverPermissionedServerlessRule(),
 		builtin.NewPathTraversalRule(),
+		builtin.NewPhysicalAccessRule(),
+		builtin.NewPipelinePoisoningRule(),
+		builtin.NewPublicCloudStorageExposureRule(),
 		builtin.NewPushInsteadPullDeploymentRule(),
+		builtin.NewRansomwareSusceptibilityRule(),
 		builtin.NewSearchQueryInjectionRule(),
+		builtin.NewSecretsInEnvironmentRule(),
+		builtin.NewSensitiveDataInLogsRule(),
 		builtin.NewServerSideRequestForgeryRule(),
+		builtin.NewServerSideTemplateInjectionRule(),
 		builtin.NewServiceRegistryPoisoningRule(),
+		builtin.NewShadowAssetRule(),
+		builtin.NewShadowItDependencyRule(),
+		builtin.NewSharedExecutionEnvironmentPrivilegeEscalationRule(),
+		builtin.NewSharedStorageRaceConditionRule(),
+		builtin.NewSidecarHostNetworkRule(),
 		builtin.NewSqlNoSqlInjectionRule(),
+		builtin.NewSsoSinglePointOfFailureRule(),
+		builtin.NewStaticIpAllowlistOnlyRule(),
+		builtin.NewThirdPartyDataSharingRule(),
+		builtin.NewThirdPartyJavascriptRule(),
+		builtin.NewTransitivePrivilegeEscalationRule(),
 		builtin.NewUncheckedDeploymentRule(),
+		builtin.NewUnencryptedArtifactStorageRule(),
 		builtin.NewUnencryptedAssetRule(),
 		builtin.NewUnencryptedCommunicationRule(),
+		builtin.NewUnencryptedEastWestTrafficRule(),
+		builtin.NewUnencryptedOrExposedBackupRule(),
 		builtin.NewUnguardedAccessFromInternetRule(),
 		builtin.NewUnguardedDirectDatastoreAccessRule(),
+		builtin.NewUnmonitoredPrivilegedDbAccessRule(),
 		builtin.NewUnnecessaryCommunicationLinkRule(),
 		builtin.NewUnnecessaryDataAssetRule(),
 		builtin.NewUnnecessaryDataTransferRule(),
 		builtin.NewUnnecessaryTechnicalAssetRule(),
+		builtin.NewUnpatchedBaseImageRule(),
+		builtin.NewUnprotectedAdminConsoleRule(),
+		builtin.NewUnprotectedWikiRule(),
 		builtin.NewUntrustedDeserializationRule(),
+		builtin.NewWeakCryptographyRule(),
+		builtin.NewWeakCspRule(),
+		builtin.NewWeakPasswordPolicyRule(),
+		builtin.NewWeakSessionManagementRule(),
+		builtin.NewWeakTlsLegacyProtocolRule(),
 		builtin.NewWrongCommunicationLinkContentRule(),
 		builtin.NewWrongTrustBoundaryContentRule(),
 		builtin.NewXmlExternalEntityRule(),
-	} {
-		rules[rule.Category().ID] = rule
 	}
-
-	scriptRules, scriptError := GetScriptRiskRules()
-	if scriptError != nil {
-		fmt.Printf("error loading script risk rules: %v\n", scriptError)
-		return rules
-	}
-
-	for id, rule := range scriptRules {
-		builtinRule, ok := rules[id]
-		if ok && builtinRule != nil {
-			fmt.Printf("WARNING: script risk rule %q shadows built-in risk rule\n", id)
-		}
-
-		rules[id] = rule
-	}
-
-	return rules
 }
 
 //go:embed scripts/*.yaml
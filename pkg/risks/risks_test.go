@@ -0,0 +1,20 @@
+package risks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestBuiltInRiskRulesAreExplainable(t *testing.T) {
+	for id, rule := range GetBuiltInRiskRules() {
+		assert.Implements(t, (*types.ExplainableRiskRule)(nil), rule, id)
+	}
+}
+
+func TestBuiltInRiskRulesAreLabeled(t *testing.T) {
+	for id, rule := range GetBuiltInRiskRules() {
+		assert.NotEmpty(t, rule.Category().Labels, id)
+	}
+}
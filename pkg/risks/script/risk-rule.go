@@ -88,6 +88,31 @@ func (what *RiskRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, er
 	return newRisks, nil
 }
 
+// ExplainRisk reports the explanations recorded by the script when generating the risk
+func (what *RiskRule) ExplainRisk(parsedModel *types.Model, risk string) []string {
+	generatedRisks, riskError := what.GenerateRisks(parsedModel)
+	if riskError != nil {
+		return nil
+	}
+
+	explanation := make([]string, 0)
+	for _, generatedRisk := range generatedRisks {
+		if !strings.EqualFold(generatedRisk.SyntheticId, risk) && !strings.EqualFold(generatedRisk.CategoryId+"@*", risk) {
+			continue
+		}
+
+		if len(explanation) > 0 {
+			explanation = append(explanation, "")
+		}
+
+		explanation = append(explanation, fmt.Sprintf("risk %q", generatedRisk.SyntheticId))
+		explanation = append(explanation, generatedRisk.RiskExplanation...)
+		explanation = append(explanation, generatedRisk.RatingExplanation...)
+	}
+
+	return explanation
+}
+
 func (what *RiskRule) Load(fileSystem fs.FS, path string, entry fs.DirEntry) error {
 	if entry.IsDir() {
 		return nil
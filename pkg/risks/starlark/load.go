@@ -0,0 +1,48 @@
+package starlark
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// LoadRiskRules loads all starlark risk rules (*.star) found in the folder and its sub-folders
+func LoadRiskRules(folder string) (types.RiskRules, error) {
+	rules := make(types.RiskRules)
+	if len(folder) == 0 {
+		return rules, nil
+	}
+
+	fileSystem := os.DirFS(folder)
+	walkError := fs.WalkDir(fileSystem, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(path), ".star") {
+			return nil
+		}
+
+		newRule := new(RiskRule).Init()
+		loadError := newRule.Load(fileSystem, path, entry)
+		if loadError != nil {
+			return loadError
+		}
+
+		if newRule.Category().ID == "" {
+			return nil
+		}
+
+		rules[newRule.Category().ID] = newRule
+		return nil
+	})
+
+	if walkError != nil {
+		return nil, walkError
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,207 @@
+package starlark
+
+import (
+	"fmt"
+	"sort"
+
+	starlarkgo "go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// newModel provides read-only accessors to the parsed model; all values returned are frozen
+func newModel(parsedModel *types.Model) starlarkgo.Value {
+	model := starlarkstruct.FromStringDict(starlarkstruct.Default, starlarkgo.StringDict{
+		"title": starlarkgo.String(parsedModel.Title),
+
+		"sorted_technical_asset_ids": starlarkgo.NewBuiltin("sorted_technical_asset_ids", func(_ *starlarkgo.Thread, builtin *starlarkgo.Builtin, args starlarkgo.Tuple, kwargs []starlarkgo.Tuple) (starlarkgo.Value, error) {
+			if err := starlarkgo.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+				return nil, err
+			}
+			return stringList(parsedModel.SortedTechnicalAssetIDs()), nil
+		}),
+		"technical_asset": idAccessor("technical_asset", func(id string) (starlarkgo.Value, error) {
+			techAsset, ok := parsedModel.TechnicalAssets[id]
+			if !ok {
+				return nil, fmt.Errorf("unknown technical asset %q", id)
+			}
+			return technicalAssetValue(techAsset), nil
+		}),
+		"highest_processed_confidentiality": idAccessor("highest_processed_confidentiality", func(id string) (starlarkgo.Value, error) {
+			techAsset, ok := parsedModel.TechnicalAssets[id]
+			if !ok {
+				return nil, fmt.Errorf("unknown technical asset %q", id)
+			}
+			return starlarkgo.String(parsedModel.HighestProcessedConfidentiality(techAsset).String()), nil
+		}),
+		"highest_processed_integrity": idAccessor("highest_processed_integrity", func(id string) (starlarkgo.Value, error) {
+			techAsset, ok := parsedModel.TechnicalAssets[id]
+			if !ok {
+				return nil, fmt.Errorf("unknown technical asset %q", id)
+			}
+			return starlarkgo.String(parsedModel.HighestProcessedIntegrity(techAsset).String()), nil
+		}),
+		"highest_processed_availability": idAccessor("highest_processed_availability", func(id string) (starlarkgo.Value, error) {
+			techAsset, ok := parsedModel.TechnicalAssets[id]
+			if !ok {
+				return nil, fmt.Errorf("unknown technical asset %q", id)
+			}
+			return starlarkgo.String(parsedModel.HighestProcessedAvailability(techAsset).String()), nil
+		}),
+		"incoming_communication_links": idAccessor("incoming_communication_links", func(id string) (starlarkgo.Value, error) {
+			links := make([]starlarkgo.Value, 0)
+			for _, commLink := range parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId[id] {
+				links = append(links, communicationLinkValue(commLink))
+			}
+			return frozenList(links), nil
+		}),
+		"data_asset": idAccessor("data_asset", func(id string) (starlarkgo.Value, error) {
+			dataAsset, ok := parsedModel.DataAssets[id]
+			if !ok {
+				return nil, fmt.Errorf("unknown data asset %q", id)
+			}
+			return dataAssetValue(dataAsset), nil
+		}),
+		"sorted_trust_boundary_ids": starlarkgo.NewBuiltin("sorted_trust_boundary_ids", func(_ *starlarkgo.Thread, builtin *starlarkgo.Builtin, args starlarkgo.Tuple, kwargs []starlarkgo.Tuple) (starlarkgo.Value, error) {
+			if err := starlarkgo.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+				return nil, err
+			}
+			ids := make([]string, 0)
+			for id := range parsedModel.TrustBoundaries {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			return stringList(ids), nil
+		}),
+		"trust_boundary": idAccessor("trust_boundary", func(id string) (starlarkgo.Value, error) {
+			trustBoundary, ok := parsedModel.TrustBoundaries[id]
+			if !ok {
+				return nil, fmt.Errorf("unknown trust boundary %q", id)
+			}
+			return trustBoundaryValue(trustBoundary), nil
+		}),
+		"trust_boundary_of": idAccessor("trust_boundary_of", func(id string) (starlarkgo.Value, error) {
+			trustBoundary, ok := parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId[id]
+			if !ok || trustBoundary == nil {
+				return starlarkgo.None, nil
+			}
+			return starlarkgo.String(trustBoundary.Id), nil
+		}),
+	})
+
+	model.Freeze()
+	return model
+}
+
+func idAccessor(name string, accessor func(id string) (starlarkgo.Value, error)) *starlarkgo.Builtin {
+	return starlarkgo.NewBuiltin(name, func(_ *starlarkgo.Thread, builtin *starlarkgo.Builtin, args starlarkgo.Tuple, kwargs []starlarkgo.Tuple) (starlarkgo.Value, error) {
+		var id string
+		if err := starlarkgo.UnpackPositionalArgs(builtin.Name(), args, kwargs, 1, &id); err != nil {
+			return nil, err
+		}
+		return accessor(id)
+	})
+}
+
+func technicalAssetValue(techAsset *types.TechnicalAsset) starlarkgo.Value {
+	technologies := make([]string, 0)
+	for _, technology := range techAsset.Technologies {
+		technologies = append(technologies, technology.Name)
+	}
+
+	links := make([]starlarkgo.Value, 0)
+	for _, commLink := range techAsset.CommunicationLinksSorted() {
+		links = append(links, communicationLinkValue(commLink))
+	}
+
+	return frozenStruct(starlarkgo.StringDict{
+		"id":                      starlarkgo.String(techAsset.Id),
+		"title":                   starlarkgo.String(techAsset.Title),
+		"usage":                   starlarkgo.String(techAsset.Usage.String()),
+		"type":                    starlarkgo.String(techAsset.Type.String()),
+		"size":                    starlarkgo.String(techAsset.Size.String()),
+		"technologies":            stringList(technologies),
+		"machine":                 starlarkgo.String(techAsset.Machine.String()),
+		"internet":                starlarkgo.Bool(techAsset.Internet),
+		"multi_tenant":            starlarkgo.Bool(techAsset.MultiTenant),
+		"redundant":               starlarkgo.Bool(techAsset.Redundant),
+		"custom_developed_parts":  starlarkgo.Bool(techAsset.CustomDevelopedParts),
+		"out_of_scope":            starlarkgo.Bool(techAsset.OutOfScope),
+		"used_as_client_by_human": starlarkgo.Bool(techAsset.UsedAsClientByHuman),
+		"encryption":              starlarkgo.String(techAsset.Encryption.String()),
+		"owner":                   starlarkgo.String(techAsset.Owner),
+		"location":                starlarkgo.String(techAsset.Location),
+		"confidentiality":         starlarkgo.String(techAsset.Confidentiality.String()),
+		"integrity":               starlarkgo.String(techAsset.Integrity.String()),
+		"availability":            starlarkgo.String(techAsset.Availability.String()),
+		"tags":                    stringList(techAsset.Tags),
+		"data_assets_processed":   stringList(techAsset.DataAssetsProcessed),
+		"data_assets_stored":      stringList(techAsset.DataAssetsStored),
+		"communication_links":     frozenList(links),
+		"raa":                     starlarkgo.Float(techAsset.RAA),
+	})
+}
+
+func communicationLinkValue(commLink *types.CommunicationLink) starlarkgo.Value {
+	return frozenStruct(starlarkgo.StringDict{
+		"id":                   starlarkgo.String(commLink.Id),
+		"title":                starlarkgo.String(commLink.Title),
+		"source_id":            starlarkgo.String(commLink.SourceId),
+		"target_id":            starlarkgo.String(commLink.TargetId),
+		"protocol":             starlarkgo.String(commLink.Protocol.String()),
+		"authentication":       starlarkgo.String(commLink.Authentication.String()),
+		"authorization":        starlarkgo.String(commLink.Authorization.String()),
+		"usage":                starlarkgo.String(commLink.Usage.String()),
+		"readonly":             starlarkgo.Bool(commLink.Readonly),
+		"vpn":                  starlarkgo.Bool(commLink.VPN),
+		"ip_filtered":          starlarkgo.Bool(commLink.IpFiltered),
+		"tags":                 stringList(commLink.Tags),
+		"data_assets_sent":     stringList(commLink.DataAssetsSent),
+		"data_assets_received": stringList(commLink.DataAssetsReceived),
+	})
+}
+
+func dataAssetValue(dataAsset *types.DataAsset) starlarkgo.Value {
+	return frozenStruct(starlarkgo.StringDict{
+		"id":              starlarkgo.String(dataAsset.Id),
+		"title":           starlarkgo.String(dataAsset.Title),
+		"usage":           starlarkgo.String(dataAsset.Usage.String()),
+		"quantity":        starlarkgo.String(dataAsset.Quantity.String()),
+		"confidentiality": starlarkgo.String(dataAsset.Confidentiality.String()),
+		"integrity":       starlarkgo.String(dataAsset.Integrity.String()),
+		"availability":    starlarkgo.String(dataAsset.Availability.String()),
+		"tags":            stringList(dataAsset.Tags),
+	})
+}
+
+func trustBoundaryValue(trustBoundary *types.TrustBoundary) starlarkgo.Value {
+	return frozenStruct(starlarkgo.StringDict{
+		"id":                      starlarkgo.String(trustBoundary.Id),
+		"title":                   starlarkgo.String(trustBoundary.Title),
+		"type":                    starlarkgo.String(trustBoundary.Type.String()),
+		"tags":                    stringList(trustBoundary.Tags),
+		"technical_assets_inside": stringList(trustBoundary.TechnicalAssetsInside),
+		"trust_boundaries_nested": stringList(trustBoundary.TrustBoundariesNested),
+	})
+}
+
+func frozenStruct(values starlarkgo.StringDict) starlarkgo.Value {
+	result := starlarkstruct.FromStringDict(starlarkstruct.Default, values)
+	result.Freeze()
+	return result
+}
+
+func frozenList(values []starlarkgo.Value) starlarkgo.Value {
+	result := starlarkgo.NewList(values)
+	result.Freeze()
+	return result
+}
+
+func stringList(values []string) starlarkgo.Value {
+	result := make([]starlarkgo.Value, 0)
+	for _, value := range values {
+		result = append(result, starlarkgo.String(value))
+	}
+	return frozenList(result)
+}
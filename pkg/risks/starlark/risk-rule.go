@@ -0,0 +1,256 @@
+package starlark
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	starlarkgo "go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const maxExecutionSteps = 100_000_000
+
+// RiskRule is a user-defined risk rule written as Starlark script.
+// The script defines the dict 'category' (with the same keys as the risk category of script risk rules),
+// optionally the list 'supported_tags', and the function 'generate_risks(model)' returning a list of risk dicts.
+type RiskRule struct {
+	category       types.RiskCategory
+	supportedTags  []string
+	generateRisks  starlarkgo.Callable
+	scriptFilename string
+}
+
+func (what *RiskRule) Init() *RiskRule {
+	return what
+}
+
+func (what *RiskRule) ParseFromData(filename string, text []byte) (*RiskRule, error) {
+	what.scriptFilename = filename
+	globals, execError := starlarkgo.ExecFileOptions(&syntax.FileOptions{Set: true, While: true}, newThread(filename), filename, text, nil)
+	if execError != nil {
+		return nil, execError
+	}
+
+	category, ok := globals["category"].(*starlarkgo.Dict)
+	if !ok {
+		return nil, fmt.Errorf("missing dict %q", "category")
+	}
+
+	categoryData, categoryError := json.Marshal(toGo(category))
+	if categoryError != nil {
+		return nil, categoryError
+	}
+
+	unmarshalError := json.Unmarshal(categoryData, &what.category)
+	if unmarshalError != nil {
+		return nil, fmt.Errorf("invalid category: %w", unmarshalError)
+	}
+
+	what.supportedTags = make([]string, 0)
+	if supportedTags, hasTags := globals["supported_tags"]; hasTags {
+		tags, tagsOk := toGo(supportedTags).([]any)
+		if !tagsOk {
+			return nil, fmt.Errorf("%q must be a list of strings", "supported_tags")
+		}
+
+		for _, tag := range tags {
+			tagText, tagOk := tag.(string)
+			if !tagOk {
+				return nil, fmt.Errorf("%q must be a list of strings", "supported_tags")
+			}
+
+			what.supportedTags = append(what.supportedTags, tagText)
+		}
+	}
+
+	what.generateRisks, ok = globals["generate_risks"].(starlarkgo.Callable)
+	if !ok {
+		return nil, fmt.Errorf("missing function %q", "generate_risks")
+	}
+
+	return what, nil
+}
+
+func (what *RiskRule) Category() *types.RiskCategory {
+	return &what.category
+}
+
+func (what *RiskRule) SupportedTags() []string {
+	return what.supportedTags
+}
+
+func (what *RiskRule) GenerateRisks(parsedModel *types.Model) ([]*types.Risk, error) {
+	if what.generateRisks == nil {
+		return nil, fmt.Errorf("no function %q found in risk rule", "generate_risks")
+	}
+
+	result, callError := starlarkgo.Call(newThread(what.scriptFilename), what.generateRisks, starlarkgo.Tuple{newModel(parsedModel)}, nil)
+	if callError != nil {
+		if evalError, isEvalError := callError.(*starlarkgo.EvalError); isEvalError {
+			return nil, fmt.Errorf("error generating risks: %v", evalError.Backtrace())
+		}
+
+		return nil, fmt.Errorf("error generating risks: %w", callError)
+	}
+
+	riskList, ok := toGo(result).([]any)
+	if !ok {
+		return nil, fmt.Errorf("%q must return a list of risks, got %v", "generate_risks", result.Type())
+	}
+
+	risks := make([]*types.Risk, 0)
+	for _, item := range riskList {
+		values, isDict := item.(map[string]any)
+		if !isDict {
+			return nil, fmt.Errorf("%q must return a list of dicts", "generate_risks")
+		}
+
+		risk, riskError := what.createRisk(parsedModel, values)
+		if riskError != nil {
+			return nil, riskError
+		}
+
+		risks = append(risks, risk)
+	}
+
+	return risks, nil
+}
+
+// createRisk creates a risk from the dict returned by the script; only 'asset' is required
+func (what *RiskRule) createRisk(parsedModel *types.Model, values map[string]any) (*types.Risk, error) {
+	assetId, _ := values["asset"].(string)
+	techAsset, ok := parsedModel.TechnicalAssets[assetId]
+	if !ok {
+		return nil, fmt.Errorf("risk refers to unknown technical asset %q", assetId)
+	}
+
+	likelihood, likelihoodError := types.ParseRiskExploitationLikelihood(stringValue(values, "likelihood", types.Likely.String()))
+	if likelihoodError != nil {
+		return nil, likelihoodError
+	}
+
+	impact, impactError := types.ParseRiskExploitationImpact(stringValue(values, "impact", types.MediumImpact.String()))
+	if impactError != nil {
+		return nil, impactError
+	}
+
+	confidence, confidenceError := types.ParseRiskConfidence(stringValue(values, "confidence", types.HighConfidence.String()))
+	if confidenceError != nil {
+		return nil, confidenceError
+	}
+
+	dataBreachProbability, probabilityError := types.ParseDataBreachProbability(stringValue(values, "data_breach_probability", types.Possible.String()))
+	if probabilityError != nil {
+		return nil, probabilityError
+	}
+
+	dataBreachAssets := []string{techAsset.Id}
+	if assets, hasAssets := values["data_breach_assets"].([]any); hasAssets {
+		dataBreachAssets = make([]string, 0)
+		for _, asset := range assets {
+			dataBreachAssets = append(dataBreachAssets, fmt.Sprintf("%v", asset))
+		}
+	}
+
+	risk := &types.Risk{
+		CategoryId:                   what.category.ID,
+		Severity:                     types.CalculateSeverity(likelihood, impact),
+		ExploitationLikelihood:       likelihood,
+		ExploitationImpact:           impact,
+		Confidence:                   confidence,
+		Title:                        stringValue(values, "title", "<b>"+what.category.Title+"</b> risk at <b>"+techAsset.Title+"</b>"),
+		MostRelevantTechnicalAssetId: techAsset.Id,
+		DataBreachProbability:        dataBreachProbability,
+		DataBreachTechnicalAssetIDs:  dataBreachAssets,
+	}
+	risk.SyntheticId = risk.CategoryId + "@" + stringValue(values, "id", techAsset.Id)
+	return risk, nil
+}
+
+func (what *RiskRule) Load(fileSystem fs.FS, path string, entry fs.DirEntry) error {
+	if entry.IsDir() {
+		return nil
+	}
+
+	loadError := what.loadRiskRule(fileSystem, path)
+	if loadError != nil {
+		return loadError
+	}
+
+	return nil
+}
+
+func (what *RiskRule) loadRiskRule(fileSystem fs.FS, filename string) error {
+	scriptFilename := filepath.Clean(filename)
+
+	ruleData, ruleReadError := fs.ReadFile(fileSystem, scriptFilename)
+	if ruleReadError != nil {
+		return fmt.Errorf("error reading risk rule: %w", ruleReadError)
+	}
+
+	_, parseError := what.ParseFromData(scriptFilename, ruleData)
+	if parseError != nil {
+		return fmt.Errorf("error parsing starlark risk rule from %q: %w", scriptFilename, parseError)
+	}
+
+	return nil
+}
+
+func newThread(name string) *starlarkgo.Thread {
+	thread := &starlarkgo.Thread{Name: name}
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+	return thread
+}
+
+func stringValue(values map[string]any, key string, defaultValue string) string {
+	value, ok := values[key].(string)
+	if !ok || len(value) == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// toGo converts starlark values into their plain go equivalent (like to be encoded as JSON)
+func toGo(value starlarkgo.Value) any {
+	switch typed := value.(type) {
+	case starlarkgo.NoneType:
+		return nil
+	case starlarkgo.Bool:
+		return bool(typed)
+	case starlarkgo.Int:
+		number, _ := typed.Int64()
+		return number
+	case starlarkgo.Float:
+		return float64(typed)
+	case starlarkgo.String:
+		return string(typed)
+	case *starlarkgo.Dict:
+		result := make(map[string]any)
+		for _, item := range typed.Items() {
+			key, isString := item[0].(starlarkgo.String)
+			if isString {
+				result[string(key)] = toGo(item[1])
+			} else {
+				result[item[0].String()] = toGo(item[1])
+			}
+		}
+		return result
+	case starlarkgo.Iterable:
+		result := make([]any, 0)
+		iterator := typed.Iterate()
+		defer iterator.Done()
+
+		var item starlarkgo.Value
+		for iterator.Next(&item) {
+			result = append(result, toGo(item))
+		}
+		return result
+	default:
+		return value.String()
+	}
+}
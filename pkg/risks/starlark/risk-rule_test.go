@@ -0,0 +1,191 @@
+package starlark
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+const testRule = `
+category = {
+    "id": "unencrypted-customer-database",
+    "title": "Unencrypted Customer Database",
+    "cwe": 311,
+}
+
+supported_tags = ["customer"]
+
+def generate_risks(model):
+    risks = []
+    for id in model.sorted_technical_asset_ids():
+        asset = model.technical_asset(id)
+        if asset.out_of_scope or asset.type != "datastore" or "customer" not in asset.tags or asset.encryption != "none":
+            continue
+        risks.append({
+            "asset": id,
+            "likelihood": "unlikely",
+            "impact": "high" if model.highest_processed_confidentiality(id) == "strictly-confidential" else "medium",
+            "confidence": "medium",
+        })
+    return risks
+`
+
+func testModel() *types.Model {
+	return &types.Model{
+		TechnicalAssets: map[string]*types.TechnicalAsset{
+			"customer-db": {Id: "customer-db", Title: "Customer DB", Type: types.Datastore, Tags: []string{"customer"}, DataAssetsProcessed: []string{"customers"}, DataAssetsStored: []string{"customers"}},
+			"order-db":    {Id: "order-db", Title: "Order DB", Type: types.Datastore, Tags: []string{"customer"}, Encryption: types.Transparent},
+			"frontend":    {Id: "frontend", Title: "Frontend", Type: types.Process, Tags: []string{"customer"}},
+		},
+		DataAssets: map[string]*types.DataAsset{
+			"customers": {Id: "customers", Confidentiality: types.StrictlyConfidential},
+		},
+		TrustBoundaries: map[string]*types.TrustBoundary{
+			"backend": {Id: "backend", Title: "Backend", TechnicalAssetsInside: []string{"customer-db", "order-db"}},
+		},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*types.TrustBoundary{
+			"customer-db": {Id: "backend"},
+			"order-db":    {Id: "backend"},
+		},
+	}
+}
+
+func TestRiskRuleParseFromData(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData("rule.star", []byte(testRule))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "unencrypted-customer-database", rule.Category().ID)
+	assert.Equal(t, "Unencrypted Customer Database", rule.Category().Title)
+	assert.Equal(t, 311, rule.Category().CWE)
+	assert.Equal(t, []string{"customer"}, rule.SupportedTags())
+}
+
+func TestRiskRuleParseFromDataInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"invalid syntax":       "category = {\n",
+		"missing category":     "def generate_risks(model):\n    return []\n",
+		"missing function":     "category = {\"id\": \"test\"}\n",
+		"invalid tags":         "category = {\"id\": \"test\"}\nsupported_tags = [1]\ndef generate_risks(model):\n    return []\n",
+		"invalid category":     "category = {\"id\": \"test\", \"cwe\": \"many\"}\ndef generate_risks(model):\n    return []\n",
+		"endless loop":         "category = {\"id\": \"test\"}\ndef loop():\n    while True:\n        pass\nloop()\n",
+		"undefined identifier": "category = unknown\n",
+	}
+
+	for name, data := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := new(RiskRule).Init().ParseFromData("rule.star", []byte(data))
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestRiskRuleGenerateRisks(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData("rule.star", []byte(testRule))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(testModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "unencrypted-customer-database@customer-db", risks[0].SyntheticId)
+	assert.Equal(t, "<b>Unencrypted Customer Database</b> risk at <b>Customer DB</b>", risks[0].Title)
+	assert.Equal(t, types.Unlikely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.HighImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, types.CalculateSeverity(types.Unlikely, types.HighImpact), risks[0].Severity)
+	assert.Equal(t, []string{"customer-db"}, risks[0].DataBreachTechnicalAssetIDs)
+	assert.Equal(t, types.MediumConfidence, risks[0].Confidence)
+}
+
+func TestRiskRuleGenerateRisksDefaultRating(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData("rule.star", []byte("category = {\"id\": \"test\", \"title\": \"Test\"}\ndef generate_risks(model):\n    return [{\"asset\": \"frontend\"}]\n"))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(testModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 1)
+	assert.Equal(t, types.Likely, risks[0].ExploitationLikelihood)
+	assert.Equal(t, types.MediumImpact, risks[0].ExploitationImpact)
+	assert.Equal(t, types.Possible, risks[0].DataBreachProbability)
+	assert.Equal(t, types.HighConfidence, risks[0].Confidence)
+}
+
+func TestRiskRuleGenerateRisksTrustBoundaries(t *testing.T) {
+	script := `
+category = {"id": "test", "title": "Test"}
+
+def generate_risks(model):
+    risks = []
+    for boundary_id in model.sorted_trust_boundary_ids():
+        boundary = model.trust_boundary(boundary_id)
+        for id in boundary.technical_assets_inside:
+            if model.trust_boundary_of(id) == boundary_id:
+                risks.append({"asset": id, "id": boundary_id + "@" + id})
+    if model.trust_boundary_of("frontend") != None:
+        fail("frontend is not inside a trust boundary")
+    return risks
+`
+	rule, err := new(RiskRule).Init().ParseFromData("rule.star", []byte(script))
+	assert.Nil(t, err)
+
+	risks, err := rule.GenerateRisks(testModel())
+
+	assert.Nil(t, err)
+	assert.Len(t, risks, 2)
+	assert.Equal(t, "test@backend@customer-db", risks[0].SyntheticId)
+	assert.Equal(t, "test@backend@order-db", risks[1].SyntheticId)
+}
+
+func TestRiskRuleGenerateRisksReadOnlyModel(t *testing.T) {
+	rule, err := new(RiskRule).Init().ParseFromData("rule.star", []byte("category = {\"id\": \"test\"}\ndef generate_risks(model):\n    model.technical_asset(\"frontend\").tags.append(\"changed\")\n    return []\n"))
+	assert.Nil(t, err)
+
+	_, err = rule.GenerateRisks(testModel())
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"customer"}, testModel().TechnicalAssets["frontend"].Tags)
+}
+
+func TestRiskRuleGenerateRisksInvalidResult(t *testing.T) {
+	testCases := map[string]string{
+		"no list":       "    return {\"asset\": \"frontend\"}\n",
+		"no dict":       "    return [\"frontend\"]\n",
+		"unknown asset": "    return [{\"asset\": \"unknown\"}]\n",
+		"invalid value": "    return [{\"asset\": \"frontend\", \"impact\": \"enormous\"}]\n",
+		"runtime error": "    return model.technical_asset(\"unknown\")\n",
+	}
+
+	for name, body := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule, err := new(RiskRule).Init().ParseFromData("rule.star", []byte("category = {\"id\": \"test\"}\ndef generate_risks(model):\n"+body))
+			assert.Nil(t, err)
+
+			_, err = rule.GenerateRisks(testModel())
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestLoadRiskRules(t *testing.T) {
+	folder := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "rule.star"), []byte(testRule), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "rule.yaml"), []byte("id: cel-rule\n"), 0600))
+
+	rules, err := LoadRiskRules(folder)
+
+	assert.Nil(t, err)
+	assert.Len(t, rules, 1)
+	assert.Contains(t, rules, "unencrypted-customer-database")
+}
+
+func TestLoadRiskRulesNoFolder(t *testing.T) {
+	rules, err := LoadRiskRules("")
+
+	assert.Nil(t, err)
+	assert.Empty(t, rules)
+}
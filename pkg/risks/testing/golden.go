@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	gotesting "testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// UpdateGoldenFilesKey is the environment variable which, set to "true", makes the golden file assertions
+// (re)write the golden files with the risks generated instead of comparing them
+const UpdateGoldenFilesKey = "THREAGILE_UPDATE_GOLDEN_FILES"
+
+// AssertGoldenRisks generates the risks of the rule for the model and compares them, sorted by synthetic id and encoded as YAML,
+// with the content of the golden file
+func AssertGoldenRisks(t gotesting.TB, rule types.RiskRule, model *types.Model, goldenFilename string) {
+	t.Helper()
+
+	risks, err := rule.GenerateRisks(model)
+	if !assert.NoError(t, err, "generating risks of %q", rule.Category().ID) {
+		return
+	}
+
+	actual, marshalError := marshalRisks(risks)
+	if !assert.NoError(t, marshalError) {
+		return
+	}
+
+	if os.Getenv(UpdateGoldenFilesKey) == "true" {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(goldenFilename), 0750))
+		assert.NoError(t, os.WriteFile(goldenFilename, actual, 0600))
+		return
+	}
+
+	expected, readError := os.ReadFile(filepath.Clean(goldenFilename))
+	if !assert.NoError(t, readError, "reading golden file (run with %v=true to create it)", UpdateGoldenFilesKey) {
+		return
+	}
+
+	assert.Equal(t, string(expected), string(actual), "risks differ from golden file %q", goldenFilename)
+}
+
+// RunGoldenTests runs a sub-test for each of the models, comparing the risks generated by the rule
+// with the golden file named after the test case in the given folder (like "testdata/<name>.yaml")
+func RunGoldenTests(t *gotesting.T, rule types.RiskRule, folder string, models map[string]*types.Model) {
+	t.Helper()
+
+	for name, model := range models {
+		t.Run(name, func(t *gotesting.T) {
+			AssertGoldenRisks(t, rule, model, filepath.Join(folder, name+".yaml"))
+		})
+	}
+}
+
+func marshalRisks(risks []*types.Risk) ([]byte, error) {
+	sorted := make([]*types.Risk, len(risks))
+	copy(sorted, risks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].SyntheticId < sorted[j].SyntheticId
+	})
+
+	return yaml.Marshal(sorted)
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	gotesting "testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/risks/builtin"
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func unencryptedLinkModel(protocol types.Protocol, acrossTrustBoundary bool) *types.Model {
+	builder := NewModel().
+		DataAsset("customer-data", func(dataAsset *types.DataAsset) {
+			dataAsset.Confidentiality = types.StrictlyConfidential
+		}).
+		TechnicalAsset("frontend").
+		TechnicalAsset("backend").
+		CommunicationLink("frontend", "backend", func(commLink *types.CommunicationLink) {
+			commLink.Protocol = protocol
+			commLink.DataAssetsSent = []string{"customer-data"}
+		}).
+		TrustBoundary("internal", types.NetworkOnPrem, []string{"backend"})
+
+	if acrossTrustBoundary {
+		builder.TrustBoundary("dmz", types.NetworkCloudProvider, []string{"frontend"})
+	}
+
+	return builder.Build()
+}
+
+func TestRunGoldenTests(t *gotesting.T) {
+	RunGoldenTests(t, builtin.NewUnencryptedCommunicationRule(), "testdata", map[string]*types.Model{
+		"unencrypted-across-trust-boundary":  unencryptedLinkModel(types.HTTP, true),
+		"unencrypted-without-trust-boundary": unencryptedLinkModel(types.HTTP, false),
+		"encrypted":                          unencryptedLinkModel(types.HTTPS, true),
+	})
+}
+
+// recordingTB records failures instead of failing the test
+type recordingTB struct {
+	gotesting.TB
+	failures []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, format)
+}
+
+func TestAssertGoldenRisksMismatch(t *gotesting.T) {
+	goldenFilename := filepath.Join(t.TempDir(), "golden.yaml")
+	assert.NoError(t, os.WriteFile(goldenFilename, []byte("[]\n"), 0600))
+
+	recorder := &recordingTB{TB: t}
+	AssertGoldenRisks(recorder, builtin.NewUnencryptedCommunicationRule(), unencryptedLinkModel(types.HTTP, true), goldenFilename)
+
+	assert.NotEmpty(t, recorder.failures)
+}
+
+func TestAssertGoldenRisksMissingFile(t *gotesting.T) {
+	recorder := &recordingTB{TB: t}
+	AssertGoldenRisks(recorder, builtin.NewUnencryptedCommunicationRule(), unencryptedLinkModel(types.HTTP, true), filepath.Join(t.TempDir(), "missing.yaml"))
+
+	assert.NotEmpty(t, recorder.failures)
+}
+
+func TestAssertGoldenRisksUpdate(t *gotesting.T) {
+	t.Setenv(UpdateGoldenFilesKey, "true")
+	goldenFilename := filepath.Join(t.TempDir(), "golden", "encrypted.yaml")
+
+	AssertGoldenRisks(t, builtin.NewUnencryptedCommunicationRule(), unencryptedLinkModel(types.HTTPS, true), goldenFilename)
+
+	data, err := os.ReadFile(goldenFilename)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]\n", string(data))
+}
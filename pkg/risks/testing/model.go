@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+// ModelBuilder builds minimal models for testing risk rules.
+// Elements get their id as title, everything else keeps its zero value unless changed by the given options.
+// Build derives the lookup maps of the model the same way the model parser does.
+type ModelBuilder struct {
+	model *types.Model
+}
+
+func NewModel() *ModelBuilder {
+	return &ModelBuilder{model: &types.Model{
+		Title:                 "Test Model",
+		DataAssets:            make(map[string]*types.DataAsset),
+		TechnicalAssets:       make(map[string]*types.TechnicalAsset),
+		TrustBoundaries:       make(map[string]*types.TrustBoundary),
+		SharedRuntimes:        make(map[string]*types.SharedRuntime),
+		CommunicationLinks:    make(map[string]*types.CommunicationLink),
+		RiskTracking:          make(map[string]*types.RiskTracking),
+		AllSupportedTags:      make(map[string]bool),
+		BuiltInRiskCategories: make(types.RiskCategories, 0),
+		CustomRiskCategories:  make(types.RiskCategories, 0),
+
+		IncomingTechnicalCommunicationLinksMappedByTargetId:   make(map[string][]*types.CommunicationLink),
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: make(map[string]*types.TrustBoundary),
+		GeneratedRisksByCategory:                              make(map[string][]*types.Risk),
+		GeneratedRisksBySyntheticId:                           make(map[string]*types.Risk),
+	}}
+}
+
+// Model applies the options to the model itself, like to set its business criticality
+func (what *ModelBuilder) Model(options ...func(*types.Model)) *ModelBuilder {
+	for _, option := range options {
+		option(what.model)
+	}
+
+	return what
+}
+
+func (what *ModelBuilder) DataAsset(id string, options ...func(*types.DataAsset)) *ModelBuilder {
+	dataAsset := &types.DataAsset{Id: id, Title: id}
+	for _, option := range options {
+		option(dataAsset)
+	}
+
+	what.model.DataAssets[id] = dataAsset
+	return what
+}
+
+func (what *ModelBuilder) TechnicalAsset(id string, options ...func(*types.TechnicalAsset)) *ModelBuilder {
+	techAsset := &types.TechnicalAsset{Id: id, Title: id}
+	for _, option := range options {
+		option(techAsset)
+	}
+
+	what.model.TechnicalAssets[id] = techAsset
+	return what
+}
+
+// CommunicationLink adds a link from the source to the target technical asset, which both need to be added before;
+// the link gets the id "<source>><target>" and the title "<source> to <target>"
+func (what *ModelBuilder) CommunicationLink(sourceId string, targetId string, options ...func(*types.CommunicationLink)) *ModelBuilder {
+	commLink := &types.CommunicationLink{
+		Id:       sourceId + ">" + targetId,
+		SourceId: sourceId,
+		TargetId: targetId,
+		Title:    sourceId + " to " + targetId,
+	}
+	for _, option := range options {
+		option(commLink)
+	}
+
+	source, ok := what.model.TechnicalAssets[sourceId]
+	if !ok {
+		panic("communication link from unknown technical asset " + sourceId)
+	}
+
+	source.CommunicationLinks = append(source.CommunicationLinks, commLink)
+	what.model.CommunicationLinks[commLink.Id] = commLink
+	return what
+}
+
+// TrustBoundary adds a trust boundary containing the given technical assets
+func (what *ModelBuilder) TrustBoundary(id string, boundaryType types.TrustBoundaryType, technicalAssetIds []string, options ...func(*types.TrustBoundary)) *ModelBuilder {
+	trustBoundary := &types.TrustBoundary{
+		Id:                    id,
+		Title:                 id,
+		Type:                  boundaryType,
+		TechnicalAssetsInside: technicalAssetIds,
+	}
+	for _, option := range options {
+		option(trustBoundary)
+	}
+
+	what.model.TrustBoundaries[id] = trustBoundary
+	return what
+}
+
+// SharedRuntime adds a shared runtime running the given technical assets
+func (what *ModelBuilder) SharedRuntime(id string, technicalAssetIds []string, options ...func(*types.SharedRuntime)) *ModelBuilder {
+	sharedRuntime := &types.SharedRuntime{
+		Id:                     id,
+		Title:                  id,
+		TechnicalAssetsRunning: technicalAssetIds,
+	}
+	for _, option := range options {
+		option(sharedRuntime)
+	}
+
+	what.model.SharedRuntimes[id] = sharedRuntime
+	return what
+}
+
+// Build returns the model after deriving its lookup maps; the builder must not be used afterward
+func (what *ModelBuilder) Build() *types.Model {
+	model := what.model
+
+	for _, id := range model.SortedTechnicalAssetIDs() {
+		for _, commLink := range model.TechnicalAssets[id].CommunicationLinks {
+			model.IncomingTechnicalCommunicationLinksMappedByTargetId[commLink.TargetId] = append(
+				model.IncomingTechnicalCommunicationLinksMappedByTargetId[commLink.TargetId], commLink)
+
+			// a target of a communication link implicitly processes all data assets sent to or received by that target
+			target, ok := model.TechnicalAssets[commLink.TargetId]
+			if !ok || commLink.TargetId == id {
+				continue
+			}
+
+			for _, dataAssetId := range append(append([]string{}, commLink.DataAssetsSent...), commLink.DataAssetsReceived...) {
+				if !slices.Contains(target.DataAssetsProcessed, dataAssetId) {
+					target.DataAssetsProcessed = append(target.DataAssetsProcessed, dataAssetId)
+				}
+			}
+		}
+	}
+
+	trustBoundaryIds := make([]string, 0)
+	for id := range model.TrustBoundaries {
+		trustBoundaryIds = append(trustBoundaryIds, id)
+	}
+	sort.Strings(trustBoundaryIds)
+
+	for _, id := range trustBoundaryIds {
+		for _, techAssetId := range model.TrustBoundaries[id].TechnicalAssetsInside {
+			model.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAssetId] = model.TrustBoundaries[id]
+		}
+	}
+
+	return model
+}
+
+// Technology creates a technology with the given attributes (like types.WebServer) set
+func Technology(name string, attributes ...string) *types.Technology {
+	technology := &types.Technology{Name: name, Attributes: make(map[string]bool)}
+	for _, attribute := range attributes {
+		technology.Attributes[attribute] = true
+	}
+
+	return technology
+}
@@ -0,0 +1,46 @@
+package testing
+
+import (
+	gotesting "testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/threagile/threagile/pkg/types"
+)
+
+func TestModelBuilder(t *gotesting.T) {
+	model := NewModel().
+		DataAsset("customer-data", func(dataAsset *types.DataAsset) {
+			dataAsset.Confidentiality = types.StrictlyConfidential
+		}).
+		TechnicalAsset("frontend", func(techAsset *types.TechnicalAsset) {
+			techAsset.Technologies = types.TechnologyList{Technology("web-server", types.WebServer)}
+		}).
+		TechnicalAsset("backend").
+		CommunicationLink("frontend", "backend", func(commLink *types.CommunicationLink) {
+			commLink.Protocol = types.HTTP
+			commLink.DataAssetsSent = []string{"customer-data"}
+		}).
+		TrustBoundary("dmz", types.NetworkCloudProvider, []string{"frontend"}).
+		SharedRuntime("cluster", []string{"frontend", "backend"}).
+		Build()
+
+	assert.Equal(t, "frontend", model.TechnicalAssets["frontend"].Title)
+	assert.True(t, model.TechnicalAssets["frontend"].Technologies.GetAttribute(types.WebServer))
+
+	commLink := model.CommunicationLinks["frontend>backend"]
+	assert.NotNil(t, commLink)
+	assert.Equal(t, []*types.CommunicationLink{commLink}, model.TechnicalAssets["frontend"].CommunicationLinks)
+	assert.Equal(t, []*types.CommunicationLink{commLink}, model.IncomingTechnicalCommunicationLinksMappedByTargetId["backend"])
+	assert.Equal(t, []string{"customer-data"}, model.TechnicalAssets["backend"].DataAssetsProcessed)
+
+	assert.Equal(t, model.TrustBoundaries["dmz"], model.DirectContainingTrustBoundaryMappedByTechnicalAssetId["frontend"])
+	assert.NotContains(t, model.DirectContainingTrustBoundaryMappedByTechnicalAssetId, "backend")
+	assert.Equal(t, []string{"frontend", "backend"}, model.SharedRuntimes["cluster"].TechnicalAssetsRunning)
+}
+
+func TestModelBuilderUnknownLinkSource(t *gotesting.T) {
+	assert.Panics(t, func() {
+		NewModel().CommunicationLink("unknown", "backend")
+	})
+}
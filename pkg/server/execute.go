@@ -187,9 +187,37 @@ func (s *server) doItViaRuntimeCall(modelFile string, outputDir string,
 		"--output", outputDir,
 		"--execute-model-macro", s.config.GetExecuteModelMacro(),
 		"--custom-risk-rules-plugin", strings.Join(s.config.GetRiskRulePlugins(), ","),
+		"--custom-risk-rules-grpc-timeout", strconv.Itoa(int(s.config.GetGrpcPluginTimeout().Seconds())),
 		"--skip-risk-rules", strings.Join(s.config.GetSkipRiskRules(), ","),
 		"--diagram-dpi", strconv.Itoa(dpi),
 	}
+	if len(s.config.GetRiskRulesFolder()) > 0 {
+		args = append(args, "--custom-risk-rules-dir", s.config.GetRiskRulesFolder())
+	}
+	if len(s.config.GetGrpcPluginFolder()) > 0 {
+		args = append(args, "--custom-risk-rules-grpc-dir", s.config.GetGrpcPluginFolder())
+	}
+	if len(s.config.GetRiskRulesConfigFilename()) > 0 {
+		args = append(args, "--risk-rules-config", s.config.GetRiskRulesConfigFilename())
+	}
+	if len(s.config.GetRiskRulesProfile()) > 0 {
+		args = append(args, "--risk-rules-profile", s.config.GetRiskRulesProfile())
+	}
+	if len(s.config.GetRiskCacheFilename()) > 0 {
+		args = append(args, "--risk-cache", s.config.GetRiskCacheFilename())
+	}
+	if s.config.GetRiskRuleWorkers() > 0 {
+		args = append(args, "--risk-rule-workers", strconv.Itoa(s.config.GetRiskRuleWorkers()))
+	}
+	if s.config.GetConsolidateRisks() {
+		args = append(args, "--consolidate-risks")
+	}
+	if len(s.config.GetRiskLabels()) > 0 {
+		args = append(args, "--risk-labels", strings.Join(s.config.GetRiskLabels(), ","))
+	}
+	if len(s.config.GetRuleTracesFilename()) > 0 {
+		args = append(args, "--rule-traces", s.config.GetRuleTracesFilename())
+	}
 	if s.config.GetVerbose() {
 		args = append(args, "--verbose")
 	}
@@ -264,7 +292,7 @@ func (s *server) editModelAnalyze(ginContext *gin.Context) {
 		Verbose:       s.config.GetVerbose(),
 		SuppressError: true,
 	}
-	customRiskRules := model.LoadCustomRiskRules(s.config.GetPluginFolder(), s.config.GetRiskRulePlugins(), progressReporter)
+	customRiskRules := model.LoadCustomRiskRules(s.config.GetPluginFolder(), s.config.GetRiskRulePlugins(), s.config.GetRiskRulesFolder(), s.config.GetGrpcPluginFolder(), s.config.GetGrpcPluginTimeout(), progressReporter)
 	builtinRiskRules := risks.GetBuiltInRiskRules()
 
 	result, err := model.AnalyzeModel(&modelInput, s.config, builtinRiskRules, customRiskRules, progressReporter)
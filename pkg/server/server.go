@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -34,6 +35,8 @@ type serverConfigReader interface {
 	GetKeyFolder() string
 	GetInputFile() string
 	GetImportedInputFile() string
+	GetModelOverlays() []string
+	GetModelVariables() map[string]string
 	GetDataFlowDiagramFilenamePNG() string
 	GetDataAssetDiagramFilenamePNG() string
 	GetDataFlowDiagramFilenameDOT() string
@@ -50,7 +53,17 @@ type serverConfigReader interface {
 	GetTemplateFilename() string
 	GetTechnologyFilename() string
 	GetRiskRulePlugins() []string
+	GetRiskRulesFolder() string
+	GetGrpcPluginFolder() string
+	GetGrpcPluginTimeout() time.Duration
+	GetRiskRulesConfigFilename() string
+	GetRiskRulesProfile() string
 	GetSkipRiskRules() []string
+	GetRiskRuleWorkers() int
+	GetRiskCacheFilename() string
+	GetConsolidateRisks() bool
+	GetRiskLabels() []string
+	GetRuleTracesFilename() string
 	GetExecuteModelMacro() string
 	GetServerMode() bool
 	GetDiagramDPI() int
@@ -225,7 +238,7 @@ func RunServer(config serverConfigReader, builtinRiskRules types.RiskRules) {
 	router.PUT("/models/:model-id/shared-runtimes/:shared-runtime-id", s.setSharedRuntime)
 	router.DELETE("/models/:model-id/shared-runtimes/:shared-runtime-id", s.deleteSharedRuntime)
 
-	s.customRiskRules = model.LoadCustomRiskRules(s.config.GetPluginFolder(), s.config.GetRiskRulePlugins(), config.GetProgressReporter())
+	s.customRiskRules = model.LoadCustomRiskRules(s.config.GetPluginFolder(), s.config.GetRiskRulePlugins(), s.config.GetRiskRulesFolder(), s.config.GetGrpcPluginFolder(), s.config.GetGrpcPluginTimeout(), config.GetProgressReporter())
 
 	fmt.Println("Threagile is running...")
 	_ = router.Run(":" + strconv.Itoa(s.config.GetServerPort())) // listen and serve on 0.0.0.0:8080 or whatever port was specified
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"slices"
+	"sort"
+)
+
+// AnalysisContext holds lookups computed once from a model, which risk rules would otherwise recompute per technical asset:
+// the highest sensitivity of the technical assets, the communication partners of the technical assets and the trust boundaries
+// containing them.
+// It never changes after being created, so risk rules running concurrently can share it; changes of the model made afterward
+// are not reflected.
+type AnalysisContext struct {
+	dataAssets                 map[string]*DataAsset
+	processedSensitivity       map[string]sensitivity
+	storedSensitivity          map[string]sensitivity
+	incomingLinks              map[string][]*CommunicationLink
+	communicationPartnerIds    map[string][]string
+	trustBoundaryByTechAssetId map[string]*TrustBoundary
+	parentTrustBoundaryById    map[string]*TrustBoundary
+}
+
+func NewAnalysisContext(model *Model) *AnalysisContext {
+	what := &AnalysisContext{
+		dataAssets:                 model.DataAssets,
+		processedSensitivity:       make(map[string]sensitivity),
+		storedSensitivity:          make(map[string]sensitivity),
+		incomingLinks:              make(map[string][]*CommunicationLink),
+		communicationPartnerIds:    make(map[string][]string),
+		trustBoundaryByTechAssetId: make(map[string]*TrustBoundary),
+		parentTrustBoundaryById:    make(map[string]*TrustBoundary),
+	}
+
+	techAssetIds := model.SortedTechnicalAssetIDs()
+	for _, id := range techAssetIds {
+		techAsset := model.TechnicalAssets[id]
+		what.processedSensitivity[id] = what.highestSensitivity(techAsset, techAsset.DataAssetsProcessed)
+		what.storedSensitivity[id] = what.highestSensitivity(techAsset, techAsset.DataAssetsStored)
+
+		for _, commLink := range techAsset.CommunicationLinks {
+			what.incomingLinks[commLink.TargetId] = append(what.incomingLinks[commLink.TargetId], commLink)
+			what.addCommunicationPartner(id, commLink.TargetId)
+			what.addCommunicationPartner(commLink.TargetId, id)
+		}
+	}
+
+	// the incoming communication links derived by the model parser are included as well
+	for _, techAssetId := range techAssetIds {
+		for _, commLink := range model.IncomingTechnicalCommunicationLinksMappedByTargetId[techAssetId] {
+			if !slices.Contains(what.incomingLinks[techAssetId], commLink) {
+				what.incomingLinks[techAssetId] = append(what.incomingLinks[techAssetId], commLink)
+				what.addCommunicationPartner(techAssetId, commLink.SourceId)
+				what.addCommunicationPartner(commLink.SourceId, techAssetId)
+			}
+		}
+	}
+
+	for _, partnerIds := range what.communicationPartnerIds {
+		sort.Strings(partnerIds)
+	}
+
+	trustBoundaryIds := make([]string, 0)
+	for id := range model.TrustBoundaries {
+		trustBoundaryIds = append(trustBoundaryIds, id)
+	}
+	sort.Strings(trustBoundaryIds)
+
+	for _, id := range trustBoundaryIds {
+		trustBoundary := model.TrustBoundaries[id]
+		for _, techAssetId := range trustBoundary.TechnicalAssetsInside {
+			what.trustBoundaryByTechAssetId[techAssetId] = trustBoundary
+		}
+
+		for _, nestedId := range trustBoundary.TrustBoundariesNested {
+			if _, ok := what.parentTrustBoundaryById[nestedId]; !ok {
+				what.parentTrustBoundaryById[nestedId] = trustBoundary
+			}
+		}
+	}
+
+	// the containing trust boundaries derived by the model parser take precedence
+	for techAssetId, trustBoundary := range model.DirectContainingTrustBoundaryMappedByTechnicalAssetId {
+		what.trustBoundaryByTechAssetId[techAssetId] = trustBoundary
+	}
+
+	return what
+}
+
+func (what *AnalysisContext) HighestProcessedConfidentiality(techAsset *TechnicalAsset) Confidentiality {
+	return what.processed(techAsset).confidentiality
+}
+
+func (what *AnalysisContext) HighestProcessedIntegrity(techAsset *TechnicalAsset) Criticality {
+	return what.processed(techAsset).integrity
+}
+
+func (what *AnalysisContext) HighestProcessedAvailability(techAsset *TechnicalAsset) Criticality {
+	return what.processed(techAsset).availability
+}
+
+func (what *AnalysisContext) HighestStoredConfidentiality(techAsset *TechnicalAsset) Confidentiality {
+	return what.stored(techAsset).confidentiality
+}
+
+func (what *AnalysisContext) HighestStoredIntegrity(techAsset *TechnicalAsset) Criticality {
+	return what.stored(techAsset).integrity
+}
+
+func (what *AnalysisContext) HighestStoredAvailability(techAsset *TechnicalAsset) Criticality {
+	return what.stored(techAsset).availability
+}
+
+// IsHighlySensitive checks if the technical asset processes strictly confidential or mission-critical (integrity) data
+func (what *AnalysisContext) IsHighlySensitive(techAsset *TechnicalAsset) bool {
+	processed := what.processed(techAsset)
+	return processed.confidentiality == StrictlyConfidential || processed.integrity == MissionCritical
+}
+
+// IncomingCommunicationLinks returns the communication links targeting the technical asset
+func (what *AnalysisContext) IncomingCommunicationLinks(techAssetId string) []*CommunicationLink {
+	return what.incomingLinks[techAssetId]
+}
+
+// CommunicationPartnerIds returns the sorted ids of the technical assets communicating with the technical asset in either direction
+func (what *AnalysisContext) CommunicationPartnerIds(techAssetId string) []string {
+	return what.communicationPartnerIds[techAssetId]
+}
+
+// HasDirectConnection checks if the technical assets communicate with each other in either direction
+func (what *AnalysisContext) HasDirectConnection(techAssetId string, otherTechAssetId string) bool {
+	_, found := slices.BinarySearch(what.communicationPartnerIds[techAssetId], otherTechAssetId)
+	return found
+}
+
+// TrustBoundary returns the trust boundary directly containing the technical asset, or nil
+func (what *AnalysisContext) TrustBoundary(techAssetId string) *TrustBoundary {
+	return what.trustBoundaryByTechAssetId[techAssetId]
+}
+
+// TrustBoundaryId returns the id of the trust boundary directly containing the technical asset, or an empty string
+func (what *AnalysisContext) TrustBoundaryId(techAssetId string) string {
+	trustBoundary := what.TrustBoundary(techAssetId)
+	if trustBoundary == nil {
+		return ""
+	}
+
+	return trustBoundary.Id
+}
+
+// ParentTrustBoundary returns the trust boundary the trust boundary is nested in, or nil
+func (what *AnalysisContext) ParentTrustBoundary(trustBoundary *TrustBoundary) *TrustBoundary {
+	if trustBoundary == nil {
+		return nil
+	}
+
+	return what.parentTrustBoundaryById[trustBoundary.Id]
+}
+
+// AllParentTrustBoundaryIDs returns the id of the trust boundary followed by the ids of all trust boundaries it is nested in, innermost first
+func (what *AnalysisContext) AllParentTrustBoundaryIDs(trustBoundary *TrustBoundary) []string {
+	result := make([]string, 0)
+	for current := trustBoundary; current != nil && !slices.Contains(result, current.Id); current = what.ParentTrustBoundary(current) {
+		result = append(result, current.Id)
+	}
+
+	return result
+}
+
+func (what *AnalysisContext) processed(techAsset *TechnicalAsset) sensitivity {
+	if cached, ok := what.processedSensitivity[techAsset.Id]; ok {
+		return cached
+	}
+
+	return what.highestSensitivity(techAsset, techAsset.DataAssetsProcessed)
+}
+
+func (what *AnalysisContext) stored(techAsset *TechnicalAsset) sensitivity {
+	if cached, ok := what.storedSensitivity[techAsset.Id]; ok {
+		return cached
+	}
+
+	return what.highestSensitivity(techAsset, techAsset.DataAssetsStored)
+}
+
+func (what *AnalysisContext) highestSensitivity(techAsset *TechnicalAsset, dataAssetIds []string) sensitivity {
+	result := sensitivity{
+		confidentiality: techAsset.Confidentiality,
+		integrity:       techAsset.Integrity,
+		availability:    techAsset.Availability,
+	}
+
+	for _, dataAssetId := range dataAssetIds {
+		dataAsset, ok := what.dataAssets[dataAssetId]
+		if !ok {
+			continue
+		}
+
+		result.confidentiality = max(result.confidentiality, dataAsset.Confidentiality)
+		result.integrity = max(result.integrity, dataAsset.Integrity)
+		result.availability = max(result.availability, dataAsset.Availability)
+	}
+
+	return result
+}
+
+func (what *AnalysisContext) addCommunicationPartner(techAssetId string, partnerId string) {
+	if techAssetId == partnerId || slices.Contains(what.communicationPartnerIds[techAssetId], partnerId) {
+		return
+	}
+
+	what.communicationPartnerIds[techAssetId] = append(what.communicationPartnerIds[techAssetId], partnerId)
+}
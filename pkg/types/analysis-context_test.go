@@ -0,0 +1,144 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testAnalysisContextModel() *Model {
+	clientToWeb := &CommunicationLink{Id: "client>web", SourceId: "client", TargetId: "web"}
+	webToDb := &CommunicationLink{Id: "web>db", SourceId: "web", TargetId: "db"}
+	dbToWeb := &CommunicationLink{Id: "db>web", SourceId: "db", TargetId: "web"}
+
+	return &Model{
+		DataAssets: map[string]*DataAsset{
+			"customer": {Id: "customer", Confidentiality: StrictlyConfidential, Integrity: Important, Availability: Operational},
+			"log":      {Id: "log", Confidentiality: Internal, Integrity: MissionCritical, Availability: Archive},
+		},
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"client": {Id: "client", Confidentiality: Public, Integrity: Archive, Availability: Critical, CommunicationLinks: []*CommunicationLink{clientToWeb}},
+			"web":    {Id: "web", DataAssetsProcessed: []string{"customer", "unknown"}, CommunicationLinks: []*CommunicationLink{webToDb}},
+			"db":     {Id: "db", DataAssetsProcessed: []string{"customer"}, DataAssetsStored: []string{"log"}, CommunicationLinks: []*CommunicationLink{dbToWeb}},
+			"other":  {Id: "other"},
+		},
+		TrustBoundaries: map[string]*TrustBoundary{
+			"network":   {Id: "network", TechnicalAssetsInside: []string{"client"}, TrustBoundariesNested: []string{"cluster"}},
+			"cluster":   {Id: "cluster", TechnicalAssetsInside: []string{"web"}, TrustBoundariesNested: []string{"namespace"}},
+			"namespace": {Id: "namespace", TechnicalAssetsInside: []string{"db"}},
+		},
+	}
+}
+
+func TestAnalysisContextHighestSensitivity(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+	context := NewAnalysisContext(parsedModel)
+
+	client := parsedModel.TechnicalAssets["client"]
+	assert.Equal(t, Public, context.HighestProcessedConfidentiality(client))
+	assert.Equal(t, Archive, context.HighestProcessedIntegrity(client))
+	assert.Equal(t, Critical, context.HighestProcessedAvailability(client))
+	assert.False(t, context.IsHighlySensitive(client))
+
+	web := parsedModel.TechnicalAssets["web"]
+	assert.Equal(t, StrictlyConfidential, context.HighestProcessedConfidentiality(web))
+	assert.Equal(t, Important, context.HighestProcessedIntegrity(web))
+	assert.Equal(t, Operational, context.HighestProcessedAvailability(web))
+	assert.Equal(t, Public, context.HighestStoredConfidentiality(web))
+	assert.True(t, context.IsHighlySensitive(web))
+
+	db := parsedModel.TechnicalAssets["db"]
+	assert.Equal(t, Internal, context.HighestStoredConfidentiality(db))
+	assert.Equal(t, MissionCritical, context.HighestStoredIntegrity(db))
+	assert.Equal(t, Archive, context.HighestStoredAvailability(db))
+
+	// technical assets unknown to the context are evaluated on demand
+	unknown := &TechnicalAsset{Id: "unknown", DataAssetsProcessed: []string{"log"}}
+	assert.Equal(t, MissionCritical, context.HighestProcessedIntegrity(unknown))
+	assert.True(t, context.IsHighlySensitive(unknown))
+}
+
+func TestAnalysisContextCommunication(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+	context := NewAnalysisContext(parsedModel)
+
+	assert.Equal(t, []string{"client", "db"}, context.CommunicationPartnerIds("web"))
+	assert.Equal(t, []string{"web"}, context.CommunicationPartnerIds("db"))
+	assert.Empty(t, context.CommunicationPartnerIds("other"))
+
+	assert.True(t, context.HasDirectConnection("client", "web"))
+	assert.True(t, context.HasDirectConnection("web", "client"))
+	assert.False(t, context.HasDirectConnection("client", "db"))
+	assert.False(t, context.HasDirectConnection("other", "web"))
+
+	incomingLinkIds := make([]string, 0)
+	for _, commLink := range context.IncomingCommunicationLinks("web") {
+		incomingLinkIds = append(incomingLinkIds, commLink.Id)
+	}
+	assert.ElementsMatch(t, []string{"client>web", "db>web"}, incomingLinkIds)
+}
+
+func TestAnalysisContextIncludesDerivedIncomingLinks(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+	external := &CommunicationLink{Id: "external>other", SourceId: "external", TargetId: "other"}
+	parsedModel.IncomingTechnicalCommunicationLinksMappedByTargetId = map[string][]*CommunicationLink{
+		"web":   parsedModel.TechnicalAssets["client"].CommunicationLinks,
+		"other": {external},
+	}
+
+	context := NewAnalysisContext(parsedModel)
+
+	assert.Len(t, context.IncomingCommunicationLinks("web"), 2)
+	assert.Equal(t, []*CommunicationLink{external}, context.IncomingCommunicationLinks("other"))
+	assert.True(t, context.HasDirectConnection("other", "external"))
+}
+
+func TestAnalysisContextTrustBoundaries(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+	context := NewAnalysisContext(parsedModel)
+
+	assert.Equal(t, "namespace", context.TrustBoundaryId("db"))
+	assert.Equal(t, parsedModel.TrustBoundaries["cluster"], context.TrustBoundary("web"))
+	assert.Nil(t, context.TrustBoundary("other"))
+	assert.Empty(t, context.TrustBoundaryId("other"))
+
+	assert.Equal(t, parsedModel.TrustBoundaries["cluster"], context.ParentTrustBoundary(parsedModel.TrustBoundaries["namespace"]))
+	assert.Nil(t, context.ParentTrustBoundary(parsedModel.TrustBoundaries["network"]))
+	assert.Nil(t, context.ParentTrustBoundary(nil))
+
+	assert.Equal(t, []string{"namespace", "cluster", "network"}, context.AllParentTrustBoundaryIDs(parsedModel.TrustBoundaries["namespace"]))
+	assert.Empty(t, context.AllParentTrustBoundaryIDs(nil))
+}
+
+func TestAnalysisContextNestingCycle(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+	parsedModel.TrustBoundaries["namespace"].TrustBoundariesNested = []string{"network"}
+
+	context := NewAnalysisContext(parsedModel)
+
+	assert.Equal(t, []string{"namespace", "cluster", "network"}, context.AllParentTrustBoundaryIDs(parsedModel.TrustBoundaries["namespace"]))
+}
+
+func TestAnalysisContextPrefersDerivedTrustBoundaries(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+	parsedModel.DirectContainingTrustBoundaryMappedByTechnicalAssetId = map[string]*TrustBoundary{
+		"other": parsedModel.TrustBoundaries["network"],
+		"db":    parsedModel.TrustBoundaries["cluster"],
+	}
+
+	context := NewAnalysisContext(parsedModel)
+
+	assert.Equal(t, "network", context.TrustBoundaryId("other"))
+	assert.Equal(t, "cluster", context.TrustBoundaryId("db"))
+}
+
+func TestModelAnalysisContextIsComputedOnce(t *testing.T) {
+	parsedModel := testAnalysisContextModel()
+
+	context := parsedModel.AnalysisContext()
+	assert.Same(t, context, parsedModel.AnalysisContext())
+
+	// changes of the model made afterward are not reflected
+	parsedModel.TechnicalAssets["other"].CommunicationLinks = []*CommunicationLink{{Id: "other>web", SourceId: "other", TargetId: "web"}}
+	assert.False(t, parsedModel.AnalysisContext().HasDirectConnection("other", "web"))
+}
@@ -1,7 +1,7 @@
 package types
 
 type Author struct {
-	Name     string
-	Contact  string
-	Homepage string
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
+	Contact  string `json:"contact,omitempty" yaml:"contact,omitempty"`
+	Homepage string `json:"homepage,omitempty" yaml:"homepage,omitempty"`
 }
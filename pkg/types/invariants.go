@@ -0,0 +1,154 @@
+package types
+
+import (
+	"fmt"
+)
+
+// SecurityInvariant is a model-level policy which must always hold, like "all communication links from the PCI zone
+// to the internet must be encrypted". Violations of invariants are policy violations and thus reported separately
+// from the risks identified by the risk rules.
+type SecurityInvariant interface {
+	Check(model *Model) []InvariantViolation
+}
+
+type InvariantViolation struct {
+	Invariant           string `json:"invariant,omitempty" yaml:"invariant,omitempty"`
+	CommunicationLinkId string `json:"communication_link,omitempty" yaml:"communication_link,omitempty"`
+	SourceId            string `json:"source,omitempty" yaml:"source,omitempty"`
+	TargetId            string `json:"target,omitempty" yaml:"target,omitempty"`
+	Message             string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+func (what InvariantViolation) String() string {
+	return fmt.Sprintf("invariant violation (%v): %v", what.Invariant, what.Message)
+}
+
+const (
+	RequireEncryptionAcrossBoundaryInvariant = "require-encryption-across-boundary"
+	RequireAuthenticationInvariant           = "require-authentication"
+	ProhibitDirectLinkInvariant              = "prohibit-direct-link"
+)
+
+// RequireEncryptionAcrossBoundary requires all communication links from technical assets within trust boundaries
+// tagged with fromBoundaryTag to technical assets within trust boundaries tagged with toBoundaryTag to be encrypted
+func RequireEncryptionAcrossBoundary(fromBoundaryTag, toBoundaryTag string) SecurityInvariant {
+	return &requireEncryptionAcrossBoundary{fromBoundaryTag: fromBoundaryTag, toBoundaryTag: toBoundaryTag}
+}
+
+type requireEncryptionAcrossBoundary struct {
+	fromBoundaryTag string
+	toBoundaryTag   string
+}
+
+func (what *requireEncryptionAcrossBoundary) Check(model *Model) []InvariantViolation {
+	violations := make([]InvariantViolation, 0)
+	for _, link := range model.sortedCommunicationLinks() {
+		if link.Protocol.IsEncrypted() || link.Protocol.IsProcessLocal() {
+			continue
+		}
+		if !model.isInTrustBoundaryTaggedWith(link.SourceId, what.fromBoundaryTag) || !model.isInTrustBoundaryTaggedWith(link.TargetId, what.toBoundaryTag) {
+			continue
+		}
+		violations = append(violations, InvariantViolation{
+			Invariant:           RequireEncryptionAcrossBoundaryInvariant,
+			CommunicationLinkId: link.Id,
+			SourceId:            link.SourceId,
+			TargetId:            link.TargetId,
+			Message: fmt.Sprintf("communication link %q from %q (trust boundary tagged %q) to %q (trust boundary tagged %q) uses unencrypted protocol %v",
+				link.Id, link.SourceId, what.fromBoundaryTag, link.TargetId, what.toBoundaryTag, link.Protocol),
+		})
+	}
+	return violations
+}
+
+// RequireAuthentication requires all communication links entering trust boundaries tagged with boundaryTag
+// from outside to be authenticated
+func RequireAuthentication(boundaryTag string) SecurityInvariant {
+	return &requireAuthentication{boundaryTag: boundaryTag}
+}
+
+type requireAuthentication struct {
+	boundaryTag string
+}
+
+func (what *requireAuthentication) Check(model *Model) []InvariantViolation {
+	violations := make([]InvariantViolation, 0)
+	for _, link := range model.sortedCommunicationLinks() {
+		if link.Authentication != NoneAuthentication {
+			continue
+		}
+		if !model.isInTrustBoundaryTaggedWith(link.TargetId, what.boundaryTag) || model.isInTrustBoundaryTaggedWith(link.SourceId, what.boundaryTag) {
+			continue
+		}
+		violations = append(violations, InvariantViolation{
+			Invariant:           RequireAuthenticationInvariant,
+			CommunicationLinkId: link.Id,
+			SourceId:            link.SourceId,
+			TargetId:            link.TargetId,
+			Message: fmt.Sprintf("communication link %q from %q enters trust boundary tagged %q at %q without authentication",
+				link.Id, link.SourceId, what.boundaryTag, link.TargetId),
+		})
+	}
+	return violations
+}
+
+// ProhibitDirectLink prohibits any direct communication link from technical assets within trust boundaries tagged
+// with sourceBoundaryTag to technical assets within trust boundaries tagged with targetBoundaryTag
+func ProhibitDirectLink(sourceBoundaryTag, targetBoundaryTag string) SecurityInvariant {
+	return &prohibitDirectLink{sourceBoundaryTag: sourceBoundaryTag, targetBoundaryTag: targetBoundaryTag}
+}
+
+type prohibitDirectLink struct {
+	sourceBoundaryTag string
+	targetBoundaryTag string
+}
+
+func (what *prohibitDirectLink) Check(model *Model) []InvariantViolation {
+	violations := make([]InvariantViolation, 0)
+	for _, link := range model.sortedCommunicationLinks() {
+		if !model.isInTrustBoundaryTaggedWith(link.SourceId, what.sourceBoundaryTag) || !model.isInTrustBoundaryTaggedWith(link.TargetId, what.targetBoundaryTag) {
+			continue
+		}
+		violations = append(violations, InvariantViolation{
+			Invariant:           ProhibitDirectLinkInvariant,
+			CommunicationLinkId: link.Id,
+			SourceId:            link.SourceId,
+			TargetId:            link.TargetId,
+			Message: fmt.Sprintf("communication link %q directly connects %q (trust boundary tagged %q) with %q (trust boundary tagged %q)",
+				link.Id, link.SourceId, what.sourceBoundaryTag, link.TargetId, what.targetBoundaryTag),
+		})
+	}
+	return violations
+}
+
+// CheckSecurityInvariants evaluates all security invariants of the model and returns their violations
+func (model *Model) CheckSecurityInvariants() []InvariantViolation {
+	violations := make([]InvariantViolation, 0)
+	for _, invariant := range model.SecurityInvariants {
+		violations = append(violations, invariant.Check(model)...)
+	}
+	return violations
+}
+
+func (model *Model) sortedCommunicationLinks() []*CommunicationLink {
+	result := make([]*CommunicationLink, 0)
+	for _, id := range model.SortedTechnicalAssetIDs() {
+		result = append(result, model.TechnicalAssets[id].CommunicationLinksSorted()...)
+	}
+	return result
+}
+
+// isInTrustBoundaryTaggedWith checks whether the technical asset is (directly or via a parent) within a trust
+// boundary tagged with the given tag
+func (model *Model) isInTrustBoundaryTaggedWith(techAssetId string, tag string) bool {
+	trustBoundary, ok := model.DirectContainingTrustBoundaryMappedByTechnicalAssetId[techAssetId]
+	if !ok {
+		return false
+	}
+	for _, id := range model.AllParentTrustBoundaryIDs(trustBoundary) {
+		if parent, found := model.TrustBoundaries[id]; found && parent.IsTaggedWithAny(tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type SecurityInvariantTest struct {
+	protocol       Protocol
+	authentication Authentication
+	sourceTags     []string
+	targetTags     []string
+	expected       []string
+}
+
+// createInvariantTestModel creates a model with a single communication link from a technical asset in a trust
+// boundary tagged with sourceTags to a technical asset in a trust boundary tagged with targetTags
+func createInvariantTestModel(testCase SecurityInvariantTest) *Model {
+	source := &TechnicalAsset{
+		Id: "source",
+		CommunicationLinks: []*CommunicationLink{
+			{
+				Id:             "source>target",
+				SourceId:       "source",
+				TargetId:       "target",
+				Protocol:       testCase.protocol,
+				Authentication: testCase.authentication,
+			},
+		},
+	}
+	target := &TechnicalAsset{Id: "target"}
+	sourceBoundary := &TrustBoundary{Id: "source-boundary", Tags: testCase.sourceTags, TechnicalAssetsInside: []string{"source"}}
+	targetBoundary := &TrustBoundary{Id: "target-boundary", Tags: testCase.targetTags, TechnicalAssetsInside: []string{"target"}}
+	return &Model{
+		TechnicalAssets: map[string]*TechnicalAsset{"source": source, "target": target},
+		TrustBoundaries: map[string]*TrustBoundary{sourceBoundary.Id: sourceBoundary, targetBoundary.Id: targetBoundary},
+		DirectContainingTrustBoundaryMappedByTechnicalAssetId: map[string]*TrustBoundary{
+			"source": sourceBoundary,
+			"target": targetBoundary,
+		},
+	}
+}
+
+func violatingLinks(violations []InvariantViolation) []string {
+	result := make([]string, 0)
+	for _, violation := range violations {
+		result = append(result, violation.CommunicationLinkId)
+	}
+	return result
+}
+
+func TestRequireEncryptionAcrossBoundary(t *testing.T) {
+	testCases := map[string]SecurityInvariantTest{
+		"unencrypted link between tagged boundaries": {
+			protocol:   HTTP,
+			sourceTags: []string{"pci"},
+			targetTags: []string{"internet"},
+			expected:   []string{"source>target"},
+		},
+		"encrypted link between tagged boundaries": {
+			protocol:   HTTPS,
+			sourceTags: []string{"pci"},
+			targetTags: []string{"internet"},
+			expected:   []string{},
+		},
+		"unencrypted link from untagged boundary": {
+			protocol:   HTTP,
+			targetTags: []string{"internet"},
+			expected:   []string{},
+		},
+		"unencrypted link to untagged boundary": {
+			protocol:   HTTP,
+			sourceTags: []string{"pci"},
+			expected:   []string{},
+		},
+		"unencrypted link in reverse direction": {
+			protocol:   HTTP,
+			sourceTags: []string{"internet"},
+			targetTags: []string{"pci"},
+			expected:   []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := createInvariantTestModel(testCase)
+
+			violations := RequireEncryptionAcrossBoundary("pci", "internet").Check(model)
+
+			assert.Equal(t, testCase.expected, violatingLinks(violations))
+			for _, violation := range violations {
+				assert.Equal(t, RequireEncryptionAcrossBoundaryInvariant, violation.Invariant)
+			}
+		})
+	}
+}
+
+func TestRequireAuthentication(t *testing.T) {
+	testCases := map[string]SecurityInvariantTest{
+		"unauthenticated link entering tagged boundary": {
+			authentication: NoneAuthentication,
+			targetTags:     []string{"pci"},
+			expected:       []string{"source>target"},
+		},
+		"authenticated link entering tagged boundary": {
+			authentication: Token,
+			targetTags:     []string{"pci"},
+			expected:       []string{},
+		},
+		"unauthenticated link within tagged boundary": {
+			authentication: NoneAuthentication,
+			sourceTags:     []string{"pci"},
+			targetTags:     []string{"pci"},
+			expected:       []string{},
+		},
+		"unauthenticated link leaving tagged boundary": {
+			authentication: NoneAuthentication,
+			sourceTags:     []string{"pci"},
+			expected:       []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := createInvariantTestModel(testCase)
+
+			violations := RequireAuthentication("pci").Check(model)
+
+			assert.Equal(t, testCase.expected, violatingLinks(violations))
+			for _, violation := range violations {
+				assert.Equal(t, RequireAuthenticationInvariant, violation.Invariant)
+			}
+		})
+	}
+}
+
+func TestProhibitDirectLink(t *testing.T) {
+	testCases := map[string]SecurityInvariantTest{
+		"direct link between tagged boundaries": {
+			protocol:   HTTPS,
+			sourceTags: []string{"pci"},
+			targetTags: []string{"internet"},
+			expected:   []string{"source>target"},
+		},
+		"direct link from untagged boundary": {
+			protocol:   HTTPS,
+			targetTags: []string{"internet"},
+			expected:   []string{},
+		},
+		"direct link in reverse direction": {
+			protocol:   HTTPS,
+			sourceTags: []string{"internet"},
+			targetTags: []string{"pci"},
+			expected:   []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := createInvariantTestModel(testCase)
+
+			violations := ProhibitDirectLink("pci", "internet").Check(model)
+
+			assert.Equal(t, testCase.expected, violatingLinks(violations))
+			for _, violation := range violations {
+				assert.Equal(t, ProhibitDirectLinkInvariant, violation.Invariant)
+			}
+		})
+	}
+}
+
+func TestSecurityInvariantTagOfParentTrustBoundary(t *testing.T) {
+	model := createInvariantTestModel(SecurityInvariantTest{protocol: HTTP, targetTags: []string{"internet"}})
+	model.TrustBoundaries["pci-zone"] = &TrustBoundary{
+		Id:                    "pci-zone",
+		Tags:                  []string{"pci"},
+		TrustBoundariesNested: []string{"source-boundary"},
+	}
+
+	violations := RequireEncryptionAcrossBoundary("pci", "internet").Check(model)
+
+	assert.Equal(t, []string{"source>target"}, violatingLinks(violations))
+}
+
+func TestCheckSecurityInvariants(t *testing.T) {
+	model := createInvariantTestModel(SecurityInvariantTest{
+		protocol:       HTTP,
+		authentication: NoneAuthentication,
+		sourceTags:     []string{"pci"},
+		targetTags:     []string{"internet"},
+	})
+	model.SecurityInvariants = []SecurityInvariant{
+		RequireEncryptionAcrossBoundary("pci", "internet"),
+		RequireAuthentication("internet"),
+		ProhibitDirectLink("internet", "pci"),
+	}
+
+	violations := model.CheckSecurityInvariants()
+
+	assert.Len(t, violations, 2)
+	assert.Equal(t, RequireEncryptionAcrossBoundaryInvariant, violations[0].Invariant)
+	assert.Equal(t, RequireAuthenticationInvariant, violations[1].Invariant)
+}
+
+func TestCheckSecurityInvariantsNoInvariants(t *testing.T) {
+	model := createInvariantTestModel(SecurityInvariantTest{protocol: HTTP})
+
+	violations := model.CheckSecurityInvariants()
+
+	assert.Empty(t, violations)
+}
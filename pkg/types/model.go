@@ -6,10 +6,13 @@ package types
 
 import (
 	"fmt"
+	"log"
 	"regexp"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // TODO: move model out of types package and
@@ -39,6 +42,7 @@ type Model struct {
 	CustomRiskCategories                          RiskCategories                `json:"custom_risk_categories,omitempty" yaml:"custom_risk_categories,omitempty"`
 	BuiltInRiskCategories                         RiskCategories                `json:"built_in_risk_categories,omitempty" yaml:"built_in_risk_categories,omitempty"`
 	RiskTracking                                  map[string]*RiskTracking      `json:"risk_tracking,omitempty" yaml:"risk_tracking,omitempty"`
+	SecurityInvariants                            []SecurityInvariant           `json:"-" yaml:"-"`
 	CommunicationLinks                            map[string]*CommunicationLink `json:"communication_links,omitempty" yaml:"communication_links,omitempty"`
 	AllSupportedTags                              map[string]bool               `json:"all_supported_tags,omitempty" yaml:"all_supported_tags,omitempty"`
 	DiagramTweakNodesep                           int                           `json:"diagram_tweak_nodesep,omitempty" yaml:"diagram_tweak_nodesep,omitempty"`
@@ -54,6 +58,21 @@ type Model struct {
 	DirectContainingTrustBoundaryMappedByTechnicalAssetId map[string]*TrustBoundary       `json:"direct_containing_trust_boundary_mapped_by_technical_asset_id,omitempty" yaml:"direct_containing_trust_boundary_mapped_by_technical_asset_id,omitempty"`
 	GeneratedRisksByCategory                              map[string][]*Risk              `json:"generated_risks_by_category,omitempty" yaml:"generated_risks_by_category,omitempty"`
 	GeneratedRisksBySyntheticId                           map[string]*Risk                `json:"generated_risks_by_synthetic_id,omitempty" yaml:"generated_risks_by_synthetic_id,omitempty"`
+	InvariantViolations                                   []InvariantViolation            `json:"invariant_violations,omitempty" yaml:"invariant_violations,omitempty"`
+	AcceptedRisks                                         []*AcceptedRisk                 `json:"accepted_risks,omitempty" yaml:"accepted_risks,omitempty"`
+
+	analysisContext atomic.Pointer[AnalysisContext]
+
+	// the caches below are filled while risk rules run concurrently
+	cacheMutex                            sync.Mutex
+	dataAssetsProcessedByTechnicalAssetId map[string][]*DataAsset
+	sensitivityByTrustBoundaryId          map[string]sensitivity
+}
+
+type sensitivity struct {
+	confidentiality Confidentiality
+	integrity       Criticality
+	availability    Criticality
 }
 
 type ProgressReporter interface {
@@ -65,6 +84,17 @@ type ProgressReporter interface {
 	Errorf(format string, a ...any)
 }
 
+// AnalysisContext returns the analysis context of the model, which is created on first use and does not change afterward,
+// so it must not be used before the model is complete
+func (model *Model) AnalysisContext() *AnalysisContext {
+	if analysisContext := model.analysisContext.Load(); analysisContext != nil {
+		return analysisContext
+	}
+
+	model.analysisContext.CompareAndSwap(nil, NewAnalysisContext(model))
+	return model.analysisContext.Load()
+}
+
 func (model *Model) AddToListOfSupportedTags(tags []string) {
 	for _, tag := range tags {
 		model.AllSupportedTags[tag] = true
@@ -407,6 +437,36 @@ func (model *Model) FindTrustBoundaryHighestAvailability(tb *TrustBoundary) Crit
 	return highest
 }
 
+// HighestTrustBoundarySensitivity returns the highest confidentiality, integrity, and availability of all technical assets
+// directly inside the trust boundary (including the data assets they process or store).
+// The result is cached per trust boundary, so the model is expected not to change afterwards.
+func (model *Model) HighestTrustBoundarySensitivity(tb *TrustBoundary) (Confidentiality, Criticality, Criticality) {
+	model.cacheMutex.Lock()
+	cached, ok := model.sensitivityByTrustBoundaryId[tb.Id]
+	model.cacheMutex.Unlock()
+	if ok {
+		return cached.confidentiality, cached.integrity, cached.availability
+	}
+
+	result := sensitivity{confidentiality: Public, integrity: Archive, availability: Archive}
+	for _, id := range tb.TechnicalAssetsInside {
+		techAsset, ok := model.TechnicalAssets[id]
+		if !ok {
+			continue
+		}
+		result.confidentiality = max(result.confidentiality, model.HighestTechnicalAssetConfidentiality(techAsset))
+		result.integrity = max(result.integrity, model.HighestIntegrity(techAsset))
+		result.availability = max(result.availability, model.HighestAvailability(techAsset))
+	}
+	model.cacheMutex.Lock()
+	if model.sensitivityByTrustBoundaryId == nil {
+		model.sensitivityByTrustBoundaryId = make(map[string]sensitivity)
+	}
+	model.sensitivityByTrustBoundaryId[tb.Id] = result
+	model.cacheMutex.Unlock()
+	return result.confidentiality, result.integrity, result.availability
+}
+
 func (model *Model) RecursivelyAllTechnicalAssetIDsInside(tb *TrustBoundary) []string {
 	result := make([]string, 0)
 	model.addAssetIDsRecursively(tb, &result)
@@ -720,6 +780,40 @@ func (model *Model) HighestStoredConfidentiality(what *TechnicalAsset) Confident
 	return highest
 }
 
+// DataAssetsProcessedBy returns the data assets processed or stored by the given technical asset.
+// The result is cached per technical asset, so the model is expected not to change afterwards.
+func (model *Model) DataAssetsProcessedBy(what *TechnicalAsset) []*DataAsset {
+	model.cacheMutex.Lock()
+	cached, ok := model.dataAssetsProcessedByTechnicalAssetId[what.Id]
+	model.cacheMutex.Unlock()
+	if ok {
+		return cached
+	}
+
+	result := make([]*DataAsset, 0)
+	seen := make(map[string]bool)
+	for _, dataAssetId := range append(slices.Clone(what.DataAssetsProcessed), what.DataAssetsStored...) {
+		if seen[dataAssetId] {
+			continue
+		}
+		seen[dataAssetId] = true
+
+		dataAsset, ok := model.DataAssets[dataAssetId]
+		if !ok {
+			log.Printf("warning: technical asset %q references unknown data asset %q", what.Id, dataAssetId)
+			continue
+		}
+		result = append(result, dataAsset)
+	}
+	model.cacheMutex.Lock()
+	if model.dataAssetsProcessedByTechnicalAssetId == nil {
+		model.dataAssetsProcessedByTechnicalAssetId = make(map[string][]*DataAsset)
+	}
+	model.dataAssetsProcessedByTechnicalAssetId[what.Id] = result
+	model.cacheMutex.Unlock()
+	return result
+}
+
 func (model *Model) DataAssetsProcessedSorted(what *TechnicalAsset) []*DataAsset {
 	result := make([]*DataAsset, 0)
 	for _, assetID := range what.DataAssetsProcessed {
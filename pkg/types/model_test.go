@@ -0,0 +1,200 @@
+/*
+Copyright © 2023 NAME HERE <EMAIL ADDRESS>
+*/
+
+package types
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type DataAssetsProcessedByTest struct {
+	processed []string
+	stored    []string
+	expected  []string
+}
+
+func TestDataAssetsProcessedBy(t *testing.T) {
+	testCases := map[string]DataAssetsProcessedByTest{
+		"no data assets": {
+			expected: []string{},
+		},
+		"one processed data asset": {
+			processed: []string{"customer"},
+			expected:  []string{"customer"},
+		},
+		"one stored data asset": {
+			stored:   []string{"customer"},
+			expected: []string{"customer"},
+		},
+		"multiple data assets": {
+			processed: []string{"customer", "order"},
+			stored:    []string{"order", "invoice"},
+			expected:  []string{"customer", "order", "invoice"},
+		},
+		"unknown data asset skipped": {
+			processed: []string{"customer", "unknown"},
+			expected:  []string{"customer"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			techAsset := &TechnicalAsset{
+				Id:                  "app",
+				DataAssetsProcessed: testCase.processed,
+				DataAssetsStored:    testCase.stored,
+			}
+			model := &Model{
+				DataAssets: map[string]*DataAsset{
+					"customer": {Id: "customer"},
+					"order":    {Id: "order"},
+					"invoice":  {Id: "invoice"},
+				},
+				TechnicalAssets: map[string]*TechnicalAsset{"app": techAsset},
+			}
+
+			actual := make([]string, 0)
+			for _, dataAsset := range model.DataAssetsProcessedBy(techAsset) {
+				actual = append(actual, dataAsset.Id)
+			}
+
+			assert.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestDataAssetsProcessedBySharedBetweenTechnicalAssets(t *testing.T) {
+	customer := &DataAsset{Id: "customer"}
+	frontend := &TechnicalAsset{Id: "frontend", DataAssetsProcessed: []string{"customer"}}
+	backend := &TechnicalAsset{Id: "backend", DataAssetsStored: []string{"customer"}}
+	model := &Model{
+		DataAssets: map[string]*DataAsset{"customer": customer},
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"frontend": frontend,
+			"backend":  backend,
+		},
+	}
+
+	assert.Equal(t, []*DataAsset{customer}, model.DataAssetsProcessedBy(frontend))
+	assert.Equal(t, []*DataAsset{customer}, model.DataAssetsProcessedBy(backend))
+	assert.Same(t, model.DataAssetsProcessedBy(frontend)[0], model.DataAssetsProcessedBy(backend)[0])
+}
+
+func TestDataAssetsProcessedByConcurrently(t *testing.T) {
+	customer := &DataAsset{Id: "customer"}
+	model := &Model{
+		DataAssets:      map[string]*DataAsset{"customer": customer},
+		TechnicalAssets: make(map[string]*TechnicalAsset),
+		TrustBoundaries: map[string]*TrustBoundary{"tb": {Id: "tb"}},
+	}
+	for n := range 10 {
+		id := fmt.Sprintf("app-%d", n)
+		model.TechnicalAssets[id] = &TechnicalAsset{Id: id, DataAssetsProcessed: []string{"customer"}}
+		model.TrustBoundaries["tb"].TechnicalAssetsInside = append(model.TrustBoundaries["tb"].TechnicalAssetsInside, id)
+	}
+
+	var waitGroup sync.WaitGroup
+	for range 4 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for _, techAsset := range model.TechnicalAssets {
+				assert.Equal(t, []*DataAsset{customer}, model.DataAssetsProcessedBy(techAsset))
+			}
+			model.HighestTrustBoundarySensitivity(model.TrustBoundaries["tb"])
+		}()
+	}
+	waitGroup.Wait()
+}
+
+func TestYamlRoundTrip(t *testing.T) {
+	testCases := map[string]string{
+		"parsed model": filepath.Join("..", "..", "test", "parsed-model.yaml"),
+		"round trip":   filepath.Join("testdata", "round-trip.yaml"),
+	}
+
+	for name, filename := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data, readError := os.ReadFile(filepath.Clean(filename))
+			assert.NoError(t, readError)
+
+			var inputNode yaml.Node
+			assert.NoError(t, yaml.Unmarshal(data, &inputNode))
+			expected, expectedError := StabilizeYaml(&inputNode)
+			assert.NoError(t, expectedError)
+
+			model := new(Model)
+			assert.NoError(t, yaml.Unmarshal(data, model))
+			actual, actualError := StabilizeModel(model)
+			assert.NoError(t, actualError)
+
+			assert.Equal(t, string(expected), string(actual))
+
+			reparsed := new(Model)
+			assert.NoError(t, yaml.Unmarshal(actual, reparsed))
+			again, againError := StabilizeModel(reparsed)
+			assert.NoError(t, againError)
+
+			assert.Equal(t, string(actual), string(again))
+		})
+	}
+}
+
+func TestHighestTrustBoundarySensitivityMixedSensitivityAssets(t *testing.T) {
+	trustBoundary := &TrustBoundary{
+		Id:                    "tb",
+		TechnicalAssetsInside: []string{"frontend", "backend"},
+		TrustBoundariesNested: []string{"nested"},
+	}
+	model := &Model{
+		DataAssets: map[string]*DataAsset{
+			"customer": {Id: "customer", Confidentiality: StrictlyConfidential, Integrity: Important, Availability: Operational},
+		},
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"frontend": {Id: "frontend", Confidentiality: Internal, Integrity: Critical, Availability: Important},
+			"backend":  {Id: "backend", Confidentiality: Restricted, Integrity: Operational, Availability: Operational, DataAssetsStored: []string{"customer"}},
+			"other":    {Id: "other", Confidentiality: Public, Integrity: MissionCritical, Availability: MissionCritical},
+		},
+		TrustBoundaries: map[string]*TrustBoundary{
+			"tb":     trustBoundary,
+			"nested": {Id: "nested", TechnicalAssetsInside: []string{"other"}},
+		},
+	}
+
+	confidentiality, integrity, availability := model.HighestTrustBoundarySensitivity(trustBoundary)
+
+	assert.Equal(t, StrictlyConfidential, confidentiality)
+	assert.Equal(t, Critical, integrity)
+	assert.Equal(t, Important, availability)
+}
+
+func TestHighestTrustBoundarySensitivityEmptyTrustBoundary(t *testing.T) {
+	model := &Model{}
+
+	confidentiality, integrity, availability := model.HighestTrustBoundarySensitivity(&TrustBoundary{Id: "tb"})
+
+	assert.Equal(t, Public, confidentiality)
+	assert.Equal(t, Archive, integrity)
+	assert.Equal(t, Archive, availability)
+}
+
+func TestHighestTrustBoundarySensitivityIsCachedPerTrustBoundary(t *testing.T) {
+	techAsset := &TechnicalAsset{Id: "app", Confidentiality: Internal}
+	trustBoundary := &TrustBoundary{Id: "tb", TechnicalAssetsInside: []string{"app"}}
+	model := &Model{TechnicalAssets: map[string]*TechnicalAsset{"app": techAsset}}
+
+	first, _, _ := model.HighestTrustBoundarySensitivity(trustBoundary)
+	techAsset.Confidentiality = StrictlyConfidential
+	second, _, _ := model.HighestTrustBoundarySensitivity(trustBoundary)
+
+	assert.Equal(t, Internal, first)
+	assert.Equal(t, Internal, second)
+}
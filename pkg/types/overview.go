@@ -1,6 +1,6 @@
 package types
 
 type Overview struct {
-	Description string
-	Images      []map[string]string // yes, array of map here, as array keeps the order of the image keys
+	Description string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Images      []map[string]string `json:"images,omitempty" yaml:"images,omitempty"` // yes, array of map here, as array keeps the order of the image keys
 }
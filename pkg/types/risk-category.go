@@ -19,6 +19,7 @@ type RiskCategory struct {
 	FalsePositives             string       `json:"false_positives,omitempty" yaml:"false_positives,omitempty"`
 	ModelFailurePossibleReason bool         `json:"model_failure_possible_reason,omitempty" yaml:"model_failure_possible_reason,omitempty"`
 	CWE                        int          `json:"cwe,omitempty" yaml:"cwe,omitempty"`
+	Labels                     []string     `json:"labels,omitempty" yaml:"labels,omitempty"` // labels of all risks of the category, like "network" or "compliance:pci"
 }
 
 type RiskCategories []*RiskCategory
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// RiskQuery selects generated risks by a small query language of whitespace separated terms:
+//   - a risk id pattern "<category>@<id>", where either part may be "*" or contain "*" as wildcard; "<category>@*" matches
+//     all risks of a category and "*@<technical asset>" all risks of a technical asset, the id part also matches the remainder
+//     of synthetic ids; a pattern without "@" matches categories only
+//   - tag filters "tag=<tag>", matching risks whose most relevant model elements are tagged with the tag
+//   - label filters "label=<label>", matching risks labeled with the label by their risk rule
+//   - severity filters "severity=<severity>", "severity>=<severity>" or "severity<=<severity>"
+//   - confidence filters "confidence=<confidence>", "confidence>=<confidence>" or "confidence<=<confidence>"
+//
+// All terms of a query have to match; the risk id pattern defaults to "*@*".
+type RiskQuery struct {
+	category    *regexp.Regexp
+	id          *regexp.Regexp
+	tags        []string
+	labels      []string
+	severities  []ratingFilter
+	confidences []ratingFilter
+}
+
+// ratingFilter compares the weight of a rating like the severity or the confidence of a risk
+type ratingFilter struct {
+	operator string
+	weight   int
+}
+
+func ParseRiskQuery(query string) (*RiskQuery, error) {
+	what := &RiskQuery{
+		category: wildcardExpression("*", ".*"),
+		id:       wildcardExpression("*", ".*"),
+	}
+
+	idPatternSeen := false
+	for _, term := range strings.Fields(query) {
+		switch {
+		case strings.HasPrefix(term, "tag="):
+			tag := strings.TrimPrefix(term, "tag=")
+			if len(tag) == 0 {
+				return nil, fmt.Errorf("missing tag in risk query term %q", term)
+			}
+
+			what.tags = append(what.tags, tag)
+
+		case strings.HasPrefix(term, "label="):
+			label := strings.TrimPrefix(term, "label=")
+			if len(label) == 0 {
+				return nil, fmt.Errorf("missing label in risk query term %q", term)
+			}
+
+			what.labels = append(what.labels, label)
+
+		case strings.HasPrefix(term, "severity"):
+			filter, filterError := parseRatingFilter(term, "severity", func(value string) (int, error) {
+				severity, parseError := ParseRiskSeverity(value)
+				return int(severity), parseError
+			})
+			if filterError != nil {
+				return nil, filterError
+			}
+
+			what.severities = append(what.severities, filter)
+
+		case strings.HasPrefix(term, "confidence"):
+			filter, filterError := parseRatingFilter(term, "confidence", func(value string) (int, error) {
+				confidence, parseError := ParseRiskConfidence(value)
+				return confidence.Weight(), parseError
+			})
+			if filterError != nil {
+				return nil, filterError
+			}
+
+			what.confidences = append(what.confidences, filter)
+
+		default:
+			if idPatternSeen {
+				return nil, fmt.Errorf("risk query %q contains more than one risk id pattern", query)
+			}
+
+			idPatternSeen = true
+			category, id, hasId := strings.Cut(term, "@")
+			what.category = wildcardExpression(category, "[^@]*")
+			if hasId {
+				what.id = wildcardExpression(id, ".*")
+			}
+		}
+	}
+
+	return what, nil
+}
+
+// MatchRisk checks if the risk matches all terms of the query
+func (what *RiskQuery) MatchRisk(parsedModel *Model, risk *Risk) bool {
+	if !what.category.MatchString(risk.CategoryId) {
+		return false
+	}
+
+	idRemainder := strings.TrimPrefix(risk.SyntheticId, risk.CategoryId+"@")
+	if !what.id.MatchString(idRemainder) && !what.id.MatchString(risk.MostRelevantTechnicalAssetId) {
+		return false
+	}
+
+	for _, filter := range what.severities {
+		if !filter.match(int(risk.Severity)) {
+			return false
+		}
+	}
+
+	for _, filter := range what.confidences {
+		if !filter.match(risk.Confidence.Weight()) {
+			return false
+		}
+	}
+
+	for _, tag := range what.tags {
+		if !isRiskTaggedWith(parsedModel, risk, tag) {
+			return false
+		}
+	}
+
+	for _, label := range what.labels {
+		if !slices.Contains(risk.Labels, label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// QueryRisks returns the generated risks matching the query, sorted by synthetic id
+func (model *Model) QueryRisks(query *RiskQuery) []*Risk {
+	result := make([]*Risk, 0)
+	for _, risk := range model.GeneratedRisksBySyntheticId {
+		if query.MatchRisk(model, risk) {
+			result = append(result, risk)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SyntheticId < result[j].SyntheticId
+	})
+
+	return result
+}
+
+func parseRatingFilter(term string, name string, parseWeight func(value string) (int, error)) (ratingFilter, error) {
+	for _, operator := range []string{">=", "<=", "="} {
+		value, ok := strings.CutPrefix(term, name+operator)
+		if !ok {
+			continue
+		}
+
+		weight, parseError := parseWeight(value)
+		if parseError != nil {
+			return ratingFilter{}, fmt.Errorf("invalid %v in risk query term %q: %w", name, term, parseError)
+		}
+
+		return ratingFilter{operator: operator, weight: weight}, nil
+	}
+
+	return ratingFilter{}, fmt.Errorf("invalid %v filter %q, expected %v=, %v>= or %v<=", name, term, name, name, name)
+}
+
+func (what ratingFilter) match(weight int) bool {
+	switch what.operator {
+	case ">=":
+		return weight >= what.weight
+
+	case "<=":
+		return weight <= what.weight
+
+	default:
+		return weight == what.weight
+	}
+}
+
+func isRiskTaggedWith(parsedModel *Model, risk *Risk, tag string) bool {
+	if techAsset, ok := parsedModel.TechnicalAssets[risk.MostRelevantTechnicalAssetId]; ok && techAsset.IsTaggedWithAny(tag) {
+		return true
+	}
+
+	if commLink, ok := parsedModel.CommunicationLinks[risk.MostRelevantCommunicationLinkId]; ok && commLink.IsTaggedWithAny(tag) {
+		return true
+	}
+
+	if dataAsset, ok := parsedModel.DataAssets[risk.MostRelevantDataAssetId]; ok && dataAsset.IsTaggedWithAny(tag) {
+		return true
+	}
+
+	if trustBoundary, ok := parsedModel.TrustBoundaries[risk.MostRelevantTrustBoundaryId]; ok && trustBoundary.IsTaggedWithAny(tag) {
+		return true
+	}
+
+	if sharedRuntime, ok := parsedModel.SharedRuntimes[risk.MostRelevantSharedRuntimeId]; ok && sharedRuntime.IsTaggedWithAny(tag) {
+		return true
+	}
+
+	return false
+}
+
+// wildcardExpression matches the pattern case-insensitively as a whole, with "*" matching any text allowed by wildcard
+func wildcardExpression(pattern string, wildcard string) *regexp.Regexp {
+	return regexp.MustCompile("(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, wildcard) + "$")
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRiskQueryModel() *Model {
+	risks := []*Risk{
+		{CategoryId: "sql-nosql-injection", SyntheticId: "sql-nosql-injection@web>db@web@db", MostRelevantTechnicalAssetId: "db", MostRelevantCommunicationLinkId: "web>db", Severity: HighSeverity, Labels: []string{"appsec"}},
+		{CategoryId: "missing-hardening", SyntheticId: "missing-hardening@db", MostRelevantTechnicalAssetId: "db", Severity: MediumSeverity, Confidence: MediumConfidence},
+		{CategoryId: "missing-hardening", SyntheticId: "missing-hardening@web", MostRelevantTechnicalAssetId: "web", Severity: LowSeverity, Confidence: LowConfidence},
+		{CategoryId: "missing-cloud-hardening", SyntheticId: "missing-cloud-hardening@cloud@aws", MostRelevantTrustBoundaryId: "cloud", Severity: CriticalSeverity, Labels: []string{"cloud", "compliance:pci"}},
+	}
+
+	model := &Model{
+		TechnicalAssets: map[string]*TechnicalAsset{
+			"web": {Id: "web", Tags: []string{"frontend"}},
+			"db":  {Id: "db"},
+		},
+		CommunicationLinks: map[string]*CommunicationLink{
+			"web>db": {Id: "web>db", Tags: []string{"jdbc"}},
+		},
+		TrustBoundaries: map[string]*TrustBoundary{
+			"cloud": {Id: "cloud", Tags: []string{"aws"}},
+		},
+		GeneratedRisksBySyntheticId: make(map[string]*Risk),
+	}
+
+	for _, risk := range risks {
+		model.GeneratedRisksBySyntheticId[risk.SyntheticId] = risk
+	}
+
+	return model
+}
+
+func queriedRiskIds(t *testing.T, model *Model, query string) []string {
+	riskQuery, err := ParseRiskQuery(query)
+	assert.NoError(t, err)
+
+	ids := make([]string, 0)
+	for _, risk := range model.QueryRisks(riskQuery) {
+		ids = append(ids, risk.SyntheticId)
+	}
+
+	return ids
+}
+
+func TestRiskQueryMatchesRiskIds(t *testing.T) {
+	model := testRiskQueryModel()
+
+	testCases := map[string][]string{
+		"missing-hardening@db":              {"missing-hardening@db"},
+		"MISSING-HARDENING@DB":              {"missing-hardening@db"},
+		"missing-hardening@*":               {"missing-hardening@db", "missing-hardening@web"},
+		"missing-hardening":                 {"missing-hardening@db", "missing-hardening@web"},
+		"missing-*":                         {"missing-cloud-hardening@cloud@aws", "missing-hardening@db", "missing-hardening@web"},
+		"*@db":                              {"missing-hardening@db", "sql-nosql-injection@web>db@web@db"},
+		"sql-nosql-injection@web>db@web@db": {"sql-nosql-injection@web>db@web@db"},
+		"sql-nosql-injection@web>db@*":      {"sql-nosql-injection@web>db@web@db"},
+		"missing-cloud-hardening@cloud@aws": {"missing-cloud-hardening@cloud@aws"},
+		"unknown@*":                         {},
+		"":                                  {"missing-cloud-hardening@cloud@aws", "missing-hardening@db", "missing-hardening@web", "sql-nosql-injection@web>db@web@db"},
+	}
+
+	for query, expected := range testCases {
+		t.Run(query, func(t *testing.T) {
+			assert.Equal(t, expected, queriedRiskIds(t, model, query))
+		})
+	}
+}
+
+func TestRiskQueryFilters(t *testing.T) {
+	model := testRiskQueryModel()
+
+	testCases := map[string][]string{
+		"severity=medium":                 {"missing-hardening@db"},
+		"severity>=high":                  {"missing-cloud-hardening@cloud@aws", "sql-nosql-injection@web>db@web@db"},
+		"severity<=medium":                {"missing-hardening@db", "missing-hardening@web"},
+		"*@db severity>=medium":           {"missing-hardening@db", "sql-nosql-injection@web>db@web@db"},
+		"confidence=high":                 {"missing-cloud-hardening@cloud@aws", "sql-nosql-injection@web>db@web@db"},
+		"confidence>=medium":              {"missing-cloud-hardening@cloud@aws", "missing-hardening@db", "sql-nosql-injection@web>db@web@db"},
+		"confidence<=medium":              {"missing-hardening@db", "missing-hardening@web"},
+		"severity>=low confidence=low":    {"missing-hardening@web"},
+		"tag=frontend":                    {"missing-hardening@web"},
+		"tag=jdbc":                        {"sql-nosql-injection@web>db@web@db"},
+		"tag=aws":                         {"missing-cloud-hardening@cloud@aws"},
+		"tag=jdbc tag=frontend":           {},
+		"label=appsec":                    {"sql-nosql-injection@web>db@web@db"},
+		"label=compliance:pci":            {"missing-cloud-hardening@cloud@aws"},
+		"label=cloud label=appsec":        {},
+		"missing-hardening@* tag=unknown": {},
+	}
+
+	for query, expected := range testCases {
+		t.Run(query, func(t *testing.T) {
+			assert.Equal(t, expected, queriedRiskIds(t, model, query))
+		})
+	}
+}
+
+func TestParseRiskQueryErrors(t *testing.T) {
+	for _, query := range []string{"severity=unknown", "severity~high", "confidence=unknown", "confidence>high", "tag=", "label=", "a@* b@*"} {
+		_, err := ParseRiskQuery(query)
+		assert.Error(t, err, query)
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RiskRuleParameters holds the parameters of a single risk rule as read from the rules config;
+// parameters not given keep the default value of the rule
+type RiskRuleParameters struct {
+	values map[string]any
+	used   map[string]bool
+}
+
+func NewRiskRuleParameters(values map[string]any) *RiskRuleParameters {
+	return &RiskRuleParameters{
+		values: values,
+		used:   make(map[string]bool),
+	}
+}
+
+func (what *RiskRuleParameters) Int(name string, value *int) error {
+	raw, ok := what.lookup(name)
+	if !ok {
+		return nil
+	}
+
+	number, isInt := raw.(int)
+	if !isInt {
+		return fmt.Errorf("parameter %q must be an integer, got %v", name, raw)
+	}
+
+	*value = number
+	return nil
+}
+
+func (what *RiskRuleParameters) Strings(name string, value *[]string) error {
+	raw, ok := what.lookup(name)
+	if !ok {
+		return nil
+	}
+
+	list, isList := raw.([]any)
+	if !isList {
+		return fmt.Errorf("parameter %q must be a list of strings, got %v", name, raw)
+	}
+
+	result := make([]string, 0)
+	for _, item := range list {
+		text, isString := item.(string)
+		if !isString {
+			return fmt.Errorf("parameter %q must be a list of strings, got %v", name, raw)
+		}
+
+		result = append(result, text)
+	}
+
+	*value = result
+	return nil
+}
+
+func (what *RiskRuleParameters) Protocols(name string, value *[]Protocol) error {
+	var names []string
+	stringsError := what.Strings(name, &names)
+	if stringsError != nil || names == nil {
+		return stringsError
+	}
+
+	result := make([]Protocol, 0)
+	for _, protocolName := range names {
+		protocol, parseError := ParseProtocol(protocolName)
+		if parseError != nil {
+			return fmt.Errorf("parameter %q: %w", name, parseError)
+		}
+
+		result = append(result, protocol)
+	}
+
+	*value = result
+	return nil
+}
+
+// Unused returns the sorted names of all parameters not read by the risk rule, like misspelled ones
+func (what *RiskRuleParameters) Unused() []string {
+	unused := make([]string, 0)
+	for name := range what.values {
+		if !what.used[name] {
+			unused = append(unused, name)
+		}
+	}
+
+	sort.Strings(unused)
+	return unused
+}
+
+func (what *RiskRuleParameters) lookup(name string) (any, bool) {
+	value, ok := what.values[name]
+	if ok {
+		what.used[name] = true
+	}
+
+	return value, ok
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiskRuleParametersMissingKeepsDefault(t *testing.T) {
+	parameters := NewRiskRuleParameters(map[string]any{})
+	limit, tags, protocols := 40, []string{"git"}, []Protocol{HTTPS}
+
+	assert.Nil(t, parameters.Int("limit", &limit))
+	assert.Nil(t, parameters.Strings("tags", &tags))
+	assert.Nil(t, parameters.Protocols("protocols", &protocols))
+
+	assert.Equal(t, 40, limit)
+	assert.Equal(t, []string{"git"}, tags)
+	assert.Equal(t, []Protocol{HTTPS}, protocols)
+}
+
+func TestRiskRuleParametersValues(t *testing.T) {
+	parameters := NewRiskRuleParameters(map[string]any{
+		"limit":     60,
+		"tags":      []any{"gitlab", "github"},
+		"protocols": []any{"https", "sftp"},
+	})
+	limit, tags, protocols := 40, []string{"git"}, []Protocol{HTTPS}
+
+	assert.Nil(t, parameters.Int("limit", &limit))
+	assert.Nil(t, parameters.Strings("tags", &tags))
+	assert.Nil(t, parameters.Protocols("protocols", &protocols))
+
+	assert.Equal(t, 60, limit)
+	assert.Equal(t, []string{"gitlab", "github"}, tags)
+	assert.Equal(t, []Protocol{HTTPS, SFTP}, protocols)
+	assert.Empty(t, parameters.Unused())
+}
+
+func TestRiskRuleParametersInvalidValues(t *testing.T) {
+	parameters := NewRiskRuleParameters(map[string]any{
+		"limit":     "sixty",
+		"tags":      "git",
+		"mixed":     []any{"git", 1},
+		"protocols": []any{"carrier-pigeon"},
+	})
+	limit, tags, mixed, protocols := 40, []string{"git"}, []string{"git"}, []Protocol{HTTPS}
+
+	assert.NotNil(t, parameters.Int("limit", &limit))
+	assert.NotNil(t, parameters.Strings("tags", &tags))
+	assert.NotNil(t, parameters.Strings("mixed", &mixed))
+	assert.NotNil(t, parameters.Protocols("protocols", &protocols))
+
+	assert.Equal(t, 40, limit)
+	assert.Equal(t, []string{"git"}, tags)
+	assert.Equal(t, []string{"git"}, mixed)
+	assert.Equal(t, []Protocol{HTTPS}, protocols)
+}
+
+func TestRiskRuleParametersUnused(t *testing.T) {
+	parameters := NewRiskRuleParameters(map[string]any{"limit": 60, "limt": 60, "other": true})
+	limit := 40
+
+	assert.Nil(t, parameters.Int("limit", &limit))
+
+	assert.Equal(t, []string{"limt", "other"}, parameters.Unused())
+}
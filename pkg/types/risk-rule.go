@@ -1,5 +1,7 @@
 package types
 
+import "sort"
+
 type RiskRule interface {
 	Category() *RiskCategory
 	SupportedTags() []string
@@ -15,3 +17,32 @@ func (what RiskRules) Merge(rules RiskRules) RiskRules {
 
 	return what
 }
+
+func (what RiskRules) SortedIds() []string {
+	ids := make([]string, 0)
+	for id := range what {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+	return ids
+}
+
+// ConfigurableRiskRule is implemented by risk rules reading parameters (like tags or thresholds) from the rules config
+type ConfigurableRiskRule interface {
+	Configure(parameters *RiskRuleParameters) error
+}
+
+// ExplainableRiskRule is implemented by risk rules explaining why a risk was flagged: the conditions matched, the thresholds
+// applied and the attributes of the assets involved; the risk is given by its synthetic id, or as "<category>@*" for all
+// risks of the rule
+type ExplainableRiskRule interface {
+	ExplainRisk(parsedModel *Model, risk string) []string
+}
+
+// AssetScopedRiskRule is implemented by risk rules generating the risks of a technical asset only from the asset itself and its
+// direct neighborhood (communication links, communication partners and containing trust boundary), and attributing each risk
+// to a technical asset; incremental risk generation re-evaluates such rules for changed technical assets only
+type AssetScopedRiskRule interface {
+	IsAssetScoped() bool
+}
@@ -6,6 +6,8 @@ type Risk struct {
 	Severity                        RiskSeverity               `yaml:"severity,omitempty" json:"severity,omitempty"`
 	ExploitationLikelihood          RiskExploitationLikelihood `yaml:"exploitation_likelihood,omitempty" json:"exploitation_likelihood,omitempty"`
 	ExploitationImpact              RiskExploitationImpact     `yaml:"exploitation_impact,omitempty" json:"exploitation_impact,omitempty"`
+	Confidence                      RiskConfidence             `yaml:"confidence,omitempty" json:"confidence"`
+	Labels                          []string                   `yaml:"labels,omitempty" json:"labels,omitempty"` // labels of the risk category along with labels of the risk itself
 	Title                           string                     `yaml:"title,omitempty" json:"title,omitempty"`
 	SyntheticId                     string                     `yaml:"synthetic_id,omitempty" json:"synthetic_id,omitempty"`
 	MostRelevantDataAssetId         string                     `yaml:"most_relevant_data_asset,omitempty" json:"most_relevant_data_asset,omitempty"`
@@ -17,5 +19,6 @@ type Risk struct {
 	DataBreachTechnicalAssetIDs     []string                   `yaml:"data_breach_technical_assets,omitempty" json:"data_breach_technical_assets,omitempty"`
 	RiskExplanation                 []string                   `yaml:"risk_explanation,omitempty" json:"risk_explanation,omitempty"`
 	RatingExplanation               []string                   `yaml:"rating_explanation,omitempty" json:"rating_explanation,omitempty"`
+	ConsolidatedRisks               []*Risk                    `yaml:"consolidated_risks,omitempty" json:"consolidated_risks,omitempty"` // related risks of the same communication link or technical asset, if risks are consolidated
 	// TODO: refactor all "ID" here to "ID"?
 }
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RiskConfidence tells how certain a risk rule is that a generated risk applies: rules detecting risks from explicit
+// model attributes report a high confidence, heuristic rules a lower one, so these risks can be reviewed first
+type RiskConfidence int
+
+const (
+	HighConfidence RiskConfidence = iota
+	MediumConfidence
+	LowConfidence
+)
+
+func RiskConfidenceValues() []TypeEnum {
+	return []TypeEnum{
+		HighConfidence,
+		MediumConfidence,
+		LowConfidence,
+	}
+}
+
+var RiskConfidenceTypeDescription = [...]TypeDescription{
+	{"high", "High, the risk applies as modeled"},
+	{"medium", "Medium, the risk may be caused by an incomplete model"},
+	{"low", "Low, the risk is based on heuristics and needs review"},
+}
+
+func ParseRiskConfidence(value string) (riskConfidence RiskConfidence, err error) {
+	return RiskConfidence(0).Find(value)
+}
+
+func (what RiskConfidence) String() string {
+	// NOTE: maintain list also in schema.json for validation in IDEs
+	return RiskConfidenceTypeDescription[what].Name
+}
+
+func (what RiskConfidence) Explain() string {
+	return RiskConfidenceTypeDescription[what].Description
+}
+
+func (what RiskConfidence) Title() string {
+	return [...]string{"High", "Medium", "Low"}[what]
+}
+
+// Weight orders the confidence levels ascending, as the default high confidence is the zero value
+func (what RiskConfidence) Weight() int {
+	return [...]int{3, 2, 1}[what]
+}
+
+func (what RiskConfidence) Find(value string) (RiskConfidence, error) {
+	if len(value) == 0 {
+		return HighConfidence, nil
+	}
+
+	for index, description := range RiskConfidenceTypeDescription {
+		if strings.EqualFold(value, description.Name) {
+			return RiskConfidence(index), nil
+		}
+	}
+
+	return RiskConfidence(0), fmt.Errorf("unknown risk confidence value %q", value)
+}
+
+func (what RiskConfidence) MarshalJSON() ([]byte, error) {
+	return json.Marshal(what.String())
+}
+
+func (what *RiskConfidence) UnmarshalJSON(data []byte) error {
+	var text string
+	unmarshalError := json.Unmarshal(data, &text)
+	if unmarshalError != nil {
+		return unmarshalError
+	}
+
+	value, findError := what.Find(text)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
+
+func (what RiskConfidence) MarshalYAML() (interface{}, error) {
+	return what.String(), nil
+}
+
+func (what *RiskConfidence) UnmarshalYAML(node *yaml.Node) error {
+	value, findError := what.Find(node.Value)
+	if findError != nil {
+		return findError
+	}
+
+	*what = value
+	return nil
+}
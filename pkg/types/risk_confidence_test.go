@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ParseRiskConfidenceTest struct {
+	input         string
+	expected      RiskConfidence
+	expectedError error
+}
+
+func TestParseRiskConfidence(t *testing.T) {
+	testCases := map[string]ParseRiskConfidenceTest{
+		"high": {
+			input:    "high",
+			expected: HighConfidence,
+		},
+		"medium": {
+			input:    "medium",
+			expected: MediumConfidence,
+		},
+		"low": {
+			input:    "low",
+			expected: LowConfidence,
+		},
+		"default": {
+			input:    "",
+			expected: HighConfidence,
+		},
+		"unknown": {
+			input:         "unknown",
+			expectedError: fmt.Errorf("unknown risk confidence value \"unknown\""),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseRiskConfidence(testCase.input)
+
+			assert.Equal(t, testCase.expected, actual)
+			assert.Equal(t, testCase.expectedError, err)
+		})
+	}
+}
+
+func TestRiskConfidenceWeight(t *testing.T) {
+	assert.Greater(t, HighConfidence.Weight(), MediumConfidence.Weight())
+	assert.Greater(t, MediumConfidence.Weight(), LowConfidence.Weight())
+}
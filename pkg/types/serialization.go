@@ -0,0 +1,92 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// orderSensitiveKeys lists the keys of sequences whose order carries meaning and therefore must not be sorted
+var orderSensitiveKeys = map[string]bool{
+	"includes":           true,
+	"risk_explanation":   true,
+	"rating_explanation": true,
+}
+
+// StabilizeModel serializes the model into YAML with a deterministic key ordering (alphabetical within each struct)
+// and canonical (sorted) ordering of scalar lists, so that re-serializing an unchanged model produces identical output.
+// Fields holding their default (zero) value are omitted.
+func StabilizeModel(model *Model) ([]byte, error) {
+	var node yaml.Node
+	encodeError := node.Encode(model)
+	if encodeError != nil {
+		return nil, fmt.Errorf("unable to encode model: %w", encodeError)
+	}
+
+	return StabilizeYaml(&node)
+}
+
+// StabilizeYaml canonicalizes the given YAML node the same way StabilizeModel does and serializes it.
+func StabilizeYaml(node *yaml.Node) ([]byte, error) {
+	canonicalizeYamlNode(node, "")
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(4)
+	encodeError := encoder.Encode(node)
+	if encodeError != nil {
+		return nil, fmt.Errorf("unable to serialize model: %w", encodeError)
+	}
+
+	closeError := encoder.Close()
+	if closeError != nil {
+		return nil, fmt.Errorf("unable to serialize model: %w", closeError)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func canonicalizeYamlNode(node *yaml.Node, key string) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			canonicalizeYamlNode(child, key)
+		}
+
+	case yaml.MappingNode:
+		pairs := make([][2]*yaml.Node, 0, len(node.Content)/2)
+		for n := 0; n+1 < len(node.Content); n += 2 {
+			canonicalizeYamlNode(node.Content[n+1], node.Content[n].Value)
+			pairs = append(pairs, [2]*yaml.Node{node.Content[n], node.Content[n+1]})
+		}
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return pairs[i][0].Value < pairs[j][0].Value
+		})
+		node.Content = node.Content[:0]
+		for _, pair := range pairs {
+			node.Content = append(node.Content, pair[0], pair[1])
+		}
+
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			canonicalizeYamlNode(child, key)
+		}
+		if orderSensitiveKeys[key] || !isScalarSequence(node) {
+			return
+		}
+		sort.SliceStable(node.Content, func(i, j int) bool {
+			return node.Content[i].Value < node.Content[j].Value
+		})
+	}
+}
+
+func isScalarSequence(node *yaml.Node) bool {
+	for _, child := range node.Content {
+		if child.Kind != yaml.ScalarNode {
+			return false
+		}
+	}
+	return true
+}
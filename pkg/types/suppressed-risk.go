@@ -0,0 +1,22 @@
+package types
+
+import "time"
+
+// SuppressedRisk accepts the risks of a risk category at a technical asset or communication link by design until it expires
+type SuppressedRisk struct {
+	CategoryId    string `json:"category,omitempty" yaml:"category,omitempty"`
+	Justification string `json:"justification,omitempty" yaml:"justification,omitempty"`
+	Expires       Date   `json:"expires,omitempty" yaml:"expires,omitempty"`
+}
+
+// IsExpired checks whether the suppression has expired at the given time; it is valid up to and including the day it expires
+func (what SuppressedRisk) IsExpired(now time.Time) bool {
+	return !now.Before(what.Expires.AddDate(0, 0, 1))
+}
+
+// AcceptedRisk is a generated risk that has been suppressed by design
+type AcceptedRisk struct {
+	Risk          *Risk  `json:"risk,omitempty" yaml:"risk,omitempty"`
+	Justification string `json:"justification,omitempty" yaml:"justification,omitempty"`
+	Expires       Date   `json:"expires,omitempty" yaml:"expires,omitempty"`
+}
@@ -26,6 +26,7 @@ type TechnicalAsset struct {
 	Encryption              EncryptionStyle       `json:"encryption,omitempty" yaml:"encryption,omitempty"`
 	JustificationOutOfScope string                `json:"justification_out_of_scope,omitempty" yaml:"justification_out_of_scope,omitempty"`
 	Owner                   string                `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Location                string                `json:"location,omitempty" yaml:"location,omitempty"`
 	Confidentiality         Confidentiality       `json:"confidentiality,omitempty" yaml:"confidentiality,omitempty"`
 	Integrity               Criticality           `json:"integrity,omitempty" yaml:"integrity,omitempty"`
 	Availability            Criticality           `json:"availability,omitempty" yaml:"availability,omitempty"`
@@ -36,6 +37,7 @@ type TechnicalAsset struct {
 	DataFormatsAccepted     []DataFormat          `json:"data_formats_accepted,omitempty" yaml:"data_formats_accepted,omitempty"`
 	CommunicationLinks      []*CommunicationLink  `json:"communication_links,omitempty" yaml:"communication_links,omitempty"`
 	DiagramTweakOrder       int                   `json:"diagram_tweak_order,omitempty" yaml:"diagram_tweak_order,omitempty"`
+	SuppressedRisks         []*SuppressedRisk     `json:"suppressed_risks,omitempty" yaml:"suppressed_risks,omitempty"`
 	RAA                     float64               `json:"raa,omitempty" yaml:"raa,omitempty"` // will be set by separate calculation step
 }
 
@@ -19,6 +19,7 @@ const (
 	Virtual
 	Container
 	Serverless
+	Embedded
 )
 
 func TechnicalAssetMachineValues() []TypeEnum {
@@ -27,6 +28,7 @@ func TechnicalAssetMachineValues() []TypeEnum {
 		Virtual,
 		Container,
 		Serverless,
+		Embedded,
 	}
 }
 
@@ -35,6 +37,7 @@ var TechnicalAssetMachineTypeDescription = [...]TypeDescription{
 	{"virtual", "A virtual machine"},
 	{"container", "A container"},
 	{"serverless", "A serverless application"},
+	{"embedded", "An embedded device (like an IoT or edge device)"},
 }
 
 func ParseTechnicalAssetMachine(value string) (technicalAssetMachine TechnicalAssetMachine, err error) {
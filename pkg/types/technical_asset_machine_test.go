@@ -35,6 +35,10 @@ func TestParseTechnicalAssetMachine(t *testing.T) {
 			input:    "serverless",
 			expected: Serverless,
 		},
+		"embedded": {
+			input:    "embedded",
+			expected: Embedded,
+		},
 		"unknown": {
 			input:         "unknown",
 			expectedError: fmt.Errorf("unable to parse into type: unknown"),
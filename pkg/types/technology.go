@@ -6,6 +6,7 @@ const (
 	AI                     = "ai"
 	ApplicationServer      = "application-server"
 	ArtifactRegistry       = "artifact-registry"
+	BackupStorage          = "backup-storage"
 	BatchProcessing        = "batch-processing"
 	BigDataPlatform        = "big-data-platform"
 	BlockStorage           = "block-storage"
@@ -26,6 +26,7 @@ func GetBuiltinTypeValues(cfg technologyMapConfigReader) map[string][]TypeEnum {
 		"Encryption":                                   EncryptionStyleValues(),
 		"Protocol":                                     ProtocolValues(),
 		"Quantity":                                     QuantityValues(),
+		"Risk Confidence":                              RiskConfidenceValues(),
 		"Risk Exploitation Impact":                     RiskExploitationImpactValues(),
 		"Risk Exploitation Likelihood":                 RiskExploitationLikelihoodValues(),
 		"Risk Function":                                RiskFunctionValues(),
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+type ValidationSeverity int
+
+const (
+	ValidationSeverityWarning ValidationSeverity = iota
+	ValidationSeverityError
+)
+
+func (what ValidationSeverity) String() string {
+	if what == ValidationSeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+type ValidationWarning struct {
+	Severity        ValidationSeverity
+	TrustBoundaryId string
+	Message         string
+}
+
+func (what ValidationWarning) String() string {
+	return fmt.Sprintf("%v: trust boundary %q: %v", what.Severity, what.TrustBoundaryId, what.Message)
+}
+
+// ValidateTrustBoundaryTypes checks the nesting of trust boundaries for type combinations which make no sense
+// (reported as errors) or are unusual and therefore likely a modeling error (reported as warnings).
+func ValidateTrustBoundaryTypes(model *Model) []ValidationWarning {
+	ids := make([]string, 0)
+	for id := range model.TrustBoundaries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := make([]ValidationWarning, 0)
+	for _, id := range ids {
+		parent := model.TrustBoundaries[id]
+		for _, nestedId := range parent.TrustBoundariesNested {
+			nested, ok := model.TrustBoundaries[nestedId]
+			if !ok {
+				continue
+			}
+
+			switch {
+			case parent.Type == ExecutionEnvironment && nested.Type.IsNetworkBoundary():
+				result = append(result, ValidationWarning{
+					Severity:        ValidationSeverityError,
+					TrustBoundaryId: nested.Id,
+					Message: fmt.Sprintf("network trust boundary of type %v is nested inside execution environment %q",
+						nested.Type, parent.Id),
+				})
+			case parent.Type == ExecutionEnvironment && nested.Type == ExecutionEnvironment:
+				result = append(result, ValidationWarning{
+					Severity:        ValidationSeverityWarning,
+					TrustBoundaryId: nested.Id,
+					Message:         fmt.Sprintf("execution environment is nested inside execution environment %q", parent.Id),
+				})
+			case parent.Type == NetworkCloudProvider && nested.Type == NetworkOnPrem && len(nested.Description) == 0:
+				result = append(result, ValidationWarning{
+					Severity:        ValidationSeverityWarning,
+					TrustBoundaryId: nested.Id,
+					Message: fmt.Sprintf("on-prem network is nested inside cloud provider network %q without a description explaining it",
+						parent.Id),
+				})
+			}
+		}
+	}
+	return result
+}
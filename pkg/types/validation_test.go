@@ -0,0 +1,94 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ValidateTrustBoundaryTypesTest struct {
+	parentType        TrustBoundaryType
+	nestedType        TrustBoundaryType
+	nestedDescription string
+
+	expected []ValidationWarning
+}
+
+func TestValidateTrustBoundaryTypes(t *testing.T) {
+	testCases := map[string]ValidateTrustBoundaryTypesTest{
+		"execution environment inside network": {
+			parentType: NetworkCloudProvider,
+			nestedType: ExecutionEnvironment,
+			expected:   []ValidationWarning{},
+		},
+		"security group inside cloud provider": {
+			parentType: NetworkCloudProvider,
+			nestedType: NetworkCloudSecurityGroup,
+			expected:   []ValidationWarning{},
+		},
+		"execution environment inside execution environment": {
+			parentType: ExecutionEnvironment,
+			nestedType: ExecutionEnvironment,
+			expected: []ValidationWarning{{
+				Severity:        ValidationSeverityWarning,
+				TrustBoundaryId: "nested",
+				Message:         `execution environment is nested inside execution environment "parent"`,
+			}},
+		},
+		"network inside execution environment": {
+			parentType: ExecutionEnvironment,
+			nestedType: NetworkVirtualLAN,
+			expected: []ValidationWarning{{
+				Severity:        ValidationSeverityError,
+				TrustBoundaryId: "nested",
+				Message:         `network trust boundary of type network-virtual-lan is nested inside execution environment "parent"`,
+			}},
+		},
+		"on-prem inside cloud provider without description": {
+			parentType: NetworkCloudProvider,
+			nestedType: NetworkOnPrem,
+			expected: []ValidationWarning{{
+				Severity:        ValidationSeverityWarning,
+				TrustBoundaryId: "nested",
+				Message:         `on-prem network is nested inside cloud provider network "parent" without a description explaining it`,
+			}},
+		},
+		"on-prem inside cloud provider with description": {
+			parentType:        NetworkCloudProvider,
+			nestedType:        NetworkOnPrem,
+			nestedDescription: "Outpost rack located on prem",
+			expected:          []ValidationWarning{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := &Model{
+				TrustBoundaries: map[string]*TrustBoundary{
+					"parent": {
+						Id:                    "parent",
+						Type:                  testCase.parentType,
+						TrustBoundariesNested: []string{"nested"},
+					},
+					"nested": {
+						Id:          "nested",
+						Type:        testCase.nestedType,
+						Description: testCase.nestedDescription,
+					},
+				},
+			}
+
+			assert.Equal(t, testCase.expected, ValidateTrustBoundaryTypes(model))
+		})
+	}
+}
+
+func TestValidationWarningString(t *testing.T) {
+	warning := ValidationWarning{
+		Severity:        ValidationSeverityError,
+		TrustBoundaryId: "nested",
+		Message:         "some message",
+	}
+
+	assert.Equal(t, `error: trust boundary "nested": some message`, warning.String())
+}